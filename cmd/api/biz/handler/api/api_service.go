@@ -22,6 +22,7 @@ import (
 	conf "github.com/Yra-A/Fusion_Go/pkg/configs/oss"
 	"github.com/Yra-A/Fusion_Go/pkg/constants"
 	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"github.com/Yra-A/Fusion_Go/pkg/events"
 	"github.com/Yra-A/Fusion_Go/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -257,6 +258,32 @@ func ContestList(ctx context.Context, c *app.RequestContext) {
 	handler.SendResponse(c, resp)
 }
 
+// ContestRecommend .
+// @router /fusion/contest/recommend [GET]
+func ContestRecommend(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ContestRecommendRequest
+	if err = c.BindAndValidate(&req); err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	kresp, err := rpc.ContestRecommend(context.Background(), &contest.ContestRecommendRequest{
+		UserId: req.UserID,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.ContestRecommendResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.Total = kresp.Total
+	resp.ContestList = utils.ConvertBriefInfoToAPI(kresp.ContestList)
+	handler.SendResponse(c, resp)
+}
+
 // ContestInfo .
 // @router /fusion/contest/info/{contest_id} [GET]
 func ContestInfo(ctx context.Context, c *app.RequestContext) {
@@ -372,10 +399,12 @@ func TeamList(ctx context.Context, c *app.RequestContext) {
 	}
 
 	kresp, err := rpc.TeamList(context.Background(), &team.TeamListRequest{
-		ContestId: req.ContestID,
-		Limit:     req.Limit,
-		Offset:    req.Offset,
-		UserId:    req.UserID,
+		ContestId:          req.ContestID,
+		Limit:              req.Limit,
+		Offset:             req.Offset,
+		UserId:             req.UserID,
+		WithRecommendation: req.WithRecommendation,
+		Cursor:             req.Cursor,
 	})
 	if err != nil {
 		handler.BadResponse(c, err)
@@ -386,6 +415,9 @@ func TeamList(ctx context.Context, c *app.RequestContext) {
 	resp.StatusMsg = kresp.StatusMsg
 	resp.Total = kresp.Total
 	resp.TeamList = utils.ConvertTeamBriefInfoListToAPI(kresp.TeamList)
+	resp.Experiment = kresp.Experiment
+	resp.Strategy = kresp.Strategy
+	resp.NextCursor = kresp.NextCursor
 
 	handler.SendResponse(c, resp)
 }
@@ -415,6 +447,17 @@ func TeamInfo(ctx context.Context, c *app.RequestContext) {
 	resp.StatusMsg = kresp.StatusMsg
 	if kresp.TeamInfo != nil {
 		resp.TeamInfo = utils.ConverTeamInfoToAPI(kresp.TeamInfo)
+		// 记录用户查看队伍详情
+		if user_id, ok := c.Get(constants.IdentityKey); ok {
+			if id, ok := user_id.(float64); ok {
+				events.Record(&events.Event{
+					Type:      events.TypeDetailView,
+					UserID:    int32(id),
+					ContestID: req.ContestID,
+					TeamID:    req.TeamID,
+				})
+			}
+		}
 	}
 
 	handler.SendResponse(c, resp)
@@ -449,6 +492,98 @@ func TeamApplicationSubmit(ctx context.Context, c *app.RequestContext) {
 	handler.SendResponse(c, resp)
 }
 
+// TeamApplicationWithdraw .
+// @router /fusion/team/application/withdraw [POST]
+func TeamApplicationWithdraw(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamApplicationWithdrawRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamApplicationWithdraw(context.Background(), &team.TeamApplicationWithdrawRequest{
+		UserId:        req.UserID,
+		ApplicationId: req.ApplicationID,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamApplicationWithdrawResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// MyApplications .
+// @router /fusion/team/my/applications [GET]
+func MyApplications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MyApplicationsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.MyApplications(context.Background(), &team.MyApplicationsRequest{
+		UserId: req.UserID,
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	resp := new(api.MyApplicationsResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.Total = kresp.Total
+	if kresp.ApplicationList != nil {
+		resp.ApplicationList = utils.ConvertMyApplicationListToAPI(kresp.ApplicationList)
+	}
+
+	handler.SendResponse(c, resp)
+}
+
+// MyTeamList .
+// @router /fusion/team/my/list [GET]
+func MyTeamList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MyTeamListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.MyTeamList(context.Background(), &team.MyTeamListRequest{
+		UserId: req.UserID,
+		Role:   req.Role,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	resp := new(api.MyTeamListResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.Total = kresp.Total
+	if kresp.TeamList != nil {
+		resp.TeamList = utils.ConvertMyTeamListToAPI(kresp.TeamList)
+	}
+
+	handler.SendResponse(c, resp)
+}
+
 // TeamManageList .
 // @router /fusion/team/manage/list/ [GET]
 func TeamManageList(ctx context.Context, c *app.RequestContext) {
@@ -479,6 +614,289 @@ func TeamManageList(ctx context.Context, c *app.RequestContext) {
 	handler.SendResponse(c, resp)
 }
 
+// TeamLeave .
+// @router /fusion/team/leave [POST]
+func TeamLeave(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamLeaveRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamLeave(context.Background(), &team.TeamLeaveRequest{
+		UserId: req.UserID,
+		TeamId: req.TeamID,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamLeaveResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamMemberRemove .
+// @router /fusion/team/manage/remove [POST]
+func TeamMemberRemove(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamMemberRemoveRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamMemberRemove(context.Background(), &team.TeamMemberRemoveRequest{
+		UserId:   req.UserID,
+		TeamId:   req.TeamID,
+		MemberId: req.MemberID,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamMemberRemoveResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamLeaderTransfer .
+// @router /fusion/team/manage/transfer [POST]
+func TeamLeaderTransfer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamLeaderTransferRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamLeaderTransfer(context.Background(), &team.TeamLeaderTransferRequest{
+		UserId:   req.UserID,
+		TeamId:   req.TeamID,
+		MemberId: req.MemberID,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamLeaderTransferResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamClose .
+// @router /fusion/team/manage/close [POST]
+func TeamClose(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamCloseRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamClose(context.Background(), &team.TeamCloseRequest{
+		UserId: req.UserID,
+		TeamId: req.TeamID,
+		Closed: req.Closed,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamCloseResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamDisband .
+// @router /fusion/team/manage/disband [POST]
+func TeamDisband(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamDisbandRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamDisband(context.Background(), &team.TeamDisbandRequest{
+		UserId: req.UserID,
+		TeamId: req.TeamID,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamDisbandResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamInvitationSend .
+// @router /fusion/team/invitation/send [POST]
+func TeamInvitationSend(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamInvitationSendRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamInvitationSend(context.Background(), &team.TeamInvitationSendRequest{
+		UserId:    req.UserID,
+		TeamId:    req.TeamID,
+		InviteeId: req.InviteeID,
+		Message:   req.Message,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamInvitationSendResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.InvitationID = kresp.InvitationId
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamInvitationRespond .
+// @router /fusion/team/invitation/respond [POST]
+func TeamInvitationRespond(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamInvitationRespondRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamInvitationRespond(context.Background(), &team.TeamInvitationRespondRequest{
+		UserId:       req.UserID,
+		InvitationId: req.InvitationID,
+		ActionType:   req.ActionType,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+	resp := new(api.TeamInvitationRespondResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamInvitationList .
+// @router /fusion/team/invitation/list [GET]
+func TeamInvitationList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamInvitationListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamInvitationList(context.Background(), &team.TeamInvitationListRequest{
+		UserId: req.UserID,
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	resp := new(api.TeamInvitationListResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.Total = kresp.Total
+	if kresp.InvitationList != nil {
+		resp.InvitationList = utils.ConvertInvitationListToAPI(kresp.InvitationList)
+	}
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamSentInvitationList .
+// @router /fusion/team/invitation/sent [GET]
+func TeamSentInvitationList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamSentInvitationListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamSentInvitationList(context.Background(), &team.TeamSentInvitationListRequest{
+		UserId: req.UserID,
+		TeamId: req.TeamID,
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	resp := new(api.TeamSentInvitationListResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.Total = kresp.Total
+	if kresp.InvitationList != nil {
+		resp.InvitationList = utils.ConvertInvitationListToAPI(kresp.InvitationList)
+	}
+
+	handler.SendResponse(c, resp)
+}
+
+// TeamInvitationCancel .
+// @router /fusion/team/invitation/cancel [POST]
+func TeamInvitationCancel(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamInvitationCancelRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamInvitationCancel(context.Background(), &team.TeamInvitationCancelRequest{
+		UserId:       req.UserID,
+		InvitationId: req.InvitationID,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	resp := new(api.TeamInvitationCancelResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+
+	handler.SendResponse(c, resp)
+}
+
 // TeamManageAction .
 // @router /fusion/team/manage/action/ [POST]
 func TeamManageAction(ctx context.Context, c *app.RequestContext) {
@@ -508,6 +926,35 @@ func TeamManageAction(ctx context.Context, c *app.RequestContext) {
 	handler.SendResponse(c, resp)
 }
 
+// TeamCandidates .
+// @router /fusion/team/manage/candidates [GET]
+func TeamCandidates(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TeamCandidatesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	kresp, err := rpc.TeamCandidates(context.Background(), &team.TeamCandidatesRequest{
+		UserId: req.UserID,
+		TeamId: req.TeamID,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		handler.BadResponse(c, err)
+		return
+	}
+
+	resp := new(api.TeamCandidatesResponse)
+	resp.StatusCode = kresp.StatusCode
+	resp.StatusMsg = kresp.StatusMsg
+	resp.JobCandidates = utils.ConvertJobCandidatesListToAPI(kresp.JobCandidates)
+
+	handler.SendResponse(c, resp)
+}
+
 // ContestFavoriteAction .
 // @router /fusion/favorite/contest/action/ [POST]
 func ContestFavoriteAction(ctx context.Context, c *app.RequestContext) {
@@ -6204,29 +6204,41 @@ func (p *ContestListResponse) String() string {
 	return fmt.Sprintf("ContestListResponse(%+v)", *p)
 }
 
-type ContestInfoRequest struct {
-	ContestID int32 `thrift:"contest_id,1" json:"contest_id" path:"contest_id"`
-	UserID    int32 `thrift:"user_id,2" json:"user_id" query:"user_id"`
+type ContestRecommendRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" json:"user_id" query:"user_id"`
+	Limit         int32  `thrift:"limit,3" json:"limit" query:"limit"`
+	Offset        int32  `thrift:"offset,4" json:"offset" query:"offset"`
 }
 
-func NewContestInfoRequest() *ContestInfoRequest {
-	return &ContestInfoRequest{}
+func NewContestRecommendRequest() *ContestRecommendRequest {
+	return &ContestRecommendRequest{}
 }
 
-func (p *ContestInfoRequest) GetContestID() (v int32) {
-	return p.ContestID
+func (p *ContestRecommendRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func (p *ContestInfoRequest) GetUserID() (v int32) {
+func (p *ContestRecommendRequest) GetUserID() (v int32) {
 	return p.UserID
 }
 
-var fieldIDToName_ContestInfoRequest = map[int16]string{
-	1: "contest_id",
+func (p *ContestRecommendRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *ContestRecommendRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+
+var fieldIDToName_ContestRecommendRequest = map[int16]string{
+	1: "authorization",
 	2: "user_id",
+	3: "limit",
+	4: "offset",
 }
 
-func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6246,7 +6258,7 @@ func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6265,6 +6277,26 @@ func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6285,7 +6317,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestRecommendRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6295,16 +6327,16 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *ContestRecommendRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ContestID = v
+		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *ContestInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestRecommendRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -6313,9 +6345,27 @@ func (p *ContestInfoRequest) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *ContestRecommendRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *ContestRecommendRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ContestRecommendRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6327,6 +6377,14 @@ func (p *ContestInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -6346,11 +6404,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
+func (p *ContestRecommendRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
+	if err := oprot.WriteString(p.Authorization); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6363,7 +6421,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6380,51 +6438,83 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) String() string {
+func (p *ContestRecommendRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestRecommendRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ContestRecommendRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestInfoRequest(%+v)", *p)
+	return fmt.Sprintf("ContestRecommendRequest(%+v)", *p)
 }
 
-type ContestInfoResponse struct {
-	StatusCode int32    `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string   `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	Contest    *Contest `thrift:"contest,3" form:"contest" json:"contest" query:"contest"`
+type ContestRecommendResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total      int32  `thrift:"total,3" form:"total" json:"total" query:"total"`
+	// 按推荐分数降序排列
+	ContestList []*ContestBriefInfo `thrift:"contest_list,4" form:"contest_list" json:"contest_list" query:"contest_list"`
 }
 
-func NewContestInfoResponse() *ContestInfoResponse {
-	return &ContestInfoResponse{}
+func NewContestRecommendResponse() *ContestRecommendResponse {
+	return &ContestRecommendResponse{}
 }
 
-func (p *ContestInfoResponse) GetStatusCode() (v int32) {
+func (p *ContestRecommendResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ContestInfoResponse) GetStatusMsg() (v string) {
+func (p *ContestRecommendResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-var ContestInfoResponse_Contest_DEFAULT *Contest
+func (p *ContestRecommendResponse) GetTotal() (v int32) {
+	return p.Total
+}
 
-func (p *ContestInfoResponse) GetContest() (v *Contest) {
-	if !p.IsSetContest() {
-		return ContestInfoResponse_Contest_DEFAULT
-	}
-	return p.Contest
+func (p *ContestRecommendResponse) GetContestList() (v []*ContestBriefInfo) {
+	return p.ContestList
 }
 
-var fieldIDToName_ContestInfoResponse = map[int16]string{
+var fieldIDToName_ContestRecommendResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "contest",
-}
-
-func (p *ContestInfoResponse) IsSetContest() bool {
-	return p.Contest != nil
+	3: "total",
+	4: "contest_list",
 }
 
-func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6464,7 +6554,7 @@ func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6473,6 +6563,16 @@ func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6493,7 +6593,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestRecommendResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6503,7 +6603,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContestRecommendResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -6512,7 +6612,7 @@ func (p *ContestInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestRecommendResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -6521,17 +6621,38 @@ func (p *ContestInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestInfoResponse) ReadField3(iprot thrift.TProtocol) error {
-	p.Contest = NewContest()
-	if err := p.Contest.Read(iprot); err != nil {
+func (p *ContestRecommendResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.Total = v
 	}
 	return nil
 }
 
-func (p *ContestInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ContestList = make([]*ContestBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewContestBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ContestList = append(p.ContestList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestRecommendResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ContestRecommendResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6547,6 +6668,10 @@ func (p *ContestInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -6566,7 +6691,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6583,7 +6708,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6600,11 +6725,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 3); err != nil {
+func (p *ContestRecommendResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Contest.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.Total); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6617,39 +6742,61 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) String() string {
+func (p *ContestRecommendResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
+		return err
+	}
+	for _, v := range p.ContestList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ContestRecommendResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestInfoResponse(%+v)", *p)
+	return fmt.Sprintf("ContestRecommendResponse(%+v)", *p)
 }
 
-type ContestCreateRequest struct {
-	Contest *Contest `thrift:"contest,1" form:"contest" json:"contest" query:"contest"`
+type ContestInfoRequest struct {
+	ContestID int32 `thrift:"contest_id,1" json:"contest_id" path:"contest_id"`
+	UserID    int32 `thrift:"user_id,2" json:"user_id" query:"user_id"`
 }
 
-func NewContestCreateRequest() *ContestCreateRequest {
-	return &ContestCreateRequest{}
+func NewContestInfoRequest() *ContestInfoRequest {
+	return &ContestInfoRequest{}
 }
 
-var ContestCreateRequest_Contest_DEFAULT *Contest
-
-func (p *ContestCreateRequest) GetContest() (v *Contest) {
-	if !p.IsSetContest() {
-		return ContestCreateRequest_Contest_DEFAULT
-	}
-	return p.Contest
+func (p *ContestInfoRequest) GetContestID() (v int32) {
+	return p.ContestID
 }
 
-var fieldIDToName_ContestCreateRequest = map[int16]string{
-	1: "contest",
+func (p *ContestInfoRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func (p *ContestCreateRequest) IsSetContest() bool {
-	return p.Contest != nil
+var fieldIDToName_ContestInfoRequest = map[int16]string{
+	1: "contest_id",
+	2: "user_id",
 }
 
-func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6669,7 +6816,7 @@ func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6678,6 +6825,16 @@ func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6698,7 +6855,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6708,17 +6865,27 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestCreateRequest) ReadField1(iprot thrift.TProtocol) error {
-	p.Contest = NewContest()
-	if err := p.Contest.Read(iprot); err != nil {
+func (p *ContestInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.ContestID = v
 	}
 	return nil
 }
 
-func (p *ContestCreateRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *ContestInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ContestInfoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6726,6 +6893,10 @@ func (p *ContestCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -6745,11 +6916,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 1); err != nil {
+func (p *ContestInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Contest.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.ContestID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6762,42 +6933,68 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestCreateRequest) String() string {
+func (p *ContestInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestInfoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestCreateRequest(%+v)", *p)
+	return fmt.Sprintf("ContestInfoRequest(%+v)", *p)
 }
 
-type ContestCreateResponse struct {
-	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	ContestID  int32  `thrift:"contest_id,3" form:"contest_id" json:"contest_id" query:"contest_id"`
+type ContestInfoResponse struct {
+	StatusCode int32    `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string   `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Contest    *Contest `thrift:"contest,3" form:"contest" json:"contest" query:"contest"`
 }
 
-func NewContestCreateResponse() *ContestCreateResponse {
-	return &ContestCreateResponse{}
+func NewContestInfoResponse() *ContestInfoResponse {
+	return &ContestInfoResponse{}
 }
 
-func (p *ContestCreateResponse) GetStatusCode() (v int32) {
+func (p *ContestInfoResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ContestCreateResponse) GetStatusMsg() (v string) {
+func (p *ContestInfoResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-func (p *ContestCreateResponse) GetContestID() (v int32) {
-	return p.ContestID
+var ContestInfoResponse_Contest_DEFAULT *Contest
+
+func (p *ContestInfoResponse) GetContest() (v *Contest) {
+	if !p.IsSetContest() {
+		return ContestInfoResponse_Contest_DEFAULT
+	}
+	return p.Contest
 }
 
-var fieldIDToName_ContestCreateResponse = map[int16]string{
+var fieldIDToName_ContestInfoResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "contest_id",
+	3: "contest",
 }
 
-func (p *ContestCreateResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) IsSetContest() bool {
+	return p.Contest != nil
+}
+
+func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6837,7 +7034,7 @@ func (p *ContestCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6866,7 +7063,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6876,7 +7073,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContestInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -6885,7 +7082,7 @@ func (p *ContestCreateResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -6894,18 +7091,17 @@ func (p *ContestCreateResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestCreateResponse) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *ContestInfoResponse) ReadField3(iprot thrift.TProtocol) error {
+	p.Contest = NewContest()
+	if err := p.Contest.Read(iprot); err != nil {
 		return err
-	} else {
-		p.ContestID = v
 	}
 	return nil
 }
 
-func (p *ContestCreateResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreateResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ContestInfoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6940,7 +7136,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6957,7 +7153,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6974,11 +7170,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
+func (p *ContestInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
+	if err := p.Contest.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6991,37 +7187,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) String() string {
+func (p *ContestInfoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestCreateResponse(%+v)", *p)
+	return fmt.Sprintf("ContestInfoResponse(%+v)", *p)
 }
 
-/* =========================== utils =========================== */
-type ImageUploadRequest struct {
-	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	File          []byte `thrift:"file,2" form:"file" json:"file"`
+type ContestCreateRequest struct {
+	Contest *Contest `thrift:"contest,1" form:"contest" json:"contest" query:"contest"`
 }
 
-func NewImageUploadRequest() *ImageUploadRequest {
-	return &ImageUploadRequest{}
+func NewContestCreateRequest() *ContestCreateRequest {
+	return &ContestCreateRequest{}
 }
 
-func (p *ImageUploadRequest) GetAuthorization() (v string) {
-	return p.Authorization
+var ContestCreateRequest_Contest_DEFAULT *Contest
+
+func (p *ContestCreateRequest) GetContest() (v *Contest) {
+	if !p.IsSetContest() {
+		return ContestCreateRequest_Contest_DEFAULT
+	}
+	return p.Contest
 }
 
-func (p *ImageUploadRequest) GetFile() (v []byte) {
-	return p.File
+var fieldIDToName_ContestCreateRequest = map[int16]string{
+	1: "contest",
 }
 
-var fieldIDToName_ImageUploadRequest = map[int16]string{
-	1: "authorization",
-	2: "file",
+func (p *ContestCreateRequest) IsSetContest() bool {
+	return p.Contest != nil
 }
 
-func (p *ImageUploadRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7041,7 +7239,7 @@ func (p *ImageUploadRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7050,16 +7248,6 @@ func (p *ImageUploadRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7080,7 +7268,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageUploadRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7090,27 +7278,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ImageUploadRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Authorization = v
-	}
-	return nil
-}
-
-func (p *ImageUploadRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
+func (p *ContestCreateRequest) ReadField1(iprot thrift.TProtocol) error {
+	p.Contest = NewContest()
+	if err := p.Contest.Read(iprot); err != nil {
 		return err
-	} else {
-		p.File = []byte(v)
 	}
 	return nil
 }
 
-func (p *ImageUploadRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImageUploadRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ContestCreateRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7118,10 +7296,6 @@ func (p *ImageUploadRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -7141,11 +7315,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ImageUploadRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+func (p *ContestCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Authorization); err != nil {
+	if err := p.Contest.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7158,59 +7332,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ImageUploadRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("file", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBinary([]byte(p.File)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *ImageUploadRequest) String() string {
+func (p *ContestCreateRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ImageUploadRequest(%+v)", *p)
+	return fmt.Sprintf("ContestCreateRequest(%+v)", *p)
 }
 
-type ImageUploadResponse struct {
+type ContestCreateResponse struct {
 	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
 	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	ImageURL   string `thrift:"image_url,3" form:"image_url" json:"image_url" query:"image_url"`
+	ContestID  int32  `thrift:"contest_id,3" form:"contest_id" json:"contest_id" query:"contest_id"`
 }
 
-func NewImageUploadResponse() *ImageUploadResponse {
-	return &ImageUploadResponse{}
+func NewContestCreateResponse() *ContestCreateResponse {
+	return &ContestCreateResponse{}
 }
 
-func (p *ImageUploadResponse) GetStatusCode() (v int32) {
+func (p *ContestCreateResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ImageUploadResponse) GetStatusMsg() (v string) {
+func (p *ContestCreateResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-func (p *ImageUploadResponse) GetImageURL() (v string) {
-	return p.ImageURL
+func (p *ContestCreateResponse) GetContestID() (v int32) {
+	return p.ContestID
 }
 
-var fieldIDToName_ImageUploadResponse = map[int16]string{
+var fieldIDToName_ContestCreateResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "image_url",
+	3: "contest_id",
 }
 
-func (p *ImageUploadResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7250,7 +7407,7 @@ func (p *ImageUploadResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7279,7 +7436,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageUploadResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7289,7 +7446,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ImageUploadResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContestCreateResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -7298,7 +7455,7 @@ func (p *ImageUploadResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ImageUploadResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestCreateResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -7307,18 +7464,18 @@ func (p *ImageUploadResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ImageUploadResponse) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ContestCreateResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ImageURL = v
+		p.ContestID = v
 	}
 	return nil
 }
 
-func (p *ImageUploadResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestCreateResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImageUploadResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ContestCreateResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7353,7 +7510,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ImageUploadResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7370,7 +7527,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ImageUploadResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ContestCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7387,11 +7544,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ImageUploadResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("image_url", thrift.STRING, 3); err != nil {
+func (p *ContestCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ImageURL); err != nil {
+	if err := oprot.WriteI32(p.ContestID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7404,67 +7561,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ImageUploadResponse) String() string {
+func (p *ContestCreateResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ImageUploadResponse(%+v)", *p)
-}
-
-/* =========================== team =========================== */
-type MemberInfo struct {
-	UserID         int32    `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
-	Nickname       string   `thrift:"nickname,2" form:"nickname" json:"nickname" query:"nickname"`
-	College        string   `thrift:"college,3" form:"college" json:"college" query:"college"`
-	AvatarURL      string   `thrift:"avatar_url,4" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
-	Gender         int32    `thrift:"gender,5" form:"gender" json:"gender" query:"gender"`
-	EnrollmentYear int32    `thrift:"enrollment_year,6" form:"enrollment_year" json:"enrollment_year" query:"enrollment_year"`
-	Honors         []string `thrift:"honors,7" form:"honors" json:"honors" query:"honors"`
-}
-
-func NewMemberInfo() *MemberInfo {
-	return &MemberInfo{}
-}
-
-func (p *MemberInfo) GetUserID() (v int32) {
-	return p.UserID
-}
-
-func (p *MemberInfo) GetNickname() (v string) {
-	return p.Nickname
-}
-
-func (p *MemberInfo) GetCollege() (v string) {
-	return p.College
+	return fmt.Sprintf("ContestCreateResponse(%+v)", *p)
 }
 
-func (p *MemberInfo) GetAvatarURL() (v string) {
-	return p.AvatarURL
+/* =========================== utils =========================== */
+type ImageUploadRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	File          []byte `thrift:"file,2" form:"file" json:"file"`
 }
 
-func (p *MemberInfo) GetGender() (v int32) {
-	return p.Gender
+func NewImageUploadRequest() *ImageUploadRequest {
+	return &ImageUploadRequest{}
 }
 
-func (p *MemberInfo) GetEnrollmentYear() (v int32) {
-	return p.EnrollmentYear
+func (p *ImageUploadRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func (p *MemberInfo) GetHonors() (v []string) {
-	return p.Honors
+func (p *ImageUploadRequest) GetFile() (v []byte) {
+	return p.File
 }
 
-var fieldIDToName_MemberInfo = map[int16]string{
-	1: "user_id",
-	2: "nickname",
-	3: "college",
-	4: "avatar_url",
-	5: "gender",
-	6: "enrollment_year",
-	7: "honors",
+var fieldIDToName_ImageUploadRequest = map[int16]string{
+	1: "authorization",
+	2: "file",
 }
 
-func (p *MemberInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *ImageUploadRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7484,7 +7611,7 @@ func (p *MemberInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7503,56 +7630,6 @@ func (p *MemberInfo) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7573,7 +7650,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MemberInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageUploadRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7583,85 +7660,27 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MemberInfo) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.UserID = v
-	}
-	return nil
-}
-
-func (p *MemberInfo) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Nickname = v
-	}
-	return nil
-}
-
-func (p *MemberInfo) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.College = v
-	}
-	return nil
-}
-
-func (p *MemberInfo) ReadField4(iprot thrift.TProtocol) error {
+func (p *ImageUploadRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.AvatarURL = v
-	}
-	return nil
-}
-
-func (p *MemberInfo) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.Gender = v
+		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *MemberInfo) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *ImageUploadRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return err
 	} else {
-		p.EnrollmentYear = v
-	}
-	return nil
-}
-
-func (p *MemberInfo) ReadField7(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	p.Honors = make([]string, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		p.Honors = append(p.Honors, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+		p.File = []byte(v)
 	}
 	return nil
 }
 
-func (p *MemberInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *ImageUploadRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("MemberInfo"); err != nil {
+	if err = oprot.WriteStructBegin("ImageUploadRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7673,26 +7692,6 @@ func (p *MemberInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -7712,11 +7711,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MemberInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+func (p *ImageUploadRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteString(p.Authorization); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7729,11 +7728,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *MemberInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("nickname", thrift.STRING, 2); err != nil {
+func (p *ImageUploadRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Nickname); err != nil {
+	if err := oprot.WriteBinary([]byte(p.File)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7746,168 +7745,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *MemberInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("college", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.College); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *MemberInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AvatarURL); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *MemberInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("gender", thrift.I32, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.Gender); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *MemberInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("enrollment_year", thrift.I32, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.EnrollmentYear); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *MemberInfo) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("honors", thrift.LIST, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Honors)); err != nil {
-		return err
-	}
-	for _, v := range p.Honors {
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *MemberInfo) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("MemberInfo(%+v)", *p)
-}
-
-type TeamBriefInfo struct {
-	TeamID       int32       `thrift:"team_id,1" form:"team_id" json:"team_id" query:"team_id"`
-	Title        string      `thrift:"title,2" form:"title" json:"title" query:"title"`
-	Goal         string      `thrift:"goal,3" form:"goal" json:"goal" query:"goal"`
-	CurPeopleNum int32       `thrift:"cur_people_num,4" form:"cur_people_num" json:"cur_people_num" query:"cur_people_num"`
-	CreatedTime  int64       `thrift:"created_time,5" form:"created_time" json:"created_time" query:"created_time"`
-	LeaderInfo   *MemberInfo `thrift:"leader_info,6" form:"leader_info" json:"leader_info" query:"leader_info"`
-	ContestID    int32       `thrift:"contest_id,7" form:"contest_id" json:"contest_id" query:"contest_id"`
-}
-
-func NewTeamBriefInfo() *TeamBriefInfo {
-	return &TeamBriefInfo{}
-}
-
-func (p *TeamBriefInfo) GetTeamID() (v int32) {
-	return p.TeamID
-}
-
-func (p *TeamBriefInfo) GetTitle() (v string) {
-	return p.Title
-}
-
-func (p *TeamBriefInfo) GetGoal() (v string) {
-	return p.Goal
+	return fmt.Sprintf("ImageUploadRequest(%+v)", *p)
 }
 
-func (p *TeamBriefInfo) GetCurPeopleNum() (v int32) {
-	return p.CurPeopleNum
+type ImageUploadResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	ImageURL   string `thrift:"image_url,3" form:"image_url" json:"image_url" query:"image_url"`
 }
 
-func (p *TeamBriefInfo) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func NewImageUploadResponse() *ImageUploadResponse {
+	return &ImageUploadResponse{}
 }
 
-var TeamBriefInfo_LeaderInfo_DEFAULT *MemberInfo
-
-func (p *TeamBriefInfo) GetLeaderInfo() (v *MemberInfo) {
-	if !p.IsSetLeaderInfo() {
-		return TeamBriefInfo_LeaderInfo_DEFAULT
-	}
-	return p.LeaderInfo
+func (p *ImageUploadResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func (p *TeamBriefInfo) GetContestID() (v int32) {
-	return p.ContestID
+func (p *ImageUploadResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-var fieldIDToName_TeamBriefInfo = map[int16]string{
-	1: "team_id",
-	2: "title",
-	3: "goal",
-	4: "cur_people_num",
-	5: "created_time",
-	6: "leader_info",
-	7: "contest_id",
+func (p *ImageUploadResponse) GetImageURL() (v string) {
+	return p.ImageURL
 }
 
-func (p *TeamBriefInfo) IsSetLeaderInfo() bool {
-	return p.LeaderInfo != nil
+var fieldIDToName_ImageUploadResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "image_url",
 }
 
-func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *ImageUploadResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7956,46 +7829,6 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8016,7 +7849,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamBriefInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ImageUploadResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8026,71 +7859,36 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *ImageUploadResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamID = v
+		p.StatusCode = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *ImageUploadResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Title = v
+		p.StatusMsg = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *ImageUploadResponse) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Goal = v
-	}
-	return nil
-}
-
-func (p *TeamBriefInfo) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.CurPeopleNum = v
-	}
-	return nil
-}
-
-func (p *TeamBriefInfo) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		p.CreatedTime = v
-	}
-	return nil
-}
-
-func (p *TeamBriefInfo) ReadField6(iprot thrift.TProtocol) error {
-	p.LeaderInfo = NewMemberInfo()
-	if err := p.LeaderInfo.Read(iprot); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (p *TeamBriefInfo) ReadField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.ContestID = v
+		p.ImageURL = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *ImageUploadResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamBriefInfo"); err != nil {
+	if err = oprot.WriteStructBegin("ImageUploadResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8106,22 +7904,6 @@ func (p *TeamBriefInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -8141,11 +7923,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
+func (p *ImageUploadResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamID); err != nil {
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8158,11 +7940,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *ImageUploadResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8175,11 +7957,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 3); err != nil {
+func (p *ImageUploadResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("image_url", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Goal); err != nil {
+	if err := oprot.WriteString(p.ImageURL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8192,125 +7974,67 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("cur_people_num", thrift.I32, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.CurPeopleNum); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *TeamBriefInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *TeamBriefInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("leader_info", thrift.STRUCT, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.LeaderInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ImageUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return fmt.Sprintf("ImageUploadResponse(%+v)", *p)
 }
 
-func (p *TeamBriefInfo) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+/* =========================== team =========================== */
+type MemberInfo struct {
+	UserID         int32    `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
+	Nickname       string   `thrift:"nickname,2" form:"nickname" json:"nickname" query:"nickname"`
+	College        string   `thrift:"college,3" form:"college" json:"college" query:"college"`
+	AvatarURL      string   `thrift:"avatar_url,4" form:"avatar_url" json:"avatar_url" query:"avatar_url"`
+	Gender         int32    `thrift:"gender,5" form:"gender" json:"gender" query:"gender"`
+	EnrollmentYear int32    `thrift:"enrollment_year,6" form:"enrollment_year" json:"enrollment_year" query:"enrollment_year"`
+	Honors         []string `thrift:"honors,7" form:"honors" json:"honors" query:"honors"`
 }
 
-func (p *TeamBriefInfo) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("TeamBriefInfo(%+v)", *p)
+func NewMemberInfo() *MemberInfo {
+	return &MemberInfo{}
 }
 
-type TeamInfo struct {
-	TeamBriefInfo *TeamBriefInfo `thrift:"team_brief_info,1" form:"team_brief_info" json:"team_brief_info" query:"team_brief_info"`
-	Description   string         `thrift:"description,2" form:"description" json:"description" query:"description"`
-	TeamSkills    []*TeamSkill   `thrift:"team_skills,3" form:"team_skills" json:"team_skills" query:"team_skills"`
-	Members       []*MemberInfo  `thrift:"members,4" form:"members" json:"members" query:"members"`
+func (p *MemberInfo) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func NewTeamInfo() *TeamInfo {
-	return &TeamInfo{}
+func (p *MemberInfo) GetNickname() (v string) {
+	return p.Nickname
 }
 
-var TeamInfo_TeamBriefInfo_DEFAULT *TeamBriefInfo
-
-func (p *TeamInfo) GetTeamBriefInfo() (v *TeamBriefInfo) {
-	if !p.IsSetTeamBriefInfo() {
-		return TeamInfo_TeamBriefInfo_DEFAULT
-	}
-	return p.TeamBriefInfo
+func (p *MemberInfo) GetCollege() (v string) {
+	return p.College
 }
 
-func (p *TeamInfo) GetDescription() (v string) {
-	return p.Description
+func (p *MemberInfo) GetAvatarURL() (v string) {
+	return p.AvatarURL
 }
 
-func (p *TeamInfo) GetTeamSkills() (v []*TeamSkill) {
-	return p.TeamSkills
+func (p *MemberInfo) GetGender() (v int32) {
+	return p.Gender
 }
 
-func (p *TeamInfo) GetMembers() (v []*MemberInfo) {
-	return p.Members
+func (p *MemberInfo) GetEnrollmentYear() (v int32) {
+	return p.EnrollmentYear
 }
 
-var fieldIDToName_TeamInfo = map[int16]string{
-	1: "team_brief_info",
-	2: "description",
-	3: "team_skills",
-	4: "members",
+func (p *MemberInfo) GetHonors() (v []string) {
+	return p.Honors
 }
 
-func (p *TeamInfo) IsSetTeamBriefInfo() bool {
-	return p.TeamBriefInfo != nil
+var fieldIDToName_MemberInfo = map[int16]string{
+	1: "user_id",
+	2: "nickname",
+	3: "college",
+	4: "avatar_url",
+	5: "gender",
+	6: "enrollment_year",
+	7: "honors",
 }
 
-func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *MemberInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8330,7 +8054,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8350,7 +8074,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8360,7 +8084,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8369,6 +8093,36 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8389,7 +8143,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MemberInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8399,66 +8153,85 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamInfo) ReadField1(iprot thrift.TProtocol) error {
-	p.TeamBriefInfo = NewTeamBriefInfo()
-	if err := p.TeamBriefInfo.Read(iprot); err != nil {
+func (p *MemberInfo) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *MemberInfo) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Description = v
+		p.Nickname = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *MemberInfo) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.College = v
 	}
-	p.TeamSkills = make([]*TeamSkill, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamSkill()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	return nil
+}
 
-		p.TeamSkills = append(p.TeamSkills, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *MemberInfo) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.AvatarURL = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *MemberInfo) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.Gender = v
 	}
-	p.Members = make([]*MemberInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewMemberInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	return nil
+}
 
-		p.Members = append(p.Members, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *MemberInfo) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.EnrollmentYear = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfo"); err != nil {
+func (p *MemberInfo) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Honors = make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.Honors = append(p.Honors, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MemberInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MemberInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8478,6 +8251,18 @@ func (p *TeamInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -8497,11 +8282,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_brief_info", thrift.STRUCT, 1); err != nil {
+func (p *MemberInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.TeamBriefInfo.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8514,11 +8299,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
+func (p *MemberInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nickname", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := oprot.WriteString(p.Nickname); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8531,19 +8316,28 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 3); err != nil {
+func (p *MemberInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("college", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
+	if err := oprot.WriteString(p.College); err != nil {
 		return err
 	}
-	for _, v := range p.TeamSkills {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MemberInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AvatarURL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8551,20 +8345,54 @@ func (p *TeamInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("members", thrift.LIST, 4); err != nil {
+func (p *MemberInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("gender", thrift.I32, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
+	if err := oprot.WriteI32(p.Gender); err != nil {
 		return err
 	}
-	for _, v := range p.Members {
-		if err := v.Write(oprot); err != nil {
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *MemberInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("enrollment_year", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.EnrollmentYear); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *MemberInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("honors", thrift.LIST, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Honors)); err != nil {
+		return err
+	}
+	for _, v := range p.Honors {
+		if err := oprot.WriteString(v); err != nil {
 			return err
 		}
 	}
@@ -8576,74 +8404,86 @@ func (p *TeamInfo) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TeamInfo) String() string {
+func (p *MemberInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamInfo(%+v)", *p)
+	return fmt.Sprintf("MemberInfo(%+v)", *p)
 }
 
-type TeamApplication struct {
-	TeamID          int32       `thrift:"team_id,1" form:"team_id" json:"team_id" query:"team_id"`
-	Reason          string      `thrift:"reason,2" form:"reason" json:"reason" query:"reason"`
-	CreatedTime     int64       `thrift:"created_time,3" form:"created_time" json:"created_time" query:"created_time"`
-	ApplicationType int32       `thrift:"application_type,4" form:"application_type" json:"application_type" query:"application_type"`
-	MemberInfo      *MemberInfo `thrift:"member_info,5" form:"member_info" json:"member_info" query:"member_info"`
-	ApplicationID   int32       `thrift:"application_id,6" form:"application_id" json:"application_id" query:"application_id"`
+// 推荐系统对队伍的打分及推荐理由
+type TeamRecommendation struct {
+	// 最终推荐分数
+	Score float64 `thrift:"score,1" form:"score" json:"score" query:"score"`
+	// 与岗位描述的语义相似度
+	SemanticScore float64 `thrift:"semantic_score,2" form:"semantic_score" json:"semantic_score" query:"semantic_score"`
+	// 与岗位技能要求的匹配度
+	SkillScore float64 `thrift:"skill_score,3" form:"skill_score" json:"skill_score" query:"skill_score"`
+	// 与用户最匹配的岗位
+	Job string `thrift:"job,4" form:"job" json:"job" query:"job"`
+	// 用户满足的技能要求
+	MatchedSkills []string `thrift:"matched_skills,5" form:"matched_skills" json:"matched_skills" query:"matched_skills"`
+	// 按队伍创建时间计算的衰减系数
+	TimeBoost float64 `thrift:"time_boost,6" form:"time_boost" json:"time_boost" query:"time_boost"`
+	// 队伍在赛事中的热度分数，按热度排序或混合排序时返回
+	PopularityScore float64 `thrift:"popularity_score,7" form:"popularity_score" json:"popularity_score" query:"popularity_score"`
+	// 推荐理由，按热度推荐时为“本赛事热门队伍”
+	Reason string `thrift:"reason,8" form:"reason" json:"reason" query:"reason"`
 }
 
-func NewTeamApplication() *TeamApplication {
-	return &TeamApplication{}
+func NewTeamRecommendation() *TeamRecommendation {
+	return &TeamRecommendation{}
 }
 
-func (p *TeamApplication) GetTeamID() (v int32) {
-	return p.TeamID
+func (p *TeamRecommendation) GetScore() (v float64) {
+	return p.Score
 }
 
-func (p *TeamApplication) GetReason() (v string) {
-	return p.Reason
+func (p *TeamRecommendation) GetSemanticScore() (v float64) {
+	return p.SemanticScore
 }
 
-func (p *TeamApplication) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func (p *TeamRecommendation) GetSkillScore() (v float64) {
+	return p.SkillScore
 }
 
-func (p *TeamApplication) GetApplicationType() (v int32) {
-	return p.ApplicationType
+func (p *TeamRecommendation) GetJob() (v string) {
+	return p.Job
 }
 
-var TeamApplication_MemberInfo_DEFAULT *MemberInfo
+func (p *TeamRecommendation) GetMatchedSkills() (v []string) {
+	return p.MatchedSkills
+}
 
-func (p *TeamApplication) GetMemberInfo() (v *MemberInfo) {
-	if !p.IsSetMemberInfo() {
-		return TeamApplication_MemberInfo_DEFAULT
-	}
-	return p.MemberInfo
+func (p *TeamRecommendation) GetTimeBoost() (v float64) {
+	return p.TimeBoost
 }
 
-func (p *TeamApplication) GetApplicationID() (v int32) {
-	return p.ApplicationID
+func (p *TeamRecommendation) GetPopularityScore() (v float64) {
+	return p.PopularityScore
 }
 
-var fieldIDToName_TeamApplication = map[int16]string{
-	1: "team_id",
-	2: "reason",
-	3: "created_time",
-	4: "application_type",
-	5: "member_info",
-	6: "application_id",
+func (p *TeamRecommendation) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *TeamApplication) IsSetMemberInfo() bool {
-	return p.MemberInfo != nil
+var fieldIDToName_TeamRecommendation = map[int16]string{
+	1: "score",
+	2: "semantic_score",
+	3: "skill_score",
+	4: "job",
+	5: "matched_skills",
+	6: "time_boost",
+	7: "popularity_score",
+	8: "reason",
 }
 
-func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamRecommendation) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8663,7 +8503,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8673,7 +8513,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8683,7 +8523,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8693,7 +8533,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8703,7 +8543,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8713,7 +8553,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8722,6 +8562,26 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8742,7 +8602,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplication[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamRecommendation[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8752,62 +8612,94 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamApplication) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamRecommendation) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.TeamID = v
+		p.Score = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamRecommendation) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.Reason = v
+		p.SemanticScore = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *TeamRecommendation) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.CreatedTime = v
+		p.SkillScore = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamRecommendation) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ApplicationType = v
+		p.Job = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField5(iprot thrift.TProtocol) error {
-	p.MemberInfo = NewMemberInfo()
-	if err := p.MemberInfo.Read(iprot); err != nil {
+func (p *TeamRecommendation) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.MatchedSkills = make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.MatchedSkills = append(p.MatchedSkills, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamRecommendation) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.ApplicationID = v
+		p.TimeBoost = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamRecommendation) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		p.PopularityScore = v
+	}
+	return nil
+}
+
+func (p *TeamRecommendation) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Reason = v
+	}
+	return nil
+}
+
+func (p *TeamRecommendation) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplication"); err != nil {
+	if err = oprot.WriteStructBegin("TeamRecommendation"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8835,6 +8727,14 @@ func (p *TeamApplication) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -8854,11 +8754,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
+func (p *TeamRecommendation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamID); err != nil {
+	if err := oprot.WriteDouble(p.Score); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8871,11 +8771,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+func (p *TeamRecommendation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("semantic_score", thrift.DOUBLE, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Reason); err != nil {
+	if err := oprot.WriteDouble(p.SemanticScore); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8888,11 +8788,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 3); err != nil {
+func (p *TeamRecommendation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skill_score", thrift.DOUBLE, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+	if err := oprot.WriteDouble(p.SkillScore); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8905,11 +8805,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 4); err != nil {
+func (p *TeamRecommendation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationType); err != nil {
+	if err := oprot.WriteString(p.Job); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8922,11 +8822,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 5); err != nil {
+func (p *TeamRecommendation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("matched_skills", thrift.LIST, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.MemberInfo.Write(oprot); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.MatchedSkills)); err != nil {
+		return err
+	}
+	for _, v := range p.MatchedSkills {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8939,11 +8847,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 6); err != nil {
+func (p *TeamRecommendation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("time_boost", thrift.DOUBLE, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationID); err != nil {
+	if err := oprot.WriteDouble(p.TimeBoost); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8956,55 +8864,132 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamApplication) String() string {
+func (p *TeamRecommendation) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("popularity_score", thrift.DOUBLE, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.PopularityScore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamRecommendation) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamRecommendation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamApplication(%+v)", *p)
+	return fmt.Sprintf("TeamRecommendation(%+v)", *p)
 }
 
-type TeamSkill struct {
-	TeamSkillID int32  `thrift:"team_skill_id,1" form:"team_skill_id" json:"team_skill_id" query:"team_skill_id"`
-	TeamID      int32  `thrift:"team_id,2" form:"team_id" json:"team_id" query:"team_id"`
-	Skill       string `thrift:"skill,3" form:"skill" json:"skill" query:"skill"`
-	Category    string `thrift:"category,4" form:"category" json:"category" query:"category"`
-	// 什么岗位需要该skill
-	Job string `thrift:"job,5" form:"job" json:"job" query:"job"`
+type TeamBriefInfo struct {
+	TeamID       int32       `thrift:"team_id,1" form:"team_id" json:"team_id" query:"team_id"`
+	Title        string      `thrift:"title,2" form:"title" json:"title" query:"title"`
+	Goal         string      `thrift:"goal,3" form:"goal" json:"goal" query:"goal"`
+	CurPeopleNum int32       `thrift:"cur_people_num,4" form:"cur_people_num" json:"cur_people_num" query:"cur_people_num"`
+	CreatedTime  int64       `thrift:"created_time,5" form:"created_time" json:"created_time" query:"created_time"`
+	LeaderInfo   *MemberInfo `thrift:"leader_info,6" form:"leader_info" json:"leader_info" query:"leader_info"`
+	ContestID    int32       `thrift:"contest_id,7" form:"contest_id" json:"contest_id" query:"contest_id"`
+	// 仅在请求推荐理由时返回
+	Recommendation *TeamRecommendation `thrift:"recommendation,8,optional" form:"recommendation" json:"recommendation,omitempty" query:"recommendation"`
+	// 队伍已停止招募
+	Closed bool `thrift:"closed,9" form:"closed" json:"closed" query:"closed"`
 }
 
-func NewTeamSkill() *TeamSkill {
-	return &TeamSkill{}
+func NewTeamBriefInfo() *TeamBriefInfo {
+	return &TeamBriefInfo{}
 }
 
-func (p *TeamSkill) GetTeamSkillID() (v int32) {
-	return p.TeamSkillID
+func (p *TeamBriefInfo) GetTeamID() (v int32) {
+	return p.TeamID
 }
 
-func (p *TeamSkill) GetTeamID() (v int32) {
-	return p.TeamID
+func (p *TeamBriefInfo) GetTitle() (v string) {
+	return p.Title
 }
 
-func (p *TeamSkill) GetSkill() (v string) {
-	return p.Skill
+func (p *TeamBriefInfo) GetGoal() (v string) {
+	return p.Goal
 }
 
-func (p *TeamSkill) GetCategory() (v string) {
-	return p.Category
+func (p *TeamBriefInfo) GetCurPeopleNum() (v int32) {
+	return p.CurPeopleNum
 }
 
-func (p *TeamSkill) GetJob() (v string) {
-	return p.Job
+func (p *TeamBriefInfo) GetCreatedTime() (v int64) {
+	return p.CreatedTime
 }
 
-var fieldIDToName_TeamSkill = map[int16]string{
-	1: "team_skill_id",
-	2: "team_id",
-	3: "skill",
-	4: "category",
-	5: "job",
+var TeamBriefInfo_LeaderInfo_DEFAULT *MemberInfo
+
+func (p *TeamBriefInfo) GetLeaderInfo() (v *MemberInfo) {
+	if !p.IsSetLeaderInfo() {
+		return TeamBriefInfo_LeaderInfo_DEFAULT
+	}
+	return p.LeaderInfo
 }
 
-func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamBriefInfo) GetContestID() (v int32) {
+	return p.ContestID
+}
+
+var TeamBriefInfo_Recommendation_DEFAULT *TeamRecommendation
+
+func (p *TeamBriefInfo) GetRecommendation() (v *TeamRecommendation) {
+	if !p.IsSetRecommendation() {
+		return TeamBriefInfo_Recommendation_DEFAULT
+	}
+	return p.Recommendation
+}
+
+func (p *TeamBriefInfo) GetClosed() (v bool) {
+	return p.Closed
+}
+
+var fieldIDToName_TeamBriefInfo = map[int16]string{
+	1: "team_id",
+	2: "title",
+	3: "goal",
+	4: "cur_people_num",
+	5: "created_time",
+	6: "leader_info",
+	7: "contest_id",
+	8: "recommendation",
+	9: "closed",
+}
+
+func (p *TeamBriefInfo) IsSetLeaderInfo() bool {
+	return p.LeaderInfo != nil
+}
+
+func (p *TeamBriefInfo) IsSetRecommendation() bool {
+	return p.Recommendation != nil
+}
+
+func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9034,7 +9019,7 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9054,7 +9039,7 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9064,7 +9049,7 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9073,6 +9058,46 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -9093,7 +9118,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSkill[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamBriefInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9103,54 +9128,88 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamSkill) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamBriefInfo) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamSkillID = v
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamBriefInfo) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.TeamID = v
+		p.Title = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamBriefInfo) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Skill = v
+		p.Goal = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamBriefInfo) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Category = v
+		p.CurPeopleNum = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamBriefInfo) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.Job = v
+		p.CreatedTime = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamBriefInfo) ReadField6(iprot thrift.TProtocol) error {
+	p.LeaderInfo = NewMemberInfo()
+	if err := p.LeaderInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestID = v
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) ReadField8(iprot thrift.TProtocol) error {
+	p.Recommendation = NewTeamRecommendation()
+	if err := p.Recommendation.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Closed = v
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamSkill"); err != nil {
+	if err = oprot.WriteStructBegin("TeamBriefInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9174,30 +9233,46 @@ func (p *TeamSkill) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_skill_id", thrift.I32, 1); err != nil {
+func (p *TeamBriefInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamSkillID); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9210,11 +9285,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+func (p *TeamBriefInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamID); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9227,11 +9302,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skill", thrift.STRING, 3); err != nil {
+func (p *TeamBriefInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Skill); err != nil {
+	if err := oprot.WriteString(p.Goal); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9244,11 +9319,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
+func (p *TeamBriefInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cur_people_num", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Category); err != nil {
+	if err := oprot.WriteI32(p.CurPeopleNum); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9261,11 +9336,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("job", thrift.STRING, 5); err != nil {
+func (p *TeamBriefInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Job); err != nil {
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9278,72 +9353,127 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamSkill) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TeamBriefInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leader_info", thrift.STRUCT, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("TeamSkill(%+v)", *p)
+	if err := p.LeaderInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-type TeamCreateRequest struct {
-	Authorization string       `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	UserID        int32        `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
-	TeamID        int32        `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
-	Title         string       `thrift:"title,4" form:"title" json:"title" query:"title"`
-	Goal          string       `thrift:"goal,5" form:"goal" json:"goal" query:"goal"`
-	Description   string       `thrift:"description,6" form:"description" json:"description" query:"description"`
-	TeamSkills    []*TeamSkill `thrift:"team_skills,7" form:"team_skills" json:"team_skills" query:"team_skills"`
-	ContestID     int32        `thrift:"contest_id,8" form:"contest_id" json:"contest_id" query:"contest_id"`
+func (p *TeamBriefInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func NewTeamCreateRequest() *TeamCreateRequest {
-	return &TeamCreateRequest{}
+func (p *TeamBriefInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecommendation() {
+		if err = oprot.WriteFieldBegin("recommendation", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Recommendation.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) GetAuthorization() (v string) {
-	return p.Authorization
+func (p *TeamBriefInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("closed", thrift.BOOL, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Closed); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) GetUserID() (v int32) {
-	return p.UserID
+func (p *TeamBriefInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamBriefInfo(%+v)", *p)
 }
 
-func (p *TeamCreateRequest) GetTeamID() (v int32) {
-	return p.TeamID
+type TeamInfo struct {
+	TeamBriefInfo *TeamBriefInfo `thrift:"team_brief_info,1" form:"team_brief_info" json:"team_brief_info" query:"team_brief_info"`
+	Description   string         `thrift:"description,2" form:"description" json:"description" query:"description"`
+	TeamSkills    []*TeamSkill   `thrift:"team_skills,3" form:"team_skills" json:"team_skills" query:"team_skills"`
+	Members       []*MemberInfo  `thrift:"members,4" form:"members" json:"members" query:"members"`
 }
 
-func (p *TeamCreateRequest) GetTitle() (v string) {
-	return p.Title
+func NewTeamInfo() *TeamInfo {
+	return &TeamInfo{}
 }
 
-func (p *TeamCreateRequest) GetGoal() (v string) {
-	return p.Goal
+var TeamInfo_TeamBriefInfo_DEFAULT *TeamBriefInfo
+
+func (p *TeamInfo) GetTeamBriefInfo() (v *TeamBriefInfo) {
+	if !p.IsSetTeamBriefInfo() {
+		return TeamInfo_TeamBriefInfo_DEFAULT
+	}
+	return p.TeamBriefInfo
 }
 
-func (p *TeamCreateRequest) GetDescription() (v string) {
+func (p *TeamInfo) GetDescription() (v string) {
 	return p.Description
 }
 
-func (p *TeamCreateRequest) GetTeamSkills() (v []*TeamSkill) {
+func (p *TeamInfo) GetTeamSkills() (v []*TeamSkill) {
 	return p.TeamSkills
 }
 
-func (p *TeamCreateRequest) GetContestID() (v int32) {
-	return p.ContestID
+func (p *TeamInfo) GetMembers() (v []*MemberInfo) {
+	return p.Members
 }
 
-var fieldIDToName_TeamCreateRequest = map[int16]string{
-	1: "authorization",
-	2: "user_id",
-	3: "team_id",
-	4: "title",
-	5: "goal",
-	6: "description",
-	7: "team_skills",
-	8: "contest_id",
+var fieldIDToName_TeamInfo = map[int16]string{
+	1: "team_brief_info",
+	2: "description",
+	3: "team_skills",
+	4: "members",
 }
 
-func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInfo) IsSetTeamBriefInfo() bool {
+	return p.TeamBriefInfo != nil
+}
+
+func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9363,7 +9493,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9373,7 +9503,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9383,7 +9513,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9393,48 +9523,8 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 8:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField8(iprot); err != nil {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else {
@@ -9462,7 +9552,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9472,73 +9562,56 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamInfo) ReadField1(iprot thrift.TProtocol) error {
+	p.TeamBriefInfo = NewTeamBriefInfo()
+	if err := p.TeamBriefInfo.Read(iprot); err != nil {
 		return err
-	} else {
-		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamInfo) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.UserID = v
+		p.Description = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamInfo) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		p.TeamID = v
 	}
-	return nil
-}
-
-func (p *TeamCreateRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Title = v
-	}
-	return nil
-}
+	p.TeamSkills = make([]*TeamSkill, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamSkill()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-func (p *TeamCreateRequest) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Goal = v
+		p.TeamSkills = append(p.TeamSkills, _elem)
 	}
-	return nil
-}
-
-func (p *TeamCreateRequest) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		p.Description = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField7(iprot thrift.TProtocol) error {
+func (p *TeamInfo) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	p.TeamSkills = make([]*TeamSkill, 0, size)
+	p.Members = make([]*MemberInfo, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := NewTeamSkill()
+		_elem := NewMemberInfo()
 		if err := _elem.Read(iprot); err != nil {
 			return err
 		}
 
-		p.TeamSkills = append(p.TeamSkills, _elem)
+		p.Members = append(p.Members, _elem)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
@@ -9546,18 +9619,9 @@ func (p *TeamCreateRequest) ReadField7(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField8(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.ContestID = v
-	}
-	return nil
-}
-
-func (p *TeamCreateRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9577,22 +9641,6 @@ func (p *TeamCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -9612,11 +9660,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+func (p *TeamInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_brief_info", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Authorization); err != nil {
+	if err := p.TeamBriefInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9629,11 +9677,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+func (p *TeamInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteString(p.Description); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9646,62 +9694,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.TeamID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *TeamCreateRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Title); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *TeamCreateRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 5); err != nil {
+func (p *TeamInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Goal); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *TeamCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 6); err != nil {
-		goto WriteFieldBeginError
+	for _, v := range p.TeamSkills {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9709,19 +9714,19 @@ func (p *TeamCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 7); err != nil {
+func (p *TeamInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("members", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
 		return err
 	}
-	for _, v := range p.TeamSkills {
+	for _, v := range p.Members {
 		if err := v.Write(oprot); err != nil {
 			return err
 		}
@@ -9734,64 +9739,95 @@ func (p *TeamCreateRequest) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *TeamInfo) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return fmt.Sprintf("TeamInfo(%+v)", *p)
 }
 
-func (p *TeamCreateRequest) String() string {
-	if p == nil {
-		return "<nil>"
+type TeamApplication struct {
+	TeamID          int32       `thrift:"team_id,1" form:"team_id" json:"team_id" query:"team_id"`
+	Reason          string      `thrift:"reason,2" form:"reason" json:"reason" query:"reason"`
+	CreatedTime     int64       `thrift:"created_time,3" form:"created_time" json:"created_time" query:"created_time"`
+	ApplicationType int32       `thrift:"application_type,4" form:"application_type" json:"application_type" query:"application_type"`
+	MemberInfo      *MemberInfo `thrift:"member_info,5" form:"member_info" json:"member_info" query:"member_info"`
+	ApplicationID   int32       `thrift:"application_id,6" form:"application_id" json:"application_id" query:"application_id"`
+	// pending 待处理、accepted 已接受、rejected 已拒绝、withdrawn 已撤回、expired 已过期
+	Status string `thrift:"status,7" form:"status" json:"status" query:"status"`
+	// 申请被处理、撤回或过期的时间，待处理时为 0
+	DecidedTime int64 `thrift:"decided_time,8" form:"decided_time" json:"decided_time" query:"decided_time"`
+	// 处理申请的用户：接受或拒绝时为队长，撤回时为申请人，过期时为 0
+	DecidedBy int32 `thrift:"decided_by,9" form:"decided_by" json:"decided_by" query:"decided_by"`
+}
+
+func NewTeamApplication() *TeamApplication {
+	return &TeamApplication{}
+}
+
+func (p *TeamApplication) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamApplication) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *TeamApplication) GetCreatedTime() (v int64) {
+	return p.CreatedTime
+}
+
+func (p *TeamApplication) GetApplicationType() (v int32) {
+	return p.ApplicationType
+}
+
+var TeamApplication_MemberInfo_DEFAULT *MemberInfo
+
+func (p *TeamApplication) GetMemberInfo() (v *MemberInfo) {
+	if !p.IsSetMemberInfo() {
+		return TeamApplication_MemberInfo_DEFAULT
 	}
-	return fmt.Sprintf("TeamCreateRequest(%+v)", *p)
+	return p.MemberInfo
 }
 
-type TeamCreateResponse struct {
-	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	TeamID     int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+func (p *TeamApplication) GetApplicationID() (v int32) {
+	return p.ApplicationID
 }
 
-func NewTeamCreateResponse() *TeamCreateResponse {
-	return &TeamCreateResponse{}
+func (p *TeamApplication) GetStatus() (v string) {
+	return p.Status
 }
 
-func (p *TeamCreateResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *TeamApplication) GetDecidedTime() (v int64) {
+	return p.DecidedTime
 }
 
-func (p *TeamCreateResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *TeamApplication) GetDecidedBy() (v int32) {
+	return p.DecidedBy
 }
 
-func (p *TeamCreateResponse) GetTeamID() (v int32) {
-	return p.TeamID
+var fieldIDToName_TeamApplication = map[int16]string{
+	1: "team_id",
+	2: "reason",
+	3: "created_time",
+	4: "application_type",
+	5: "member_info",
+	6: "application_id",
+	7: "status",
+	8: "decided_time",
+	9: "decided_by",
 }
 
-var fieldIDToName_TeamCreateResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
-	3: "team_id",
+func (p *TeamApplication) IsSetMemberInfo() bool {
+	return p.MemberInfo != nil
 }
 
-func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9831,7 +9867,7 @@ func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -9840,11 +9876,71 @@ func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
 
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -9860,7 +9956,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplication[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9870,36 +9966,89 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplication) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.StatusCode = v
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *TeamCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplication) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.StatusMsg = v
+		p.Reason = v
 	}
 	return nil
 }
 
-func (p *TeamCreateResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamApplication) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CreatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamApplication) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamID = v
+		p.ApplicationType = v
 	}
 	return nil
 }
 
-func (p *TeamCreateResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplication) ReadField5(iprot thrift.TProtocol) error {
+	p.MemberInfo = NewMemberInfo()
+	if err := p.MemberInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamApplication) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ApplicationID = v
+	}
+	return nil
+}
+
+func (p *TeamApplication) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamApplication) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.DecidedTime = v
+	}
+	return nil
+}
+
+func (p *TeamApplication) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.DecidedBy = v
+	}
+	return nil
+}
+
+func (p *TeamApplication) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreateResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplication"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9915,6 +10064,30 @@ func (p *TeamCreateResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -9934,11 +10107,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+func (p *TeamApplication) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9951,11 +10124,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+func (p *TeamApplication) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StatusMsg); err != nil {
+	if err := oprot.WriteString(p.Reason); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9968,11 +10141,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+func (p *TeamApplication) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamID); err != nil {
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9985,54 +10158,157 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TeamApplication) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("TeamCreateResponse(%+v)", *p)
-}
-
-type TeamListRequest struct {
-	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	ContestID     int32  `thrift:"contest_id,2" json:"contest_id" path:"contest_id"`
-	Limit         int32  `thrift:"limit,3" json:"limit" query:"limit"`
-	Offset        int32  `thrift:"offset,4" json:"offset" query:"offset"`
-	UserID        int32  `thrift:"user_id,5" json:"user_id" query:"user_id"`
-}
-
-func NewTeamListRequest() *TeamListRequest {
-	return &TeamListRequest{}
+	if err := oprot.WriteI32(p.ApplicationType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamListRequest) GetAuthorization() (v string) {
-	return p.Authorization
+func (p *TeamApplication) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.MemberInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamListRequest) GetContestID() (v int32) {
-	return p.ContestID
+func (p *TeamApplication) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ApplicationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamListRequest) GetLimit() (v int32) {
-	return p.Limit
+func (p *TeamApplication) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TeamListRequest) GetOffset() (v int32) {
-	return p.Offset
+func (p *TeamApplication) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decided_time", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DecidedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *TeamListRequest) GetUserID() (v int32) {
-	return p.UserID
+func (p *TeamApplication) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decided_by", thrift.I32, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.DecidedBy); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-var fieldIDToName_TeamListRequest = map[int16]string{
-	1: "authorization",
-	2: "contest_id",
-	3: "limit",
-	4: "offset",
-	5: "user_id",
+func (p *TeamApplication) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamApplication(%+v)", *p)
 }
 
-func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
+type TeamSkill struct {
+	TeamSkillID int32  `thrift:"team_skill_id,1" form:"team_skill_id" json:"team_skill_id" query:"team_skill_id"`
+	TeamID      int32  `thrift:"team_id,2" form:"team_id" json:"team_id" query:"team_id"`
+	Skill       string `thrift:"skill,3" form:"skill" json:"skill" query:"skill"`
+	Category    string `thrift:"category,4" form:"category" json:"category" query:"category"`
+	// 什么岗位需要该skill
+	Job string `thrift:"job,5" form:"job" json:"job" query:"job"`
+}
+
+func NewTeamSkill() *TeamSkill {
+	return &TeamSkill{}
+}
+
+func (p *TeamSkill) GetTeamSkillID() (v int32) {
+	return p.TeamSkillID
+}
+
+func (p *TeamSkill) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamSkill) GetSkill() (v string) {
+	return p.Skill
+}
+
+func (p *TeamSkill) GetCategory() (v string) {
+	return p.Category
+}
+
+func (p *TeamSkill) GetJob() (v string) {
+	return p.Job
+}
+
+var fieldIDToName_TeamSkill = map[int16]string{
+	1: "team_skill_id",
+	2: "team_id",
+	3: "skill",
+	4: "category",
+	5: "job",
+}
+
+func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10052,7 +10328,7 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10072,7 +10348,7 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10082,7 +10358,7 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10092,7 +10368,7 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10121,7 +10397,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSkill[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10131,54 +10407,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamListRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamSkill) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Authorization = v
+		p.TeamSkillID = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamSkill) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ContestID = v
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamSkill) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Limit = v
+		p.Skill = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamSkill) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Offset = v
+		p.Category = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamSkill) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.UserID = v
+		p.Job = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamSkill) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamSkill"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10221,11 +10497,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+func (p *TeamSkill) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_skill_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Authorization); err != nil {
+	if err := oprot.WriteI32(p.TeamSkillID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10238,11 +10514,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 2); err != nil {
+func (p *TeamSkill) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10255,11 +10531,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+func (p *TeamSkill) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skill", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Limit); err != nil {
+	if err := oprot.WriteString(p.Skill); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10272,11 +10548,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+func (p *TeamSkill) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Offset); err != nil {
+	if err := oprot.WriteString(p.Category); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10289,11 +10565,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 5); err != nil {
+func (p *TeamSkill) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRING, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteString(p.Job); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10306,48 +10582,96 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamListRequest) String() string {
+func (p *TeamSkill) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamListRequest(%+v)", *p)
+	return fmt.Sprintf("TeamSkill(%+v)", *p)
 }
 
-type TeamListResponse struct {
-	StatusCode int32            `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string           `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	Total      int32            `thrift:"total,3" form:"total" json:"total" query:"total"`
-	TeamList   []*TeamBriefInfo `thrift:"team_list,4" form:"team_list" json:"team_list" query:"team_list"`
+type TeamInvitation struct {
+	InvitationID int32  `thrift:"invitation_id,1" form:"invitation_id" json:"invitation_id" query:"invitation_id"`
+	TeamID       int32  `thrift:"team_id,2" form:"team_id" json:"team_id" query:"team_id"`
+	TeamTitle    string `thrift:"team_title,3" form:"team_title" json:"team_title" query:"team_title"`
+	ContestID    int32  `thrift:"contest_id,4" form:"contest_id" json:"contest_id" query:"contest_id"`
+	// 发出邀请的队长
+	InviterInfo *MemberInfo `thrift:"inviter_info,5" form:"inviter_info" json:"inviter_info" query:"inviter_info"`
+	InviteeID   int32       `thrift:"invitee_id,6" form:"invitee_id" json:"invitee_id" query:"invitee_id"`
+	Message     string      `thrift:"message,7" form:"message" json:"message" query:"message"`
+	CreatedTime int64       `thrift:"created_time,8" form:"created_time" json:"created_time" query:"created_time"`
+	// pending 待处理、accepted 已接受、declined 已拒绝、cancelled 已取消（队伍解散、队长取消、原队长不再担任队长或被邀请人已加入该赛事其他队伍）、expired 已过期
+	Status string `thrift:"status,9" form:"status" json:"status" query:"status"`
+	// 邀请被处理、取消或过期的时间，待处理时为 0
+	DecidedTime int64 `thrift:"decided_time,10" form:"decided_time" json:"decided_time" query:"decided_time"`
 }
 
-func NewTeamListResponse() *TeamListResponse {
-	return &TeamListResponse{}
+func NewTeamInvitation() *TeamInvitation {
+	return &TeamInvitation{}
 }
 
-func (p *TeamListResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *TeamInvitation) GetInvitationID() (v int32) {
+	return p.InvitationID
 }
 
-func (p *TeamListResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *TeamInvitation) GetTeamID() (v int32) {
+	return p.TeamID
 }
 
-func (p *TeamListResponse) GetTotal() (v int32) {
-	return p.Total
+func (p *TeamInvitation) GetTeamTitle() (v string) {
+	return p.TeamTitle
 }
 
-func (p *TeamListResponse) GetTeamList() (v []*TeamBriefInfo) {
-	return p.TeamList
+func (p *TeamInvitation) GetContestID() (v int32) {
+	return p.ContestID
 }
 
-var fieldIDToName_TeamListResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
-	3: "total",
-	4: "team_list",
+var TeamInvitation_InviterInfo_DEFAULT *MemberInfo
+
+func (p *TeamInvitation) GetInviterInfo() (v *MemberInfo) {
+	if !p.IsSetInviterInfo() {
+		return TeamInvitation_InviterInfo_DEFAULT
+	}
+	return p.InviterInfo
 }
 
-func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInvitation) GetInviteeID() (v int32) {
+	return p.InviteeID
+}
+
+func (p *TeamInvitation) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *TeamInvitation) GetCreatedTime() (v int64) {
+	return p.CreatedTime
+}
+
+func (p *TeamInvitation) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamInvitation) GetDecidedTime() (v int64) {
+	return p.DecidedTime
+}
+
+var fieldIDToName_TeamInvitation = map[int16]string{
+	1:  "invitation_id",
+	2:  "team_id",
+	3:  "team_title",
+	4:  "contest_id",
+	5:  "inviter_info",
+	6:  "invitee_id",
+	7:  "message",
+	8:  "created_time",
+	9:  "status",
+	10: "decided_time",
+}
+
+func (p *TeamInvitation) IsSetInviterInfo() bool {
+	return p.InviterInfo != nil
+}
+
+func (p *TeamInvitation) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10377,7 +10701,7 @@ func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10387,7 +10711,7 @@ func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10397,7 +10721,7 @@ func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10406,6 +10730,66 @@ func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -10426,7 +10810,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitation[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10436,56 +10820,98 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamInvitation) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.StatusCode = v
+		p.InvitationID = v
 	}
 	return nil
 }
 
-func (p *TeamListResponse) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamInvitation) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.StatusMsg = v
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *TeamListResponse) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamInvitation) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Total = v
+		p.TeamTitle = v
 	}
 	return nil
 }
 
-func (p *TeamListResponse) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *TeamInvitation) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.ContestID = v
 	}
-	p.TeamList = make([]*TeamBriefInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamBriefInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	return nil
+}
 
-		p.TeamList = append(p.TeamList, _elem)
+func (p *TeamInvitation) ReadField5(iprot thrift.TProtocol) error {
+	p.InviterInfo = NewMemberInfo()
+	if err := p.InviterInfo.Read(iprot); err != nil {
+		return err
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	return nil
+}
+
+func (p *TeamInvitation) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InviteeID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.Message = v
 	}
 	return nil
 }
 
-func (p *TeamListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInvitation) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CreatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.DecidedTime = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitation"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10505,6 +10931,30 @@ func (p *TeamListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -10524,11 +10974,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+func (p *TeamInvitation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
+	if err := oprot.WriteI32(p.InvitationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10541,11 +10991,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+func (p *TeamInvitation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StatusMsg); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10558,11 +11008,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+func (p *TeamInvitation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_title", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Total); err != nil {
+	if err := oprot.WriteString(p.TeamTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10575,19 +11025,28 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_list", thrift.LIST, 4); err != nil {
+func (p *TeamInvitation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamList)); err != nil {
+	if err := oprot.WriteI32(p.ContestID); err != nil {
 		return err
 	}
-	for _, v := range p.TeamList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("inviter_info", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.InviterInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10595,47 +11054,162 @@ func (p *TeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamListResponse) String() string {
+func (p *TeamInvitation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitee_id", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InviteeID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decided_time", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DecidedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *TeamInvitation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamListResponse(%+v)", *p)
+	return fmt.Sprintf("TeamInvitation(%+v)", *p)
 }
 
-type TeamInfoRequest struct {
-	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	ContestID     int32  `thrift:"contest_id,2" json:"contest_id" path:"contest_id"`
-	TeamID        int32  `thrift:"team_id,3" json:"team_id" path:"team_id"`
+type TeamCreateRequest struct {
+	Authorization string       `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32        `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32        `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+	Title         string       `thrift:"title,4" form:"title" json:"title" query:"title"`
+	Goal          string       `thrift:"goal,5" form:"goal" json:"goal" query:"goal"`
+	Description   string       `thrift:"description,6" form:"description" json:"description" query:"description"`
+	TeamSkills    []*TeamSkill `thrift:"team_skills,7" form:"team_skills" json:"team_skills" query:"team_skills"`
+	ContestID     int32        `thrift:"contest_id,8" form:"contest_id" json:"contest_id" query:"contest_id"`
 }
 
-func NewTeamInfoRequest() *TeamInfoRequest {
-	return &TeamInfoRequest{}
+func NewTeamCreateRequest() *TeamCreateRequest {
+	return &TeamCreateRequest{}
 }
 
-func (p *TeamInfoRequest) GetAuthorization() (v string) {
+func (p *TeamCreateRequest) GetAuthorization() (v string) {
 	return p.Authorization
 }
 
-func (p *TeamInfoRequest) GetContestID() (v int32) {
-	return p.ContestID
+func (p *TeamCreateRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func (p *TeamInfoRequest) GetTeamID() (v int32) {
+func (p *TeamCreateRequest) GetTeamID() (v int32) {
 	return p.TeamID
 }
 
-var fieldIDToName_TeamInfoRequest = map[int16]string{
+func (p *TeamCreateRequest) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *TeamCreateRequest) GetGoal() (v string) {
+	return p.Goal
+}
+
+func (p *TeamCreateRequest) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *TeamCreateRequest) GetTeamSkills() (v []*TeamSkill) {
+	return p.TeamSkills
+}
+
+func (p *TeamCreateRequest) GetContestID() (v int32) {
+	return p.ContestID
+}
+
+var fieldIDToName_TeamCreateRequest = map[int16]string{
 	1: "authorization",
-	2: "contest_id",
+	2: "user_id",
 	3: "team_id",
+	4: "title",
+	5: "goal",
+	6: "description",
+	7: "team_skills",
+	8: "contest_id",
 }
 
-func (p *TeamInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10684,15 +11258,65 @@ func (p *TeamInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
 	if err = iprot.ReadStructEnd(); err != nil {
 		goto ReadStructEndError
@@ -10704,7 +11328,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10714,7 +11338,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamCreateRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -10723,16 +11347,16 @@ func (p *TeamInfoRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamCreateRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ContestID = v
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *TeamInfoRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamCreateRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -10741,9 +11365,65 @@ func (p *TeamInfoRequest) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Title = v
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Goal = v
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamSkills = make([]*TeamSkill, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamSkill()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamSkills = append(p.TeamSkills, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestID = v
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCreateRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10759,6 +11439,26 @@ func (p *TeamInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -10778,7 +11478,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10795,11 +11495,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 2); err != nil {
+func (p *TeamCreateRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10812,7 +11512,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10829,51 +11529,135 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TeamCreateRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("TeamInfoRequest(%+v)", *p)
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-type TeamInfoResponse struct {
-	StatusCode int32     `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string    `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	TeamInfo   *TeamInfo `thrift:"team_info,3" form:"team_info" json:"team_info" query:"team_info"`
+func (p *TeamCreateRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Goal); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func NewTeamInfoResponse() *TeamInfoResponse {
-	return &TeamInfoResponse{}
+func (p *TeamCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *TeamCreateRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamSkills {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *TeamCreateRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-var TeamInfoResponse_TeamInfo_DEFAULT *TeamInfo
-
-func (p *TeamInfoResponse) GetTeamInfo() (v *TeamInfo) {
-	if !p.IsSetTeamInfo() {
-		return TeamInfoResponse_TeamInfo_DEFAULT
+func (p *TeamCreateRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.TeamInfo
+	return fmt.Sprintf("TeamCreateRequest(%+v)", *p)
 }
 
-var fieldIDToName_TeamInfoResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
-	3: "team_info",
+type TeamCreateResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	TeamID     int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
 }
 
-func (p *TeamInfoResponse) IsSetTeamInfo() bool {
-	return p.TeamInfo != nil
+func NewTeamCreateResponse() *TeamCreateResponse {
+	return &TeamCreateResponse{}
 }
 
-func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamCreateResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamCreateResponse) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+var fieldIDToName_TeamCreateResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "team_id",
+}
+
+func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10913,7 +11697,7 @@ func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10942,7 +11726,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10952,7 +11736,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamCreateResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -10961,7 +11745,7 @@ func (p *TeamInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamCreateResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -10970,17 +11754,18 @@ func (p *TeamInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoResponse) ReadField3(iprot thrift.TProtocol) error {
-	p.TeamInfo = NewTeamInfo()
-	if err := p.TeamInfo.Read(iprot); err != nil {
+func (p *TeamCreateResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *TeamInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCreateResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11015,7 +11800,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11032,7 +11817,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11049,11 +11834,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_info", thrift.STRUCT, 3); err != nil {
+func (p *TeamCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.TeamInfo.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11066,69 +11851,68 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) String() string {
+func (p *TeamCreateResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamInfoResponse(%+v)", *p)
+	return fmt.Sprintf("TeamCreateResponse(%+v)", *p)
 }
 
-type TeamApplicationSubmitRequest struct {
-	Authorization   string      `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	TeamID          int32       `thrift:"team_id,2" form:"team_id" json:"team_id" query:"team_id"`
-	Reason          string      `thrift:"reason,3" form:"reason" json:"reason" query:"reason"`
-	CreatedTime     int64       `thrift:"created_time,4" form:"created_time" json:"created_time" query:"created_time"`
-	ApplicationType int32       `thrift:"application_type,5" form:"application_type" json:"application_type" query:"application_type"`
-	MemberInfo      *MemberInfo `thrift:"member_info,6" form:"member_info" json:"member_info" query:"member_info"`
+type TeamListRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	ContestID     int32  `thrift:"contest_id,2" json:"contest_id" path:"contest_id"`
+	Limit         int32  `thrift:"limit,3" json:"limit" query:"limit"`
+	Offset        int32  `thrift:"offset,4" json:"offset" query:"offset"`
+	UserID        int32  `thrift:"user_id,5" json:"user_id" query:"user_id"`
+	// 为 true 时在每个队伍中返回推荐分数及理由
+	WithRecommendation bool `thrift:"with_recommendation,6" json:"with_recommendation" query:"with_recommendation"`
+	// 上一页响应中的 next_cursor，不为空时忽略 offset
+	Cursor string `thrift:"cursor,7" json:"cursor" query:"cursor"`
 }
 
-func NewTeamApplicationSubmitRequest() *TeamApplicationSubmitRequest {
-	return &TeamApplicationSubmitRequest{}
+func NewTeamListRequest() *TeamListRequest {
+	return &TeamListRequest{}
 }
 
-func (p *TeamApplicationSubmitRequest) GetAuthorization() (v string) {
+func (p *TeamListRequest) GetAuthorization() (v string) {
 	return p.Authorization
 }
 
-func (p *TeamApplicationSubmitRequest) GetTeamID() (v int32) {
-	return p.TeamID
+func (p *TeamListRequest) GetContestID() (v int32) {
+	return p.ContestID
 }
 
-func (p *TeamApplicationSubmitRequest) GetReason() (v string) {
-	return p.Reason
+func (p *TeamListRequest) GetLimit() (v int32) {
+	return p.Limit
 }
 
-func (p *TeamApplicationSubmitRequest) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func (p *TeamListRequest) GetOffset() (v int32) {
+	return p.Offset
 }
 
-func (p *TeamApplicationSubmitRequest) GetApplicationType() (v int32) {
-	return p.ApplicationType
+func (p *TeamListRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-var TeamApplicationSubmitRequest_MemberInfo_DEFAULT *MemberInfo
-
-func (p *TeamApplicationSubmitRequest) GetMemberInfo() (v *MemberInfo) {
-	if !p.IsSetMemberInfo() {
-		return TeamApplicationSubmitRequest_MemberInfo_DEFAULT
-	}
-	return p.MemberInfo
+func (p *TeamListRequest) GetWithRecommendation() (v bool) {
+	return p.WithRecommendation
 }
 
-var fieldIDToName_TeamApplicationSubmitRequest = map[int16]string{
-	1: "authorization",
-	2: "team_id",
-	3: "reason",
-	4: "created_time",
-	5: "application_type",
-	6: "member_info",
+func (p *TeamListRequest) GetCursor() (v string) {
+	return p.Cursor
 }
 
-func (p *TeamApplicationSubmitRequest) IsSetMemberInfo() bool {
-	return p.MemberInfo != nil
+var fieldIDToName_TeamListRequest = map[int16]string{
+	1: "authorization",
+	2: "contest_id",
+	3: "limit",
+	4: "offset",
+	5: "user_id",
+	6: "with_recommendation",
+	7: "cursor",
 }
 
-func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11168,7 +11952,7 @@ func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11178,7 +11962,7 @@ func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11198,7 +11982,7 @@ func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11207,6 +11991,16 @@ func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error)
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -11227,7 +12021,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11237,7 +12031,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamListRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -11246,53 +12040,63 @@ func (p *TeamApplicationSubmitRequest) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamListRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamID = v
+		p.ContestID = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Reason = v
+		p.Limit = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *TeamListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.CreatedTime = v
+		p.Offset = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField5(iprot thrift.TProtocol) error {
+func (p *TeamListRequest) ReadField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ApplicationType = v
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField6(iprot thrift.TProtocol) error {
-	p.MemberInfo = NewMemberInfo()
-	if err := p.MemberInfo.Read(iprot); err != nil {
+func (p *TeamListRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.WithRecommendation = v
+	}
+	return nil
+}
+
+func (p *TeamListRequest) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.Cursor = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmitRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11320,6 +12124,10 @@ func (p *TeamApplicationSubmitRequest) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -11339,7 +12147,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamListRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11356,11 +12164,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+func (p *TeamListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamID); err != nil {
+	if err := oprot.WriteI32(p.ContestID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11373,11 +12181,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+func (p *TeamListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Reason); err != nil {
+	if err := oprot.WriteI32(p.Limit); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11390,11 +12198,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 4); err != nil {
+func (p *TeamListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+	if err := oprot.WriteI32(p.Offset); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11407,11 +12215,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 5); err != nil {
+func (p *TeamListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationType); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11424,11 +12232,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 6); err != nil {
+func (p *TeamListRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("with_recommendation", thrift.BOOL, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.MemberInfo.Write(oprot); err != nil {
+	if err := oprot.WriteBool(p.WithRecommendation); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11441,38 +12249,88 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) String() string {
+func (p *TeamListRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Cursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamApplicationSubmitRequest(%+v)", *p)
+	return fmt.Sprintf("TeamListRequest(%+v)", *p)
 }
 
-type TeamApplicationSubmitResponse struct {
-	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+type TeamListResponse struct {
+	StatusCode int32            `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string           `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total      int32            `thrift:"total,3" form:"total" json:"total" query:"total"`
+	TeamList   []*TeamBriefInfo `thrift:"team_list,4" form:"team_list" json:"team_list" query:"team_list"`
+	// 推荐排序所在的实验分组，格式为 实验名/分组名，未参与实验时为空
+	Experiment string `thrift:"experiment,5" form:"experiment" json:"experiment" query:"experiment"`
+	// 推荐策略: personalized 个性化、blended 与热度混合、popularity 按热度，未使用推荐排序时为空
+	Strategy string `thrift:"strategy,6" form:"strategy" json:"strategy" query:"strategy"`
+	// 下一页的游标，已是最后一页时为空
+	NextCursor string `thrift:"next_cursor,7" form:"next_cursor" json:"next_cursor" query:"next_cursor"`
 }
 
-func NewTeamApplicationSubmitResponse() *TeamApplicationSubmitResponse {
-	return &TeamApplicationSubmitResponse{}
+func NewTeamListResponse() *TeamListResponse {
+	return &TeamListResponse{}
 }
 
-func (p *TeamApplicationSubmitResponse) GetStatusCode() (v int32) {
+func (p *TeamListResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *TeamApplicationSubmitResponse) GetStatusMsg() (v string) {
+func (p *TeamListResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-var fieldIDToName_TeamApplicationSubmitResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
+func (p *TeamListResponse) GetTotal() (v int32) {
+	return p.Total
 }
 
-func (p *TeamApplicationSubmitResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) GetTeamList() (v []*TeamBriefInfo) {
+	return p.TeamList
+}
 
-	var fieldTypeId thrift.TType
+func (p *TeamListResponse) GetExperiment() (v string) {
+	return p.Experiment
+}
+
+func (p *TeamListResponse) GetStrategy() (v string) {
+	return p.Strategy
+}
+
+func (p *TeamListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+
+var fieldIDToName_TeamListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "team_list",
+	5: "experiment",
+	6: "strategy",
+	7: "next_cursor",
+}
+
+func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
 	var fieldId int16
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
@@ -11509,6 +12367,56 @@ func (p *TeamApplicationSubmitResponse) Read(iprot thrift.TProtocol) (err error)
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -11529,7 +12437,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11539,7 +12447,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamListResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -11548,7 +12456,7 @@ func (p *TeamApplicationSubmitResponse) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamApplicationSubmitResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamListResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -11557,9 +12465,65 @@ func (p *TeamApplicationSubmitResponse) ReadField2(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamApplicationSubmitResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamList = make([]*TeamBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamList = append(p.TeamList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Experiment = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Strategy = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NextCursor = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmitResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11571,6 +12535,26 @@ func (p *TeamApplicationSubmitResponse) Write(oprot thrift.TProtocol) (err error
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -11590,7 +12574,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11607,7 +12591,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11624,42 +12608,135 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) String() string {
+func (p *TeamListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamList)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("experiment", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Experiment); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("strategy", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Strategy); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamApplicationSubmitResponse(%+v)", *p)
+	return fmt.Sprintf("TeamListResponse(%+v)", *p)
 }
 
-type TeamManageListRequest struct {
+type TeamInfoRequest struct {
 	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	UserID        int32  `thrift:"user_id,2" json:"user_id" query:"user_id"`
-	TeamID        int32  `thrift:"team_id,3" json:"team_id" query:"team_id"`
+	ContestID     int32  `thrift:"contest_id,2" json:"contest_id" path:"contest_id"`
+	TeamID        int32  `thrift:"team_id,3" json:"team_id" path:"team_id"`
 }
 
-func NewTeamManageListRequest() *TeamManageListRequest {
-	return &TeamManageListRequest{}
+func NewTeamInfoRequest() *TeamInfoRequest {
+	return &TeamInfoRequest{}
 }
 
-func (p *TeamManageListRequest) GetAuthorization() (v string) {
+func (p *TeamInfoRequest) GetAuthorization() (v string) {
 	return p.Authorization
 }
 
-func (p *TeamManageListRequest) GetUserID() (v int32) {
-	return p.UserID
+func (p *TeamInfoRequest) GetContestID() (v int32) {
+	return p.ContestID
 }
 
-func (p *TeamManageListRequest) GetTeamID() (v int32) {
+func (p *TeamInfoRequest) GetTeamID() (v int32) {
 	return p.TeamID
 }
 
-var fieldIDToName_TeamManageListRequest = map[int16]string{
+var fieldIDToName_TeamInfoRequest = map[int16]string{
 	1: "authorization",
-	2: "user_id",
+	2: "contest_id",
 	3: "team_id",
 }
 
-func (p *TeamManageListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11728,7 +12805,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11738,7 +12815,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamInfoRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -11747,16 +12824,16 @@ func (p *TeamManageListRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamInfoRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.UserID = v
+		p.ContestID = v
 	}
 	return nil
 }
 
-func (p *TeamManageListRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamInfoRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -11765,9 +12842,9 @@ func (p *TeamManageListRequest) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInfoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11802,7 +12879,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11819,11 +12896,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+func (p *TeamInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteI32(p.ContestID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11836,7 +12913,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11853,42 +12930,51 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) String() string {
+func (p *TeamInfoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageListRequest(%+v)", *p)
+	return fmt.Sprintf("TeamInfoRequest(%+v)", *p)
 }
 
-type TeamManageListResponse struct {
-	StatusCode      int32              `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg       string             `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	ApplicationList []*TeamApplication `thrift:"application_list,3" form:"application_list" json:"application_list" query:"application_list"`
+type TeamInfoResponse struct {
+	StatusCode int32     `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string    `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	TeamInfo   *TeamInfo `thrift:"team_info,3" form:"team_info" json:"team_info" query:"team_info"`
 }
 
-func NewTeamManageListResponse() *TeamManageListResponse {
-	return &TeamManageListResponse{}
+func NewTeamInfoResponse() *TeamInfoResponse {
+	return &TeamInfoResponse{}
 }
 
-func (p *TeamManageListResponse) GetStatusCode() (v int32) {
+func (p *TeamInfoResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *TeamManageListResponse) GetStatusMsg() (v string) {
+func (p *TeamInfoResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-func (p *TeamManageListResponse) GetApplicationList() (v []*TeamApplication) {
-	return p.ApplicationList
+var TeamInfoResponse_TeamInfo_DEFAULT *TeamInfo
+
+func (p *TeamInfoResponse) GetTeamInfo() (v *TeamInfo) {
+	if !p.IsSetTeamInfo() {
+		return TeamInfoResponse_TeamInfo_DEFAULT
+	}
+	return p.TeamInfo
 }
 
-var fieldIDToName_TeamManageListResponse = map[int16]string{
+var fieldIDToName_TeamInfoResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "application_list",
+	3: "team_info",
 }
 
-func (p *TeamManageListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) IsSetTeamInfo() bool {
+	return p.TeamInfo != nil
+}
+
+func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11928,7 +13014,7 @@ func (p *TeamManageListResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11957,7 +13043,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11967,7 +13053,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -11976,7 +13062,7 @@ func (p *TeamManageListResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -11985,29 +13071,17 @@ func (p *TeamManageListResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageListResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	p.ApplicationList = make([]*TeamApplication, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamApplication()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		p.ApplicationList = append(p.ApplicationList, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *TeamInfoResponse) ReadField3(iprot thrift.TProtocol) error {
+	p.TeamInfo = NewTeamInfo()
+	if err := p.TeamInfo.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamManageListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInfoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12042,7 +13116,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12059,7 +13133,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12076,19 +13150,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_list", thrift.LIST, 3); err != nil {
+func (p *TeamInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_info", thrift.STRUCT, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ApplicationList)); err != nil {
-		return err
-	}
-	for _, v := range p.ApplicationList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := p.TeamInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12101,48 +13167,69 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) String() string {
+func (p *TeamInfoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageListResponse(%+v)", *p)
+	return fmt.Sprintf("TeamInfoResponse(%+v)", *p)
 }
 
-type TeamManageActionRequest struct {
-	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	UserID        int32  `thrift:"user_id,2" json:"user_id" path:"user_id"`
-	ApplicationID int32  `thrift:"application_id,3" json:"application_id" path:"application_id"`
-	ActionType    int32  `thrift:"action_type,4" json:"action_type" path:"action_type"`
+type TeamApplicationSubmitRequest struct {
+	Authorization   string      `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	TeamID          int32       `thrift:"team_id,2" form:"team_id" json:"team_id" query:"team_id"`
+	Reason          string      `thrift:"reason,3" form:"reason" json:"reason" query:"reason"`
+	CreatedTime     int64       `thrift:"created_time,4" form:"created_time" json:"created_time" query:"created_time"`
+	ApplicationType int32       `thrift:"application_type,5" form:"application_type" json:"application_type" query:"application_type"`
+	MemberInfo      *MemberInfo `thrift:"member_info,6" form:"member_info" json:"member_info" query:"member_info"`
 }
 
-func NewTeamManageActionRequest() *TeamManageActionRequest {
-	return &TeamManageActionRequest{}
+func NewTeamApplicationSubmitRequest() *TeamApplicationSubmitRequest {
+	return &TeamApplicationSubmitRequest{}
 }
 
-func (p *TeamManageActionRequest) GetAuthorization() (v string) {
+func (p *TeamApplicationSubmitRequest) GetAuthorization() (v string) {
 	return p.Authorization
 }
 
-func (p *TeamManageActionRequest) GetUserID() (v int32) {
-	return p.UserID
+func (p *TeamApplicationSubmitRequest) GetTeamID() (v int32) {
+	return p.TeamID
 }
 
-func (p *TeamManageActionRequest) GetApplicationID() (v int32) {
-	return p.ApplicationID
+func (p *TeamApplicationSubmitRequest) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *TeamManageActionRequest) GetActionType() (v int32) {
-	return p.ActionType
+func (p *TeamApplicationSubmitRequest) GetCreatedTime() (v int64) {
+	return p.CreatedTime
 }
 
-var fieldIDToName_TeamManageActionRequest = map[int16]string{
+func (p *TeamApplicationSubmitRequest) GetApplicationType() (v int32) {
+	return p.ApplicationType
+}
+
+var TeamApplicationSubmitRequest_MemberInfo_DEFAULT *MemberInfo
+
+func (p *TeamApplicationSubmitRequest) GetMemberInfo() (v *MemberInfo) {
+	if !p.IsSetMemberInfo() {
+		return TeamApplicationSubmitRequest_MemberInfo_DEFAULT
+	}
+	return p.MemberInfo
+}
+
+var fieldIDToName_TeamApplicationSubmitRequest = map[int16]string{
 	1: "authorization",
-	2: "user_id",
-	3: "application_id",
-	4: "action_type",
+	2: "team_id",
+	3: "reason",
+	4: "created_time",
+	5: "application_type",
+	6: "member_info",
 }
 
-func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitRequest) IsSetMemberInfo() bool {
+	return p.MemberInfo != nil
+}
+
+func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12182,7 +13269,7 @@ func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12192,7 +13279,7 @@ func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12201,6 +13288,26 @@ func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12221,7 +13328,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12231,7 +13338,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -12240,36 +13347,53 @@ func (p *TeamManageActionRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageActionRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.UserID = v
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamApplicationSubmitRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ApplicationID = v
+		p.Reason = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CreatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationSubmitRequest) ReadField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ActionType = v
+		p.ApplicationType = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitRequest) ReadField6(iprot thrift.TProtocol) error {
+	p.MemberInfo = NewMemberInfo()
+	if err := p.MemberInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamApplicationSubmitRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageActionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationSubmitRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12289,6 +13413,14 @@ func (p *TeamManageActionRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -12308,7 +13440,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12325,11 +13457,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
-		goto WriteFieldBeginError
+func (p *TeamApplicationSubmitRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12342,11 +13474,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 3); err != nil {
+func (p *TeamApplicationSubmitRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationID); err != nil {
+	if err := oprot.WriteString(p.Reason); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12359,11 +13491,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 4); err != nil {
+func (p *TeamApplicationSubmitRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ActionType); err != nil {
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12376,36 +13508,70 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) String() string {
+func (p *TeamApplicationSubmitRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ApplicationType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamApplicationSubmitRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.MemberInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamApplicationSubmitRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageActionRequest(%+v)", *p)
+	return fmt.Sprintf("TeamApplicationSubmitRequest(%+v)", *p)
 }
 
-type TeamManageActionResponse struct {
+type TeamApplicationSubmitResponse struct {
 	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
 	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
 }
 
-func NewTeamManageActionResponse() *TeamManageActionResponse {
-	return &TeamManageActionResponse{}
+func NewTeamApplicationSubmitResponse() *TeamApplicationSubmitResponse {
+	return &TeamApplicationSubmitResponse{}
 }
 
-func (p *TeamManageActionResponse) GetStatusCode() (v int32) {
+func (p *TeamApplicationSubmitResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *TeamManageActionResponse) GetStatusMsg() (v string) {
+func (p *TeamApplicationSubmitResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-var fieldIDToName_TeamManageActionResponse = map[int16]string{
+var fieldIDToName_TeamApplicationSubmitResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
 }
 
-func (p *TeamManageActionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12464,7 +13630,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12474,7 +13640,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -12483,7 +13649,7 @@ func (p *TeamManageActionResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageActionResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -12492,9 +13658,9 @@ func (p *TeamManageActionResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageActionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageActionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationSubmitResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12525,7 +13691,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12542,7 +13708,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12559,49 +13725,57 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) String() string {
+func (p *TeamApplicationSubmitResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageActionResponse(%+v)", *p)
+	return fmt.Sprintf("TeamApplicationSubmitResponse(%+v)", *p)
 }
 
-/* =========================== favorite =========================== */
-type ContestFavoriteActionRequest struct {
-	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
-	ContestID     int32  `thrift:"contest_id,3" form:"contest_id" json:"contest_id" query:"contest_id"`
-	ActionType    int32  `thrift:"action_type,4" form:"action_type" json:"action_type" query:"action_type"`
+type MyApplication struct {
+	Application  *TeamApplication `thrift:"application,1" form:"application" json:"application" query:"application"`
+	TeamTitle    string           `thrift:"team_title,2" form:"team_title" json:"team_title" query:"team_title"`
+	ContestID    int32            `thrift:"contest_id,3" form:"contest_id" json:"contest_id" query:"contest_id"`
+	ContestTitle string           `thrift:"contest_title,4" form:"contest_title" json:"contest_title" query:"contest_title"`
 }
 
-func NewContestFavoriteActionRequest() *ContestFavoriteActionRequest {
-	return &ContestFavoriteActionRequest{}
+func NewMyApplication() *MyApplication {
+	return &MyApplication{}
 }
 
-func (p *ContestFavoriteActionRequest) GetAuthorization() (v string) {
-	return p.Authorization
+var MyApplication_Application_DEFAULT *TeamApplication
+
+func (p *MyApplication) GetApplication() (v *TeamApplication) {
+	if !p.IsSetApplication() {
+		return MyApplication_Application_DEFAULT
+	}
+	return p.Application
 }
 
-func (p *ContestFavoriteActionRequest) GetUserID() (v int32) {
-	return p.UserID
+func (p *MyApplication) GetTeamTitle() (v string) {
+	return p.TeamTitle
 }
 
-func (p *ContestFavoriteActionRequest) GetContestID() (v int32) {
+func (p *MyApplication) GetContestID() (v int32) {
 	return p.ContestID
 }
 
-func (p *ContestFavoriteActionRequest) GetActionType() (v int32) {
-	return p.ActionType
+func (p *MyApplication) GetContestTitle() (v string) {
+	return p.ContestTitle
 }
 
-var fieldIDToName_ContestFavoriteActionRequest = map[int16]string{
-	1: "authorization",
-	2: "user_id",
+var fieldIDToName_MyApplication = map[int16]string{
+	1: "application",
+	2: "team_title",
 	3: "contest_id",
-	4: "action_type",
+	4: "contest_title",
 }
 
-func (p *ContestFavoriteActionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *MyApplication) IsSetApplication() bool {
+	return p.Application != nil
+}
+
+func (p *MyApplication) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12621,7 +13795,7 @@ func (p *ContestFavoriteActionRequest) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12631,7 +13805,7 @@ func (p *ContestFavoriteActionRequest) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12651,7 +13825,7 @@ func (p *ContestFavoriteActionRequest) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12680,7 +13854,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteActionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyApplication[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12690,25 +13864,24 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *MyApplication) ReadField1(iprot thrift.TProtocol) error {
+	p.Application = NewTeamApplication()
+	if err := p.Application.Read(iprot); err != nil {
 		return err
-	} else {
-		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteActionRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyApplication) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.UserID = v
+		p.TeamTitle = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteActionRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *MyApplication) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -12717,18 +13890,18 @@ func (p *ContestFavoriteActionRequest) ReadField3(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ContestFavoriteActionRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyApplication) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ActionType = v
+		p.ContestTitle = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteActionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyApplication) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteActionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplication"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12767,11 +13940,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+func (p *MyApplication) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Authorization); err != nil {
+	if err := p.Application.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12784,11 +13957,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+func (p *MyApplication) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_title", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteString(p.TeamTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12801,7 +13974,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *MyApplication) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12818,11 +13991,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 4); err != nil {
+func (p *MyApplication) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_title", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ActionType); err != nil {
+	if err := oprot.WriteString(p.ContestTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -12835,36 +14008,54 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionRequest) String() string {
+func (p *MyApplication) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestFavoriteActionRequest(%+v)", *p)
+	return fmt.Sprintf("MyApplication(%+v)", *p)
 }
 
-type ContestFavoriteActionResponse struct {
-	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+type MyApplicationsRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	Status        string `thrift:"status,3" form:"status" json:"status" query:"status"`
+	Limit         int32  `thrift:"limit,4" form:"limit" json:"limit" query:"limit"`
+	Offset        int32  `thrift:"offset,5" form:"offset" json:"offset" query:"offset"`
 }
 
-func NewContestFavoriteActionResponse() *ContestFavoriteActionResponse {
-	return &ContestFavoriteActionResponse{}
+func NewMyApplicationsRequest() *MyApplicationsRequest {
+	return &MyApplicationsRequest{}
 }
 
-func (p *ContestFavoriteActionResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *MyApplicationsRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func (p *ContestFavoriteActionResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *MyApplicationsRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-var fieldIDToName_ContestFavoriteActionResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
+func (p *MyApplicationsRequest) GetStatus() (v string) {
+	return p.Status
 }
 
-func (p *ContestFavoriteActionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *MyApplicationsRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *MyApplicationsRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+
+var fieldIDToName_MyApplicationsRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "status",
+	4: "limit",
+	5: "offset",
+}
+
+func (p *MyApplicationsRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12884,7 +14075,7 @@ func (p *ContestFavoriteActionResponse) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12894,7 +14085,7 @@ func (p *ContestFavoriteActionResponse) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12903,6 +14094,36 @@ func (p *ContestFavoriteActionResponse) Read(iprot thrift.TProtocol) (err error)
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12923,7 +14144,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteActionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyApplicationsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12933,27 +14154,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *MyApplicationsRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *MyApplicationsRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.StatusCode = v
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteActionResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *MyApplicationsRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.StatusMsg = v
+		p.Status = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteActionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyApplicationsRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *MyApplicationsRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *MyApplicationsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteActionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplicationsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12965,6 +14213,18 @@ func (p *ContestFavoriteActionResponse) Write(oprot thrift.TProtocol) (err error
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -12984,11 +14244,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+func (p *MyApplicationsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
+	if err := oprot.WriteString(p.Authorization); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13001,11 +14261,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+func (p *MyApplicationsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StatusMsg); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13018,48 +14278,99 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestFavoriteActionResponse) String() string {
+func (p *MyApplicationsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MyApplicationsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MyApplicationsRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *MyApplicationsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestFavoriteActionResponse(%+v)", *p)
+	return fmt.Sprintf("MyApplicationsRequest(%+v)", *p)
 }
 
-type ContestFavoriteListRequest struct {
-	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
-	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
-	Limit         int32  `thrift:"limit,3" form:"limit" json:"limit" query:"limit"`
-	Offset        int32  `thrift:"offset,4" form:"offset" json:"offset" query:"offset"`
+type MyApplicationsResponse struct {
+	StatusCode      int32            `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg       string           `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total           int32            `thrift:"total,3" form:"total" json:"total" query:"total"`
+	ApplicationList []*MyApplication `thrift:"application_list,4" form:"application_list" json:"application_list" query:"application_list"`
 }
 
-func NewContestFavoriteListRequest() *ContestFavoriteListRequest {
-	return &ContestFavoriteListRequest{}
+func NewMyApplicationsResponse() *MyApplicationsResponse {
+	return &MyApplicationsResponse{}
 }
 
-func (p *ContestFavoriteListRequest) GetAuthorization() (v string) {
-	return p.Authorization
+func (p *MyApplicationsResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func (p *ContestFavoriteListRequest) GetUserID() (v int32) {
-	return p.UserID
+func (p *MyApplicationsResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-func (p *ContestFavoriteListRequest) GetLimit() (v int32) {
-	return p.Limit
+func (p *MyApplicationsResponse) GetTotal() (v int32) {
+	return p.Total
 }
 
-func (p *ContestFavoriteListRequest) GetOffset() (v int32) {
-	return p.Offset
+func (p *MyApplicationsResponse) GetApplicationList() (v []*MyApplication) {
+	return p.ApplicationList
 }
 
-var fieldIDToName_ContestFavoriteListRequest = map[int16]string{
-	1: "authorization",
-	2: "user_id",
-	3: "limit",
-	4: "offset",
+var fieldIDToName_MyApplicationsResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "application_list",
 }
 
-func (p *ContestFavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *MyApplicationsResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13079,7 +14390,7 @@ func (p *ContestFavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13089,7 +14400,7 @@ func (p *ContestFavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13109,7 +14420,7 @@ func (p *ContestFavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13138,7 +14449,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyApplicationsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13148,45 +14459,56 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteListRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *MyApplicationsResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Authorization = v
+		p.StatusCode = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyApplicationsResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.UserID = v
+		p.StatusMsg = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *MyApplicationsResponse) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Limit = v
+		p.Total = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyApplicationsResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ApplicationList = make([]*MyApplication, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewMyApplication()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ApplicationList = append(p.ApplicationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		p.Offset = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyApplicationsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplicationsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13225,11 +14547,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+func (p *MyApplicationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Authorization); err != nil {
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13242,11 +14564,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+func (p *MyApplicationsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserID); err != nil {
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13259,11 +14581,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+func (p *MyApplicationsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Limit); err != nil {
+	if err := oprot.WriteI32(p.Total); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13276,11 +14598,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+func (p *MyApplicationsResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_list", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Offset); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ApplicationList)); err != nil {
+		return err
+	}
+	for _, v := range p.ApplicationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13293,48 +14623,51 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListRequest) String() string {
+func (p *MyApplicationsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestFavoriteListRequest(%+v)", *p)
+	return fmt.Sprintf("MyApplicationsResponse(%+v)", *p)
 }
 
-type ContestFavoriteListResponse struct {
-	StatusCode  int32               `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg   string              `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	ContestList []*ContestBriefInfo `thrift:"contest_list,3" form:"contest_list" json:"contest_list" query:"contest_list"`
-	Total       int32               `thrift:"total,4" form:"total" json:"total" query:"total"`
+type MyTeam struct {
+	TeamBriefInfo *TeamBriefInfo `thrift:"team_brief_info,1" form:"team_brief_info" json:"team_brief_info" query:"team_brief_info"`
+	ContestTitle  string         `thrift:"contest_title,2" form:"contest_title" json:"contest_title" query:"contest_title"`
+	Role          string         `thrift:"role,3" form:"role" json:"role" query:"role"`
 }
 
-func NewContestFavoriteListResponse() *ContestFavoriteListResponse {
-	return &ContestFavoriteListResponse{}
+func NewMyTeam() *MyTeam {
+	return &MyTeam{}
 }
 
-func (p *ContestFavoriteListResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+var MyTeam_TeamBriefInfo_DEFAULT *TeamBriefInfo
+
+func (p *MyTeam) GetTeamBriefInfo() (v *TeamBriefInfo) {
+	if !p.IsSetTeamBriefInfo() {
+		return MyTeam_TeamBriefInfo_DEFAULT
+	}
+	return p.TeamBriefInfo
 }
 
-func (p *ContestFavoriteListResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *MyTeam) GetContestTitle() (v string) {
+	return p.ContestTitle
 }
 
-func (p *ContestFavoriteListResponse) GetContestList() (v []*ContestBriefInfo) {
-	return p.ContestList
+func (p *MyTeam) GetRole() (v string) {
+	return p.Role
 }
 
-func (p *ContestFavoriteListResponse) GetTotal() (v int32) {
-	return p.Total
+var fieldIDToName_MyTeam = map[int16]string{
+	1: "team_brief_info",
+	2: "contest_title",
+	3: "role",
 }
 
-var fieldIDToName_ContestFavoriteListResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
-	3: "contest_list",
-	4: "total",
+func (p *MyTeam) IsSetTeamBriefInfo() bool {
+	return p.TeamBriefInfo != nil
 }
 
-func (p *ContestFavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *MyTeam) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13354,7 +14687,7 @@ func (p *ContestFavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13374,7 +14707,7 @@ func (p *ContestFavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13383,16 +14716,6 @@ func (p *ContestFavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -13413,7 +14736,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyTeam[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13423,56 +14746,35 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteListResponse) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyTeam) ReadField1(iprot thrift.TProtocol) error {
+	p.TeamBriefInfo = NewTeamBriefInfo()
+	if err := p.TeamBriefInfo.Read(iprot); err != nil {
 		return err
-	} else {
-		p.StatusCode = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *MyTeam) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.StatusMsg = v
-	}
-	return nil
-}
-
-func (p *ContestFavoriteListResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	p.ContestList = make([]*ContestBriefInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewContestBriefInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		p.ContestList = append(p.ContestList, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+		p.ContestTitle = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListResponse) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyTeam) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Total = v
+		p.Role = v
 	}
 	return nil
 }
 
-func (p *ContestFavoriteListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyTeam) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("MyTeam"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13488,10 +14790,6 @@ func (p *ContestFavoriteListResponse) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -13511,11 +14809,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestFavoriteListResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+func (p *MyTeam) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_brief_info", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
+	if err := p.TeamBriefInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13528,11 +14826,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+func (p *MyTeam) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_title", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StatusMsg); err != nil {
+	if err := oprot.WriteString(p.ContestTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13545,19 +14843,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 3); err != nil {
+func (p *MyTeam) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
-		return err
-	}
-	for _, v := range p.ContestList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.Role); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13570,78 +14860,54 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestFavoriteListResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
-		goto WriteFieldBeginError
+func (p *MyTeam) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteI32(p.Total); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *ContestFavoriteListResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ContestFavoriteListResponse(%+v)", *p)
-}
-
-/* =========================== article =========================== */
-type ArticleBrief struct {
-	ArticleID   int32  `thrift:"article_id,1" form:"article_id" json:"article_id" query:"article_id"`
-	Title       string `thrift:"title,2" form:"title" json:"title" query:"title"`
-	AuthorID    int32  `thrift:"author_id,3" form:"author_id" json:"author_id" query:"author_id"`
-	Author      string `thrift:"author,4" form:"author" json:"author" query:"author"`
-	CreatedTime int64  `thrift:"created_time,5" form:"created_time" json:"created_time" query:"created_time"`
-	Link        string `thrift:"link,6" form:"link" json:"link" query:"link"`
+	return fmt.Sprintf("MyTeam(%+v)", *p)
 }
 
-func NewArticleBrief() *ArticleBrief {
-	return &ArticleBrief{}
+type MyTeamListRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	Role          string `thrift:"role,3" form:"role" json:"role" query:"role"`
+	Limit         int32  `thrift:"limit,4" form:"limit" json:"limit" query:"limit"`
+	Offset        int32  `thrift:"offset,5" form:"offset" json:"offset" query:"offset"`
 }
 
-func (p *ArticleBrief) GetArticleID() (v int32) {
-	return p.ArticleID
+func NewMyTeamListRequest() *MyTeamListRequest {
+	return &MyTeamListRequest{}
 }
 
-func (p *ArticleBrief) GetTitle() (v string) {
-	return p.Title
+func (p *MyTeamListRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func (p *ArticleBrief) GetAuthorID() (v int32) {
-	return p.AuthorID
+func (p *MyTeamListRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func (p *ArticleBrief) GetAuthor() (v string) {
-	return p.Author
+func (p *MyTeamListRequest) GetRole() (v string) {
+	return p.Role
 }
 
-func (p *ArticleBrief) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func (p *MyTeamListRequest) GetLimit() (v int32) {
+	return p.Limit
 }
 
-func (p *ArticleBrief) GetLink() (v string) {
-	return p.Link
+func (p *MyTeamListRequest) GetOffset() (v int32) {
+	return p.Offset
 }
 
-var fieldIDToName_ArticleBrief = map[int16]string{
-	1: "article_id",
-	2: "title",
-	3: "author_id",
-	4: "author",
-	5: "created_time",
-	6: "link",
+var fieldIDToName_MyTeamListRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "role",
+	4: "limit",
+	5: "offset",
 }
 
-func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
+func (p *MyTeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13661,7 +14927,7 @@ func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13671,7 +14937,7 @@ func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13681,7 +14947,7 @@ func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13691,7 +14957,7 @@ func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13701,7 +14967,7 @@ func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13710,16 +14976,6 @@ func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -13740,7 +14996,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleBrief[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyTeamListRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13750,63 +15006,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleBrief) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.ArticleID = v
-	}
-	return nil
-}
-
-func (p *ArticleBrief) ReadField2(iprot thrift.TProtocol) error {
+func (p *MyTeamListRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Title = v
+		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *ArticleBrief) ReadField3(iprot thrift.TProtocol) error {
+func (p *MyTeamListRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.AuthorID = v
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *ArticleBrief) ReadField4(iprot thrift.TProtocol) error {
+func (p *MyTeamListRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Author = v
+		p.Role = v
 	}
 	return nil
 }
 
-func (p *ArticleBrief) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *MyTeamListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.CreatedTime = v
+		p.Limit = v
 	}
 	return nil
 }
 
-func (p *ArticleBrief) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *MyTeamListRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Link = v
+		p.Offset = v
 	}
 	return nil
 }
 
-func (p *ArticleBrief) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyTeamListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleBrief"); err != nil {
+	if err = oprot.WriteStructBegin("MyTeamListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13830,10 +15077,6 @@ func (p *ArticleBrief) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -13853,11 +15096,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleBrief) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_id", thrift.I32, 1); err != nil {
+func (p *MyTeamListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ArticleID); err != nil {
+	if err := oprot.WriteString(p.Authorization); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13870,11 +15113,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleBrief) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *MyTeamListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13887,11 +15130,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleBrief) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author_id", thrift.I32, 3); err != nil {
+func (p *MyTeamListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.AuthorID); err != nil {
+	if err := oprot.WriteString(p.Role); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13904,11 +15147,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ArticleBrief) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author", thrift.STRING, 4); err != nil {
+func (p *MyTeamListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Author); err != nil {
+	if err := oprot.WriteI32(p.Limit); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13921,11 +15164,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ArticleBrief) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 5); err != nil {
+func (p *MyTeamListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+	if err := oprot.WriteI32(p.Offset); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13938,56 +15181,48 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ArticleBrief) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("link", thrift.STRING, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Link); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *ArticleBrief) String() string {
+func (p *MyTeamListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ArticleBrief(%+v)", *p)
+	return fmt.Sprintf("MyTeamListRequest(%+v)", *p)
 }
 
-type ArticleBriefInfo struct {
-	ArticleBriefInfo *ArticleBrief `thrift:"article_brief_info,1" form:"article_brief_info" json:"article_brief_info" query:"article_brief_info"`
+type MyTeamListResponse struct {
+	StatusCode int32     `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string    `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total      int32     `thrift:"total,3" form:"total" json:"total" query:"total"`
+	TeamList   []*MyTeam `thrift:"team_list,4" form:"team_list" json:"team_list" query:"team_list"`
 }
 
-func NewArticleBriefInfo() *ArticleBriefInfo {
-	return &ArticleBriefInfo{}
+func NewMyTeamListResponse() *MyTeamListResponse {
+	return &MyTeamListResponse{}
 }
 
-var ArticleBriefInfo_ArticleBriefInfo_DEFAULT *ArticleBrief
+func (p *MyTeamListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
 
-func (p *ArticleBriefInfo) GetArticleBriefInfo() (v *ArticleBrief) {
-	if !p.IsSetArticleBriefInfo() {
-		return ArticleBriefInfo_ArticleBriefInfo_DEFAULT
-	}
-	return p.ArticleBriefInfo
+func (p *MyTeamListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-var fieldIDToName_ArticleBriefInfo = map[int16]string{
-	1: "article_brief_info",
+func (p *MyTeamListResponse) GetTotal() (v int32) {
+	return p.Total
 }
 
-func (p *ArticleBriefInfo) IsSetArticleBriefInfo() bool {
-	return p.ArticleBriefInfo != nil
+func (p *MyTeamListResponse) GetTeamList() (v []*MyTeam) {
+	return p.TeamList
 }
 
-func (p *ArticleBriefInfo) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_MyTeamListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "team_list",
+}
+
+func (p *MyTeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14007,7 +15242,7 @@ func (p *ArticleBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -14016,6 +15251,36 @@ func (p *ArticleBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -14036,7 +15301,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleBriefInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyTeamListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14046,48 +15311,99 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleBriefInfo) ReadField1(iprot thrift.TProtocol) error {
-	p.ArticleBriefInfo = NewArticleBrief()
-	if err := p.ArticleBriefInfo.Read(iprot); err != nil {
+func (p *MyTeamListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.StatusCode = v
 	}
 	return nil
 }
 
-func (p *ArticleBriefInfo) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleBriefInfo"); err != nil {
-		goto WriteStructBeginError
+func (p *MyTeamListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
+	return nil
+}
 
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+func (p *MyTeamListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleBriefInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_brief_info", thrift.STRUCT, 1); err != nil {
+func (p *MyTeamListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamList = make([]*MyTeam, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewMyTeam()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamList = append(p.TeamList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MyTeamListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyTeamListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.ArticleBriefInfo.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14100,42 +15416,101 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleBriefInfo) String() string {
+func (p *MyTeamListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamList)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ArticleBriefInfo(%+v)", *p)
+	return fmt.Sprintf("MyTeamListResponse(%+v)", *p)
 }
 
-type ArticleListRequest struct {
-	ContestID int32 `thrift:"contest_id,1" json:"contest_id" query:"contest_id"`
-	Limit     int32 `thrift:"limit,2" json:"limit" query:"limit"`
-	Offset    int32 `thrift:"offset,3" json:"offset" query:"offset"`
+type TeamApplicationWithdrawRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	ApplicationID int32  `thrift:"application_id,3" form:"application_id" json:"application_id" query:"application_id"`
 }
 
-func NewArticleListRequest() *ArticleListRequest {
-	return &ArticleListRequest{}
+func NewTeamApplicationWithdrawRequest() *TeamApplicationWithdrawRequest {
+	return &TeamApplicationWithdrawRequest{}
 }
 
-func (p *ArticleListRequest) GetContestID() (v int32) {
-	return p.ContestID
+func (p *TeamApplicationWithdrawRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func (p *ArticleListRequest) GetLimit() (v int32) {
-	return p.Limit
+func (p *TeamApplicationWithdrawRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func (p *ArticleListRequest) GetOffset() (v int32) {
-	return p.Offset
+func (p *TeamApplicationWithdrawRequest) GetApplicationID() (v int32) {
+	return p.ApplicationID
 }
 
-var fieldIDToName_ArticleListRequest = map[int16]string{
-	1: "contest_id",
-	2: "limit",
-	3: "offset",
+var fieldIDToName_TeamApplicationWithdrawRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "application_id",
 }
 
-func (p *ArticleListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationWithdrawRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14155,7 +15530,7 @@ func (p *ArticleListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -14204,7 +15579,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationWithdrawRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14214,36 +15589,36 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleListRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamApplicationWithdrawRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ContestID = v
+		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *ArticleListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplicationWithdrawRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Limit = v
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *ArticleListRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamApplicationWithdrawRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Offset = v
+		p.ApplicationID = v
 	}
 	return nil
 }
 
-func (p *ArticleListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationWithdrawRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationWithdrawRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14278,11 +15653,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
+func (p *TeamApplicationWithdrawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
+	if err := oprot.WriteString(p.Authorization); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14295,11 +15670,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
+func (p *TeamApplicationWithdrawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Limit); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14312,11 +15687,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleListRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("offset", thrift.I32, 3); err != nil {
+func (p *TeamApplicationWithdrawRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Offset); err != nil {
+	if err := oprot.WriteI32(p.ApplicationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14329,48 +15704,36 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ArticleListRequest) String() string {
+func (p *TeamApplicationWithdrawRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ArticleListRequest(%+v)", *p)
+	return fmt.Sprintf("TeamApplicationWithdrawRequest(%+v)", *p)
 }
 
-type ArticleListResponse struct {
-	StatusCode  int32               `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg   string              `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	Total       int32               `thrift:"total,3" form:"total" json:"total" query:"total"`
-	ArticleList []*ArticleBriefInfo `thrift:"article_list,4" form:"article_list" json:"article_list" query:"article_list"`
+type TeamApplicationWithdrawResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
 }
 
-func NewArticleListResponse() *ArticleListResponse {
-	return &ArticleListResponse{}
+func NewTeamApplicationWithdrawResponse() *TeamApplicationWithdrawResponse {
+	return &TeamApplicationWithdrawResponse{}
 }
 
-func (p *ArticleListResponse) GetStatusCode() (v int32) {
+func (p *TeamApplicationWithdrawResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ArticleListResponse) GetStatusMsg() (v string) {
+func (p *TeamApplicationWithdrawResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-func (p *ArticleListResponse) GetTotal() (v int32) {
-	return p.Total
-}
-
-func (p *ArticleListResponse) GetArticleList() (v []*ArticleBriefInfo) {
-	return p.ArticleList
-}
-
-var fieldIDToName_ArticleListResponse = map[int16]string{
+var fieldIDToName_TeamApplicationWithdrawResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "total",
-	4: "article_list",
 }
 
-func (p *ArticleListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationWithdrawResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14409,26 +15772,6 @@ func (p *ArticleListResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -14449,7 +15792,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationWithdrawResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14459,7 +15802,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplicationWithdrawResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -14468,7 +15811,7 @@ func (p *ArticleListResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ArticleListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplicationWithdrawResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -14477,38 +15820,9 @@ func (p *ArticleListResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ArticleListResponse) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.Total = v
-	}
-	return nil
-}
-
-func (p *ArticleListResponse) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	p.ArticleList = make([]*ArticleBriefInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewArticleBriefInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		p.ArticleList = append(p.ArticleList, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (p *ArticleListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationWithdrawResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationWithdrawResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14520,14 +15834,6 @@ func (p *ArticleListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -14547,7 +15853,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationWithdrawResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14564,7 +15870,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationWithdrawResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14581,102 +15887,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.Total); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *ArticleListResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_list", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ArticleList)); err != nil {
-		return err
-	}
-	for _, v := range p.ArticleList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *ArticleListResponse) String() string {
+func (p *TeamApplicationWithdrawResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ArticleListResponse(%+v)", *p)
-}
-
-type ArticleCreateRequest struct {
-	ArticleID int32  `thrift:"article_id,1" form:"article_id" json:"article_id" query:"article_id"`
-	Title     string `thrift:"title,2" form:"title" json:"title" query:"title"`
-	AuthorID  int32  `thrift:"author_id,3" form:"author_id" json:"author_id" query:"author_id"`
-	Author    string `thrift:"author,4" form:"author" json:"author" query:"author"`
-	Link      string `thrift:"link,5" form:"link" json:"link" query:"link"`
-	ContestID int32  `thrift:"contest_id,6" form:"contest_id" json:"contest_id" query:"contest_id"`
-}
-
-func NewArticleCreateRequest() *ArticleCreateRequest {
-	return &ArticleCreateRequest{}
-}
-
-func (p *ArticleCreateRequest) GetArticleID() (v int32) {
-	return p.ArticleID
+	return fmt.Sprintf("TeamApplicationWithdrawResponse(%+v)", *p)
 }
 
-func (p *ArticleCreateRequest) GetTitle() (v string) {
-	return p.Title
+type TeamManageListRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" json:"team_id" query:"team_id"`
 }
 
-func (p *ArticleCreateRequest) GetAuthorID() (v int32) {
-	return p.AuthorID
+func NewTeamManageListRequest() *TeamManageListRequest {
+	return &TeamManageListRequest{}
 }
 
-func (p *ArticleCreateRequest) GetAuthor() (v string) {
-	return p.Author
+func (p *TeamManageListRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func (p *ArticleCreateRequest) GetLink() (v string) {
-	return p.Link
+func (p *TeamManageListRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func (p *ArticleCreateRequest) GetContestID() (v int32) {
-	return p.ContestID
+func (p *TeamManageListRequest) GetTeamID() (v int32) {
+	return p.TeamID
 }
 
-var fieldIDToName_ArticleCreateRequest = map[int16]string{
-	1: "article_id",
-	2: "title",
-	3: "author_id",
-	4: "author",
-	5: "link",
-	6: "contest_id",
+var fieldIDToName_TeamManageListRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
 }
 
-func (p *ArticleCreateRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamManageListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14696,7 +15942,7 @@ func (p *ArticleCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -14706,7 +15952,7 @@ func (p *ArticleCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -14725,36 +15971,6 @@ func (p *ArticleCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -14775,7 +15991,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleCreateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14785,63 +16001,36 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleCreateRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		p.ArticleID = v
-	}
-	return nil
-}
-
-func (p *ArticleCreateRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamManageListRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Title = v
+		p.Authorization = v
 	}
 	return nil
 }
 
-func (p *ArticleCreateRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamManageListRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.AuthorID = v
-	}
-	return nil
-}
-
-func (p *ArticleCreateRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Author = v
-	}
-	return nil
-}
-
-func (p *ArticleCreateRequest) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Link = v
+		p.UserID = v
 	}
 	return nil
 }
 
-func (p *ArticleCreateRequest) ReadField6(iprot thrift.TProtocol) error {
+func (p *TeamManageListRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ContestID = v
+		p.TeamID = v
 	}
 	return nil
 }
 
-func (p *ArticleCreateRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamManageListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleCreateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamManageListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14857,18 +16046,6 @@ func (p *ArticleCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -14888,11 +16065,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_id", thrift.I32, 1); err != nil {
+func (p *TeamManageListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ArticleID); err != nil {
+	if err := oprot.WriteString(p.Authorization); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14905,11 +16082,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleCreateRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *TeamManageListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteI32(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14922,11 +16099,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleCreateRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author_id", thrift.I32, 3); err != nil {
+func (p *TeamManageListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.AuthorID); err != nil {
+	if err := oprot.WriteI32(p.TeamID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14939,93 +16116,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ArticleCreateRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("author", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Author); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *TeamManageListRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return fmt.Sprintf("TeamManageListRequest(%+v)", *p)
 }
 
-func (p *ArticleCreateRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("link", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Link); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+type TeamManageListResponse struct {
+	StatusCode      int32              `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg       string             `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	ApplicationList []*TeamApplication `thrift:"application_list,3" form:"application_list" json:"application_list" query:"application_list"`
 }
 
-func (p *ArticleCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.ContestID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *ArticleCreateRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ArticleCreateRequest(%+v)", *p)
-}
-
-type ArticleCreateResponse struct {
-	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
-	ArticleID  int32  `thrift:"article_id,3" form:"article_id" json:"article_id" query:"article_id"`
-}
-
-func NewArticleCreateResponse() *ArticleCreateResponse {
-	return &ArticleCreateResponse{}
+func NewTeamManageListResponse() *TeamManageListResponse {
+	return &TeamManageListResponse{}
 }
 
-func (p *ArticleCreateResponse) GetStatusCode() (v int32) {
+func (p *TeamManageListResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ArticleCreateResponse) GetStatusMsg() (v string) {
+func (p *TeamManageListResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-func (p *ArticleCreateResponse) GetArticleID() (v int32) {
-	return p.ArticleID
+func (p *TeamManageListResponse) GetApplicationList() (v []*TeamApplication) {
+	return p.ApplicationList
 }
 
-var fieldIDToName_ArticleCreateResponse = map[int16]string{
+var fieldIDToName_TeamManageListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "article_id",
+	3: "application_list",
 }
 
-func (p *ArticleCreateResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamManageListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15065,7 +16191,7 @@ func (p *ArticleCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -15094,7 +16220,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleCreateResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15104,7 +16230,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ArticleCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamManageListResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -15113,7 +16239,7 @@ func (p *ArticleCreateResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ArticleCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamManageListResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -15122,18 +16248,29 @@ func (p *ArticleCreateResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ArticleCreateResponse) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamManageListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ApplicationList = make([]*TeamApplication, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamApplication()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ApplicationList = append(p.ApplicationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		p.ArticleID = v
 	}
 	return nil
 }
 
-func (p *ArticleCreateResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamManageListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ArticleCreateResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamManageListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15168,7 +16305,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ArticleCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamManageListResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15185,7 +16322,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ArticleCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamManageListResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15202,11 +16339,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ArticleCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("article_id", thrift.I32, 3); err != nil {
+func (p *TeamManageListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_list", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ArticleID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ApplicationList)); err != nil {
+		return err
+	}
+	for _, v := range p.ApplicationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -15219,1315 +16364,15532 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ArticleCreateResponse) String() string {
+func (p *TeamManageListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ArticleCreateResponse(%+v)", *p)
+	return fmt.Sprintf("TeamManageListResponse(%+v)", *p)
 }
 
-type ApiService interface {
-	/* user */
-	// 用户注册操作
-	UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error)
-	// 用户登录操作
-	UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error)
-	// 获取用户信息
-	UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error)
-	// 上传用户信息
-	UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error)
-	// 获取用户档案信息
-	UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error)
-	// 上传用户档案信息
-	UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error)
-	/* contest */
-	// 获取赛事资讯列表
-	ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error)
-	// 获取赛事资讯详情
-	ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error)
-	// 创建赛事资讯
-	ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error)
-	/* utils */
-	// 上传图片
-	ImageUpload(ctx context.Context, req *ImageUploadRequest) (r *ImageUploadResponse, err error)
-	/* team */
-	// 创建队伍
-	TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error)
-	// 获取队伍列表
-	TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error)
-	// 获取队伍详情
-	TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error)
-	// 提交队伍申请
-	TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error)
-	// 获取队伍申请列表
-	TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error)
-	// 队伍申请操作
-	TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error)
-	/* favorite */
-	// 赛事收藏操作
-	ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error)
-	// 获取赛事收藏列表
-	ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error)
-	/* article */
-	// 获取赛事资讯文章列表
-	ArticleList(ctx context.Context, req *ArticleListRequest) (r *ArticleListResponse, err error)
-	// 创建赛事资讯文章
-	ArticleCreate(ctx context.Context, req *ArticleCreateRequest) (r *ArticleCreateResponse, err error)
+type TeamLeaveRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
 }
 
-type ApiServiceClient struct {
-	c thrift.TClient
+func NewTeamLeaveRequest() *TeamLeaveRequest {
+	return &TeamLeaveRequest{}
 }
 
-func NewApiServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *TeamLeaveRequest) GetAuthorization() (v string) {
+	return p.Authorization
 }
 
-func NewApiServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *TeamLeaveRequest) GetUserID() (v int32) {
+	return p.UserID
 }
 
-func NewApiServiceClient(c thrift.TClient) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: c,
-	}
+func (p *TeamLeaveRequest) GetTeamID() (v int32) {
+	return p.TeamID
 }
 
-func (p *ApiServiceClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_TeamLeaveRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
 }
 
-func (p *ApiServiceClient) UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error) {
-	var _args ApiServiceUserRegisterArgs
-	_args.Req = req
-	var _result ApiServiceUserRegisterResult
-	if err = p.Client_().Call(ctx, "UserRegister", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error) {
-	var _args ApiServiceUserLoginArgs
-	_args.Req = req
-	var _result ApiServiceUserLoginResult
-	if err = p.Client_().Call(ctx, "UserLogin", &_args, &_result); err != nil {
-		return
+func (p *TeamLeaveRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error) {
-	var _args ApiServiceUserInfoArgs
-	_args.Req = req
-	var _result ApiServiceUserInfoResult
-	if err = p.Client_().Call(ctx, "UserInfo", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error) {
-	var _args ApiServiceUserInfoUploadArgs
-	_args.Req = req
-	var _result ApiServiceUserInfoUploadResult
-	if err = p.Client_().Call(ctx, "UserInfoUpload", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaveRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *ApiServiceClient) UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error) {
-	var _args ApiServiceUserProfileInfoArgs
-	_args.Req = req
-	var _result ApiServiceUserProfileInfoResult
-	if err = p.Client_().Call(ctx, "UserProfileInfo", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
 	}
-	return _result.GetSuccess(), nil
+	return nil
 }
-func (p *ApiServiceClient) UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error) {
-	var _args ApiServiceUserProfileUploadArgs
-	_args.Req = req
-	var _result ApiServiceUserProfileUploadResult
-	if err = p.Client_().Call(ctx, "UserProfileUpload", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
 	}
-	return _result.GetSuccess(), nil
+	return nil
 }
-func (p *ApiServiceClient) ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error) {
-	var _args ApiServiceContestListArgs
-	_args.Req = req
-	var _result ApiServiceContestListResult
-	if err = p.Client_().Call(ctx, "ContestList", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
 	}
-	return _result.GetSuccess(), nil
+	return nil
 }
-func (p *ApiServiceClient) ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error) {
-	var _args ApiServiceContestInfoArgs
-	_args.Req = req
-	var _result ApiServiceContestInfoResult
-	if err = p.Client_().Call(ctx, "ContestInfo", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaveRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error) {
-	var _args ApiServiceContestCreateArgs
-	_args.Req = req
-	var _result ApiServiceContestCreateResult
-	if err = p.Client_().Call(ctx, "ContestCreate", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ImageUpload(ctx context.Context, req *ImageUploadRequest) (r *ImageUploadResponse, err error) {
-	var _args ApiServiceImageUploadArgs
-	_args.Req = req
-	var _result ApiServiceImageUploadResult
-	if err = p.Client_().Call(ctx, "ImageUpload", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error) {
-	var _args ApiServiceTeamCreateArgs
-	_args.Req = req
-	var _result ApiServiceTeamCreateResult
-	if err = p.Client_().Call(ctx, "TeamCreate", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *ApiServiceClient) TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error) {
-	var _args ApiServiceTeamListArgs
-	_args.Req = req
-	var _result ApiServiceTeamListResult
-	if err = p.Client_().Call(ctx, "TeamList", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error) {
-	var _args ApiServiceTeamInfoArgs
-	_args.Req = req
-	var _result ApiServiceTeamInfoResult
-	if err = p.Client_().Call(ctx, "TeamInfo", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error) {
-	var _args ApiServiceTeamApplicationSubmitArgs
-	_args.Req = req
-	var _result ApiServiceTeamApplicationSubmitResult
-	if err = p.Client_().Call(ctx, "TeamApplicationSubmit", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ApiServiceClient) TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error) {
-	var _args ApiServiceTeamManageListArgs
-	_args.Req = req
-	var _result ApiServiceTeamManageListResult
-	if err = p.Client_().Call(ctx, "TeamManageList", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error) {
-	var _args ApiServiceTeamManageActionArgs
-	_args.Req = req
-	var _result ApiServiceTeamManageActionResult
-	if err = p.Client_().Call(ctx, "TeamManageAction", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error) {
-	var _args ApiServiceContestFavoriteActionArgs
-	_args.Req = req
-	var _result ApiServiceContestFavoriteActionResult
-	if err = p.Client_().Call(ctx, "ContestFavoriteAction", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ApiServiceClient) ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error) {
-	var _args ApiServiceContestFavoriteListArgs
-	_args.Req = req
-	var _result ApiServiceContestFavoriteListResult
-	if err = p.Client_().Call(ctx, "ContestFavoriteList", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ArticleList(ctx context.Context, req *ArticleListRequest) (r *ArticleListResponse, err error) {
-	var _args ApiServiceArticleListArgs
-	_args.Req = req
-	var _result ApiServiceArticleListResult
-	if err = p.Client_().Call(ctx, "ArticleList", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ApiServiceClient) ArticleCreate(ctx context.Context, req *ArticleCreateRequest) (r *ArticleCreateResponse, err error) {
-	var _args ApiServiceArticleCreateArgs
-	_args.Req = req
-	var _result ApiServiceArticleCreateResult
-	if err = p.Client_().Call(ctx, "ArticleCreate", &_args, &_result); err != nil {
-		return
+
+func (p *TeamLeaveRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("TeamLeaveRequest(%+v)", *p)
 }
 
-type ApiServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      ApiService
+type TeamLeaveResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
 }
 
-func (p *ApiServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewTeamLeaveResponse() *TeamLeaveResponse {
+	return &TeamLeaveResponse{}
 }
 
-func (p *ApiServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *TeamLeaveResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func (p *ApiServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *TeamLeaveResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-func NewApiServiceProcessor(handler ApiService) *ApiServiceProcessor {
-	self := &ApiServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("UserRegister", &apiServiceProcessorUserRegister{handler: handler})
-	self.AddToProcessorMap("UserLogin", &apiServiceProcessorUserLogin{handler: handler})
-	self.AddToProcessorMap("UserInfo", &apiServiceProcessorUserInfo{handler: handler})
-	self.AddToProcessorMap("UserInfoUpload", &apiServiceProcessorUserInfoUpload{handler: handler})
-	self.AddToProcessorMap("UserProfileInfo", &apiServiceProcessorUserProfileInfo{handler: handler})
-	self.AddToProcessorMap("UserProfileUpload", &apiServiceProcessorUserProfileUpload{handler: handler})
-	self.AddToProcessorMap("ContestList", &apiServiceProcessorContestList{handler: handler})
-	self.AddToProcessorMap("ContestInfo", &apiServiceProcessorContestInfo{handler: handler})
-	self.AddToProcessorMap("ContestCreate", &apiServiceProcessorContestCreate{handler: handler})
-	self.AddToProcessorMap("ImageUpload", &apiServiceProcessorImageUpload{handler: handler})
-	self.AddToProcessorMap("TeamCreate", &apiServiceProcessorTeamCreate{handler: handler})
-	self.AddToProcessorMap("TeamList", &apiServiceProcessorTeamList{handler: handler})
-	self.AddToProcessorMap("TeamInfo", &apiServiceProcessorTeamInfo{handler: handler})
-	self.AddToProcessorMap("TeamApplicationSubmit", &apiServiceProcessorTeamApplicationSubmit{handler: handler})
-	self.AddToProcessorMap("TeamManageList", &apiServiceProcessorTeamManageList{handler: handler})
-	self.AddToProcessorMap("TeamManageAction", &apiServiceProcessorTeamManageAction{handler: handler})
-	self.AddToProcessorMap("ContestFavoriteAction", &apiServiceProcessorContestFavoriteAction{handler: handler})
-	self.AddToProcessorMap("ContestFavoriteList", &apiServiceProcessorContestFavoriteList{handler: handler})
-	self.AddToProcessorMap("ArticleList", &apiServiceProcessorArticleList{handler: handler})
-	self.AddToProcessorMap("ArticleCreate", &apiServiceProcessorArticleCreate{handler: handler})
-	return self
+var fieldIDToName_TeamLeaveResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
 }
-func (p *ApiServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *TeamLeaveResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type apiServiceProcessorUserRegister struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaveResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorUserRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUserRegisterArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamLeaveResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUserRegisterResult{}
-	var retval *UserRegisterResponse
-	if retval, err2 = p.handler.UserRegister(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserRegister: "+err2.Error())
-		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+func (p *TeamLeaveResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UserRegister", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+		p.StatusMsg = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *TeamLeaveResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaveResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type apiServiceProcessorUserLogin struct {
-	handler ApiService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorUserLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUserLoginArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamLeaveResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUserLoginResult{}
-	var retval *UserLoginResponse
-	if retval, err2 = p.handler.UserLogin(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserLogin: "+err2.Error())
-		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("UserLogin", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaveResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaveResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("TeamLeaveResponse(%+v)", *p)
 }
 
-type apiServiceProcessorUserInfo struct {
-	handler ApiService
+type TeamMemberRemoveRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+	MemberID      int32  `thrift:"member_id,4" form:"member_id" json:"member_id" query:"member_id"`
 }
 
-func (p *apiServiceProcessorUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUserInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewTeamMemberRemoveRequest() *TeamMemberRemoveRequest {
+	return &TeamMemberRemoveRequest{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUserInfoResult{}
-	var retval *UserInfoResponse
-	if retval, err2 = p.handler.UserInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfo: "+err2.Error())
-		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UserInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *TeamMemberRemoveRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamMemberRemoveRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamMemberRemoveRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamMemberRemoveRequest) GetMemberID() (v int32) {
+	return p.MemberID
+}
+
+var fieldIDToName_TeamMemberRemoveRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+	4: "member_id",
+}
+
+func (p *TeamMemberRemoveRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamMemberRemoveRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type apiServiceProcessorUserInfoUpload struct {
-	handler ApiService
+func (p *TeamMemberRemoveRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
 }
 
-func (p *apiServiceProcessorUserInfoUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUserInfoUploadArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamMemberRemoveRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUserInfoUploadResult{}
-	var retval *UserInfoUploadResponse
-	if retval, err2 = p.handler.UserInfoUpload(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfoUpload: "+err2.Error())
-		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+func (p *TeamMemberRemoveRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		p.TeamID = v
 	}
-	if err2 = oprot.WriteMessageBegin("UserInfoUpload", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *TeamMemberRemoveRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.MemberID = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *TeamMemberRemoveRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamMemberRemoveRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type apiServiceProcessorUserProfileInfo struct {
-	handler ApiService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorUserProfileInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUserProfileInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamMemberRemoveRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUserProfileInfoResult{}
-	var retval *UserProfileInfoResponse
-	if retval, err2 = p.handler.UserProfileInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileInfo: "+err2.Error())
-		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("UserProfileInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_id", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MemberID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamMemberRemoveRequest(%+v)", *p)
+}
+
+type TeamMemberRemoveResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamMemberRemoveResponse() *TeamMemberRemoveResponse {
+	return &TeamMemberRemoveResponse{}
+}
+
+func (p *TeamMemberRemoveResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamMemberRemoveResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamMemberRemoveResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamMemberRemoveResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamMemberRemoveResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamMemberRemoveResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamMemberRemoveResponse(%+v)", *p)
+}
+
+type TeamLeaderTransferRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+	// 新队长，必须是队伍中的成员
+	MemberID int32 `thrift:"member_id,4" form:"member_id" json:"member_id" query:"member_id"`
+}
+
+func NewTeamLeaderTransferRequest() *TeamLeaderTransferRequest {
+	return &TeamLeaderTransferRequest{}
+}
+
+func (p *TeamLeaderTransferRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamLeaderTransferRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamLeaderTransferRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamLeaderTransferRequest) GetMemberID() (v int32) {
+	return p.MemberID
+}
+
+var fieldIDToName_TeamLeaderTransferRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+	4: "member_id",
+}
+
+func (p *TeamLeaderTransferRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaderTransferRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.MemberID = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaderTransferRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_id", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MemberID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamLeaderTransferRequest(%+v)", *p)
+}
+
+type TeamLeaderTransferResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamLeaderTransferResponse() *TeamLeaderTransferResponse {
+	return &TeamLeaderTransferResponse{}
+}
+
+func (p *TeamLeaderTransferResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamLeaderTransferResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamLeaderTransferResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamLeaderTransferResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaderTransferResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaderTransferResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamLeaderTransferResponse(%+v)", *p)
+}
+
+type TeamCloseRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+	// true 停止招募，false 恢复招募
+	Closed bool `thrift:"closed,4" form:"closed" json:"closed" query:"closed"`
+}
+
+func NewTeamCloseRequest() *TeamCloseRequest {
+	return &TeamCloseRequest{}
+}
+
+func (p *TeamCloseRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamCloseRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamCloseRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamCloseRequest) GetClosed() (v bool) {
+	return p.Closed
+}
+
+var fieldIDToName_TeamCloseRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+	4: "closed",
+}
+
+func (p *TeamCloseRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCloseRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Closed = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCloseRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("closed", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Closed); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCloseRequest(%+v)", *p)
+}
+
+type TeamCloseResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamCloseResponse() *TeamCloseResponse {
+	return &TeamCloseResponse{}
+}
+
+func (p *TeamCloseResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamCloseResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamCloseResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamCloseResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCloseResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamCloseResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamCloseResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCloseResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCloseResponse(%+v)", *p)
+}
+
+type TeamDisbandRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+}
+
+func NewTeamDisbandRequest() *TeamDisbandRequest {
+	return &TeamDisbandRequest{}
+}
+
+func (p *TeamDisbandRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamDisbandRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamDisbandRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+var fieldIDToName_TeamDisbandRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+}
+
+func (p *TeamDisbandRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamDisbandRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamDisbandRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamDisbandRequest(%+v)", *p)
+}
+
+type TeamDisbandResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamDisbandResponse() *TeamDisbandResponse {
+	return &TeamDisbandResponse{}
+}
+
+func (p *TeamDisbandResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamDisbandResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamDisbandResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamDisbandResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamDisbandResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamDisbandResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamDisbandResponse(%+v)", *p)
+}
+
+type TeamInvitationSendRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+	InviteeID     int32  `thrift:"invitee_id,4" form:"invitee_id" json:"invitee_id" query:"invitee_id"`
+	Message       string `thrift:"message,5" form:"message" json:"message" query:"message"`
+}
+
+func NewTeamInvitationSendRequest() *TeamInvitationSendRequest {
+	return &TeamInvitationSendRequest{}
+}
+
+func (p *TeamInvitationSendRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamInvitationSendRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamInvitationSendRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamInvitationSendRequest) GetInviteeID() (v int32) {
+	return p.InviteeID
+}
+
+func (p *TeamInvitationSendRequest) GetMessage() (v string) {
+	return p.Message
+}
+
+var fieldIDToName_TeamInvitationSendRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+	4: "invitee_id",
+	5: "message",
+}
+
+func (p *TeamInvitationSendRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationSendRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InviteeID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationSendRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitee_id", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InviteeID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationSendRequest(%+v)", *p)
+}
+
+type TeamInvitationSendResponse struct {
+	StatusCode   int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg    string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	InvitationID int32  `thrift:"invitation_id,3" form:"invitation_id" json:"invitation_id" query:"invitation_id"`
+}
+
+func NewTeamInvitationSendResponse() *TeamInvitationSendResponse {
+	return &TeamInvitationSendResponse{}
+}
+
+func (p *TeamInvitationSendResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationSendResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamInvitationSendResponse) GetInvitationID() (v int32) {
+	return p.InvitationID
+}
+
+var fieldIDToName_TeamInvitationSendResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "invitation_id",
+}
+
+func (p *TeamInvitationSendResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationSendResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InvitationID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationSendResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InvitationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationSendResponse(%+v)", *p)
+}
+
+type TeamInvitationRespondRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	InvitationID  int32  `thrift:"invitation_id,3" form:"invitation_id" json:"invitation_id" query:"invitation_id"`
+	// 1 接受，其他值拒绝
+	ActionType int32 `thrift:"action_type,4" form:"action_type" json:"action_type" query:"action_type"`
+}
+
+func NewTeamInvitationRespondRequest() *TeamInvitationRespondRequest {
+	return &TeamInvitationRespondRequest{}
+}
+
+func (p *TeamInvitationRespondRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamInvitationRespondRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamInvitationRespondRequest) GetInvitationID() (v int32) {
+	return p.InvitationID
+}
+
+func (p *TeamInvitationRespondRequest) GetActionType() (v int32) {
+	return p.ActionType
+}
+
+var fieldIDToName_TeamInvitationRespondRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "invitation_id",
+	4: "action_type",
+}
+
+func (p *TeamInvitationRespondRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationRespondRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InvitationID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ActionType = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationRespondRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InvitationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationRespondRequest(%+v)", *p)
+}
+
+type TeamInvitationRespondResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamInvitationRespondResponse() *TeamInvitationRespondResponse {
+	return &TeamInvitationRespondResponse{}
+}
+
+func (p *TeamInvitationRespondResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationRespondResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamInvitationRespondResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamInvitationRespondResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationRespondResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationRespondResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationRespondResponse(%+v)", *p)
+}
+
+type TeamInvitationListRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	// 邀请状态，为空时返回所有状态的邀请
+	Status string `thrift:"status,3" form:"status" json:"status" query:"status"`
+	Limit  int32  `thrift:"limit,4" form:"limit" json:"limit" query:"limit"`
+	Offset int32  `thrift:"offset,5" form:"offset" json:"offset" query:"offset"`
+}
+
+func NewTeamInvitationListRequest() *TeamInvitationListRequest {
+	return &TeamInvitationListRequest{}
+}
+
+func (p *TeamInvitationListRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamInvitationListRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamInvitationListRequest) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamInvitationListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *TeamInvitationListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+
+var fieldIDToName_TeamInvitationListRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "status",
+	4: "limit",
+	5: "offset",
+}
+
+func (p *TeamInvitationListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationListRequest(%+v)", *p)
+}
+
+type TeamInvitationListResponse struct {
+	StatusCode     int32             `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg      string            `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total          int32             `thrift:"total,3" form:"total" json:"total" query:"total"`
+	InvitationList []*TeamInvitation `thrift:"invitation_list,4" form:"invitation_list" json:"invitation_list" query:"invitation_list"`
+}
+
+func NewTeamInvitationListResponse() *TeamInvitationListResponse {
+	return &TeamInvitationListResponse{}
+}
+
+func (p *TeamInvitationListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamInvitationListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *TeamInvitationListResponse) GetInvitationList() (v []*TeamInvitation) {
+	return p.InvitationList
+}
+
+var fieldIDToName_TeamInvitationListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "invitation_list",
+}
+
+func (p *TeamInvitationListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.InvitationList = make([]*TeamInvitation, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamInvitation()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.InvitationList = append(p.InvitationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InvitationList)); err != nil {
+		return err
+	}
+	for _, v := range p.InvitationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationListResponse(%+v)", *p)
+}
+
+type TeamSentInvitationListRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" form:"team_id" json:"team_id" query:"team_id"`
+	// 邀请状态，为空时返回所有状态的邀请
+	Status string `thrift:"status,4" form:"status" json:"status" query:"status"`
+	Limit  int32  `thrift:"limit,5" form:"limit" json:"limit" query:"limit"`
+	Offset int32  `thrift:"offset,6" form:"offset" json:"offset" query:"offset"`
+}
+
+func NewTeamSentInvitationListRequest() *TeamSentInvitationListRequest {
+	return &TeamSentInvitationListRequest{}
+}
+
+func (p *TeamSentInvitationListRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamSentInvitationListRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamSentInvitationListRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamSentInvitationListRequest) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamSentInvitationListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *TeamSentInvitationListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+
+var fieldIDToName_TeamSentInvitationListRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+	4: "status",
+	5: "limit",
+	6: "offset",
+}
+
+func (p *TeamSentInvitationListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSentInvitationListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamSentInvitationListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamSentInvitationListRequest(%+v)", *p)
+}
+
+type TeamSentInvitationListResponse struct {
+	StatusCode     int32             `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg      string            `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total          int32             `thrift:"total,3" form:"total" json:"total" query:"total"`
+	InvitationList []*TeamInvitation `thrift:"invitation_list,4" form:"invitation_list" json:"invitation_list" query:"invitation_list"`
+}
+
+func NewTeamSentInvitationListResponse() *TeamSentInvitationListResponse {
+	return &TeamSentInvitationListResponse{}
+}
+
+func (p *TeamSentInvitationListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamSentInvitationListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamSentInvitationListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *TeamSentInvitationListResponse) GetInvitationList() (v []*TeamInvitation) {
+	return p.InvitationList
+}
+
+var fieldIDToName_TeamSentInvitationListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "invitation_list",
+}
+
+func (p *TeamSentInvitationListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSentInvitationListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.InvitationList = make([]*TeamInvitation, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamInvitation()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.InvitationList = append(p.InvitationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamSentInvitationListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InvitationList)); err != nil {
+		return err
+	}
+	for _, v := range p.InvitationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamSentInvitationListResponse(%+v)", *p)
+}
+
+type TeamInvitationCancelRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	InvitationID  int32  `thrift:"invitation_id,3" form:"invitation_id" json:"invitation_id" query:"invitation_id"`
+}
+
+func NewTeamInvitationCancelRequest() *TeamInvitationCancelRequest {
+	return &TeamInvitationCancelRequest{}
+}
+
+func (p *TeamInvitationCancelRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamInvitationCancelRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamInvitationCancelRequest) GetInvitationID() (v int32) {
+	return p.InvitationID
+}
+
+var fieldIDToName_TeamInvitationCancelRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "invitation_id",
+}
+
+func (p *TeamInvitationCancelRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationCancelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InvitationID = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationCancelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InvitationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationCancelRequest(%+v)", *p)
+}
+
+type TeamInvitationCancelResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamInvitationCancelResponse() *TeamInvitationCancelResponse {
+	return &TeamInvitationCancelResponse{}
+}
+
+func (p *TeamInvitationCancelResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationCancelResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamInvitationCancelResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamInvitationCancelResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationCancelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationCancelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationCancelResponse(%+v)", *p)
+}
+
+type TeamManageActionRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" json:"user_id" path:"user_id"`
+	ApplicationID int32  `thrift:"application_id,3" json:"application_id" path:"application_id"`
+	ActionType    int32  `thrift:"action_type,4" json:"action_type" path:"action_type"`
+}
+
+func NewTeamManageActionRequest() *TeamManageActionRequest {
+	return &TeamManageActionRequest{}
+}
+
+func (p *TeamManageActionRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamManageActionRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamManageActionRequest) GetApplicationID() (v int32) {
+	return p.ApplicationID
+}
+
+func (p *TeamManageActionRequest) GetActionType() (v int32) {
+	return p.ActionType
+}
+
+var fieldIDToName_TeamManageActionRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "application_id",
+	4: "action_type",
+}
+
+func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ApplicationID = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ActionType = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageActionRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ApplicationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamManageActionRequest(%+v)", *p)
+}
+
+type TeamManageActionResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewTeamManageActionResponse() *TeamManageActionResponse {
+	return &TeamManageActionResponse{}
+}
+
+func (p *TeamManageActionResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamManageActionResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_TeamManageActionResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamManageActionResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageActionResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamManageActionResponse(%+v)", *p)
+}
+
+// 推荐给队长的候选队员
+type TeamCandidate struct {
+	MemberInfo *MemberInfo `thrift:"member_info,1" form:"member_info" json:"member_info" query:"member_info"`
+	// 与岗位的匹配度
+	Score float64 `thrift:"score,2" form:"score" json:"score" query:"score"`
+}
+
+func NewTeamCandidate() *TeamCandidate {
+	return &TeamCandidate{}
+}
+
+var TeamCandidate_MemberInfo_DEFAULT *MemberInfo
+
+func (p *TeamCandidate) GetMemberInfo() (v *MemberInfo) {
+	if !p.IsSetMemberInfo() {
+		return TeamCandidate_MemberInfo_DEFAULT
+	}
+	return p.MemberInfo
+}
+
+func (p *TeamCandidate) GetScore() (v float64) {
+	return p.Score
+}
+
+var fieldIDToName_TeamCandidate = map[int16]string{
+	1: "member_info",
+	2: "score",
+}
+
+func (p *TeamCandidate) IsSetMemberInfo() bool {
+	return p.MemberInfo != nil
+}
+
+func (p *TeamCandidate) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCandidate[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCandidate) ReadField1(iprot thrift.TProtocol) error {
+	p.MemberInfo = NewMemberInfo()
+	if err := p.MemberInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamCandidate) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		p.Score = v
+	}
+	return nil
+}
+
+func (p *TeamCandidate) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCandidate"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCandidate) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.MemberInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCandidate) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Score); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCandidate) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCandidate(%+v)", *p)
+}
+
+type JobCandidates struct {
+	Job string `thrift:"job,1" form:"job" json:"job" query:"job"`
+	// 按匹配度降序排列
+	Candidates []*TeamCandidate `thrift:"candidates,2" form:"candidates" json:"candidates" query:"candidates"`
+}
+
+func NewJobCandidates() *JobCandidates {
+	return &JobCandidates{}
+}
+
+func (p *JobCandidates) GetJob() (v string) {
+	return p.Job
+}
+
+func (p *JobCandidates) GetCandidates() (v []*TeamCandidate) {
+	return p.Candidates
+}
+
+var fieldIDToName_JobCandidates = map[int16]string{
+	1: "job",
+	2: "candidates",
+}
+
+func (p *JobCandidates) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobCandidates[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobCandidates) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Job = v
+	}
+	return nil
+}
+
+func (p *JobCandidates) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Candidates = make([]*TeamCandidate, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamCandidate()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Candidates = append(p.Candidates, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *JobCandidates) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JobCandidates"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobCandidates) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Job); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobCandidates) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("candidates", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Candidates)); err != nil {
+		return err
+	}
+	for _, v := range p.Candidates {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *JobCandidates) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobCandidates(%+v)", *p)
+}
+
+type TeamCandidatesRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" json:"user_id" query:"user_id"`
+	TeamID        int32  `thrift:"team_id,3" json:"team_id" query:"team_id"`
+	// 每个岗位返回的候选人数
+	Limit int32 `thrift:"limit,4" json:"limit" query:"limit"`
+}
+
+func NewTeamCandidatesRequest() *TeamCandidatesRequest {
+	return &TeamCandidatesRequest{}
+}
+
+func (p *TeamCandidatesRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *TeamCandidatesRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *TeamCandidatesRequest) GetTeamID() (v int32) {
+	return p.TeamID
+}
+
+func (p *TeamCandidatesRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+var fieldIDToName_TeamCandidatesRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "team_id",
+	4: "limit",
+}
+
+func (p *TeamCandidatesRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCandidatesRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamID = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCandidatesRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCandidatesRequest(%+v)", *p)
+}
+
+type TeamCandidatesResponse struct {
+	StatusCode    int32            `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg     string           `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	JobCandidates []*JobCandidates `thrift:"job_candidates,3" form:"job_candidates" json:"job_candidates" query:"job_candidates"`
+}
+
+func NewTeamCandidatesResponse() *TeamCandidatesResponse {
+	return &TeamCandidatesResponse{}
+}
+
+func (p *TeamCandidatesResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamCandidatesResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamCandidatesResponse) GetJobCandidates() (v []*JobCandidates) {
+	return p.JobCandidates
+}
+
+var fieldIDToName_TeamCandidatesResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "job_candidates",
+}
+
+func (p *TeamCandidatesResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCandidatesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.JobCandidates = make([]*JobCandidates, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewJobCandidates()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.JobCandidates = append(p.JobCandidates, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamCandidatesResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCandidatesResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_candidates", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.JobCandidates)); err != nil {
+		return err
+	}
+	for _, v := range p.JobCandidates {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCandidatesResponse(%+v)", *p)
+}
+
+/* =========================== favorite =========================== */
+type ContestFavoriteActionRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	ContestID     int32  `thrift:"contest_id,3" form:"contest_id" json:"contest_id" query:"contest_id"`
+	ActionType    int32  `thrift:"action_type,4" form:"action_type" json:"action_type" query:"action_type"`
+}
+
+func NewContestFavoriteActionRequest() *ContestFavoriteActionRequest {
+	return &ContestFavoriteActionRequest{}
+}
+
+func (p *ContestFavoriteActionRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *ContestFavoriteActionRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *ContestFavoriteActionRequest) GetContestID() (v int32) {
+	return p.ContestID
+}
+
+func (p *ContestFavoriteActionRequest) GetActionType() (v int32) {
+	return p.ActionType
+}
+
+var fieldIDToName_ContestFavoriteActionRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "contest_id",
+	4: "action_type",
+}
+
+func (p *ContestFavoriteActionRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteActionRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteActionRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteActionRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestID = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteActionRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ActionType = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteActionRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestFavoriteActionRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestFavoriteActionRequest(%+v)", *p)
+}
+
+type ContestFavoriteActionResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+}
+
+func NewContestFavoriteActionResponse() *ContestFavoriteActionResponse {
+	return &ContestFavoriteActionResponse{}
+}
+
+func (p *ContestFavoriteActionResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *ContestFavoriteActionResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+var fieldIDToName_ContestFavoriteActionResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *ContestFavoriteActionResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteActionResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteActionResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteActionResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestFavoriteActionResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestFavoriteActionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestFavoriteActionResponse(%+v)", *p)
+}
+
+type ContestFavoriteListRequest struct {
+	Authorization string `thrift:"authorization,1" header:"Authorization" json:"authorization"`
+	UserID        int32  `thrift:"user_id,2" form:"user_id" json:"user_id" query:"user_id"`
+	Limit         int32  `thrift:"limit,3" form:"limit" json:"limit" query:"limit"`
+	Offset        int32  `thrift:"offset,4" form:"offset" json:"offset" query:"offset"`
+}
+
+func NewContestFavoriteListRequest() *ContestFavoriteListRequest {
+	return &ContestFavoriteListRequest{}
+}
+
+func (p *ContestFavoriteListRequest) GetAuthorization() (v string) {
+	return p.Authorization
+}
+
+func (p *ContestFavoriteListRequest) GetUserID() (v int32) {
+	return p.UserID
+}
+
+func (p *ContestFavoriteListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *ContestFavoriteListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+
+var fieldIDToName_ContestFavoriteListRequest = map[int16]string{
+	1: "authorization",
+	2: "user_id",
+	3: "limit",
+	4: "offset",
+}
+
+func (p *ContestFavoriteListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Authorization = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserID = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestFavoriteListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorization", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Authorization); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestFavoriteListRequest(%+v)", *p)
+}
+
+type ContestFavoriteListResponse struct {
+	StatusCode  int32               `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg   string              `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	ContestList []*ContestBriefInfo `thrift:"contest_list,3" form:"contest_list" json:"contest_list" query:"contest_list"`
+	Total       int32               `thrift:"total,4" form:"total" json:"total" query:"total"`
+}
+
+func NewContestFavoriteListResponse() *ContestFavoriteListResponse {
+	return &ContestFavoriteListResponse{}
+}
+
+func (p *ContestFavoriteListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *ContestFavoriteListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *ContestFavoriteListResponse) GetContestList() (v []*ContestBriefInfo) {
+	return p.ContestList
+}
+
+func (p *ContestFavoriteListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+var fieldIDToName_ContestFavoriteListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "contest_list",
+	4: "total",
+}
+
+func (p *ContestFavoriteListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestFavoriteListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ContestList = make([]*ContestBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewContestBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ContestList = append(p.ContestList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListResponse) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *ContestFavoriteListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestFavoriteListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestFavoriteListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
+		return err
+	}
+	for _, v := range p.ContestList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ContestFavoriteListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestFavoriteListResponse(%+v)", *p)
+}
+
+/* =========================== article =========================== */
+type ArticleBrief struct {
+	ArticleID   int32  `thrift:"article_id,1" form:"article_id" json:"article_id" query:"article_id"`
+	Title       string `thrift:"title,2" form:"title" json:"title" query:"title"`
+	AuthorID    int32  `thrift:"author_id,3" form:"author_id" json:"author_id" query:"author_id"`
+	Author      string `thrift:"author,4" form:"author" json:"author" query:"author"`
+	CreatedTime int64  `thrift:"created_time,5" form:"created_time" json:"created_time" query:"created_time"`
+	Link        string `thrift:"link,6" form:"link" json:"link" query:"link"`
+}
+
+func NewArticleBrief() *ArticleBrief {
+	return &ArticleBrief{}
+}
+
+func (p *ArticleBrief) GetArticleID() (v int32) {
+	return p.ArticleID
+}
+
+func (p *ArticleBrief) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *ArticleBrief) GetAuthorID() (v int32) {
+	return p.AuthorID
+}
+
+func (p *ArticleBrief) GetAuthor() (v string) {
+	return p.Author
+}
+
+func (p *ArticleBrief) GetCreatedTime() (v int64) {
+	return p.CreatedTime
+}
+
+func (p *ArticleBrief) GetLink() (v string) {
+	return p.Link
+}
+
+var fieldIDToName_ArticleBrief = map[int16]string{
+	1: "article_id",
+	2: "title",
+	3: "author_id",
+	4: "author",
+	5: "created_time",
+	6: "link",
+}
+
+func (p *ArticleBrief) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleBrief[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleBrief) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ArticleID = v
+	}
+	return nil
+}
+
+func (p *ArticleBrief) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Title = v
+	}
+	return nil
+}
+
+func (p *ArticleBrief) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.AuthorID = v
+	}
+	return nil
+}
+
+func (p *ArticleBrief) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Author = v
+	}
+	return nil
+}
+
+func (p *ArticleBrief) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CreatedTime = v
+	}
+	return nil
+}
+
+func (p *ArticleBrief) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Link = v
+	}
+	return nil
+}
+
+func (p *ArticleBrief) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleBrief"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleBrief) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ArticleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleBrief) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleBrief) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.AuthorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleBrief) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Author); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ArticleBrief) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ArticleBrief) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("link", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Link); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ArticleBrief) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleBrief(%+v)", *p)
+}
+
+type ArticleBriefInfo struct {
+	ArticleBriefInfo *ArticleBrief `thrift:"article_brief_info,1" form:"article_brief_info" json:"article_brief_info" query:"article_brief_info"`
+}
+
+func NewArticleBriefInfo() *ArticleBriefInfo {
+	return &ArticleBriefInfo{}
+}
+
+var ArticleBriefInfo_ArticleBriefInfo_DEFAULT *ArticleBrief
+
+func (p *ArticleBriefInfo) GetArticleBriefInfo() (v *ArticleBrief) {
+	if !p.IsSetArticleBriefInfo() {
+		return ArticleBriefInfo_ArticleBriefInfo_DEFAULT
+	}
+	return p.ArticleBriefInfo
+}
+
+var fieldIDToName_ArticleBriefInfo = map[int16]string{
+	1: "article_brief_info",
+}
+
+func (p *ArticleBriefInfo) IsSetArticleBriefInfo() bool {
+	return p.ArticleBriefInfo != nil
+}
+
+func (p *ArticleBriefInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleBriefInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleBriefInfo) ReadField1(iprot thrift.TProtocol) error {
+	p.ArticleBriefInfo = NewArticleBrief()
+	if err := p.ArticleBriefInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ArticleBriefInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleBriefInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleBriefInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_brief_info", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.ArticleBriefInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleBriefInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleBriefInfo(%+v)", *p)
+}
+
+type ArticleListRequest struct {
+	ContestID int32 `thrift:"contest_id,1" json:"contest_id" query:"contest_id"`
+	Limit     int32 `thrift:"limit,2" json:"limit" query:"limit"`
+	Offset    int32 `thrift:"offset,3" json:"offset" query:"offset"`
+}
+
+func NewArticleListRequest() *ArticleListRequest {
+	return &ArticleListRequest{}
+}
+
+func (p *ArticleListRequest) GetContestID() (v int32) {
+	return p.ContestID
+}
+
+func (p *ArticleListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *ArticleListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+
+var fieldIDToName_ArticleListRequest = map[int16]string{
+	1: "contest_id",
+	2: "limit",
+	3: "offset",
+}
+
+func (p *ArticleListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestID = v
+	}
+	return nil
+}
+
+func (p *ArticleListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *ArticleListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *ArticleListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleListRequest(%+v)", *p)
+}
+
+type ArticleListResponse struct {
+	StatusCode  int32               `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg   string              `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	Total       int32               `thrift:"total,3" form:"total" json:"total" query:"total"`
+	ArticleList []*ArticleBriefInfo `thrift:"article_list,4" form:"article_list" json:"article_list" query:"article_list"`
+}
+
+func NewArticleListResponse() *ArticleListResponse {
+	return &ArticleListResponse{}
+}
+
+func (p *ArticleListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *ArticleListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *ArticleListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *ArticleListResponse) GetArticleList() (v []*ArticleBriefInfo) {
+	return p.ArticleList
+}
+
+var fieldIDToName_ArticleListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "article_list",
+}
+
+func (p *ArticleListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *ArticleListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *ArticleListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *ArticleListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ArticleList = make([]*ArticleBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewArticleBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ArticleList = append(p.ArticleList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ArticleListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ArticleList)); err != nil {
+		return err
+	}
+	for _, v := range p.ArticleList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ArticleListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleListResponse(%+v)", *p)
+}
+
+type ArticleCreateRequest struct {
+	ArticleID int32  `thrift:"article_id,1" form:"article_id" json:"article_id" query:"article_id"`
+	Title     string `thrift:"title,2" form:"title" json:"title" query:"title"`
+	AuthorID  int32  `thrift:"author_id,3" form:"author_id" json:"author_id" query:"author_id"`
+	Author    string `thrift:"author,4" form:"author" json:"author" query:"author"`
+	Link      string `thrift:"link,5" form:"link" json:"link" query:"link"`
+	ContestID int32  `thrift:"contest_id,6" form:"contest_id" json:"contest_id" query:"contest_id"`
+}
+
+func NewArticleCreateRequest() *ArticleCreateRequest {
+	return &ArticleCreateRequest{}
+}
+
+func (p *ArticleCreateRequest) GetArticleID() (v int32) {
+	return p.ArticleID
+}
+
+func (p *ArticleCreateRequest) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *ArticleCreateRequest) GetAuthorID() (v int32) {
+	return p.AuthorID
+}
+
+func (p *ArticleCreateRequest) GetAuthor() (v string) {
+	return p.Author
+}
+
+func (p *ArticleCreateRequest) GetLink() (v string) {
+	return p.Link
+}
+
+func (p *ArticleCreateRequest) GetContestID() (v int32) {
+	return p.ContestID
+}
+
+var fieldIDToName_ArticleCreateRequest = map[int16]string{
+	1: "article_id",
+	2: "title",
+	3: "author_id",
+	4: "author",
+	5: "link",
+	6: "contest_id",
+}
+
+func (p *ArticleCreateRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleCreateRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ArticleID = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Title = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.AuthorID = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Author = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Link = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestID = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleCreateRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ArticleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.AuthorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("author", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Author); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("link", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Link); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ArticleCreateRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleCreateRequest(%+v)", *p)
+}
+
+type ArticleCreateResponse struct {
+	StatusCode int32  `thrift:"status_code,1" form:"status_code" json:"status_code" query:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" form:"status_msg" json:"status_msg" query:"status_msg"`
+	ArticleID  int32  `thrift:"article_id,3" form:"article_id" json:"article_id" query:"article_id"`
+}
+
+func NewArticleCreateResponse() *ArticleCreateResponse {
+	return &ArticleCreateResponse{}
+}
+
+func (p *ArticleCreateResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *ArticleCreateResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *ArticleCreateResponse) GetArticleID() (v int32) {
+	return p.ArticleID
+}
+
+var fieldIDToName_ArticleCreateResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "article_id",
+}
+
+func (p *ArticleCreateResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArticleCreateResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArticleCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ArticleID = v
+	}
+	return nil
+}
+
+func (p *ArticleCreateResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArticleCreateResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArticleCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ArticleCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ArticleCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("article_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ArticleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ArticleCreateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArticleCreateResponse(%+v)", *p)
+}
+
+type ApiService interface {
+	/* user */
+	// 用户注册操作
+	UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error)
+	// 用户登录操作
+	UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error)
+	// 获取用户信息
+	UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error)
+	// 上传用户信息
+	UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error)
+	// 获取用户档案信息
+	UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error)
+	// 上传用户档案信息
+	UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error)
+	/* contest */
+	// 获取赛事资讯列表
+	ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error)
+	// 获取个性化推荐的赛事资讯列表
+	ContestRecommend(ctx context.Context, req *ContestRecommendRequest) (r *ContestRecommendResponse, err error)
+	// 获取赛事资讯详情
+	ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error)
+	// 创建赛事资讯
+	ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error)
+	/* utils */
+	// 上传图片
+	ImageUpload(ctx context.Context, req *ImageUploadRequest) (r *ImageUploadResponse, err error)
+	/* team */
+	// 创建队伍
+	TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error)
+	// 获取队伍列表
+	TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error)
+	// 获取队伍详情
+	TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error)
+	// 提交队伍申请
+	TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error)
+
+	TeamApplicationWithdraw(ctx context.Context, req *TeamApplicationWithdrawRequest) (r *TeamApplicationWithdrawResponse, err error)
+	// 获取用户提交的队伍申请
+	MyApplications(ctx context.Context, req *MyApplicationsRequest) (r *MyApplicationsResponse, err error)
+	// 获取用户担任队长或加入的队伍
+	MyTeamList(ctx context.Context, req *MyTeamListRequest) (r *MyTeamListResponse, err error)
+	// 获取队伍申请列表
+	TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error)
+	// 队伍申请操作
+	TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error)
+	// 退出队伍
+	TeamLeave(ctx context.Context, req *TeamLeaveRequest) (r *TeamLeaveResponse, err error)
+	// 将队员移出队伍
+	TeamMemberRemove(ctx context.Context, req *TeamMemberRemoveRequest) (r *TeamMemberRemoveResponse, err error)
+	// 转让队长
+	TeamLeaderTransfer(ctx context.Context, req *TeamLeaderTransferRequest) (r *TeamLeaderTransferResponse, err error)
+	// 停止或恢复队伍招募
+	TeamClose(ctx context.Context, req *TeamCloseRequest) (r *TeamCloseResponse, err error)
+	// 解散队伍
+	TeamDisband(ctx context.Context, req *TeamDisbandRequest) (r *TeamDisbandResponse, err error)
+	// 邀请用户加入队伍
+	TeamInvitationSend(ctx context.Context, req *TeamInvitationSendRequest) (r *TeamInvitationSendResponse, err error)
+	// 接受或拒绝入队邀请
+	TeamInvitationRespond(ctx context.Context, req *TeamInvitationRespondRequest) (r *TeamInvitationRespondResponse, err error)
+	// 获取用户收到的入队邀请
+	TeamInvitationList(ctx context.Context, req *TeamInvitationListRequest) (r *TeamInvitationListResponse, err error)
+	// 获取队伍发出的入队邀请
+	TeamSentInvitationList(ctx context.Context, req *TeamSentInvitationListRequest) (r *TeamSentInvitationListResponse, err error)
+	// 取消待处理的入队邀请
+	TeamInvitationCancel(ctx context.Context, req *TeamInvitationCancelRequest) (r *TeamInvitationCancelResponse, err error)
+	// 为队长推荐候选队员
+	TeamCandidates(ctx context.Context, req *TeamCandidatesRequest) (r *TeamCandidatesResponse, err error)
+	/* favorite */
+	// 赛事收藏操作
+	ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error)
+	// 获取赛事收藏列表
+	ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error)
+	/* article */
+	// 获取赛事资讯文章列表
+	ArticleList(ctx context.Context, req *ArticleListRequest) (r *ArticleListResponse, err error)
+	// 创建赛事资讯文章
+	ArticleCreate(ctx context.Context, req *ArticleCreateRequest) (r *ArticleCreateResponse, err error)
+}
+
+type ApiServiceClient struct {
+	c thrift.TClient
+}
+
+func NewApiServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewApiServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewApiServiceClient(c thrift.TClient) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: c,
+	}
+}
+
+func (p *ApiServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ApiServiceClient) UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error) {
+	var _args ApiServiceUserRegisterArgs
+	_args.Req = req
+	var _result ApiServiceUserRegisterResult
+	if err = p.Client_().Call(ctx, "UserRegister", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error) {
+	var _args ApiServiceUserLoginArgs
+	_args.Req = req
+	var _result ApiServiceUserLoginResult
+	if err = p.Client_().Call(ctx, "UserLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error) {
+	var _args ApiServiceUserInfoArgs
+	_args.Req = req
+	var _result ApiServiceUserInfoResult
+	if err = p.Client_().Call(ctx, "UserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error) {
+	var _args ApiServiceUserInfoUploadArgs
+	_args.Req = req
+	var _result ApiServiceUserInfoUploadResult
+	if err = p.Client_().Call(ctx, "UserInfoUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error) {
+	var _args ApiServiceUserProfileInfoArgs
+	_args.Req = req
+	var _result ApiServiceUserProfileInfoResult
+	if err = p.Client_().Call(ctx, "UserProfileInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error) {
+	var _args ApiServiceUserProfileUploadArgs
+	_args.Req = req
+	var _result ApiServiceUserProfileUploadResult
+	if err = p.Client_().Call(ctx, "UserProfileUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error) {
+	var _args ApiServiceContestListArgs
+	_args.Req = req
+	var _result ApiServiceContestListResult
+	if err = p.Client_().Call(ctx, "ContestList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ContestRecommend(ctx context.Context, req *ContestRecommendRequest) (r *ContestRecommendResponse, err error) {
+	var _args ApiServiceContestRecommendArgs
+	_args.Req = req
+	var _result ApiServiceContestRecommendResult
+	if err = p.Client_().Call(ctx, "ContestRecommend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error) {
+	var _args ApiServiceContestInfoArgs
+	_args.Req = req
+	var _result ApiServiceContestInfoResult
+	if err = p.Client_().Call(ctx, "ContestInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error) {
+	var _args ApiServiceContestCreateArgs
+	_args.Req = req
+	var _result ApiServiceContestCreateResult
+	if err = p.Client_().Call(ctx, "ContestCreate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ImageUpload(ctx context.Context, req *ImageUploadRequest) (r *ImageUploadResponse, err error) {
+	var _args ApiServiceImageUploadArgs
+	_args.Req = req
+	var _result ApiServiceImageUploadResult
+	if err = p.Client_().Call(ctx, "ImageUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error) {
+	var _args ApiServiceTeamCreateArgs
+	_args.Req = req
+	var _result ApiServiceTeamCreateResult
+	if err = p.Client_().Call(ctx, "TeamCreate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error) {
+	var _args ApiServiceTeamListArgs
+	_args.Req = req
+	var _result ApiServiceTeamListResult
+	if err = p.Client_().Call(ctx, "TeamList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error) {
+	var _args ApiServiceTeamInfoArgs
+	_args.Req = req
+	var _result ApiServiceTeamInfoResult
+	if err = p.Client_().Call(ctx, "TeamInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error) {
+	var _args ApiServiceTeamApplicationSubmitArgs
+	_args.Req = req
+	var _result ApiServiceTeamApplicationSubmitResult
+	if err = p.Client_().Call(ctx, "TeamApplicationSubmit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamApplicationWithdraw(ctx context.Context, req *TeamApplicationWithdrawRequest) (r *TeamApplicationWithdrawResponse, err error) {
+	var _args ApiServiceTeamApplicationWithdrawArgs
+	_args.Req = req
+	var _result ApiServiceTeamApplicationWithdrawResult
+	if err = p.Client_().Call(ctx, "TeamApplicationWithdraw", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) MyApplications(ctx context.Context, req *MyApplicationsRequest) (r *MyApplicationsResponse, err error) {
+	var _args ApiServiceMyApplicationsArgs
+	_args.Req = req
+	var _result ApiServiceMyApplicationsResult
+	if err = p.Client_().Call(ctx, "MyApplications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) MyTeamList(ctx context.Context, req *MyTeamListRequest) (r *MyTeamListResponse, err error) {
+	var _args ApiServiceMyTeamListArgs
+	_args.Req = req
+	var _result ApiServiceMyTeamListResult
+	if err = p.Client_().Call(ctx, "MyTeamList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error) {
+	var _args ApiServiceTeamManageListArgs
+	_args.Req = req
+	var _result ApiServiceTeamManageListResult
+	if err = p.Client_().Call(ctx, "TeamManageList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error) {
+	var _args ApiServiceTeamManageActionArgs
+	_args.Req = req
+	var _result ApiServiceTeamManageActionResult
+	if err = p.Client_().Call(ctx, "TeamManageAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamLeave(ctx context.Context, req *TeamLeaveRequest) (r *TeamLeaveResponse, err error) {
+	var _args ApiServiceTeamLeaveArgs
+	_args.Req = req
+	var _result ApiServiceTeamLeaveResult
+	if err = p.Client_().Call(ctx, "TeamLeave", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamMemberRemove(ctx context.Context, req *TeamMemberRemoveRequest) (r *TeamMemberRemoveResponse, err error) {
+	var _args ApiServiceTeamMemberRemoveArgs
+	_args.Req = req
+	var _result ApiServiceTeamMemberRemoveResult
+	if err = p.Client_().Call(ctx, "TeamMemberRemove", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamLeaderTransfer(ctx context.Context, req *TeamLeaderTransferRequest) (r *TeamLeaderTransferResponse, err error) {
+	var _args ApiServiceTeamLeaderTransferArgs
+	_args.Req = req
+	var _result ApiServiceTeamLeaderTransferResult
+	if err = p.Client_().Call(ctx, "TeamLeaderTransfer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamClose(ctx context.Context, req *TeamCloseRequest) (r *TeamCloseResponse, err error) {
+	var _args ApiServiceTeamCloseArgs
+	_args.Req = req
+	var _result ApiServiceTeamCloseResult
+	if err = p.Client_().Call(ctx, "TeamClose", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamDisband(ctx context.Context, req *TeamDisbandRequest) (r *TeamDisbandResponse, err error) {
+	var _args ApiServiceTeamDisbandArgs
+	_args.Req = req
+	var _result ApiServiceTeamDisbandResult
+	if err = p.Client_().Call(ctx, "TeamDisband", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamInvitationSend(ctx context.Context, req *TeamInvitationSendRequest) (r *TeamInvitationSendResponse, err error) {
+	var _args ApiServiceTeamInvitationSendArgs
+	_args.Req = req
+	var _result ApiServiceTeamInvitationSendResult
+	if err = p.Client_().Call(ctx, "TeamInvitationSend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamInvitationRespond(ctx context.Context, req *TeamInvitationRespondRequest) (r *TeamInvitationRespondResponse, err error) {
+	var _args ApiServiceTeamInvitationRespondArgs
+	_args.Req = req
+	var _result ApiServiceTeamInvitationRespondResult
+	if err = p.Client_().Call(ctx, "TeamInvitationRespond", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamInvitationList(ctx context.Context, req *TeamInvitationListRequest) (r *TeamInvitationListResponse, err error) {
+	var _args ApiServiceTeamInvitationListArgs
+	_args.Req = req
+	var _result ApiServiceTeamInvitationListResult
+	if err = p.Client_().Call(ctx, "TeamInvitationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamSentInvitationList(ctx context.Context, req *TeamSentInvitationListRequest) (r *TeamSentInvitationListResponse, err error) {
+	var _args ApiServiceTeamSentInvitationListArgs
+	_args.Req = req
+	var _result ApiServiceTeamSentInvitationListResult
+	if err = p.Client_().Call(ctx, "TeamSentInvitationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamInvitationCancel(ctx context.Context, req *TeamInvitationCancelRequest) (r *TeamInvitationCancelResponse, err error) {
+	var _args ApiServiceTeamInvitationCancelArgs
+	_args.Req = req
+	var _result ApiServiceTeamInvitationCancelResult
+	if err = p.Client_().Call(ctx, "TeamInvitationCancel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) TeamCandidates(ctx context.Context, req *TeamCandidatesRequest) (r *TeamCandidatesResponse, err error) {
+	var _args ApiServiceTeamCandidatesArgs
+	_args.Req = req
+	var _result ApiServiceTeamCandidatesResult
+	if err = p.Client_().Call(ctx, "TeamCandidates", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error) {
+	var _args ApiServiceContestFavoriteActionArgs
+	_args.Req = req
+	var _result ApiServiceContestFavoriteActionResult
+	if err = p.Client_().Call(ctx, "ContestFavoriteAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error) {
+	var _args ApiServiceContestFavoriteListArgs
+	_args.Req = req
+	var _result ApiServiceContestFavoriteListResult
+	if err = p.Client_().Call(ctx, "ContestFavoriteList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ArticleList(ctx context.Context, req *ArticleListRequest) (r *ArticleListResponse, err error) {
+	var _args ApiServiceArticleListArgs
+	_args.Req = req
+	var _result ApiServiceArticleListResult
+	if err = p.Client_().Call(ctx, "ArticleList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ArticleCreate(ctx context.Context, req *ArticleCreateRequest) (r *ArticleCreateResponse, err error) {
+	var _args ApiServiceArticleCreateArgs
+	_args.Req = req
+	var _result ApiServiceArticleCreateResult
+	if err = p.Client_().Call(ctx, "ArticleCreate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ApiServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ApiService
+}
+
+func (p *ApiServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ApiServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ApiServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewApiServiceProcessor(handler ApiService) *ApiServiceProcessor {
+	self := &ApiServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("UserRegister", &apiServiceProcessorUserRegister{handler: handler})
+	self.AddToProcessorMap("UserLogin", &apiServiceProcessorUserLogin{handler: handler})
+	self.AddToProcessorMap("UserInfo", &apiServiceProcessorUserInfo{handler: handler})
+	self.AddToProcessorMap("UserInfoUpload", &apiServiceProcessorUserInfoUpload{handler: handler})
+	self.AddToProcessorMap("UserProfileInfo", &apiServiceProcessorUserProfileInfo{handler: handler})
+	self.AddToProcessorMap("UserProfileUpload", &apiServiceProcessorUserProfileUpload{handler: handler})
+	self.AddToProcessorMap("ContestList", &apiServiceProcessorContestList{handler: handler})
+	self.AddToProcessorMap("ContestRecommend", &apiServiceProcessorContestRecommend{handler: handler})
+	self.AddToProcessorMap("ContestInfo", &apiServiceProcessorContestInfo{handler: handler})
+	self.AddToProcessorMap("ContestCreate", &apiServiceProcessorContestCreate{handler: handler})
+	self.AddToProcessorMap("ImageUpload", &apiServiceProcessorImageUpload{handler: handler})
+	self.AddToProcessorMap("TeamCreate", &apiServiceProcessorTeamCreate{handler: handler})
+	self.AddToProcessorMap("TeamList", &apiServiceProcessorTeamList{handler: handler})
+	self.AddToProcessorMap("TeamInfo", &apiServiceProcessorTeamInfo{handler: handler})
+	self.AddToProcessorMap("TeamApplicationSubmit", &apiServiceProcessorTeamApplicationSubmit{handler: handler})
+	self.AddToProcessorMap("TeamApplicationWithdraw", &apiServiceProcessorTeamApplicationWithdraw{handler: handler})
+	self.AddToProcessorMap("MyApplications", &apiServiceProcessorMyApplications{handler: handler})
+	self.AddToProcessorMap("MyTeamList", &apiServiceProcessorMyTeamList{handler: handler})
+	self.AddToProcessorMap("TeamManageList", &apiServiceProcessorTeamManageList{handler: handler})
+	self.AddToProcessorMap("TeamManageAction", &apiServiceProcessorTeamManageAction{handler: handler})
+	self.AddToProcessorMap("TeamLeave", &apiServiceProcessorTeamLeave{handler: handler})
+	self.AddToProcessorMap("TeamMemberRemove", &apiServiceProcessorTeamMemberRemove{handler: handler})
+	self.AddToProcessorMap("TeamLeaderTransfer", &apiServiceProcessorTeamLeaderTransfer{handler: handler})
+	self.AddToProcessorMap("TeamClose", &apiServiceProcessorTeamClose{handler: handler})
+	self.AddToProcessorMap("TeamDisband", &apiServiceProcessorTeamDisband{handler: handler})
+	self.AddToProcessorMap("TeamInvitationSend", &apiServiceProcessorTeamInvitationSend{handler: handler})
+	self.AddToProcessorMap("TeamInvitationRespond", &apiServiceProcessorTeamInvitationRespond{handler: handler})
+	self.AddToProcessorMap("TeamInvitationList", &apiServiceProcessorTeamInvitationList{handler: handler})
+	self.AddToProcessorMap("TeamSentInvitationList", &apiServiceProcessorTeamSentInvitationList{handler: handler})
+	self.AddToProcessorMap("TeamInvitationCancel", &apiServiceProcessorTeamInvitationCancel{handler: handler})
+	self.AddToProcessorMap("TeamCandidates", &apiServiceProcessorTeamCandidates{handler: handler})
+	self.AddToProcessorMap("ContestFavoriteAction", &apiServiceProcessorContestFavoriteAction{handler: handler})
+	self.AddToProcessorMap("ContestFavoriteList", &apiServiceProcessorContestFavoriteList{handler: handler})
+	self.AddToProcessorMap("ArticleList", &apiServiceProcessorArticleList{handler: handler})
+	self.AddToProcessorMap("ArticleCreate", &apiServiceProcessorArticleCreate{handler: handler})
+	return self
+}
+func (p *ApiServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type apiServiceProcessorUserRegister struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUserRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUserRegisterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUserRegisterResult{}
+	var retval *UserRegisterResponse
+	if retval, err2 = p.handler.UserRegister(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserRegister: "+err2.Error())
+		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserRegister", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUserLogin struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUserLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUserLoginArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUserLoginResult{}
+	var retval *UserLoginResponse
+	if retval, err2 = p.handler.UserLogin(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserLogin: "+err2.Error())
+		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserLogin", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUserInfo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUserInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUserInfoResult{}
+	var retval *UserInfoResponse
+	if retval, err2 = p.handler.UserInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfo: "+err2.Error())
+		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUserInfoUpload struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUserInfoUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUserInfoUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUserInfoUploadResult{}
+	var retval *UserInfoUploadResponse
+	if retval, err2 = p.handler.UserInfoUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfoUpload: "+err2.Error())
+		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserInfoUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUserProfileInfo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUserProfileInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUserProfileInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUserProfileInfoResult{}
+	var retval *UserProfileInfoResponse
+	if retval, err2 = p.handler.UserProfileInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileInfo: "+err2.Error())
+		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserProfileInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUserProfileUpload struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUserProfileUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUserProfileUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUserProfileUploadResult{}
+	var retval *UserProfileUploadResponse
+	if retval, err2 = p.handler.UserProfileUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileUpload: "+err2.Error())
+		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserProfileUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorContestList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorContestList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceContestListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceContestListResult{}
+	var retval *ContestListResponse
+	if retval, err2 = p.handler.ContestList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestList: "+err2.Error())
+		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorContestRecommend struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorContestRecommend) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceContestRecommendArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestRecommend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceContestRecommendResult{}
+	var retval *ContestRecommendResponse
+	if retval, err2 = p.handler.ContestRecommend(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestRecommend: "+err2.Error())
+		oprot.WriteMessageBegin("ContestRecommend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestRecommend", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorContestInfo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorContestInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceContestInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceContestInfoResult{}
+	var retval *ContestInfoResponse
+	if retval, err2 = p.handler.ContestInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestInfo: "+err2.Error())
+		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorContestCreate struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorContestCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceContestCreateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceContestCreateResult{}
+	var retval *ContestCreateResponse
+	if retval, err2 = p.handler.ContestCreate(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestCreate: "+err2.Error())
+		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestCreate", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorImageUpload struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorImageUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceImageUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ImageUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceImageUploadResult{}
+	var retval *ImageUploadResponse
+	if retval, err2 = p.handler.ImageUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ImageUpload: "+err2.Error())
+		oprot.WriteMessageBegin("ImageUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ImageUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamCreate struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamCreateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamCreateResult{}
+	var retval *TeamCreateResponse
+	if retval, err2 = p.handler.TeamCreate(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamCreate: "+err2.Error())
+		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamCreate", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamListResult{}
+	var retval *TeamListResponse
+	if retval, err2 = p.handler.TeamList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamInfo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamInfoResult{}
+	var retval *TeamInfoResponse
+	if retval, err2 = p.handler.TeamInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInfo: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamApplicationSubmit struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamApplicationSubmit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamApplicationSubmitArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamApplicationSubmitResult{}
+	var retval *TeamApplicationSubmitResponse
+	if retval, err2 = p.handler.TeamApplicationSubmit(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamApplicationSubmit: "+err2.Error())
+		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamApplicationWithdraw struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamApplicationWithdraw) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamApplicationWithdrawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamApplicationWithdraw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamApplicationWithdrawResult{}
+	var retval *TeamApplicationWithdrawResponse
+	if retval, err2 = p.handler.TeamApplicationWithdraw(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamApplicationWithdraw: "+err2.Error())
+		oprot.WriteMessageBegin("TeamApplicationWithdraw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamApplicationWithdraw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorMyApplications struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorMyApplications) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceMyApplicationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MyApplications", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceMyApplicationsResult{}
+	var retval *MyApplicationsResponse
+	if retval, err2 = p.handler.MyApplications(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MyApplications: "+err2.Error())
+		oprot.WriteMessageBegin("MyApplications", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MyApplications", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorMyTeamList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorMyTeamList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceMyTeamListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MyTeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceMyTeamListResult{}
+	var retval *MyTeamListResponse
+	if retval, err2 = p.handler.MyTeamList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MyTeamList: "+err2.Error())
+		oprot.WriteMessageBegin("MyTeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MyTeamList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamManageList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamManageList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamManageListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamManageListResult{}
+	var retval *TeamManageListResponse
+	if retval, err2 = p.handler.TeamManageList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamManageList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamManageAction struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamManageAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamManageActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamManageActionResult{}
+	var retval *TeamManageActionResponse
+	if retval, err2 = p.handler.TeamManageAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageAction: "+err2.Error())
+		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamManageAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamLeave struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamLeave) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamLeaveArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamLeave", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamLeaveResult{}
+	var retval *TeamLeaveResponse
+	if retval, err2 = p.handler.TeamLeave(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamLeave: "+err2.Error())
+		oprot.WriteMessageBegin("TeamLeave", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamLeave", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamMemberRemove struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamMemberRemove) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamMemberRemoveArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamMemberRemove", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamMemberRemoveResult{}
+	var retval *TeamMemberRemoveResponse
+	if retval, err2 = p.handler.TeamMemberRemove(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamMemberRemove: "+err2.Error())
+		oprot.WriteMessageBegin("TeamMemberRemove", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamMemberRemove", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamLeaderTransfer struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamLeaderTransfer) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamLeaderTransferArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamLeaderTransfer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamLeaderTransferResult{}
+	var retval *TeamLeaderTransferResponse
+	if retval, err2 = p.handler.TeamLeaderTransfer(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamLeaderTransfer: "+err2.Error())
+		oprot.WriteMessageBegin("TeamLeaderTransfer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamLeaderTransfer", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamClose struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamClose) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamCloseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamClose", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamCloseResult{}
+	var retval *TeamCloseResponse
+	if retval, err2 = p.handler.TeamClose(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamClose: "+err2.Error())
+		oprot.WriteMessageBegin("TeamClose", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamClose", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamDisband struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamDisband) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamDisbandArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamDisband", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamDisbandResult{}
+	var retval *TeamDisbandResponse
+	if retval, err2 = p.handler.TeamDisband(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamDisband: "+err2.Error())
+		oprot.WriteMessageBegin("TeamDisband", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamDisband", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamInvitationSend struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamInvitationSend) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamInvitationSendArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationSend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamInvitationSendResult{}
+	var retval *TeamInvitationSendResponse
+	if retval, err2 = p.handler.TeamInvitationSend(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationSend: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationSend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationSend", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamInvitationRespond struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamInvitationRespond) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamInvitationRespondArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationRespond", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamInvitationRespondResult{}
+	var retval *TeamInvitationRespondResponse
+	if retval, err2 = p.handler.TeamInvitationRespond(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationRespond: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationRespond", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationRespond", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamInvitationList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamInvitationList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamInvitationListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamInvitationListResult{}
+	var retval *TeamInvitationListResponse
+	if retval, err2 = p.handler.TeamInvitationList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamSentInvitationList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamSentInvitationList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamSentInvitationListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamSentInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamSentInvitationListResult{}
+	var retval *TeamSentInvitationListResponse
+	if retval, err2 = p.handler.TeamSentInvitationList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamSentInvitationList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamSentInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamSentInvitationList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamInvitationCancel struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamInvitationCancel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamInvitationCancelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationCancel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamInvitationCancelResult{}
+	var retval *TeamInvitationCancelResponse
+	if retval, err2 = p.handler.TeamInvitationCancel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationCancel: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationCancel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationCancel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTeamCandidates struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTeamCandidates) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTeamCandidatesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamCandidates", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTeamCandidatesResult{}
+	var retval *TeamCandidatesResponse
+	if retval, err2 = p.handler.TeamCandidates(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamCandidates: "+err2.Error())
+		oprot.WriteMessageBegin("TeamCandidates", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamCandidates", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorContestFavoriteAction struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorContestFavoriteAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceContestFavoriteActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceContestFavoriteActionResult{}
+	var retval *ContestFavoriteActionResponse
+	if retval, err2 = p.handler.ContestFavoriteAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteAction: "+err2.Error())
+		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestFavoriteAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorContestFavoriteList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorContestFavoriteList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceContestFavoriteListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceContestFavoriteListResult{}
+	var retval *ContestFavoriteListResponse
+	if retval, err2 = p.handler.ContestFavoriteList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteList: "+err2.Error())
+		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestFavoriteList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorArticleList struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorArticleList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceArticleListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ArticleList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceArticleListResult{}
+	var retval *ArticleListResponse
+	if retval, err2 = p.handler.ArticleList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ArticleList: "+err2.Error())
+		oprot.WriteMessageBegin("ArticleList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ArticleList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorArticleCreate struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorArticleCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceArticleCreateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ArticleCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceArticleCreateResult{}
+	var retval *ArticleCreateResponse
+	if retval, err2 = p.handler.ArticleCreate(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ArticleCreate: "+err2.Error())
+		oprot.WriteMessageBegin("ArticleCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ArticleCreate", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return true, err
-}
+	return true, err
+}
+
+type ApiServiceUserRegisterArgs struct {
+	Req *UserRegisterRequest `thrift:"req,1"`
+}
+
+func NewApiServiceUserRegisterArgs() *ApiServiceUserRegisterArgs {
+	return &ApiServiceUserRegisterArgs{}
+}
+
+var ApiServiceUserRegisterArgs_Req_DEFAULT *UserRegisterRequest
+
+func (p *ApiServiceUserRegisterArgs) GetReq() (v *UserRegisterRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceUserRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceUserRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceUserRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceUserRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserRegisterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserRegisterRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRegister_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceUserRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserRegisterArgs(%+v)", *p)
+}
+
+type ApiServiceUserRegisterResult struct {
+	Success *UserRegisterResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceUserRegisterResult() *ApiServiceUserRegisterResult {
+	return &ApiServiceUserRegisterResult{}
+}
+
+var ApiServiceUserRegisterResult_Success_DEFAULT *UserRegisterResponse
+
+func (p *ApiServiceUserRegisterResult) GetSuccess() (v *UserRegisterResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceUserRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceUserRegisterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceUserRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceUserRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserRegisterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserRegisterResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserRegisterResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRegister_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceUserRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserRegisterResult(%+v)", *p)
+}
+
+type ApiServiceUserLoginArgs struct {
+	Req *UserLoginRequest `thrift:"req,1"`
+}
+
+func NewApiServiceUserLoginArgs() *ApiServiceUserLoginArgs {
+	return &ApiServiceUserLoginArgs{}
+}
+
+var ApiServiceUserLoginArgs_Req_DEFAULT *UserLoginRequest
+
+func (p *ApiServiceUserLoginArgs) GetReq() (v *UserLoginRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceUserLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceUserLoginArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceUserLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceUserLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserLoginArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserLoginRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserLogin_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceUserLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserLoginArgs(%+v)", *p)
+}
+
+type ApiServiceUserLoginResult struct {
+	Success *UserLoginResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceUserLoginResult() *ApiServiceUserLoginResult {
+	return &ApiServiceUserLoginResult{}
+}
+
+var ApiServiceUserLoginResult_Success_DEFAULT *UserLoginResponse
+
+func (p *ApiServiceUserLoginResult) GetSuccess() (v *UserLoginResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceUserLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceUserLoginResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceUserLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceUserLoginResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserLoginResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserLoginResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserLoginResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserLogin_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceUserLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserLoginResult(%+v)", *p)
+}
+
+type ApiServiceUserInfoArgs struct {
+	Req *UserInfoRequest `thrift:"req,1"`
+}
+
+func NewApiServiceUserInfoArgs() *ApiServiceUserInfoArgs {
+	return &ApiServiceUserInfoArgs{}
+}
+
+var ApiServiceUserInfoArgs_Req_DEFAULT *UserInfoRequest
+
+func (p *ApiServiceUserInfoArgs) GetReq() (v *UserInfoRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceUserInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceUserInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceUserInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserInfoRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserInfoArgs(%+v)", *p)
+}
+
+type ApiServiceUserInfoResult struct {
+	Success *UserInfoResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceUserInfoResult() *ApiServiceUserInfoResult {
+	return &ApiServiceUserInfoResult{}
+}
+
+var ApiServiceUserInfoResult_Success_DEFAULT *UserInfoResponse
+
+func (p *ApiServiceUserInfoResult) GetSuccess() (v *UserInfoResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserInfoResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserInfoResult(%+v)", *p)
+}
+
+type ApiServiceUserInfoUploadArgs struct {
+	Req *UserInfoUploadRequest `thrift:"req,1"`
+}
+
+func NewApiServiceUserInfoUploadArgs() *ApiServiceUserInfoUploadArgs {
+	return &ApiServiceUserInfoUploadArgs{}
+}
+
+var ApiServiceUserInfoUploadArgs_Req_DEFAULT *UserInfoUploadRequest
+
+func (p *ApiServiceUserInfoUploadArgs) GetReq() (v *UserInfoUploadRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceUserInfoUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceUserInfoUploadArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceUserInfoUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceUserInfoUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoUploadArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserInfoUploadRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserInfoUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserInfoUpload_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserInfoUploadArgs(%+v)", *p)
+}
+
+type ApiServiceUserInfoUploadResult struct {
+	Success *UserInfoUploadResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceUserInfoUploadResult() *ApiServiceUserInfoUploadResult {
+	return &ApiServiceUserInfoUploadResult{}
+}
+
+var ApiServiceUserInfoUploadResult_Success_DEFAULT *UserInfoUploadResponse
+
+func (p *ApiServiceUserInfoUploadResult) GetSuccess() (v *UserInfoUploadResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceUserInfoUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceUserInfoUploadResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceUserInfoUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceUserInfoUploadResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoUploadResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserInfoUploadResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserInfoUploadResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserInfoUpload_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceUserInfoUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserInfoUploadResult(%+v)", *p)
+}
+
+type ApiServiceUserProfileInfoArgs struct {
+	Req *UserProfileInfoRequest `thrift:"req,1"`
+}
+
+func NewApiServiceUserProfileInfoArgs() *ApiServiceUserProfileInfoArgs {
+	return &ApiServiceUserProfileInfoArgs{}
+}
+
+var ApiServiceUserProfileInfoArgs_Req_DEFAULT *UserProfileInfoRequest
+
+func (p *ApiServiceUserProfileInfoArgs) GetReq() (v *UserProfileInfoRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceUserProfileInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceUserProfileInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceUserProfileInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceUserProfileInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserProfileInfoRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserProfileInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserProfileInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserProfileInfoArgs(%+v)", *p)
+}
+
+type ApiServiceUserProfileInfoResult struct {
+	Success *UserProfileInfoResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceUserProfileInfoResult() *ApiServiceUserProfileInfoResult {
+	return &ApiServiceUserProfileInfoResult{}
+}
+
+var ApiServiceUserProfileInfoResult_Success_DEFAULT *UserProfileInfoResponse
+
+func (p *ApiServiceUserProfileInfoResult) GetSuccess() (v *UserProfileInfoResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceUserProfileInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceUserProfileInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceUserProfileInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceUserProfileInfoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserProfileInfoResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserProfileInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserProfileInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserProfileInfoResult(%+v)", *p)
+}
+
+type ApiServiceUserProfileUploadArgs struct {
+	Req *UserProfileUploadRequest `thrift:"req,1"`
+}
+
+func NewApiServiceUserProfileUploadArgs() *ApiServiceUserProfileUploadArgs {
+	return &ApiServiceUserProfileUploadArgs{}
+}
+
+var ApiServiceUserProfileUploadArgs_Req_DEFAULT *UserProfileUploadRequest
+
+func (p *ApiServiceUserProfileUploadArgs) GetReq() (v *UserProfileUploadRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceUserProfileUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceUserProfileUploadArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceUserProfileUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceUserProfileUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileUploadArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserProfileUploadRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserProfileUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserProfileUpload_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserProfileUploadArgs(%+v)", *p)
+}
+
+type ApiServiceUserProfileUploadResult struct {
+	Success *UserProfileUploadResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceUserProfileUploadResult() *ApiServiceUserProfileUploadResult {
+	return &ApiServiceUserProfileUploadResult{}
+}
+
+var ApiServiceUserProfileUploadResult_Success_DEFAULT *UserProfileUploadResponse
+
+func (p *ApiServiceUserProfileUploadResult) GetSuccess() (v *UserProfileUploadResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceUserProfileUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceUserProfileUploadResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceUserProfileUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceUserProfileUploadResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileUploadResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserProfileUploadResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceUserProfileUploadResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserProfileUpload_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceUserProfileUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceUserProfileUploadResult(%+v)", *p)
+}
+
+type ApiServiceContestListArgs struct {
+	Req *ContestListRequest `thrift:"req,1"`
+}
+
+func NewApiServiceContestListArgs() *ApiServiceContestListArgs {
+	return &ApiServiceContestListArgs{}
+}
+
+var ApiServiceContestListArgs_Req_DEFAULT *ContestListRequest
+
+func (p *ApiServiceContestListArgs) GetReq() (v *ContestListRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceContestListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceContestListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceContestListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceContestListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestListRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceContestListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestListArgs(%+v)", *p)
+}
+
+type ApiServiceContestListResult struct {
+	Success *ContestListResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceContestListResult() *ApiServiceContestListResult {
+	return &ApiServiceContestListResult{}
+}
+
+var ApiServiceContestListResult_Success_DEFAULT *ContestListResponse
+
+func (p *ApiServiceContestListResult) GetSuccess() (v *ContestListResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceContestListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceContestListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceContestListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceContestListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestListResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceContestListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestListResult(%+v)", *p)
+}
+
+type ApiServiceContestRecommendArgs struct {
+	Req *ContestRecommendRequest `thrift:"req,1" json:"req"`
+}
+
+func NewApiServiceContestRecommendArgs() *ApiServiceContestRecommendArgs {
+	return &ApiServiceContestRecommendArgs{}
+}
+
+var ApiServiceContestRecommendArgs_Req_DEFAULT *ContestRecommendRequest
+
+func (p *ApiServiceContestRecommendArgs) GetReq() (v *ContestRecommendRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceContestRecommendArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceContestRecommendArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceContestRecommendArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceContestRecommendArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestRecommendArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestRecommendArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestRecommendRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestRecommendArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestRecommend_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestRecommendArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceContestRecommendArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestRecommendArgs(%+v)", *p)
+}
+
+type ApiServiceContestRecommendResult struct {
+	Success *ContestRecommendResponse `thrift:"success,0,optional" json:"success,omitempty"`
+}
+
+func NewApiServiceContestRecommendResult() *ApiServiceContestRecommendResult {
+	return &ApiServiceContestRecommendResult{}
+}
+
+var ApiServiceContestRecommendResult_Success_DEFAULT *ContestRecommendResponse
+
+func (p *ApiServiceContestRecommendResult) GetSuccess() (v *ContestRecommendResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceContestRecommendResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceContestRecommendResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceContestRecommendResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceContestRecommendResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestRecommendResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestRecommendResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestRecommendResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestRecommendResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestRecommend_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestRecommendResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceContestRecommendResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestRecommendResult(%+v)", *p)
+}
+
+type ApiServiceContestInfoArgs struct {
+	Req *ContestInfoRequest `thrift:"req,1"`
+}
+
+func NewApiServiceContestInfoArgs() *ApiServiceContestInfoArgs {
+	return &ApiServiceContestInfoArgs{}
+}
+
+var ApiServiceContestInfoArgs_Req_DEFAULT *ContestInfoRequest
+
+func (p *ApiServiceContestInfoArgs) GetReq() (v *ContestInfoRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceContestInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceContestInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceContestInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceContestInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestInfoRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceContestInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestInfoArgs(%+v)", *p)
+}
+
+type ApiServiceContestInfoResult struct {
+	Success *ContestInfoResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceContestInfoResult() *ApiServiceContestInfoResult {
+	return &ApiServiceContestInfoResult{}
+}
+
+var ApiServiceContestInfoResult_Success_DEFAULT *ContestInfoResponse
+
+func (p *ApiServiceContestInfoResult) GetSuccess() (v *ContestInfoResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceContestInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceContestInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceContestInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceContestInfoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestInfoResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceContestInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestInfoResult(%+v)", *p)
+}
+
+type ApiServiceContestCreateArgs struct {
+	Req *ContestCreateRequest `thrift:"req,1"`
+}
+
+func NewApiServiceContestCreateArgs() *ApiServiceContestCreateArgs {
+	return &ApiServiceContestCreateArgs{}
+}
+
+var ApiServiceContestCreateArgs_Req_DEFAULT *ContestCreateRequest
+
+func (p *ApiServiceContestCreateArgs) GetReq() (v *ContestCreateRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceContestCreateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceContestCreateArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceContestCreateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceContestCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestCreateArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestCreateArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestCreateRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestCreate_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceContestCreateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestCreateArgs(%+v)", *p)
+}
+
+type ApiServiceContestCreateResult struct {
+	Success *ContestCreateResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceContestCreateResult() *ApiServiceContestCreateResult {
+	return &ApiServiceContestCreateResult{}
+}
+
+var ApiServiceContestCreateResult_Success_DEFAULT *ContestCreateResponse
+
+func (p *ApiServiceContestCreateResult) GetSuccess() (v *ContestCreateResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceContestCreateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceContestCreateResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceContestCreateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceContestCreateResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestCreateResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestCreateResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestCreateResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceContestCreateResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestCreate_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceContestCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceContestCreateResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceContestCreateResult(%+v)", *p)
+}
+
+type ApiServiceImageUploadArgs struct {
+	Req *ImageUploadRequest `thrift:"req,1"`
+}
+
+func NewApiServiceImageUploadArgs() *ApiServiceImageUploadArgs {
+	return &ApiServiceImageUploadArgs{}
+}
+
+var ApiServiceImageUploadArgs_Req_DEFAULT *ImageUploadRequest
+
+func (p *ApiServiceImageUploadArgs) GetReq() (v *ImageUploadRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceImageUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceImageUploadArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceImageUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceImageUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceImageUploadArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceImageUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewImageUploadRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceImageUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ImageUpload_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceImageUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceImageUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceImageUploadArgs(%+v)", *p)
+}
+
+type ApiServiceImageUploadResult struct {
+	Success *ImageUploadResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceImageUploadResult() *ApiServiceImageUploadResult {
+	return &ApiServiceImageUploadResult{}
+}
+
+var ApiServiceImageUploadResult_Success_DEFAULT *ImageUploadResponse
+
+func (p *ApiServiceImageUploadResult) GetSuccess() (v *ImageUploadResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceImageUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceImageUploadResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceImageUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceImageUploadResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceImageUploadResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceImageUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewImageUploadResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceImageUploadResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ImageUpload_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceImageUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceImageUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceImageUploadResult(%+v)", *p)
+}
+
+type ApiServiceTeamCreateArgs struct {
+	Req *TeamCreateRequest `thrift:"req,1"`
+}
+
+func NewApiServiceTeamCreateArgs() *ApiServiceTeamCreateArgs {
+	return &ApiServiceTeamCreateArgs{}
+}
+
+var ApiServiceTeamCreateArgs_Req_DEFAULT *TeamCreateRequest
+
+func (p *ApiServiceTeamCreateArgs) GetReq() (v *TeamCreateRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceTeamCreateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceTeamCreateArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceTeamCreateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceTeamCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCreateArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamCreateArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamCreateRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceTeamCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCreate_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceTeamCreateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceTeamCreateArgs(%+v)", *p)
+}
+
+type ApiServiceTeamCreateResult struct {
+	Success *TeamCreateResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceTeamCreateResult() *ApiServiceTeamCreateResult {
+	return &ApiServiceTeamCreateResult{}
+}
+
+var ApiServiceTeamCreateResult_Success_DEFAULT *TeamCreateResponse
+
+func (p *ApiServiceTeamCreateResult) GetSuccess() (v *TeamCreateResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceTeamCreateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceTeamCreateResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceTeamCreateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceTeamCreateResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCreateResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamCreateResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamCreateResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceTeamCreateResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCreate_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceTeamCreateResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceTeamCreateResult(%+v)", *p)
+}
+
+type ApiServiceTeamListArgs struct {
+	Req *TeamListRequest `thrift:"req,1"`
+}
+
+func NewApiServiceTeamListArgs() *ApiServiceTeamListArgs {
+	return &ApiServiceTeamListArgs{}
+}
+
+var ApiServiceTeamListArgs_Req_DEFAULT *TeamListRequest
+
+func (p *ApiServiceTeamListArgs) GetReq() (v *TeamListRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceTeamListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceTeamListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceTeamListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceTeamListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamListRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ApiServiceTeamListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceTeamListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceTeamListArgs(%+v)", *p)
+}
+
+type ApiServiceTeamListResult struct {
+	Success *TeamListResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceTeamListResult() *ApiServiceTeamListResult {
+	return &ApiServiceTeamListResult{}
+}
+
+var ApiServiceTeamListResult_Success_DEFAULT *TeamListResponse
+
+func (p *ApiServiceTeamListResult) GetSuccess() (v *TeamListResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceTeamListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceTeamListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceTeamListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceTeamListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
 
-type apiServiceProcessorUserProfileUpload struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorUserProfileUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUserProfileUploadArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamListResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUserProfileUploadResult{}
-	var retval *UserProfileUploadResponse
-	if retval, err2 = p.handler.UserProfileUpload(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileUpload: "+err2.Error())
-		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceTeamListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamList_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UserProfileUpload", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceTeamListResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceTeamListResult(%+v)", *p)
 }
 
-type apiServiceProcessorContestList struct {
-	handler ApiService
+type ApiServiceTeamInfoArgs struct {
+	Req *TeamInfoRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorContestList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceContestListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceTeamInfoArgs() *ApiServiceTeamInfoArgs {
+	return &ApiServiceTeamInfoArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceContestListResult{}
-	var retval *ContestListResponse
-	if retval, err2 = p.handler.ContestList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestList: "+err2.Error())
-		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ContestList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+var ApiServiceTeamInfoArgs_Req_DEFAULT *TeamInfoRequest
+
+func (p *ApiServiceTeamInfoArgs) GetReq() (v *TeamInfoRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceTeamInfoArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorContestInfo struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTeamInfoArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorContestInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceContestInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTeamInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceContestInfoResult{}
-	var retval *ContestInfoResponse
-	if retval, err2 = p.handler.ContestInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestInfo: "+err2.Error())
-		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ContestInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTeamInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type apiServiceProcessorContestCreate struct {
-	handler ApiService
+func (p *ApiServiceTeamInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInfoRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (p *apiServiceProcessorContestCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceContestCreateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInfo_args"); err != nil {
+		goto WriteStructBeginError
 	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceContestCreateResult{}
-	var retval *ContestCreateResponse
-	if retval, err2 = p.handler.ContestCreate(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestCreate: "+err2.Error())
-		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ContestCreate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
-}
-
-type apiServiceProcessorImageUpload struct {
-	handler ApiService
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *apiServiceProcessorImageUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceImageUploadArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ImageUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ApiServiceTeamInfoArgs(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceImageUploadResult{}
-	var retval *ImageUploadResponse
-	if retval, err2 = p.handler.ImageUpload(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ImageUpload: "+err2.Error())
-		oprot.WriteMessageBegin("ImageUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ImageUpload", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+type ApiServiceTeamInfoResult struct {
+	Success *TeamInfoResponse `thrift:"success,0,optional"`
 }
 
-type apiServiceProcessorTeamCreate struct {
-	handler ApiService
+func NewApiServiceTeamInfoResult() *ApiServiceTeamInfoResult {
+	return &ApiServiceTeamInfoResult{}
 }
 
-func (p *apiServiceProcessorTeamCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTeamCreateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceTeamInfoResult_Success_DEFAULT *TeamInfoResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTeamCreateResult{}
-	var retval *TeamCreateResponse
-	if retval, err2 = p.handler.TeamCreate(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamCreate: "+err2.Error())
-		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("TeamCreate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceTeamInfoResult) GetSuccess() (v *TeamInfoResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceTeamInfoResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorTeamList struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTeamInfoResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorTeamList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTeamListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTeamInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTeamListResult{}
-	var retval *TeamListResponse
-	if retval, err2 = p.handler.TeamList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamList: "+err2.Error())
-		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("TeamList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTeamInfoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorTeamInfo struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorTeamInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTeamInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInfoResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTeamInfoResult{}
-	var retval *TeamInfoResponse
-	if retval, err2 = p.handler.TeamInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInfo: "+err2.Error())
-		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceTeamInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInfo_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("TeamInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceTeamInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceTeamInfoResult(%+v)", *p)
 }
 
-type apiServiceProcessorTeamApplicationSubmit struct {
-	handler ApiService
+type ApiServiceTeamApplicationSubmitArgs struct {
+	Req *TeamApplicationSubmitRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorTeamApplicationSubmit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTeamApplicationSubmitArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceTeamApplicationSubmitArgs() *ApiServiceTeamApplicationSubmitArgs {
+	return &ApiServiceTeamApplicationSubmitArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTeamApplicationSubmitResult{}
-	var retval *TeamApplicationSubmitResponse
-	if retval, err2 = p.handler.TeamApplicationSubmit(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamApplicationSubmit: "+err2.Error())
-		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+var ApiServiceTeamApplicationSubmitArgs_Req_DEFAULT *TeamApplicationSubmitRequest
+
+func (p *ApiServiceTeamApplicationSubmitArgs) GetReq() (v *TeamApplicationSubmitRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceTeamApplicationSubmitArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorTeamManageList struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTeamApplicationSubmitArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorTeamManageList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTeamManageListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTeamApplicationSubmitArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTeamManageListResult{}
-	var retval *TeamManageListResponse
-	if retval, err2 = p.handler.TeamManageList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageList: "+err2.Error())
-		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("TeamManageList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTeamApplicationSubmitArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamApplicationSubmitArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type apiServiceProcessorTeamManageAction struct {
-	handler ApiService
+func (p *ApiServiceTeamApplicationSubmitArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamApplicationSubmitRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (p *apiServiceProcessorTeamManageAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTeamManageActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamApplicationSubmitArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationSubmit_args"); err != nil {
+		goto WriteStructBeginError
 	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTeamManageActionResult{}
-	var retval *TeamManageActionResponse
-	if retval, err2 = p.handler.TeamManageAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageAction: "+err2.Error())
-		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("TeamManageAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamApplicationSubmitArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
-}
-
-type apiServiceProcessorContestFavoriteAction struct {
-	handler ApiService
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *apiServiceProcessorContestFavoriteAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceContestFavoriteActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamApplicationSubmitArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ApiServiceTeamApplicationSubmitArgs(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceContestFavoriteActionResult{}
-	var retval *ContestFavoriteActionResponse
-	if retval, err2 = p.handler.ContestFavoriteAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteAction: "+err2.Error())
-		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ContestFavoriteAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+type ApiServiceTeamApplicationSubmitResult struct {
+	Success *TeamApplicationSubmitResponse `thrift:"success,0,optional"`
 }
 
-type apiServiceProcessorContestFavoriteList struct {
-	handler ApiService
+func NewApiServiceTeamApplicationSubmitResult() *ApiServiceTeamApplicationSubmitResult {
+	return &ApiServiceTeamApplicationSubmitResult{}
 }
 
-func (p *apiServiceProcessorContestFavoriteList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceContestFavoriteListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceTeamApplicationSubmitResult_Success_DEFAULT *TeamApplicationSubmitResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceContestFavoriteListResult{}
-	var retval *ContestFavoriteListResponse
-	if retval, err2 = p.handler.ContestFavoriteList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteList: "+err2.Error())
-		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ContestFavoriteList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceTeamApplicationSubmitResult) GetSuccess() (v *TeamApplicationSubmitResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceTeamApplicationSubmitResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorArticleList struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTeamApplicationSubmitResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorArticleList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceArticleListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ArticleList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTeamApplicationSubmitResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceArticleListResult{}
-	var retval *ArticleListResponse
-	if retval, err2 = p.handler.ArticleList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ArticleList: "+err2.Error())
-		oprot.WriteMessageBegin("ArticleList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ArticleList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTeamApplicationSubmitResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorArticleCreate struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamApplicationSubmitResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorArticleCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceArticleCreateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ArticleCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTeamApplicationSubmitResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamApplicationSubmitResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceArticleCreateResult{}
-	var retval *ArticleCreateResponse
-	if retval, err2 = p.handler.ArticleCreate(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ArticleCreate: "+err2.Error())
-		oprot.WriteMessageBegin("ArticleCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceTeamApplicationSubmitResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationSubmit_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ArticleCreate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTeamApplicationSubmitResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceTeamApplicationSubmitResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceTeamApplicationSubmitResult(%+v)", *p)
 }
 
-type ApiServiceUserRegisterArgs struct {
-	Req *UserRegisterRequest `thrift:"req,1"`
+type ApiServiceTeamApplicationWithdrawArgs struct {
+	Req *TeamApplicationWithdrawRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUserRegisterArgs() *ApiServiceUserRegisterArgs {
-	return &ApiServiceUserRegisterArgs{}
+func NewApiServiceTeamApplicationWithdrawArgs() *ApiServiceTeamApplicationWithdrawArgs {
+	return &ApiServiceTeamApplicationWithdrawArgs{}
 }
 
-var ApiServiceUserRegisterArgs_Req_DEFAULT *UserRegisterRequest
+var ApiServiceTeamApplicationWithdrawArgs_Req_DEFAULT *TeamApplicationWithdrawRequest
 
-func (p *ApiServiceUserRegisterArgs) GetReq() (v *UserRegisterRequest) {
+func (p *ApiServiceTeamApplicationWithdrawArgs) GetReq() (v *TeamApplicationWithdrawRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUserRegisterArgs_Req_DEFAULT
+		return ApiServiceTeamApplicationWithdrawArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUserRegisterArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamApplicationWithdrawArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUserRegisterArgs) IsSetReq() bool {
+func (p *ApiServiceTeamApplicationWithdrawArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUserRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamApplicationWithdrawArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16576,7 +31938,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserRegisterArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamApplicationWithdrawArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16586,17 +31948,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserRegisterRequest()
+func (p *ApiServiceTeamApplicationWithdrawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamApplicationWithdrawRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamApplicationWithdrawArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRegister_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationWithdraw_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16623,7 +31985,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamApplicationWithdrawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16640,39 +32002,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUserRegisterArgs) String() string {
+func (p *ApiServiceTeamApplicationWithdrawArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamApplicationWithdrawArgs(%+v)", *p)
 }
 
-type ApiServiceUserRegisterResult struct {
-	Success *UserRegisterResponse `thrift:"success,0,optional"`
+type ApiServiceTeamApplicationWithdrawResult struct {
+	Success *TeamApplicationWithdrawResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUserRegisterResult() *ApiServiceUserRegisterResult {
-	return &ApiServiceUserRegisterResult{}
+func NewApiServiceTeamApplicationWithdrawResult() *ApiServiceTeamApplicationWithdrawResult {
+	return &ApiServiceTeamApplicationWithdrawResult{}
 }
 
-var ApiServiceUserRegisterResult_Success_DEFAULT *UserRegisterResponse
+var ApiServiceTeamApplicationWithdrawResult_Success_DEFAULT *TeamApplicationWithdrawResponse
 
-func (p *ApiServiceUserRegisterResult) GetSuccess() (v *UserRegisterResponse) {
+func (p *ApiServiceTeamApplicationWithdrawResult) GetSuccess() (v *TeamApplicationWithdrawResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUserRegisterResult_Success_DEFAULT
+		return ApiServiceTeamApplicationWithdrawResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUserRegisterResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamApplicationWithdrawResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUserRegisterResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamApplicationWithdrawResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUserRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamApplicationWithdrawResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16721,7 +32083,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserRegisterResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamApplicationWithdrawResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16731,17 +32093,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserRegisterResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserRegisterResponse()
+func (p *ApiServiceTeamApplicationWithdrawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamApplicationWithdrawResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamApplicationWithdrawResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRegister_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationWithdraw_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16768,7 +32130,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamApplicationWithdrawResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -16787,39 +32149,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUserRegisterResult) String() string {
+func (p *ApiServiceTeamApplicationWithdrawResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserRegisterResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamApplicationWithdrawResult(%+v)", *p)
 }
 
-type ApiServiceUserLoginArgs struct {
-	Req *UserLoginRequest `thrift:"req,1"`
+type ApiServiceMyApplicationsArgs struct {
+	Req *MyApplicationsRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceUserLoginArgs() *ApiServiceUserLoginArgs {
-	return &ApiServiceUserLoginArgs{}
+func NewApiServiceMyApplicationsArgs() *ApiServiceMyApplicationsArgs {
+	return &ApiServiceMyApplicationsArgs{}
 }
 
-var ApiServiceUserLoginArgs_Req_DEFAULT *UserLoginRequest
+var ApiServiceMyApplicationsArgs_Req_DEFAULT *MyApplicationsRequest
 
-func (p *ApiServiceUserLoginArgs) GetReq() (v *UserLoginRequest) {
+func (p *ApiServiceMyApplicationsArgs) GetReq() (v *MyApplicationsRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUserLoginArgs_Req_DEFAULT
+		return ApiServiceMyApplicationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUserLoginArgs = map[int16]string{
+var fieldIDToName_ApiServiceMyApplicationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUserLoginArgs) IsSetReq() bool {
+func (p *ApiServiceMyApplicationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUserLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyApplicationsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -16868,7 +32230,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserLoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceMyApplicationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16878,17 +32240,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserLoginRequest()
+func (p *ApiServiceMyApplicationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewMyApplicationsRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyApplicationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserLogin_args"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplications_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16915,7 +32277,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyApplicationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16932,39 +32294,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUserLoginArgs) String() string {
+func (p *ApiServiceMyApplicationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserLoginArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceMyApplicationsArgs(%+v)", *p)
 }
 
-type ApiServiceUserLoginResult struct {
-	Success *UserLoginResponse `thrift:"success,0,optional"`
+type ApiServiceMyApplicationsResult struct {
+	Success *MyApplicationsResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceUserLoginResult() *ApiServiceUserLoginResult {
-	return &ApiServiceUserLoginResult{}
+func NewApiServiceMyApplicationsResult() *ApiServiceMyApplicationsResult {
+	return &ApiServiceMyApplicationsResult{}
 }
 
-var ApiServiceUserLoginResult_Success_DEFAULT *UserLoginResponse
+var ApiServiceMyApplicationsResult_Success_DEFAULT *MyApplicationsResponse
 
-func (p *ApiServiceUserLoginResult) GetSuccess() (v *UserLoginResponse) {
+func (p *ApiServiceMyApplicationsResult) GetSuccess() (v *MyApplicationsResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUserLoginResult_Success_DEFAULT
+		return ApiServiceMyApplicationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUserLoginResult = map[int16]string{
+var fieldIDToName_ApiServiceMyApplicationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUserLoginResult) IsSetSuccess() bool {
+func (p *ApiServiceMyApplicationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUserLoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyApplicationsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17013,7 +32375,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserLoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceMyApplicationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17023,17 +32385,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserLoginResponse()
+func (p *ApiServiceMyApplicationsResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewMyApplicationsResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserLoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyApplicationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserLogin_result"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplications_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17060,7 +32422,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyApplicationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17079,39 +32441,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUserLoginResult) String() string {
+func (p *ApiServiceMyApplicationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserLoginResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceMyApplicationsResult(%+v)", *p)
 }
 
-type ApiServiceUserInfoArgs struct {
-	Req *UserInfoRequest `thrift:"req,1"`
+type ApiServiceMyTeamListArgs struct {
+	Req *MyTeamListRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceUserInfoArgs() *ApiServiceUserInfoArgs {
-	return &ApiServiceUserInfoArgs{}
+func NewApiServiceMyTeamListArgs() *ApiServiceMyTeamListArgs {
+	return &ApiServiceMyTeamListArgs{}
 }
 
-var ApiServiceUserInfoArgs_Req_DEFAULT *UserInfoRequest
+var ApiServiceMyTeamListArgs_Req_DEFAULT *MyTeamListRequest
 
-func (p *ApiServiceUserInfoArgs) GetReq() (v *UserInfoRequest) {
+func (p *ApiServiceMyTeamListArgs) GetReq() (v *MyTeamListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUserInfoArgs_Req_DEFAULT
+		return ApiServiceMyTeamListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUserInfoArgs = map[int16]string{
+var fieldIDToName_ApiServiceMyTeamListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUserInfoArgs) IsSetReq() bool {
+func (p *ApiServiceMyTeamListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyTeamListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17160,7 +32522,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceMyTeamListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17170,17 +32532,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserInfoRequest()
+func (p *ApiServiceMyTeamListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewMyTeamListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyTeamListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("MyTeamList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17207,7 +32569,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyTeamListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17224,39 +32586,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoArgs) String() string {
+func (p *ApiServiceMyTeamListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceMyTeamListArgs(%+v)", *p)
 }
 
-type ApiServiceUserInfoResult struct {
-	Success *UserInfoResponse `thrift:"success,0,optional"`
+type ApiServiceMyTeamListResult struct {
+	Success *MyTeamListResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceUserInfoResult() *ApiServiceUserInfoResult {
-	return &ApiServiceUserInfoResult{}
+func NewApiServiceMyTeamListResult() *ApiServiceMyTeamListResult {
+	return &ApiServiceMyTeamListResult{}
 }
 
-var ApiServiceUserInfoResult_Success_DEFAULT *UserInfoResponse
+var ApiServiceMyTeamListResult_Success_DEFAULT *MyTeamListResponse
 
-func (p *ApiServiceUserInfoResult) GetSuccess() (v *UserInfoResponse) {
+func (p *ApiServiceMyTeamListResult) GetSuccess() (v *MyTeamListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUserInfoResult_Success_DEFAULT
+		return ApiServiceMyTeamListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUserInfoResult = map[int16]string{
+var fieldIDToName_ApiServiceMyTeamListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUserInfoResult) IsSetSuccess() bool {
+func (p *ApiServiceMyTeamListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyTeamListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17305,7 +32667,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceMyTeamListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17315,17 +32677,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserInfoResponse()
+func (p *ApiServiceMyTeamListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewMyTeamListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyTeamListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("MyTeamList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17352,7 +32714,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceMyTeamListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17371,39 +32733,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoResult) String() string {
+func (p *ApiServiceMyTeamListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceMyTeamListResult(%+v)", *p)
 }
 
-type ApiServiceUserInfoUploadArgs struct {
-	Req *UserInfoUploadRequest `thrift:"req,1"`
+type ApiServiceTeamManageListArgs struct {
+	Req *TeamManageListRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUserInfoUploadArgs() *ApiServiceUserInfoUploadArgs {
-	return &ApiServiceUserInfoUploadArgs{}
+func NewApiServiceTeamManageListArgs() *ApiServiceTeamManageListArgs {
+	return &ApiServiceTeamManageListArgs{}
 }
 
-var ApiServiceUserInfoUploadArgs_Req_DEFAULT *UserInfoUploadRequest
+var ApiServiceTeamManageListArgs_Req_DEFAULT *TeamManageListRequest
 
-func (p *ApiServiceUserInfoUploadArgs) GetReq() (v *UserInfoUploadRequest) {
+func (p *ApiServiceTeamManageListArgs) GetReq() (v *TeamManageListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUserInfoUploadArgs_Req_DEFAULT
+		return ApiServiceTeamManageListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUserInfoUploadArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamManageListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUserInfoUploadArgs) IsSetReq() bool {
+func (p *ApiServiceTeamManageListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUserInfoUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17452,7 +32814,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoUploadArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17462,17 +32824,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoUploadArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserInfoUploadRequest()
+func (p *ApiServiceTeamManageListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamManageListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserInfoUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfoUpload_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamManageList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17499,7 +32861,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17516,39 +32878,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoUploadArgs) String() string {
+func (p *ApiServiceTeamManageListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserInfoUploadArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamManageListArgs(%+v)", *p)
 }
 
-type ApiServiceUserInfoUploadResult struct {
-	Success *UserInfoUploadResponse `thrift:"success,0,optional"`
+type ApiServiceTeamManageListResult struct {
+	Success *TeamManageListResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUserInfoUploadResult() *ApiServiceUserInfoUploadResult {
-	return &ApiServiceUserInfoUploadResult{}
+func NewApiServiceTeamManageListResult() *ApiServiceTeamManageListResult {
+	return &ApiServiceTeamManageListResult{}
 }
 
-var ApiServiceUserInfoUploadResult_Success_DEFAULT *UserInfoUploadResponse
+var ApiServiceTeamManageListResult_Success_DEFAULT *TeamManageListResponse
 
-func (p *ApiServiceUserInfoUploadResult) GetSuccess() (v *UserInfoUploadResponse) {
+func (p *ApiServiceTeamManageListResult) GetSuccess() (v *TeamManageListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUserInfoUploadResult_Success_DEFAULT
+		return ApiServiceTeamManageListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUserInfoUploadResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamManageListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUserInfoUploadResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamManageListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUserInfoUploadResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17597,7 +32959,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserInfoUploadResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17607,17 +32969,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoUploadResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserInfoUploadResponse()
+func (p *ApiServiceTeamManageListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamManageListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserInfoUploadResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfoUpload_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamManageList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17644,7 +33006,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17663,39 +33025,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUserInfoUploadResult) String() string {
+func (p *ApiServiceTeamManageListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserInfoUploadResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamManageListResult(%+v)", *p)
 }
 
-type ApiServiceUserProfileInfoArgs struct {
-	Req *UserProfileInfoRequest `thrift:"req,1"`
+type ApiServiceTeamManageActionArgs struct {
+	Req *TeamManageActionRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUserProfileInfoArgs() *ApiServiceUserProfileInfoArgs {
-	return &ApiServiceUserProfileInfoArgs{}
+func NewApiServiceTeamManageActionArgs() *ApiServiceTeamManageActionArgs {
+	return &ApiServiceTeamManageActionArgs{}
 }
 
-var ApiServiceUserProfileInfoArgs_Req_DEFAULT *UserProfileInfoRequest
+var ApiServiceTeamManageActionArgs_Req_DEFAULT *TeamManageActionRequest
 
-func (p *ApiServiceUserProfileInfoArgs) GetReq() (v *UserProfileInfoRequest) {
+func (p *ApiServiceTeamManageActionArgs) GetReq() (v *TeamManageActionRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUserProfileInfoArgs_Req_DEFAULT
+		return ApiServiceTeamManageActionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUserProfileInfoArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamManageActionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUserProfileInfoArgs) IsSetReq() bool {
+func (p *ApiServiceTeamManageActionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUserProfileInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageActionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17744,7 +33106,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageActionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17754,17 +33116,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserProfileInfoRequest()
+func (p *ApiServiceTeamManageActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamManageActionRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserProfileInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageActionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamManageAction_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17791,7 +33153,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17808,39 +33170,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileInfoArgs) String() string {
+func (p *ApiServiceTeamManageActionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserProfileInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamManageActionArgs(%+v)", *p)
 }
 
-type ApiServiceUserProfileInfoResult struct {
-	Success *UserProfileInfoResponse `thrift:"success,0,optional"`
+type ApiServiceTeamManageActionResult struct {
+	Success *TeamManageActionResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUserProfileInfoResult() *ApiServiceUserProfileInfoResult {
-	return &ApiServiceUserProfileInfoResult{}
+func NewApiServiceTeamManageActionResult() *ApiServiceTeamManageActionResult {
+	return &ApiServiceTeamManageActionResult{}
 }
 
-var ApiServiceUserProfileInfoResult_Success_DEFAULT *UserProfileInfoResponse
+var ApiServiceTeamManageActionResult_Success_DEFAULT *TeamManageActionResponse
 
-func (p *ApiServiceUserProfileInfoResult) GetSuccess() (v *UserProfileInfoResponse) {
+func (p *ApiServiceTeamManageActionResult) GetSuccess() (v *TeamManageActionResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUserProfileInfoResult_Success_DEFAULT
+		return ApiServiceTeamManageActionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUserProfileInfoResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamManageActionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUserProfileInfoResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamManageActionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUserProfileInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageActionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -17889,7 +33251,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageActionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17899,17 +33261,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserProfileInfoResponse()
+func (p *ApiServiceTeamManageActionResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamManageActionResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserProfileInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageActionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamManageAction_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17936,7 +33298,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamManageActionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -17955,39 +33317,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileInfoResult) String() string {
+func (p *ApiServiceTeamManageActionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserProfileInfoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamManageActionResult(%+v)", *p)
 }
 
-type ApiServiceUserProfileUploadArgs struct {
-	Req *UserProfileUploadRequest `thrift:"req,1"`
+type ApiServiceTeamLeaveArgs struct {
+	Req *TeamLeaveRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceUserProfileUploadArgs() *ApiServiceUserProfileUploadArgs {
-	return &ApiServiceUserProfileUploadArgs{}
+func NewApiServiceTeamLeaveArgs() *ApiServiceTeamLeaveArgs {
+	return &ApiServiceTeamLeaveArgs{}
 }
 
-var ApiServiceUserProfileUploadArgs_Req_DEFAULT *UserProfileUploadRequest
+var ApiServiceTeamLeaveArgs_Req_DEFAULT *TeamLeaveRequest
 
-func (p *ApiServiceUserProfileUploadArgs) GetReq() (v *UserProfileUploadRequest) {
+func (p *ApiServiceTeamLeaveArgs) GetReq() (v *TeamLeaveRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUserProfileUploadArgs_Req_DEFAULT
+		return ApiServiceTeamLeaveArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUserProfileUploadArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamLeaveArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUserProfileUploadArgs) IsSetReq() bool {
+func (p *ApiServiceTeamLeaveArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUserProfileUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaveArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18036,7 +33398,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileUploadArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamLeaveArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18046,17 +33408,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileUploadArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserProfileUploadRequest()
+func (p *ApiServiceTeamLeaveArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamLeaveRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserProfileUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaveArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileUpload_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamLeave_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18083,7 +33445,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaveArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18100,39 +33462,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileUploadArgs) String() string {
+func (p *ApiServiceTeamLeaveArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserProfileUploadArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamLeaveArgs(%+v)", *p)
 }
 
-type ApiServiceUserProfileUploadResult struct {
-	Success *UserProfileUploadResponse `thrift:"success,0,optional"`
+type ApiServiceTeamLeaveResult struct {
+	Success *TeamLeaveResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceUserProfileUploadResult() *ApiServiceUserProfileUploadResult {
-	return &ApiServiceUserProfileUploadResult{}
+func NewApiServiceTeamLeaveResult() *ApiServiceTeamLeaveResult {
+	return &ApiServiceTeamLeaveResult{}
 }
 
-var ApiServiceUserProfileUploadResult_Success_DEFAULT *UserProfileUploadResponse
+var ApiServiceTeamLeaveResult_Success_DEFAULT *TeamLeaveResponse
 
-func (p *ApiServiceUserProfileUploadResult) GetSuccess() (v *UserProfileUploadResponse) {
+func (p *ApiServiceTeamLeaveResult) GetSuccess() (v *TeamLeaveResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUserProfileUploadResult_Success_DEFAULT
+		return ApiServiceTeamLeaveResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUserProfileUploadResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamLeaveResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUserProfileUploadResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamLeaveResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUserProfileUploadResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaveResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18181,7 +33543,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUserProfileUploadResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamLeaveResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18191,17 +33553,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileUploadResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserProfileUploadResponse()
+func (p *ApiServiceTeamLeaveResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamLeaveResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceUserProfileUploadResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaveResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileUpload_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamLeave_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18228,7 +33590,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaveResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18247,39 +33609,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUserProfileUploadResult) String() string {
+func (p *ApiServiceTeamLeaveResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUserProfileUploadResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamLeaveResult(%+v)", *p)
 }
 
-type ApiServiceContestListArgs struct {
-	Req *ContestListRequest `thrift:"req,1"`
+type ApiServiceTeamMemberRemoveArgs struct {
+	Req *TeamMemberRemoveRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceContestListArgs() *ApiServiceContestListArgs {
-	return &ApiServiceContestListArgs{}
+func NewApiServiceTeamMemberRemoveArgs() *ApiServiceTeamMemberRemoveArgs {
+	return &ApiServiceTeamMemberRemoveArgs{}
 }
 
-var ApiServiceContestListArgs_Req_DEFAULT *ContestListRequest
+var ApiServiceTeamMemberRemoveArgs_Req_DEFAULT *TeamMemberRemoveRequest
 
-func (p *ApiServiceContestListArgs) GetReq() (v *ContestListRequest) {
+func (p *ApiServiceTeamMemberRemoveArgs) GetReq() (v *TeamMemberRemoveRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceContestListArgs_Req_DEFAULT
+		return ApiServiceTeamMemberRemoveArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceContestListArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamMemberRemoveArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceContestListArgs) IsSetReq() bool {
+func (p *ApiServiceTeamMemberRemoveArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceContestListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamMemberRemoveArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18328,7 +33690,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamMemberRemoveArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18338,17 +33700,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestListRequest()
+func (p *ApiServiceTeamMemberRemoveArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamMemberRemoveRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceContestListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamMemberRemoveArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestList_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamMemberRemove_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18375,7 +33737,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamMemberRemoveArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18392,39 +33754,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceContestListArgs) String() string {
+func (p *ApiServiceTeamMemberRemoveArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceContestListArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamMemberRemoveArgs(%+v)", *p)
 }
 
-type ApiServiceContestListResult struct {
-	Success *ContestListResponse `thrift:"success,0,optional"`
+type ApiServiceTeamMemberRemoveResult struct {
+	Success *TeamMemberRemoveResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceContestListResult() *ApiServiceContestListResult {
-	return &ApiServiceContestListResult{}
+func NewApiServiceTeamMemberRemoveResult() *ApiServiceTeamMemberRemoveResult {
+	return &ApiServiceTeamMemberRemoveResult{}
 }
 
-var ApiServiceContestListResult_Success_DEFAULT *ContestListResponse
+var ApiServiceTeamMemberRemoveResult_Success_DEFAULT *TeamMemberRemoveResponse
 
-func (p *ApiServiceContestListResult) GetSuccess() (v *ContestListResponse) {
+func (p *ApiServiceTeamMemberRemoveResult) GetSuccess() (v *TeamMemberRemoveResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceContestListResult_Success_DEFAULT
+		return ApiServiceTeamMemberRemoveResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceContestListResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamMemberRemoveResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceContestListResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamMemberRemoveResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceContestListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamMemberRemoveResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18473,7 +33835,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamMemberRemoveResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18483,17 +33845,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestListResponse()
+func (p *ApiServiceTeamMemberRemoveResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamMemberRemoveResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceContestListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamMemberRemoveResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestList_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamMemberRemove_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18520,7 +33882,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamMemberRemoveResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18539,39 +33901,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceContestListResult) String() string {
+func (p *ApiServiceTeamMemberRemoveResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceContestListResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamMemberRemoveResult(%+v)", *p)
 }
 
-type ApiServiceContestInfoArgs struct {
-	Req *ContestInfoRequest `thrift:"req,1"`
+type ApiServiceTeamLeaderTransferArgs struct {
+	Req *TeamLeaderTransferRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceContestInfoArgs() *ApiServiceContestInfoArgs {
-	return &ApiServiceContestInfoArgs{}
+func NewApiServiceTeamLeaderTransferArgs() *ApiServiceTeamLeaderTransferArgs {
+	return &ApiServiceTeamLeaderTransferArgs{}
 }
 
-var ApiServiceContestInfoArgs_Req_DEFAULT *ContestInfoRequest
+var ApiServiceTeamLeaderTransferArgs_Req_DEFAULT *TeamLeaderTransferRequest
 
-func (p *ApiServiceContestInfoArgs) GetReq() (v *ContestInfoRequest) {
+func (p *ApiServiceTeamLeaderTransferArgs) GetReq() (v *TeamLeaderTransferRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceContestInfoArgs_Req_DEFAULT
+		return ApiServiceTeamLeaderTransferArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceContestInfoArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamLeaderTransferArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceContestInfoArgs) IsSetReq() bool {
+func (p *ApiServiceTeamLeaderTransferArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceContestInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaderTransferArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18620,7 +33982,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamLeaderTransferArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18630,17 +33992,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestInfoRequest()
+func (p *ApiServiceTeamLeaderTransferArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamLeaderTransferRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceContestInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaderTransferArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamLeaderTransfer_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18667,7 +34029,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaderTransferArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18684,39 +34046,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceContestInfoArgs) String() string {
+func (p *ApiServiceTeamLeaderTransferArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceContestInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamLeaderTransferArgs(%+v)", *p)
 }
 
-type ApiServiceContestInfoResult struct {
-	Success *ContestInfoResponse `thrift:"success,0,optional"`
+type ApiServiceTeamLeaderTransferResult struct {
+	Success *TeamLeaderTransferResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceContestInfoResult() *ApiServiceContestInfoResult {
-	return &ApiServiceContestInfoResult{}
+func NewApiServiceTeamLeaderTransferResult() *ApiServiceTeamLeaderTransferResult {
+	return &ApiServiceTeamLeaderTransferResult{}
 }
 
-var ApiServiceContestInfoResult_Success_DEFAULT *ContestInfoResponse
+var ApiServiceTeamLeaderTransferResult_Success_DEFAULT *TeamLeaderTransferResponse
 
-func (p *ApiServiceContestInfoResult) GetSuccess() (v *ContestInfoResponse) {
+func (p *ApiServiceTeamLeaderTransferResult) GetSuccess() (v *TeamLeaderTransferResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceContestInfoResult_Success_DEFAULT
+		return ApiServiceTeamLeaderTransferResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceContestInfoResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamLeaderTransferResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceContestInfoResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamLeaderTransferResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceContestInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaderTransferResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18765,7 +34127,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamLeaderTransferResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18775,17 +34137,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestInfoResponse()
+func (p *ApiServiceTeamLeaderTransferResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamLeaderTransferResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceContestInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaderTransferResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamLeaderTransfer_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18812,7 +34174,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamLeaderTransferResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -18831,39 +34193,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceContestInfoResult) String() string {
+func (p *ApiServiceTeamLeaderTransferResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceContestInfoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamLeaderTransferResult(%+v)", *p)
 }
 
-type ApiServiceContestCreateArgs struct {
-	Req *ContestCreateRequest `thrift:"req,1"`
+type ApiServiceTeamCloseArgs struct {
+	Req *TeamCloseRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceContestCreateArgs() *ApiServiceContestCreateArgs {
-	return &ApiServiceContestCreateArgs{}
+func NewApiServiceTeamCloseArgs() *ApiServiceTeamCloseArgs {
+	return &ApiServiceTeamCloseArgs{}
 }
 
-var ApiServiceContestCreateArgs_Req_DEFAULT *ContestCreateRequest
+var ApiServiceTeamCloseArgs_Req_DEFAULT *TeamCloseRequest
 
-func (p *ApiServiceContestCreateArgs) GetReq() (v *ContestCreateRequest) {
+func (p *ApiServiceTeamCloseArgs) GetReq() (v *TeamCloseRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceContestCreateArgs_Req_DEFAULT
+		return ApiServiceTeamCloseArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceContestCreateArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamCloseArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceContestCreateArgs) IsSetReq() bool {
+func (p *ApiServiceTeamCloseArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceContestCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCloseArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -18912,7 +34274,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestCreateArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCloseArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18922,17 +34284,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestCreateArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestCreateRequest()
+func (p *ApiServiceTeamCloseArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamCloseRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceContestCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCloseArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreate_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamClose_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18959,7 +34321,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCloseArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18976,39 +34338,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceContestCreateArgs) String() string {
+func (p *ApiServiceTeamCloseArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceContestCreateArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamCloseArgs(%+v)", *p)
 }
 
-type ApiServiceContestCreateResult struct {
-	Success *ContestCreateResponse `thrift:"success,0,optional"`
+type ApiServiceTeamCloseResult struct {
+	Success *TeamCloseResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceContestCreateResult() *ApiServiceContestCreateResult {
-	return &ApiServiceContestCreateResult{}
+func NewApiServiceTeamCloseResult() *ApiServiceTeamCloseResult {
+	return &ApiServiceTeamCloseResult{}
 }
 
-var ApiServiceContestCreateResult_Success_DEFAULT *ContestCreateResponse
+var ApiServiceTeamCloseResult_Success_DEFAULT *TeamCloseResponse
 
-func (p *ApiServiceContestCreateResult) GetSuccess() (v *ContestCreateResponse) {
+func (p *ApiServiceTeamCloseResult) GetSuccess() (v *TeamCloseResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceContestCreateResult_Success_DEFAULT
+		return ApiServiceTeamCloseResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceContestCreateResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamCloseResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceContestCreateResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamCloseResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceContestCreateResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCloseResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19057,7 +34419,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceContestCreateResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCloseResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19067,17 +34429,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestCreateResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestCreateResponse()
+func (p *ApiServiceTeamCloseResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamCloseResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceContestCreateResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCloseResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreate_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamClose_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19104,7 +34466,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceContestCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCloseResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -19123,39 +34485,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceContestCreateResult) String() string {
+func (p *ApiServiceTeamCloseResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceContestCreateResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamCloseResult(%+v)", *p)
 }
 
-type ApiServiceImageUploadArgs struct {
-	Req *ImageUploadRequest `thrift:"req,1"`
+type ApiServiceTeamDisbandArgs struct {
+	Req *TeamDisbandRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceImageUploadArgs() *ApiServiceImageUploadArgs {
-	return &ApiServiceImageUploadArgs{}
+func NewApiServiceTeamDisbandArgs() *ApiServiceTeamDisbandArgs {
+	return &ApiServiceTeamDisbandArgs{}
 }
 
-var ApiServiceImageUploadArgs_Req_DEFAULT *ImageUploadRequest
+var ApiServiceTeamDisbandArgs_Req_DEFAULT *TeamDisbandRequest
 
-func (p *ApiServiceImageUploadArgs) GetReq() (v *ImageUploadRequest) {
+func (p *ApiServiceTeamDisbandArgs) GetReq() (v *TeamDisbandRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceImageUploadArgs_Req_DEFAULT
+		return ApiServiceTeamDisbandArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceImageUploadArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamDisbandArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceImageUploadArgs) IsSetReq() bool {
+func (p *ApiServiceTeamDisbandArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceImageUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamDisbandArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19204,7 +34566,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceImageUploadArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamDisbandArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19214,17 +34576,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceImageUploadArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewImageUploadRequest()
+func (p *ApiServiceTeamDisbandArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamDisbandRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceImageUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamDisbandArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImageUpload_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamDisband_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19251,7 +34613,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceImageUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamDisbandArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19268,39 +34630,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceImageUploadArgs) String() string {
+func (p *ApiServiceTeamDisbandArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceImageUploadArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamDisbandArgs(%+v)", *p)
 }
 
-type ApiServiceImageUploadResult struct {
-	Success *ImageUploadResponse `thrift:"success,0,optional"`
+type ApiServiceTeamDisbandResult struct {
+	Success *TeamDisbandResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceImageUploadResult() *ApiServiceImageUploadResult {
-	return &ApiServiceImageUploadResult{}
+func NewApiServiceTeamDisbandResult() *ApiServiceTeamDisbandResult {
+	return &ApiServiceTeamDisbandResult{}
 }
 
-var ApiServiceImageUploadResult_Success_DEFAULT *ImageUploadResponse
+var ApiServiceTeamDisbandResult_Success_DEFAULT *TeamDisbandResponse
 
-func (p *ApiServiceImageUploadResult) GetSuccess() (v *ImageUploadResponse) {
+func (p *ApiServiceTeamDisbandResult) GetSuccess() (v *TeamDisbandResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceImageUploadResult_Success_DEFAULT
+		return ApiServiceTeamDisbandResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceImageUploadResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamDisbandResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceImageUploadResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamDisbandResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceImageUploadResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamDisbandResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19349,7 +34711,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceImageUploadResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamDisbandResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19359,17 +34721,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceImageUploadResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewImageUploadResponse()
+func (p *ApiServiceTeamDisbandResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamDisbandResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceImageUploadResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamDisbandResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImageUpload_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamDisband_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19396,7 +34758,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceImageUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamDisbandResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -19415,39 +34777,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceImageUploadResult) String() string {
+func (p *ApiServiceTeamDisbandResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceImageUploadResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamDisbandResult(%+v)", *p)
 }
 
-type ApiServiceTeamCreateArgs struct {
-	Req *TeamCreateRequest `thrift:"req,1"`
+type ApiServiceTeamInvitationSendArgs struct {
+	Req *TeamInvitationSendRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceTeamCreateArgs() *ApiServiceTeamCreateArgs {
-	return &ApiServiceTeamCreateArgs{}
+func NewApiServiceTeamInvitationSendArgs() *ApiServiceTeamInvitationSendArgs {
+	return &ApiServiceTeamInvitationSendArgs{}
 }
 
-var ApiServiceTeamCreateArgs_Req_DEFAULT *TeamCreateRequest
+var ApiServiceTeamInvitationSendArgs_Req_DEFAULT *TeamInvitationSendRequest
 
-func (p *ApiServiceTeamCreateArgs) GetReq() (v *TeamCreateRequest) {
+func (p *ApiServiceTeamInvitationSendArgs) GetReq() (v *TeamInvitationSendRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTeamCreateArgs_Req_DEFAULT
+		return ApiServiceTeamInvitationSendArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTeamCreateArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationSendArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTeamCreateArgs) IsSetReq() bool {
+func (p *ApiServiceTeamInvitationSendArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTeamCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationSendArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19496,7 +34858,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCreateArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationSendArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19506,17 +34868,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamCreateArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamCreateRequest()
+func (p *ApiServiceTeamInvitationSendArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationSendRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationSendArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreate_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationSend_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19543,7 +34905,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationSendArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19560,39 +34922,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamCreateArgs) String() string {
+func (p *ApiServiceTeamInvitationSendArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamCreateArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationSendArgs(%+v)", *p)
 }
 
-type ApiServiceTeamCreateResult struct {
-	Success *TeamCreateResponse `thrift:"success,0,optional"`
+type ApiServiceTeamInvitationSendResult struct {
+	Success *TeamInvitationSendResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceTeamCreateResult() *ApiServiceTeamCreateResult {
-	return &ApiServiceTeamCreateResult{}
+func NewApiServiceTeamInvitationSendResult() *ApiServiceTeamInvitationSendResult {
+	return &ApiServiceTeamInvitationSendResult{}
 }
 
-var ApiServiceTeamCreateResult_Success_DEFAULT *TeamCreateResponse
+var ApiServiceTeamInvitationSendResult_Success_DEFAULT *TeamInvitationSendResponse
 
-func (p *ApiServiceTeamCreateResult) GetSuccess() (v *TeamCreateResponse) {
+func (p *ApiServiceTeamInvitationSendResult) GetSuccess() (v *TeamInvitationSendResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTeamCreateResult_Success_DEFAULT
+		return ApiServiceTeamInvitationSendResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTeamCreateResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationSendResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTeamCreateResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamInvitationSendResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTeamCreateResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationSendResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19641,7 +35003,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCreateResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationSendResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19651,17 +35013,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamCreateResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamCreateResponse()
+func (p *ApiServiceTeamInvitationSendResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationSendResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamCreateResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationSendResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreate_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationSend_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19688,7 +35050,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationSendResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -19707,39 +35069,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamCreateResult) String() string {
+func (p *ApiServiceTeamInvitationSendResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamCreateResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationSendResult(%+v)", *p)
 }
 
-type ApiServiceTeamListArgs struct {
-	Req *TeamListRequest `thrift:"req,1"`
+type ApiServiceTeamInvitationRespondArgs struct {
+	Req *TeamInvitationRespondRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceTeamListArgs() *ApiServiceTeamListArgs {
-	return &ApiServiceTeamListArgs{}
+func NewApiServiceTeamInvitationRespondArgs() *ApiServiceTeamInvitationRespondArgs {
+	return &ApiServiceTeamInvitationRespondArgs{}
 }
 
-var ApiServiceTeamListArgs_Req_DEFAULT *TeamListRequest
+var ApiServiceTeamInvitationRespondArgs_Req_DEFAULT *TeamInvitationRespondRequest
 
-func (p *ApiServiceTeamListArgs) GetReq() (v *TeamListRequest) {
+func (p *ApiServiceTeamInvitationRespondArgs) GetReq() (v *TeamInvitationRespondRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTeamListArgs_Req_DEFAULT
+		return ApiServiceTeamInvitationRespondArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTeamListArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationRespondArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTeamListArgs) IsSetReq() bool {
+func (p *ApiServiceTeamInvitationRespondArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTeamListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationRespondArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19788,7 +35150,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationRespondArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19798,17 +35160,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamListRequest()
+func (p *ApiServiceTeamInvitationRespondArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationRespondRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationRespondArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamList_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationRespond_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19835,7 +35197,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationRespondArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19852,39 +35214,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamListArgs) String() string {
+func (p *ApiServiceTeamInvitationRespondArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamListArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationRespondArgs(%+v)", *p)
 }
 
-type ApiServiceTeamListResult struct {
-	Success *TeamListResponse `thrift:"success,0,optional"`
+type ApiServiceTeamInvitationRespondResult struct {
+	Success *TeamInvitationRespondResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceTeamListResult() *ApiServiceTeamListResult {
-	return &ApiServiceTeamListResult{}
+func NewApiServiceTeamInvitationRespondResult() *ApiServiceTeamInvitationRespondResult {
+	return &ApiServiceTeamInvitationRespondResult{}
 }
 
-var ApiServiceTeamListResult_Success_DEFAULT *TeamListResponse
+var ApiServiceTeamInvitationRespondResult_Success_DEFAULT *TeamInvitationRespondResponse
 
-func (p *ApiServiceTeamListResult) GetSuccess() (v *TeamListResponse) {
+func (p *ApiServiceTeamInvitationRespondResult) GetSuccess() (v *TeamInvitationRespondResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTeamListResult_Success_DEFAULT
+		return ApiServiceTeamInvitationRespondResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTeamListResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationRespondResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTeamListResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamInvitationRespondResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTeamListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationRespondResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -19933,7 +35295,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationRespondResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19943,17 +35305,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamListResponse()
+func (p *ApiServiceTeamInvitationRespondResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationRespondResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationRespondResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamList_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationRespond_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19980,7 +35342,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationRespondResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -19999,39 +35361,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamListResult) String() string {
+func (p *ApiServiceTeamInvitationRespondResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamListResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationRespondResult(%+v)", *p)
 }
 
-type ApiServiceTeamInfoArgs struct {
-	Req *TeamInfoRequest `thrift:"req,1"`
+type ApiServiceTeamInvitationListArgs struct {
+	Req *TeamInvitationListRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceTeamInfoArgs() *ApiServiceTeamInfoArgs {
-	return &ApiServiceTeamInfoArgs{}
+func NewApiServiceTeamInvitationListArgs() *ApiServiceTeamInvitationListArgs {
+	return &ApiServiceTeamInvitationListArgs{}
 }
 
-var ApiServiceTeamInfoArgs_Req_DEFAULT *TeamInfoRequest
+var ApiServiceTeamInvitationListArgs_Req_DEFAULT *TeamInvitationListRequest
 
-func (p *ApiServiceTeamInfoArgs) GetReq() (v *TeamInfoRequest) {
+func (p *ApiServiceTeamInvitationListArgs) GetReq() (v *TeamInvitationListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTeamInfoArgs_Req_DEFAULT
+		return ApiServiceTeamInvitationListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTeamInfoArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTeamInfoArgs) IsSetReq() bool {
+func (p *ApiServiceTeamInvitationListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTeamInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20080,7 +35442,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20090,17 +35452,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamInfoRequest()
+func (p *ApiServiceTeamInvitationListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20127,7 +35489,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -20144,39 +35506,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamInfoArgs) String() string {
+func (p *ApiServiceTeamInvitationListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationListArgs(%+v)", *p)
 }
 
-type ApiServiceTeamInfoResult struct {
-	Success *TeamInfoResponse `thrift:"success,0,optional"`
+type ApiServiceTeamInvitationListResult struct {
+	Success *TeamInvitationListResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceTeamInfoResult() *ApiServiceTeamInfoResult {
-	return &ApiServiceTeamInfoResult{}
+func NewApiServiceTeamInvitationListResult() *ApiServiceTeamInvitationListResult {
+	return &ApiServiceTeamInvitationListResult{}
 }
 
-var ApiServiceTeamInfoResult_Success_DEFAULT *TeamInfoResponse
+var ApiServiceTeamInvitationListResult_Success_DEFAULT *TeamInvitationListResponse
 
-func (p *ApiServiceTeamInfoResult) GetSuccess() (v *TeamInfoResponse) {
+func (p *ApiServiceTeamInvitationListResult) GetSuccess() (v *TeamInvitationListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTeamInfoResult_Success_DEFAULT
+		return ApiServiceTeamInvitationListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTeamInfoResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTeamInfoResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamInvitationListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTeamInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20225,7 +35587,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20235,17 +35597,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamInfoResponse()
+func (p *ApiServiceTeamInvitationListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20272,7 +35634,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -20291,39 +35653,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamInfoResult) String() string {
+func (p *ApiServiceTeamInvitationListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamInfoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationListResult(%+v)", *p)
 }
 
-type ApiServiceTeamApplicationSubmitArgs struct {
-	Req *TeamApplicationSubmitRequest `thrift:"req,1"`
+type ApiServiceTeamSentInvitationListArgs struct {
+	Req *TeamSentInvitationListRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceTeamApplicationSubmitArgs() *ApiServiceTeamApplicationSubmitArgs {
-	return &ApiServiceTeamApplicationSubmitArgs{}
+func NewApiServiceTeamSentInvitationListArgs() *ApiServiceTeamSentInvitationListArgs {
+	return &ApiServiceTeamSentInvitationListArgs{}
 }
 
-var ApiServiceTeamApplicationSubmitArgs_Req_DEFAULT *TeamApplicationSubmitRequest
+var ApiServiceTeamSentInvitationListArgs_Req_DEFAULT *TeamSentInvitationListRequest
 
-func (p *ApiServiceTeamApplicationSubmitArgs) GetReq() (v *TeamApplicationSubmitRequest) {
+func (p *ApiServiceTeamSentInvitationListArgs) GetReq() (v *TeamSentInvitationListRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTeamApplicationSubmitArgs_Req_DEFAULT
+		return ApiServiceTeamSentInvitationListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTeamApplicationSubmitArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamSentInvitationListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTeamApplicationSubmitArgs) IsSetReq() bool {
+func (p *ApiServiceTeamSentInvitationListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTeamApplicationSubmitArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamSentInvitationListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20372,7 +35734,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamApplicationSubmitArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamSentInvitationListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20382,17 +35744,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamApplicationSubmitArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamApplicationSubmitRequest()
+func (p *ApiServiceTeamSentInvitationListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamSentInvitationListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamApplicationSubmitArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamSentInvitationListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmit_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamSentInvitationList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20419,7 +35781,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamApplicationSubmitArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamSentInvitationListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -20436,39 +35798,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamApplicationSubmitArgs) String() string {
+func (p *ApiServiceTeamSentInvitationListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamApplicationSubmitArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamSentInvitationListArgs(%+v)", *p)
 }
 
-type ApiServiceTeamApplicationSubmitResult struct {
-	Success *TeamApplicationSubmitResponse `thrift:"success,0,optional"`
+type ApiServiceTeamSentInvitationListResult struct {
+	Success *TeamSentInvitationListResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceTeamApplicationSubmitResult() *ApiServiceTeamApplicationSubmitResult {
-	return &ApiServiceTeamApplicationSubmitResult{}
+func NewApiServiceTeamSentInvitationListResult() *ApiServiceTeamSentInvitationListResult {
+	return &ApiServiceTeamSentInvitationListResult{}
 }
 
-var ApiServiceTeamApplicationSubmitResult_Success_DEFAULT *TeamApplicationSubmitResponse
+var ApiServiceTeamSentInvitationListResult_Success_DEFAULT *TeamSentInvitationListResponse
 
-func (p *ApiServiceTeamApplicationSubmitResult) GetSuccess() (v *TeamApplicationSubmitResponse) {
+func (p *ApiServiceTeamSentInvitationListResult) GetSuccess() (v *TeamSentInvitationListResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTeamApplicationSubmitResult_Success_DEFAULT
+		return ApiServiceTeamSentInvitationListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTeamApplicationSubmitResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamSentInvitationListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTeamApplicationSubmitResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamSentInvitationListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTeamApplicationSubmitResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamSentInvitationListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20517,7 +35879,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamApplicationSubmitResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamSentInvitationListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20527,17 +35889,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamApplicationSubmitResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamApplicationSubmitResponse()
+func (p *ApiServiceTeamSentInvitationListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamSentInvitationListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamApplicationSubmitResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamSentInvitationListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmit_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamSentInvitationList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20564,7 +35926,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamApplicationSubmitResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamSentInvitationListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -20583,39 +35945,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamApplicationSubmitResult) String() string {
+func (p *ApiServiceTeamSentInvitationListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamApplicationSubmitResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamSentInvitationListResult(%+v)", *p)
 }
 
-type ApiServiceTeamManageListArgs struct {
-	Req *TeamManageListRequest `thrift:"req,1"`
+type ApiServiceTeamInvitationCancelArgs struct {
+	Req *TeamInvitationCancelRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceTeamManageListArgs() *ApiServiceTeamManageListArgs {
-	return &ApiServiceTeamManageListArgs{}
+func NewApiServiceTeamInvitationCancelArgs() *ApiServiceTeamInvitationCancelArgs {
+	return &ApiServiceTeamInvitationCancelArgs{}
 }
 
-var ApiServiceTeamManageListArgs_Req_DEFAULT *TeamManageListRequest
+var ApiServiceTeamInvitationCancelArgs_Req_DEFAULT *TeamInvitationCancelRequest
 
-func (p *ApiServiceTeamManageListArgs) GetReq() (v *TeamManageListRequest) {
+func (p *ApiServiceTeamInvitationCancelArgs) GetReq() (v *TeamInvitationCancelRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTeamManageListArgs_Req_DEFAULT
+		return ApiServiceTeamInvitationCancelArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTeamManageListArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationCancelArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTeamManageListArgs) IsSetReq() bool {
+func (p *ApiServiceTeamInvitationCancelArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTeamManageListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationCancelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20664,7 +36026,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationCancelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20674,17 +36036,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamManageListRequest()
+func (p *ApiServiceTeamInvitationCancelArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationCancelRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamManageListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationCancelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageList_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationCancel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20711,7 +36073,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationCancelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -20728,39 +36090,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageListArgs) String() string {
+func (p *ApiServiceTeamInvitationCancelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamManageListArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationCancelArgs(%+v)", *p)
 }
 
-type ApiServiceTeamManageListResult struct {
-	Success *TeamManageListResponse `thrift:"success,0,optional"`
+type ApiServiceTeamInvitationCancelResult struct {
+	Success *TeamInvitationCancelResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceTeamManageListResult() *ApiServiceTeamManageListResult {
-	return &ApiServiceTeamManageListResult{}
+func NewApiServiceTeamInvitationCancelResult() *ApiServiceTeamInvitationCancelResult {
+	return &ApiServiceTeamInvitationCancelResult{}
 }
 
-var ApiServiceTeamManageListResult_Success_DEFAULT *TeamManageListResponse
+var ApiServiceTeamInvitationCancelResult_Success_DEFAULT *TeamInvitationCancelResponse
 
-func (p *ApiServiceTeamManageListResult) GetSuccess() (v *TeamManageListResponse) {
+func (p *ApiServiceTeamInvitationCancelResult) GetSuccess() (v *TeamInvitationCancelResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTeamManageListResult_Success_DEFAULT
+		return ApiServiceTeamInvitationCancelResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTeamManageListResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamInvitationCancelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTeamManageListResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamInvitationCancelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTeamManageListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationCancelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20809,7 +36171,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamInvitationCancelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20819,17 +36181,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamManageListResponse()
+func (p *ApiServiceTeamInvitationCancelResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationCancelResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamManageListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationCancelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageList_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationCancel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20856,7 +36218,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamInvitationCancelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -20875,39 +36237,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageListResult) String() string {
+func (p *ApiServiceTeamInvitationCancelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamManageListResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamInvitationCancelResult(%+v)", *p)
 }
 
-type ApiServiceTeamManageActionArgs struct {
-	Req *TeamManageActionRequest `thrift:"req,1"`
+type ApiServiceTeamCandidatesArgs struct {
+	Req *TeamCandidatesRequest `thrift:"req,1" json:"req"`
 }
 
-func NewApiServiceTeamManageActionArgs() *ApiServiceTeamManageActionArgs {
-	return &ApiServiceTeamManageActionArgs{}
+func NewApiServiceTeamCandidatesArgs() *ApiServiceTeamCandidatesArgs {
+	return &ApiServiceTeamCandidatesArgs{}
 }
 
-var ApiServiceTeamManageActionArgs_Req_DEFAULT *TeamManageActionRequest
+var ApiServiceTeamCandidatesArgs_Req_DEFAULT *TeamCandidatesRequest
 
-func (p *ApiServiceTeamManageActionArgs) GetReq() (v *TeamManageActionRequest) {
+func (p *ApiServiceTeamCandidatesArgs) GetReq() (v *TeamCandidatesRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTeamManageActionArgs_Req_DEFAULT
+		return ApiServiceTeamCandidatesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTeamManageActionArgs = map[int16]string{
+var fieldIDToName_ApiServiceTeamCandidatesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTeamManageActionArgs) IsSetReq() bool {
+func (p *ApiServiceTeamCandidatesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTeamManageActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCandidatesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -20956,7 +36318,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageActionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCandidatesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20966,17 +36328,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageActionArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamManageActionRequest()
+func (p *ApiServiceTeamCandidatesArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamCandidatesRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamManageActionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCandidatesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageAction_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCandidates_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -21003,7 +36365,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCandidatesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -21020,39 +36382,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageActionArgs) String() string {
+func (p *ApiServiceTeamCandidatesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamManageActionArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamCandidatesArgs(%+v)", *p)
 }
 
-type ApiServiceTeamManageActionResult struct {
-	Success *TeamManageActionResponse `thrift:"success,0,optional"`
+type ApiServiceTeamCandidatesResult struct {
+	Success *TeamCandidatesResponse `thrift:"success,0,optional" json:"success,omitempty"`
 }
 
-func NewApiServiceTeamManageActionResult() *ApiServiceTeamManageActionResult {
-	return &ApiServiceTeamManageActionResult{}
+func NewApiServiceTeamCandidatesResult() *ApiServiceTeamCandidatesResult {
+	return &ApiServiceTeamCandidatesResult{}
 }
 
-var ApiServiceTeamManageActionResult_Success_DEFAULT *TeamManageActionResponse
+var ApiServiceTeamCandidatesResult_Success_DEFAULT *TeamCandidatesResponse
 
-func (p *ApiServiceTeamManageActionResult) GetSuccess() (v *TeamManageActionResponse) {
+func (p *ApiServiceTeamCandidatesResult) GetSuccess() (v *TeamCandidatesResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTeamManageActionResult_Success_DEFAULT
+		return ApiServiceTeamCandidatesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTeamManageActionResult = map[int16]string{
+var fieldIDToName_ApiServiceTeamCandidatesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTeamManageActionResult) IsSetSuccess() bool {
+func (p *ApiServiceTeamCandidatesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTeamManageActionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCandidatesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -21101,7 +36463,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamManageActionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTeamCandidatesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -21111,17 +36473,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageActionResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamManageActionResponse()
+func (p *ApiServiceTeamCandidatesResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamCandidatesResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ApiServiceTeamManageActionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCandidatesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageAction_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCandidates_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -21148,7 +36510,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceTeamCandidatesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -21167,11 +36529,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTeamManageActionResult) String() string {
+func (p *ApiServiceTeamCandidatesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTeamManageActionResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceTeamCandidatesResult(%+v)", *p)
 }
 
 type ApiServiceContestFavoriteActionArgs struct {
@@ -106,6 +106,30 @@ func InitJwt() {
 				if userId != req.MemberInfo.UserID {
 					return false
 				}
+			} else if path == "/fusion/team/application/withdraw" {
+				var req api.TeamApplicationWithdrawRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/my/applications" {
+				var req api.MyApplicationsRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/my/list" {
+				var req api.MyTeamListRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
 			} else if path == "/fusion/team/manage/list" {
 				var req api.TeamManageListRequest
 				if err = c.BindAndValidate(&req); err != nil {
@@ -114,6 +138,102 @@ func InitJwt() {
 				if req.UserID != userId {
 					return false
 				}
+			} else if path == "/fusion/contest/recommend" {
+				var req api.ContestRecommendRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/manage/candidates" {
+				var req api.TeamCandidatesRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/leave" {
+				var req api.TeamLeaveRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/manage/remove" {
+				var req api.TeamMemberRemoveRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/manage/transfer" {
+				var req api.TeamLeaderTransferRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/manage/close" {
+				var req api.TeamCloseRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/manage/disband" {
+				var req api.TeamDisbandRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/invitation/send" {
+				var req api.TeamInvitationSendRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/invitation/respond" {
+				var req api.TeamInvitationRespondRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/invitation/list" {
+				var req api.TeamInvitationListRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/invitation/sent" {
+				var req api.TeamSentInvitationListRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
+			} else if path == "/fusion/team/invitation/cancel" {
+				var req api.TeamInvitationCancelRequest
+				if err = c.BindAndValidate(&req); err != nil {
+					return false
+				}
+				if req.UserID != userId {
+					return false
+				}
 			} else if path == "/fusion/team/manage/action" {
 				var req api.TeamManageActionRequest
 				if err = c.BindAndValidate(&req); err != nil {
@@ -28,6 +28,7 @@ func Register(r *server.Hertz) {
 			_contest := _fusion.Group("/contest", _contestMw()...)
 			_contest.POST("/create", append(_contestcreateMw(), api.ContestCreate)...)
 			_contest.GET("/list", append(_contestlistMw(), api.ContestList)...)
+			_contest.GET("/recommend", append(_contestrecommendMw(), api.ContestRecommend)...)
 			{
 				_contest_id := _contest.Group("/:contest_id", _contest_idMw()...)
 				{
@@ -55,14 +56,34 @@ func Register(r *server.Hertz) {
 		{
 			_team0 := _fusion.Group("/team", _team0Mw()...)
 			_team0.POST("/create", append(_teamcreateMw(), api.TeamCreate)...)
+			_team0.POST("/leave", append(_teamleaveMw(), api.TeamLeave)...)
 			{
 				_application := _team0.Group("/application", _applicationMw()...)
 				_application.POST("/submit", append(_teamapplicationsubmitMw(), api.TeamApplicationSubmit)...)
+				_application.POST("/withdraw", append(_teamapplicationwithdrawMw(), api.TeamApplicationWithdraw)...)
+			}
+			{
+				_invitation := _team0.Group("/invitation", _invitationMw()...)
+				_invitation.POST("/cancel", append(_teaminvitationcancelMw(), api.TeamInvitationCancel)...)
+				_invitation.GET("/list", append(_teaminvitationlistMw(), api.TeamInvitationList)...)
+				_invitation.POST("/respond", append(_teaminvitationrespondMw(), api.TeamInvitationRespond)...)
+				_invitation.POST("/send", append(_teaminvitationsendMw(), api.TeamInvitationSend)...)
+				_invitation.GET("/sent", append(_teamsentinvitationlistMw(), api.TeamSentInvitationList)...)
 			}
 			{
 				_manage := _team0.Group("/manage", _manageMw()...)
 				_manage.POST("/action", append(_teammanageactionMw(), api.TeamManageAction)...)
+				_manage.GET("/candidates", append(_teamcandidatesMw(), api.TeamCandidates)...)
 				_manage.GET("/list", append(_teammanagelistMw(), api.TeamManageList)...)
+				_manage.POST("/remove", append(_teammemberremoveMw(), api.TeamMemberRemove)...)
+				_manage.POST("/transfer", append(_teamleadertransferMw(), api.TeamLeaderTransfer)...)
+				_manage.POST("/close", append(_teamcloseMw(), api.TeamClose)...)
+				_manage.POST("/disband", append(_teamdisbandMw(), api.TeamDisband)...)
+			}
+			{
+				_my := _team0.Group("/my", _myMw()...)
+				_my.GET("/applications", append(_myapplicationsMw(), api.MyApplications)...)
+				_my.GET("/list", append(_myteamlistMw(), api.MyTeamList)...)
 			}
 		}
 		{
@@ -3,9 +3,13 @@
 package main
 
 import (
+	"context"
+
 	"github.com/Yra-A/Fusion_Go/cmd/api/biz/mw/jwt"
 	"github.com/Yra-A/Fusion_Go/cmd/api/biz/mw/oss"
 	"github.com/Yra-A/Fusion_Go/cmd/api/rpc"
+	"github.com/Yra-A/Fusion_Go/pkg/constants"
+	"github.com/Yra-A/Fusion_Go/pkg/events"
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/hertz-contrib/cors"
@@ -19,6 +23,7 @@ func Init() {
 	rpc.InitRPC()
 	jwt.InitJwt()
 	oss.Init()
+	events.Init(constants.ApiServiceName, nil)
 	logger := hertzlogrus.NewLogger()
 	hlog.SetLogger(logger)
 	hlog.SetLevel(hlog.LevelInfo)
@@ -44,6 +49,10 @@ func main() {
 		AllowCredentials: true,                       // 前端请求携带凭证如Cookies或HTTP认证的时候需要设置为true
 		MaxAge:           12 * time.Hour,
 	}))
+	// 服务退出前写入尚未落盘的事件
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		events.Close()
+	})
 	register(h)
 	h.Spin()
 }
@@ -53,6 +53,15 @@ func ContestList(ctx context.Context, req *contest.ContestListRequest) (*contest
 	return resp, nil
 }
 
+// ContestRecommend 个性化推荐比赛列表【rpc 客户端】
+func ContestRecommend(ctx context.Context, req *contest.ContestRecommendRequest) (*contest.ContestRecommendResponse, error) {
+	resp, err := contestClient.ContestRecommend(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
 // ContestInfo 比赛详情【rpc 客户端】
 func ContestInfo(ctx context.Context, req *contest.ContestInfoRequest) (*contest.ContestInfoResponse, error) {
 	resp, err := contestClient.ContestInfo(ctx, req)
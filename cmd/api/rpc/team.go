@@ -71,6 +71,123 @@ func TeamApplicationSubmit(ctx context.Context, req *team.TeamApplicationSubmitR
 	return resp, nil
 }
 
+// TeamApplicationWithdraw 撤回团队申请【rpc 客户端】
+func TeamApplicationWithdraw(ctx context.Context, req *team.TeamApplicationWithdrawRequest) (*team.TeamApplicationWithdrawResponse, error) {
+	resp, err := teamClient.TeamApplicationWithdraw(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MyApplications 获取用户提交的团队申请【rpc 客户端】
+func MyApplications(ctx context.Context, req *team.MyApplicationsRequest) (*team.MyApplicationsResponse, error) {
+	resp, err := teamClient.MyApplications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MyTeamList 获取用户担任队长或加入的团队【rpc 客户端】
+func MyTeamList(ctx context.Context, req *team.MyTeamListRequest) (*team.MyTeamListResponse, error) {
+	resp, err := teamClient.MyTeamList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamLeave 退出队伍【rpc 客户端】
+func TeamLeave(ctx context.Context, req *team.TeamLeaveRequest) (*team.TeamLeaveResponse, error) {
+	resp, err := teamClient.TeamLeave(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamMemberRemove 将队员移出队伍【rpc 客户端】
+func TeamMemberRemove(ctx context.Context, req *team.TeamMemberRemoveRequest) (*team.TeamMemberRemoveResponse, error) {
+	resp, err := teamClient.TeamMemberRemove(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamLeaderTransfer 转让队长【rpc 客户端】
+func TeamLeaderTransfer(ctx context.Context, req *team.TeamLeaderTransferRequest) (*team.TeamLeaderTransferResponse, error) {
+	resp, err := teamClient.TeamLeaderTransfer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamClose 停止或恢复队伍招募【rpc 客户端】
+func TeamClose(ctx context.Context, req *team.TeamCloseRequest) (*team.TeamCloseResponse, error) {
+	resp, err := teamClient.TeamClose(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamDisband 解散队伍【rpc 客户端】
+func TeamDisband(ctx context.Context, req *team.TeamDisbandRequest) (*team.TeamDisbandResponse, error) {
+	resp, err := teamClient.TeamDisband(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamInvitationSend 邀请用户加入团队【rpc 客户端】
+func TeamInvitationSend(ctx context.Context, req *team.TeamInvitationSendRequest) (*team.TeamInvitationSendResponse, error) {
+	resp, err := teamClient.TeamInvitationSend(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamInvitationRespond 接受或拒绝团队邀请【rpc 客户端】
+func TeamInvitationRespond(ctx context.Context, req *team.TeamInvitationRespondRequest) (*team.TeamInvitationRespondResponse, error) {
+	resp, err := teamClient.TeamInvitationRespond(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamInvitationList 获取用户收到的团队邀请【rpc 客户端】
+func TeamInvitationList(ctx context.Context, req *team.TeamInvitationListRequest) (*team.TeamInvitationListResponse, error) {
+	resp, err := teamClient.TeamInvitationList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamSentInvitationList 获取团队发出的邀请【rpc 客户端】
+func TeamSentInvitationList(ctx context.Context, req *team.TeamSentInvitationListRequest) (*team.TeamSentInvitationListResponse, error) {
+	resp, err := teamClient.TeamSentInvitationList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TeamInvitationCancel 取消团队邀请【rpc 客户端】
+func TeamInvitationCancel(ctx context.Context, req *team.TeamInvitationCancelRequest) (*team.TeamInvitationCancelResponse, error) {
+	resp, err := teamClient.TeamInvitationCancel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // TeamManageList 获取团队收到的申请列表【rpc 客户端】
 func TeamManageList(ctx context.Context, req *team.TeamManageListRequest) (*team.TeamManageListResponse, error) {
 	resp, err := teamClient.TeamManageList(ctx, req)
@@ -88,3 +205,12 @@ func TeamManageAction(ctx context.Context, req *team.TeamManageActionRequest) (*
 	}
 	return resp, nil
 }
+
+// TeamCandidates 为队长推荐候选队员【rpc 客户端】
+func TeamCandidates(ctx context.Context, req *team.TeamCandidatesRequest) (*team.TeamCandidatesResponse, error) {
+	resp, err := teamClient.TeamCandidates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -214,3 +214,15 @@ func FetchContestListByContestIds(contestIds []int32) ([]*ContestBrief, error) {
 
 	return contestBriefInfos, nil
 }
+
+// FetchRecommendCandidates 获取参与个性化推荐的全部赛事，按创建时间降序排列
+func FetchRecommendCandidates() ([]*Contest, error) {
+	var contests []*Contest
+	if err := DB.Model(&Contest{}).
+		Order("created_time desc").
+		Select("contest_id, title, description, participant_requirements, created_time, field, format").
+		Find(&contests).Error; err != nil {
+		return nil, err
+	}
+	return contests, nil
+}
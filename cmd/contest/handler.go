@@ -49,6 +49,23 @@ func (s *ContestServiceImpl) ContestList(ctx context.Context, req *contest.Conte
 	return resp, nil
 }
 
+// ContestRecommend implements the ContestServiceImpl interface.
+func (s *ContestServiceImpl) ContestRecommend(ctx context.Context, req *contest.ContestRecommendRequest) (resp *contest.ContestRecommendResponse, err error) {
+	klog.CtxDebugf(ctx, "ContestRecommend called: %v", req.GetUserId())
+	resp = new(contest.ContestRecommendResponse)
+	c, total, err := service.NewRecommendContestService(ctx).RecommendContest(req.UserId, req.Limit, req.Offset)
+	if err != nil {
+		resp.StatusCode = errno.Fail.ErrCode
+		resp.StatusMsg = errno.Fail.ErrMsg
+		return resp, nil
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.Total = total
+	resp.ContestList = c
+	return resp, nil
+}
+
 // ContestInfo implements the ContestServiceImpl interface.
 func (s *ContestServiceImpl) ContestInfo(ctx context.Context, req *contest.ContestInfoRequest) (resp *contest.ContestInfoResponse, err error) {
 	klog.CtxDebugf(ctx, "ContestInfo called: %v", req.GetContestId())
@@ -65,6 +82,22 @@ func (s *ContestServiceImpl) ContestInfo(ctx context.Context, req *contest.Conte
 	return resp, nil
 }
 
+// ContestBatchInfo implements the ContestServiceImpl interface.
+func (s *ContestServiceImpl) ContestBatchInfo(ctx context.Context, req *contest.ContestBatchInfoRequest) (resp *contest.ContestBatchInfoResponse, err error) {
+	klog.CtxDebugf(ctx, "ContestBatchInfo called: %v", req.GetContestIds())
+	resp = new(contest.ContestBatchInfoResponse)
+	c, err := service.NewContestBatchInfoService(ctx).ContestBatchInfo(req.ContestIds)
+	if err != nil {
+		resp.StatusCode = errno.Fail.ErrCode
+		resp.StatusMsg = errno.Fail.ErrMsg
+		return resp, nil
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.ContestList = c
+	return resp, nil
+}
+
 // GetContestsByFavorites implements the ContestServiceImpl interface.
 func (s *ContestServiceImpl) GetContestsByFavorites(ctx context.Context, req *contest.GetContestsByFavoritesRequest) (resp *contest.GetContestsByFavoritesResponse, err error) {
 	resp = new(contest.GetContestsByFavoritesResponse)
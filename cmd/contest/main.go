@@ -3,8 +3,10 @@ package main
 import (
 	"github.com/Yra-A/Fusion_Go/cmd/contest/dal"
 	"github.com/Yra-A/Fusion_Go/cmd/contest/rpc"
+	"github.com/Yra-A/Fusion_Go/cmd/contest/service"
 	contest "github.com/Yra-A/Fusion_Go/kitex_gen/contest/contestservice"
 	"github.com/Yra-A/Fusion_Go/pkg/constants"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
 	"github.com/Yra-A/Fusion_Go/pkg/middleware"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/limit"
@@ -21,6 +23,8 @@ func Init() {
 	klog.SetLevel(klog.LevelDebug)
 	dal.Init()
 	rpc.InitRPC()
+	embedder.Init()
+	service.StartContestEmbeddingWarmer()
 }
 func main() {
 	r, err := etcd.NewEtcdRegistry([]string{constants.EtcdAddress})
@@ -42,3 +42,12 @@ func QueryFavoriteStatusByUserId(ctx context.Context, req *favorite.QueryFavorit
 	}
 	return resp, nil
 }
+
+// ContestFavoriteList 用户收藏的赛事列表【rpc 客户端】
+func ContestFavoriteList(ctx context.Context, req *favorite.ContestFavoriteListRequest) (*favorite.ContestFavoriteListResponse, error) {
+	resp, err := favoriteClient.ContestFavoriteList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
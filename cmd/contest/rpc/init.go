@@ -2,4 +2,5 @@ package rpc
 
 func InitRPC() {
 	initFavoriteRpc()
+	initUserRpc()
 }
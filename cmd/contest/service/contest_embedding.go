@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"github.com/Yra-A/Fusion_Go/cmd/contest/dal/db"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"sync"
+	"time"
+)
+
+const (
+	// maxContestEmbeddings 缓存 embedding 的赛事数量上限，超出时只保留最新创建的赛事
+	maxContestEmbeddings = 1000
+	// contestEmbeddingWarmInterval 后台定期生成赛事 embedding 的间隔
+	contestEmbeddingWarmInterval = 10 * time.Minute
+)
+
+// contestEmbedding 缓存的赛事 embedding 及生成时使用的文本与模型
+type contestEmbedding struct {
+	text      string
+	model     string
+	embedding []float64
+}
+
+// contestEmbeddingCache 按赛事缓存 embedding，赛事内容或模型变化后重新生成
+// 请求中只读取缓存，embedding 由后台任务生成，缓存只保留参与推荐的赛事
+type contestEmbeddingCache struct {
+	mu    sync.RWMutex
+	max   int
+	items map[int32]*contestEmbedding
+}
+
+func newContestEmbeddingCache(max int) *contestEmbeddingCache {
+	return &contestEmbeddingCache{max: max, items: make(map[int32]*contestEmbedding)}
+}
+
+var contestEmbeddings = newContestEmbeddingCache(maxContestEmbeddings)
+
+// Lookup 获取赛事已缓存的 embedding，缓存不存在或赛事内容、模型已变化时返回 false
+func (c *contestEmbeddingCache) Lookup(e embedder.Embedder, contest *db.Contest) ([]float64, bool) {
+	text := embedder.ContestText(contest.Title, contest.Description, contest.ParticipantRequirements)
+	c.mu.RLock()
+	item, ok := c.items[contest.ContestID]
+	c.mu.RUnlock()
+	if !ok || item.text != text || item.model != e.Model() {
+		return nil, false
+	}
+	return item.embedding, true
+}
+
+// Warm 为 contests 中缓存不存在或已过期的赛事生成 embedding，并移除不在 contests 中的赛事
+// contests 按创建时间降序排列，超出数量上限的赛事不缓存；单个赛事生成失败时跳过，下次再试
+func (c *contestEmbeddingCache) Warm(ctx context.Context, e embedder.Embedder, contests []*db.Contest) {
+	if len(contests) > c.max {
+		contests = contests[:c.max]
+	}
+	keep := make(map[int32]bool, len(contests))
+	for _, contest := range contests {
+		keep[contest.ContestID] = true
+		if _, ok := c.Lookup(e, contest); ok {
+			continue
+		}
+		text := embedder.ContestText(contest.Title, contest.Description, contest.ParticipantRequirements)
+		embedding, err := e.Embed(ctx, text)
+		if err != nil {
+			klog.CtxWarnf(ctx, "生成赛事 %d 的embedding失败: %v", contest.ContestID, err)
+			continue
+		}
+		c.mu.Lock()
+		c.items[contest.ContestID] = &contestEmbedding{text: text, model: e.Model(), embedding: embedding}
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	for id := range c.items {
+		if !keep[id] {
+			delete(c.items, id)
+		}
+	}
+	c.mu.Unlock()
+}
+
+var (
+	warmOnce    sync.Once
+	warmTrigger = make(chan struct{}, 1)
+)
+
+// StartContestEmbeddingWarmer 启动后台任务，定期为参与推荐的赛事生成 embedding，重复调用只会启动一次
+func StartContestEmbeddingWarmer() {
+	warmOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(contestEmbeddingWarmInterval)
+			defer ticker.Stop()
+			for {
+				warmContestEmbeddings()
+				select {
+				case <-ticker.C:
+				case <-warmTrigger:
+				}
+			}
+		}()
+	})
+}
+
+// requestContestEmbeddingWarm 请求中发现缺少赛事 embedding 时通知后台任务尽快生成，不会阻塞请求
+func requestContestEmbeddingWarm() {
+	select {
+	case warmTrigger <- struct{}{}:
+	default:
+	}
+}
+
+func warmContestEmbeddings() {
+	contests, err := db.FetchRecommendCandidates()
+	if err != nil {
+		klog.Errorf("获取参与推荐的赛事失败: %v", err)
+		return
+	}
+	contestEmbeddings.Warm(context.Background(), embedder.Default(), contests)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"github.com/Yra-A/Fusion_Go/cmd/contest/dal/db"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"testing"
+)
+
+// TestContestEmbeddingCache 测试后台生成、内容变化后失效以及数量上限与不再参与推荐的赛事的移除
+func TestContestEmbeddingCache(t *testing.T) {
+	e := embedder.NewFake(8)
+	c := newContestEmbeddingCache(2)
+	contests := []*db.Contest{
+		{ContestID: 3, Title: "c"},
+		{ContestID: 2, Title: "b"},
+		{ContestID: 1, Title: "a"},
+	}
+	if _, ok := c.Lookup(e, contests[0]); ok {
+		t.Fatal("Lookup() before Warm() = true, want false")
+	}
+
+	c.Warm(context.Background(), e, contests)
+	for _, contest := range contests[:2] {
+		if _, ok := c.Lookup(e, contest); !ok {
+			t.Errorf("Lookup(%d) after Warm() = false, want true", contest.ContestID)
+		}
+	}
+	if _, ok := c.Lookup(e, contests[2]); ok {
+		t.Error("contest beyond the size limit should not be cached")
+	}
+
+	// 赛事内容变化后缓存失效，不再参与推荐的赛事被移除
+	changed := &db.Contest{ContestID: 3, Title: "c2"}
+	if _, ok := c.Lookup(e, changed); ok {
+		t.Error("Lookup() after the contest changed = true, want false")
+	}
+	c.Warm(context.Background(), e, []*db.Contest{changed})
+	if _, ok := c.Lookup(e, changed); !ok {
+		t.Error("Lookup() after re-warm = false, want true")
+	}
+	if len(c.items) != 1 {
+		t.Errorf("cache size = %d, want 1", len(c.items))
+	}
+}
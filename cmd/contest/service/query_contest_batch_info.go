@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"github.com/Yra-A/Fusion_Go/cmd/contest/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/contest"
+	"sort"
+)
+
+type ContestBatchInfoService struct {
+	ctx context.Context
+}
+
+func NewContestBatchInfoService(ctx context.Context) *ContestBatchInfoService {
+	return &ContestBatchInfoService{ctx: ctx}
+}
+
+// ContestBatchInfo 批量获取赛事简要信息，按 contestIds 的顺序排列，不存在的赛事不包含在结果中
+func (s *ContestBatchInfoService) ContestBatchInfo(contestIds []int32) ([]*contest.ContestBriefInfo, error) {
+	if len(contestIds) == 0 {
+		return []*contest.ContestBriefInfo{}, nil
+	}
+	dbContest, err := db.FetchContestListByContestIds(contestIds)
+	if err != nil {
+		return nil, err
+	}
+	return convertContestBriefs(contestIds, dbContest), nil
+}
+
+// convertContestBriefs 按照 contestIds 的顺序排序并转换赛事简要信息
+func convertContestBriefs(contestIds []int32, dbContest []*db.ContestBrief) []*contest.ContestBriefInfo {
+	// 创建排序映射
+	sortOrder := make(map[int32]int)
+	for i, id := range contestIds {
+		sortOrder[id] = i
+	}
+
+	// 实现自定义排序, 按照contestIds的顺序排序
+	sort.SliceStable(dbContest, func(i, j int) bool {
+		return sortOrder[dbContest[i].ContestID] < sortOrder[dbContest[j].ContestID]
+	})
+	contestBriefInfos := make([]*contest.ContestBriefInfo, len(dbContest))
+	for i, v := range dbContest {
+		contestBriefInfos[i] = &contest.ContestBriefInfo{
+			ContestBriefInfo: &contest.ContestBrief{
+				ContestId:   v.ContestID,
+				Title:       v.Title,
+				Description: v.Description,
+				CreatedTime: v.CreatedTime.Unix(),
+				Field:       v.Field,
+				Format:      v.Format,
+			},
+		}
+	}
+	return contestBriefInfos
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/contest/dal/db"
+)
+
+// TestConvertContestBriefs 测试按请求的 contest_id 顺序排列，不存在的赛事不包含在结果中
+func TestConvertContestBriefs(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	got := convertContestBriefs([]int32{3, 9, 1}, []*db.ContestBrief{
+		{ContestID: 1, Title: "a", CreatedTime: created},
+		{ContestID: 3, Title: "c", CreatedTime: created},
+	})
+	if len(got) != 2 {
+		t.Fatalf("convertContestBriefs() returned %d contests, want 2", len(got))
+	}
+	if got[0].ContestBriefInfo.ContestId != 3 || got[1].ContestBriefInfo.ContestId != 1 {
+		t.Errorf("convertContestBriefs() order = [%d %d], want [3 1]", got[0].ContestBriefInfo.ContestId, got[1].ContestBriefInfo.ContestId)
+	}
+	if got[0].ContestBriefInfo.Title != "c" || got[0].ContestBriefInfo.CreatedTime != created.Unix() {
+		t.Errorf("convertContestBriefs()[0] = %+v", got[0].ContestBriefInfo)
+	}
+}
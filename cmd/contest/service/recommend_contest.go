@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"github.com/Yra-A/Fusion_Go/cmd/contest/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/contest/rpc"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/contest"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/favorite"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"sort"
+)
+
+const (
+	// defaultRecommendLimit 未指定 limit 时返回的推荐赛事数量
+	defaultRecommendLimit = 10
+	// favoritePreferenceLimit 统计用户偏好时最多使用的收藏赛事数量
+	favoritePreferenceLimit = 100
+)
+
+type RecommendContestService struct {
+	ctx context.Context
+}
+
+func NewRecommendContestService(ctx context.Context) *RecommendContestService {
+	return &RecommendContestService{ctx: ctx}
+}
+
+// contestPreference 根据用户收藏的赛事统计的领域与形式偏好，取值为收藏中所占比例
+type contestPreference struct {
+	fields    map[string]float64
+	formats   map[string]float64
+	favorites map[int32]bool
+}
+
+// newContestPreference 统计收藏赛事中各领域与形式所占的比例
+func newContestPreference(favorites []*favorite.ContestBriefInfo) *contestPreference {
+	p := &contestPreference{
+		fields:    make(map[string]float64),
+		formats:   make(map[string]float64),
+		favorites: make(map[int32]bool),
+	}
+	for _, f := range favorites {
+		if f == nil || f.ContestBriefInfo == nil {
+			continue
+		}
+		p.favorites[f.ContestBriefInfo.ContestId] = true
+		p.fields[f.ContestBriefInfo.Field]++
+		p.formats[f.ContestBriefInfo.Format]++
+	}
+	for k := range p.fields {
+		p.fields[k] /= float64(len(p.favorites))
+	}
+	for k := range p.formats {
+		p.formats[k] /= float64(len(p.favorites))
+	}
+	return p
+}
+
+// contestWeights 赛事推荐分数中各项信号的权重
+type contestWeights struct {
+	semantic float64
+	field    float64
+	format   float64
+}
+
+// contestScore 按权重混合语义相似度与领域、形式偏好，缺少的信号不参与计算
+func contestScore(similarity float64, hasEmbedding bool, p *contestPreference, c *db.Contest, w contestWeights) float64 {
+	var score, total float64
+	if hasEmbedding {
+		score += similarity * w.semantic
+		total += w.semantic
+	}
+	if len(p.favorites) > 0 {
+		score += p.fields[c.Field]*w.field + p.formats[c.Format]*w.format
+		total += w.field + w.format
+	}
+	if total == 0 {
+		return 0
+	}
+	return score / total
+}
+
+// RecommendContest 根据用户档案与收藏的赛事推荐赛事，已收藏的赛事不再推荐
+// 用户没有档案也没有收藏时按创建时间降序返回
+func (s *RecommendContestService) RecommendContest(user_id int32, limit int32, offset int32) ([]*contest.ContestBriefInfo, int32, error) {
+	contests, err := db.FetchRecommendCandidates()
+	if err != nil {
+		return nil, 0, err
+	}
+	e := embedder.Default()
+	userEmbedding := s.userEmbedding(user_id, e)
+	pref := newContestPreference(s.favoriteContests(user_id))
+	w := contestWeights{
+		semantic: recommend.ContestSemanticWeight(),
+		field:    recommend.ContestFieldWeight(),
+		format:   recommend.ContestFormatWeight(),
+	}
+
+	type scoredContest struct {
+		contest *db.Contest
+		score   float64
+	}
+	scored := make([]*scoredContest, 0, len(contests))
+	missing := 0
+	for _, c := range contests {
+		if pref.favorites[c.ContestID] {
+			continue
+		}
+		var similarity float64
+		hasEmbedding := false
+		if userEmbedding != nil {
+			// 赛事 embedding 由后台任务生成，尚未生成时不计算语义相似度
+			if emb, ok := contestEmbeddings.Lookup(e, c); ok {
+				similarity = embedder.Cosine(userEmbedding, emb)
+				hasEmbedding = true
+			} else {
+				missing++
+			}
+		}
+		scored = append(scored, &scoredContest{contest: c, score: contestScore(similarity, hasEmbedding, pref, c, w)})
+	}
+	if missing > 0 {
+		klog.CtxInfof(s.ctx, "%d 个赛事尚未生成embedding, 通知后台任务生成", missing)
+		requestContestEmbeddingWarm()
+	}
+	// 赛事已按创建时间降序排列，分数相同时保持该顺序
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	total := int32(len(scored))
+	if limit <= 0 {
+		limit = defaultRecommendLimit
+	}
+	if offset < 0 || offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	contestBriefInfos := make([]*contest.ContestBriefInfo, 0, end-offset)
+	for _, v := range scored[offset:end] {
+		contestBriefInfos = append(contestBriefInfos, &contest.ContestBriefInfo{
+			ContestBriefInfo: &contest.ContestBrief{
+				ContestId:   v.contest.ContestID,
+				Title:       v.contest.Title,
+				Description: v.contest.Description,
+				CreatedTime: v.contest.CreatedTime.Unix(),
+				Field:       v.contest.Field,
+				Format:      v.contest.Format,
+			},
+		})
+	}
+	return contestBriefInfos, total, nil
+}
+
+// userEmbedding 获取用户档案的 embedding，优先使用用户服务中持久化的结果，用户没有档案或获取失败时返回 nil
+func (s *RecommendContestService) userEmbedding(user_id int32, e embedder.Embedder) []float64 {
+	kresp, err := rpc.UserEmbedding(s.ctx, &user.UserEmbeddingRequest{UserId: user_id})
+	if err == nil && kresp.StatusCode == errno.SuccessCode && len(kresp.Embedding) > 0 && kresp.Model == e.Model() {
+		return kresp.Embedding
+	}
+
+	presp, err := rpc.UserProfileInfo(s.ctx, &user.UserProfileInfoRequest{UserId: user_id})
+	if err != nil || presp.UserProfileInfo == nil || presp.UserProfileInfo.UserInfo == nil {
+		klog.CtxWarnf(s.ctx, "获取用户 %d 的档案失败, 不计算语义相似度: %v", user_id, err)
+		return nil
+	}
+	if !presp.UserProfileInfo.UserInfo.HasProfile {
+		return nil
+	}
+	emb, err := e.Embed(s.ctx, embedder.UserProfileText(presp.UserProfileInfo))
+	if err != nil {
+		klog.CtxWarnf(s.ctx, "生成用户 %d 的embedding失败, 不计算语义相似度: %v", user_id, err)
+		return nil
+	}
+	return emb
+}
+
+// favoriteContests 获取用户收藏的赛事，获取失败时返回 nil
+func (s *RecommendContestService) favoriteContests(user_id int32) []*favorite.ContestBriefInfo {
+	kresp, err := rpc.ContestFavoriteList(s.ctx, &favorite.ContestFavoriteListRequest{
+		UserId: user_id,
+		Limit:  favoritePreferenceLimit,
+	})
+	if err != nil || kresp.StatusCode != errno.SuccessCode {
+		klog.CtxWarnf(s.ctx, "获取用户 %d 收藏的赛事失败, 不计算偏好: %v", user_id, err)
+		return nil
+	}
+	return kresp.ContestList
+}
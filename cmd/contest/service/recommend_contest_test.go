@@ -0,0 +1,40 @@
+package service
+
+import (
+	"github.com/Yra-A/Fusion_Go/cmd/contest/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/favorite"
+	"math"
+	"testing"
+)
+
+// TestContestScore 测试收藏偏好的统计以及缺少信号时的权重归一化
+func TestContestScore(t *testing.T) {
+	pref := newContestPreference([]*favorite.ContestBriefInfo{
+		{ContestBriefInfo: &favorite.ContestBrief{ContestId: 1, Field: "人工智能", Format: "线上"}},
+		{ContestBriefInfo: &favorite.ContestBrief{ContestId: 2, Field: "人工智能", Format: "线下"}},
+		{ContestBriefInfo: &favorite.ContestBrief{ContestId: 3, Field: "数学建模", Format: "线上"}},
+		{ContestBriefInfo: &favorite.ContestBrief{ContestId: 4, Field: "人工智能", Format: "线上"}},
+	})
+	if !pref.favorites[3] || math.Abs(pref.fields["人工智能"]-0.75) > 1e-9 || math.Abs(pref.formats["线下"]-0.25) > 1e-9 {
+		t.Fatalf("newContestPreference() = %+v", pref)
+	}
+
+	w := contestWeights{semantic: 0.6, field: 0.25, format: 0.15}
+	c := &db.Contest{Field: "人工智能", Format: "线上"}
+	want := (0.5*0.6 + 0.75*0.25 + 0.75*0.15) / 1.0
+	if got := contestScore(0.5, true, pref, c, w); math.Abs(got-want) > 1e-9 {
+		t.Errorf("contestScore() = %v, want %v", got, want)
+	}
+	// 没有用户 embedding 时只使用收藏偏好
+	want = (0.75*0.25 + 0.75*0.15) / 0.4
+	if got := contestScore(0, false, pref, c, w); math.Abs(got-want) > 1e-9 {
+		t.Errorf("contestScore() without embedding = %v, want %v", got, want)
+	}
+	// 没有收藏时只使用语义相似度
+	if got := contestScore(0.5, true, newContestPreference(nil), c, w); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("contestScore() without favorites = %v, want 0.5", got)
+	}
+	if got := contestScore(0, false, newContestPreference(nil), c, w); got != 0 {
+		t.Errorf("contestScore() without signals = %v, want 0", got)
+	}
+}
@@ -102,3 +102,12 @@ func QueryFavoriteStatusByUserId(user_id int32, contest_id int32) (bool, error)
 	}
 	return true, nil
 }
+
+// QueryContestFavoriteUsers 获取收藏了该赛事的用户
+func QueryContestFavoriteUsers(contest_id int32) ([]int32, error) {
+	var userIds []int32
+	if err := DB.Model(&UserFavorite{}).Where("contest_id = ?", contest_id).Order("created_time DESC").Pluck("user_id", &userIds).Error; err != nil {
+		return nil, err
+	}
+	return userIds, nil
+}
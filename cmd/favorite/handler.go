@@ -54,3 +54,19 @@ func (s *FavoriteServiceImpl) QueryFavoriteStatusByUserId(ctx context.Context, r
 	resp.IsFavorite = isFavorite
 	return resp, nil
 }
+
+// QueryContestFavoriteUsers implements the FavoriteServiceImpl interface.
+func (s *FavoriteServiceImpl) QueryContestFavoriteUsers(ctx context.Context, req *favorite.QueryContestFavoriteUsersRequest) (resp *favorite.QueryContestFavoriteUsersResponse, err error) {
+	klog.CtxDebugf(ctx, "QueryContestFavoriteUsers called: %v", req.GetContestId())
+	resp = new(favorite.QueryContestFavoriteUsersResponse)
+	userIds, err := service.NewQueryContestFavoriteUsersService(ctx).QueryContestFavoriteUsers(req.ContestId)
+	if err != nil {
+		resp.StatusCode = errno.Fail.ErrCode
+		resp.StatusMsg = errno.Fail.ErrMsg
+		return resp, nil
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.UserIds = userIds
+	return resp, nil
+}
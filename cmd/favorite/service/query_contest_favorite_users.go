@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"github.com/Yra-A/Fusion_Go/cmd/favorite/dal/db"
+)
+
+type QueryContestFavoriteUsersService struct {
+	ctx context.Context
+}
+
+func NewQueryContestFavoriteUsersService(ctx context.Context) *QueryContestFavoriteUsersService {
+	return &QueryContestFavoriteUsersService{ctx: ctx}
+}
+
+func (s *QueryContestFavoriteUsersService) QueryContestFavoriteUsers(contestId int32) ([]int32, error) {
+	return db.QueryContestFavoriteUsers(contestId)
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 入队申请状态
+const (
+	ApplicationStatusPending   = "pending"
+	ApplicationStatusAccepted  = "accepted"
+	ApplicationStatusRejected  = "rejected"
+	ApplicationStatusWithdrawn = "withdrawn"
+	ApplicationStatusExpired   = "expired"
+)
+
+// applicationTransitions 申请状态允许的转换，只有待处理的申请可以被接受、拒绝、撤回或过期，其余状态均为终态
+var applicationTransitions = map[string][]string{
+	ApplicationStatusPending: {ApplicationStatusAccepted, ApplicationStatusRejected, ApplicationStatusWithdrawn, ApplicationStatusExpired},
+}
+
+// CanTransitApplication 判断申请能否从状态 from 转换为状态 to
+func CanTransitApplication(from string, to string) bool {
+	for _, s := range applicationTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceStatuses 返回可以转换为状态 to 的所有状态
+func sourceStatuses(to string) []string {
+	var from []string
+	for s := range applicationTransitions {
+		if CanTransitApplication(s, to) {
+			from = append(from, s)
+		}
+	}
+	return from
+}
+
+// transitApplication 将申请转换为状态 to，并记录处理人与处理时间
+// 申请当前状态不允许转换，或是 since 之前提交的待处理申请（已过期但尚未被清理）时返回 errno.ApplicationNotPendingErr
+func transitApplication(tx *gorm.DB, application *TeamApplication, to string, decided_by int32, since time.Time) error {
+	if !CanTransitApplication(application.Status, to) {
+		return errno.ApplicationNotPendingErr
+	}
+	q := tx.Model(&TeamApplication{}).Where("application_id = ? AND status IN ?", application.ApplicationID, sourceStatuses(to))
+	if !since.IsZero() {
+		q = q.Where("created_time >= ?", since)
+	}
+	now := time.Now()
+	res := q.Updates(map[string]interface{}{"status": to, "decided_by": decided_by, "decided_time": now})
+	if res.Error != nil {
+		return res.Error
+	}
+	// 状态已被并发的请求修改
+	if res.RowsAffected == 0 {
+		return errno.ApplicationNotPendingErr
+	}
+	application.Status = to
+	application.DecidedBy = decided_by
+	application.DecidedTime = &now
+	return nil
+}
+
+// hasPendingApplication 判断用户是否已向队伍提交了 since 之后仍待处理的申请
+func hasPendingApplication(tx *gorm.DB, user_id int32, team_id int32, since time.Time) (bool, error) {
+	q := tx.Model(&TeamApplication{}).Where("user_id = ? AND team_id = ? AND status = ?", user_id, team_id, ApplicationStatusPending)
+	if !since.IsZero() {
+		q = q.Where("created_time >= ?", since)
+	}
+	var count int64
+	if err := q.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// lockTeam 在事务中锁定队伍记录，串行化同一队伍的申请提交与成员变更，返回队伍的队长与招募状态
+func lockTeam(tx *gorm.DB, team_id int32) (*TeamInfo, error) {
+	var teamInfo TeamInfo
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("team_id", "contest_id", "leader_id", "closed").
+		Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
+		return nil, err
+	}
+	return &teamInfo, nil
+}
+
+// WithdrawTeamApplication 申请人撤回自己提交的待处理申请，返回被撤回的申请
+func WithdrawTeamApplication(user_id int32, application_id int32, since time.Time) (*TeamApplication, error) {
+	var application TeamApplication
+	if err := DB.Where("application_id = ?", application_id).First(&application).Error; err != nil {
+		return nil, err
+	}
+	// 只有申请人才能撤回申请
+	if application.UserID != user_id {
+		return nil, errno.AuthorizationFailedErr
+	}
+	if err := transitApplication(DB, &application, ApplicationStatusWithdrawn, user_id, since); err != nil {
+		return nil, err
+	}
+	return &application, nil
+}
+
+// ExpireTeamApplications 将 before 之前提交且仍待处理的申请标记为过期，返回过期的申请数
+func ExpireTeamApplications(before time.Time) (int64, error) {
+	res := DB.Model(&TeamApplication{}).
+		Where("status = ? AND created_time < ?", ApplicationStatusPending, before).
+		Updates(map[string]interface{}{"status": ApplicationStatusExpired, "decided_by": 0, "decided_time": time.Now()})
+	return res.RowsAffected, res.Error
+}
+
+// migrateApplicationStatus 为引入申请状态之前的申请补充状态
+// 旧数据中 application_type 为 0 表示已处理，申请人已成为队员的视为已接受，否则视为已拒绝
+func migrateApplicationStatus() error {
+	legacy := "status IS NULL OR status = ''"
+	if err := DB.Model(&TeamApplication{}).Where(legacy).Where("application_type != 0").
+		Update("status", ApplicationStatusPending).Error; err != nil {
+		return err
+	}
+	member := DB.Model(&TeamUserRelationship{}).Select("1").
+		Where("team_user_relationship.team_id = team_application.team_id AND team_user_relationship.user_id = team_application.user_id")
+	if err := DB.Model(&TeamApplication{}).Where(legacy).Where("EXISTS (?)", member).
+		Update("status", ApplicationStatusAccepted).Error; err != nil {
+		return err
+	}
+	return DB.Model(&TeamApplication{}).Where(legacy).Update("status", ApplicationStatusRejected).Error
+}
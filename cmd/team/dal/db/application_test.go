@@ -0,0 +1,109 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+// TestCanTransitApplication 测试只有待处理的申请可以转换状态，其余状态均为终态
+func TestCanTransitApplication(t *testing.T) {
+	terminal := []string{ApplicationStatusAccepted, ApplicationStatusRejected, ApplicationStatusWithdrawn, ApplicationStatusExpired}
+	for _, to := range terminal {
+		if !CanTransitApplication(ApplicationStatusPending, to) {
+			t.Errorf("pending -> %s 应被允许", to)
+		}
+		if got := sourceStatuses(to); len(got) != 1 || got[0] != ApplicationStatusPending {
+			t.Errorf("sourceStatuses(%s) = %v", to, got)
+		}
+	}
+	for _, from := range append(terminal, "") {
+		for _, to := range append(terminal, ApplicationStatusPending) {
+			if CanTransitApplication(from, to) {
+				t.Errorf("%q -> %s 不应被允许", from, to)
+			}
+		}
+	}
+}
+
+// TestCreateTeamApplicationDuplicate 测试同一用户对同一队伍只能有一个待处理的申请，申请撤回或过期后可以重新申请
+func TestCreateTeamApplicationDuplicate(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	since := time.Now().Add(-time.Hour)
+	old := time.Now().Add(-2 * time.Hour).Unix()
+
+	// since 之前提交的待处理申请已过期，不算作重复
+	expired, err := CreateTeamApplication(10, team_id, "", old, 1, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	application_id, err := CreateTeamApplication(10, team_id, "", 0, 1, since)
+	if err != nil {
+		t.Fatalf("CreateTeamApplication() after an expired one = %v", err)
+	}
+	if _, err := CreateTeamApplication(10, team_id, "", 0, 1, since); err != errno.ApplicationDuplicateErr {
+		t.Fatalf("CreateTeamApplication() again = %v, want ApplicationDuplicateErr", err)
+	}
+	// 其他用户与其他队伍不受影响
+	if _, err := CreateTeamApplication(11, team_id, "", 0, 1, since); err != nil {
+		t.Fatalf("CreateTeamApplication() by another user = %v", err)
+	}
+	if _, err := CreateTeamApplication(10, createTestTeam(t, 2, 1), "", 0, 1, since); err != nil {
+		t.Fatalf("CreateTeamApplication() to another team = %v", err)
+	}
+
+	if _, err := WithdrawTeamApplication(10, application_id, since); err != nil {
+		t.Fatalf("WithdrawTeamApplication() = %v", err)
+	}
+	if _, err := CreateTeamApplication(10, team_id, "", 0, 1, since); err != nil {
+		t.Fatalf("CreateTeamApplication() after withdrawing = %v", err)
+	}
+	if got := applicationStatusOf(t, expired); got != ApplicationStatusPending {
+		t.Errorf("expired application status = %s, want %s before the expiry job runs", got, ApplicationStatusPending)
+	}
+}
+
+// TestApplicationSinceExpiry 测试 since 之前提交的待处理申请不能被处理或撤回，不出现在待处理列表中，并由定时任务标记过期
+func TestApplicationSinceExpiry(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	since := time.Now().Add(-time.Hour)
+	expired, err := CreateTeamApplication(10, team_id, "", time.Now().Add(-2*time.Hour).Unix(), 1, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending, err := CreateTeamApplication(11, team_id, "", 0, 1, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := TeamManageAction(1, expired, 1, since, 0); err != errno.ApplicationNotPendingErr {
+		t.Errorf("TeamManageAction() on an expired application = %v, want ApplicationNotPendingErr", err)
+	}
+	if _, err := WithdrawTeamApplication(10, expired, since); err != errno.ApplicationNotPendingErr {
+		t.Errorf("WithdrawTeamApplication() on an expired application = %v, want ApplicationNotPendingErr", err)
+	}
+	list, err := GetTeamApplicationList(1, team_id, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ApplicationId != pending {
+		t.Errorf("GetTeamApplicationList() = %v, want only application %d", list, pending)
+	}
+	for status, want := range map[string]int64{ApplicationStatusPending: 0, ApplicationStatusExpired: 1} {
+		if _, total, err := QueryUserApplications(10, status, since, 10, 0); err != nil || total != want {
+			t.Errorf("QueryUserApplications(%q) total = %d, %v, want %d", status, total, err, want)
+		}
+	}
+
+	if n, err := ExpireTeamApplications(since); err != nil || n != 1 {
+		t.Fatalf("ExpireTeamApplications() = %d, %v, want 1", n, err)
+	}
+	for id, want := range map[int32]string{expired: ApplicationStatusExpired, pending: ApplicationStatusPending} {
+		if got := applicationStatusOf(t, id); got != want {
+			t.Errorf("application %d status = %s, want %s", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/constants"
+	"gorm.io/gorm"
+)
+
+// embedding 任务状态
+const (
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+)
+
+// EmbeddingJob 队伍 embedding 的异步生成任务
+type EmbeddingJob struct {
+	JobID       int64     `gorm:"primary_key;column:job_id;autoIncrement"`
+	TeamID      int32     `gorm:"column:team_id;index"`
+	Status      string    `gorm:"column:status;index"`
+	Attempts    int32     `gorm:"column:attempts"`
+	MaxAttempts int32     `gorm:"column:max_attempts"`
+	NextRunTime time.Time `gorm:"column:next_run_time;index"`
+	LastError   string    `gorm:"column:last_error;type:text"`
+	CreatedTime time.Time `gorm:"column:created_time"`
+	UpdatedTime time.Time `gorm:"column:updated_time"`
+}
+
+func (EmbeddingJob) TableName() string {
+	return "team_embedding_job"
+}
+
+// EnqueueEmbeddingJob 为队伍创建 embedding 任务
+// 队伍已有未开始的任务时只把它提前到立即执行，避免重复生成
+func EnqueueEmbeddingJob(team_id int32) error {
+	now := time.Now()
+	res := DB.Model(&EmbeddingJob{}).
+		Where("team_id = ? AND status = ?", team_id, JobStatusPending).
+		Updates(map[string]interface{}{"next_run_time": now, "updated_time": now})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected > 0 {
+		return nil
+	}
+	return DB.Create(&EmbeddingJob{
+		TeamID:      team_id,
+		Status:      JobStatusPending,
+		MaxAttempts: constants.EmbeddingJobMaxAttempts,
+		NextRunTime: now,
+		CreatedTime: now,
+		UpdatedTime: now,
+	}).Error
+}
+
+// FlagTeamForReindex 标记队伍需要重新生成 embedding
+// 已有未完成的任务，或最近一次任务在 cooldown 内结束（成功或失败）时不再创建新任务，
+// 避免 embedding 刚生成完仍被判定为不可用时，推荐请求反复为同一队伍入队
+func FlagTeamForReindex(team_id int32, cooldown time.Duration) error {
+	job, err := QueryLatestEmbeddingJob(team_id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if job != nil {
+		switch job.Status {
+		case JobStatusPending, JobStatusRunning:
+			return nil
+		case JobStatusSucceeded, JobStatusFailed:
+			if time.Since(job.UpdatedTime) < cooldown {
+				return nil
+			}
+		}
+	}
+	return EnqueueEmbeddingJob(team_id)
+}
+
+// QueryDueEmbeddingJobs 获取已到执行时间的待处理任务
+func QueryDueEmbeddingJobs(limit int) ([]*EmbeddingJob, error) {
+	var jobs []*EmbeddingJob
+	if err := DB.Where("status = ? AND next_run_time <= ?", JobStatusPending, time.Now()).
+		Order("next_run_time").Limit(limit).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ClaimEmbeddingJob 将任务标记为执行中并增加尝试次数，返回是否抢占成功
+// 多个实例同时轮询时只有一个能够抢到同一个任务
+func ClaimEmbeddingJob(job *EmbeddingJob) (bool, error) {
+	now := time.Now()
+	res := DB.Model(&EmbeddingJob{}).
+		Where("job_id = ? AND status = ?", job.JobID, JobStatusPending).
+		Updates(map[string]interface{}{
+			"status":       JobStatusRunning,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"updated_time": now,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	job.Status = JobStatusRunning
+	job.Attempts++
+	job.UpdatedTime = now
+	return true, nil
+}
+
+// FinishEmbeddingJob 标记任务执行成功
+func FinishEmbeddingJob(job_id int64) error {
+	return DB.Model(&EmbeddingJob{}).Where("job_id = ?", job_id).Updates(map[string]interface{}{
+		"status":       JobStatusSucceeded,
+		"last_error":   "",
+		"updated_time": time.Now(),
+	}).Error
+}
+
+// RetryEmbeddingJob 记录失败原因，在 next_run_time 重新执行
+func RetryEmbeddingJob(job_id int64, reason string, next_run_time time.Time) error {
+	return DB.Model(&EmbeddingJob{}).Where("job_id = ?", job_id).Updates(map[string]interface{}{
+		"status":        JobStatusPending,
+		"last_error":    reason,
+		"next_run_time": next_run_time,
+		"updated_time":  time.Now(),
+	}).Error
+}
+
+// FailEmbeddingJob 记录失败原因，不再重试
+func FailEmbeddingJob(job_id int64, reason string) error {
+	return DB.Model(&EmbeddingJob{}).Where("job_id = ?", job_id).Updates(map[string]interface{}{
+		"status":       JobStatusFailed,
+		"last_error":   reason,
+		"updated_time": time.Now(),
+	}).Error
+}
+
+// ResetStaleEmbeddingJobs 将长时间处于执行中的任务重新放回队列，用于实例崩溃后的恢复
+func ResetStaleEmbeddingJobs(timeout time.Duration) (int64, error) {
+	now := time.Now()
+	res := DB.Model(&EmbeddingJob{}).
+		Where("status = ? AND updated_time < ?", JobStatusRunning, now.Add(-timeout)).
+		Updates(map[string]interface{}{
+			"status":        JobStatusPending,
+			"last_error":    "任务执行超时",
+			"next_run_time": now,
+			"updated_time":  now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+// QueryLatestEmbeddingJob 获取队伍最近一次的 embedding 任务
+func QueryLatestEmbeddingJob(team_id int32) (*EmbeddingJob, error) {
+	job := &EmbeddingJob{}
+	if err := DB.Where("team_id = ?", team_id).Order("job_id desc").First(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// QueryTeamEmbeddingUpdatedTime 获取队伍 embedding 的最近更新时间
+func QueryTeamEmbeddingUpdatedTime(team_id int32) (time.Time, error) {
+	var teamInfo TeamInfo
+	if err := DB.Select("embedding_updated_time").Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
+		return time.Time{}, err
+	}
+	return teamInfo.EmbeddingUpdatedTime, nil
+}
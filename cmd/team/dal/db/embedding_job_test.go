@@ -0,0 +1,50 @@
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+// embeddingJobCount 返回队伍的 embedding 任务数
+func embeddingJobCount(t *testing.T, team_id int32) int64 {
+	t.Helper()
+	var count int64
+	if err := DB.Model(&EmbeddingJob{}).Where("team_id = ?", team_id).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+// TestFlagTeamForReindexCooldown 测试最近一次任务成功或失败后，cooldown 内不再重新入队
+func TestFlagTeamForReindexCooldown(t *testing.T) {
+	setupTestDB(t)
+	for _, status := range []string{JobStatusSucceeded, JobStatusFailed} {
+		team_id := createTestTeam(t, 1, 1)
+		if err := DB.Where("team_id = ?", team_id).Delete(&EmbeddingJob{}).Error; err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		if err := DB.Create(&EmbeddingJob{TeamID: team_id, Status: status, NextRunTime: now, CreatedTime: now, UpdatedTime: now}).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := FlagTeamForReindex(team_id, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		if got := embeddingJobCount(t, team_id); got != 1 {
+			t.Errorf("jobs after flagging a %s team within cooldown = %d, want 1", status, got)
+		}
+		if err := FlagTeamForReindex(team_id, 0); err != nil {
+			t.Fatal(err)
+		}
+		if got := embeddingJobCount(t, team_id); got != 2 {
+			t.Errorf("jobs after flagging a %s team past cooldown = %d, want 2", status, got)
+		}
+		job, err := QueryLatestEmbeddingJob(team_id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status != JobStatusPending {
+			t.Errorf("latest job status = %s, want %s", job.Status, JobStatusPending)
+		}
+	}
+}
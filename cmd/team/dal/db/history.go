@@ -0,0 +1,63 @@
+package db
+
+// TeamMember 队长以外的队伍成员
+type TeamMember struct {
+	UserID    int32
+	TeamID    int32
+	ContestID int32
+}
+
+// QueryTeamMembers 获取赛事下所有队伍中队长以外的成员，contest_id 为 0 时获取所有赛事的队伍
+func QueryTeamMembers(contest_id int32) ([]*TeamMember, error) {
+	tx := DB.Table("team_user_relationship AS r").
+		Select("r.user_id, r.team_id, t.contest_id").
+		Joins("JOIN team_info AS t ON t.team_id = r.team_id").
+		Where("r.user_id != t.leader_id")
+	if contest_id != 0 {
+		tx = tx.Where("t.contest_id = ?", contest_id)
+	}
+	var members []*TeamMember
+	if err := tx.Scan(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// QueryHandledApplications 获取赛事下所有被队长接受或拒绝的申请，contest_id 为 0 时获取所有赛事的申请
+func QueryHandledApplications(contest_id int32) ([]*TeamApplication, error) {
+	tx := DB.Where("status IN ?", []string{ApplicationStatusAccepted, ApplicationStatusRejected})
+	if contest_id != 0 {
+		tx = tx.Where("team_id IN (?)", DB.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id))
+	}
+	var applications []*TeamApplication
+	if err := tx.Find(&applications).Error; err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// QueryTeamsForEvaluation 获取赛事下所有队伍生成岗位 embedding 所需的信息，contest_id 为 0 时获取所有赛事的队伍
+func QueryTeamsForEvaluation(contest_id int32) ([]*TeamInfo, error) {
+	tx := DB.Select("team_id", "contest_id", "leader_id", "created_time", "goal", "description")
+	if contest_id != 0 {
+		tx = tx.Where("contest_id = ?", contest_id)
+	}
+	var teams []*TeamInfo
+	if err := tx.Order("team_id").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// QueryTeamsByIDs 获取队伍的人数、队长与创建时间，用于重排特征
+func QueryTeamsByIDs(team_ids []int32) ([]*TeamInfo, error) {
+	if len(team_ids) == 0 {
+		return nil, nil
+	}
+	var teams []*TeamInfo
+	if err := DB.Select("team_id", "contest_id", "cur_people_num", "leader_id", "created_time").
+		Where("team_id IN ?", team_ids).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
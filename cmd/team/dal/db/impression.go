@@ -0,0 +1,78 @@
+package db
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/events"
+	"gorm.io/gorm"
+)
+
+// RecommendImpression 一次队伍列表请求的曝光，由 feed_event 表中 request_id 相同的曝光事件组成
+type RecommendImpression struct {
+	UserID      int32
+	ContestID   int32
+	Experiment  string
+	Variant     string
+	TeamIDs     []int32 // 按曝光位置排列
+	CreatedTime time.Time
+}
+
+// groupImpressions 将曝光事件按 request_id 合并为每次请求的曝光，事件需按时间与位置排列
+func groupImpressions(rows []*events.Event) []*RecommendImpression {
+	var impressions []*RecommendImpression
+	byRequest := make(map[string]*RecommendImpression)
+	for _, e := range rows {
+		imp, ok := byRequest[e.RequestID]
+		if !ok {
+			imp = &RecommendImpression{
+				UserID:      e.UserID,
+				ContestID:   e.ContestID,
+				CreatedTime: e.CreatedTime,
+			}
+			if i := strings.Index(e.Experiment, "/"); i >= 0 {
+				imp.Experiment, imp.Variant = e.Experiment[:i], e.Experiment[i+1:]
+			}
+			byRequest[e.RequestID] = imp
+			impressions = append(impressions, imp)
+		}
+		imp.TeamIDs = append(imp.TeamIDs, e.TeamID)
+	}
+	return impressions
+}
+
+// queryImpressions 获取满足条件的曝光事件并按请求合并
+func queryImpressions(q *gorm.DB) ([]*RecommendImpression, error) {
+	var rows []*events.Event
+	if err := q.Where("type = ?", events.TypeImpression).
+		Select("request_id, user_id, contest_id, team_id, experiment, created_time").
+		Order("created_time, event_id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return groupImpressions(rows), nil
+}
+
+// QueryRecommendImpressions 获取实验在 since 之后的曝光记录
+func QueryRecommendImpressions(experiment string, since time.Time) ([]*RecommendImpression, error) {
+	return queryImpressions(DB.Model(&events.Event{}).
+		Where("experiment LIKE ? AND created_time >= ?", experiment+"/%", since))
+}
+
+// QueryApplicationsByUsers 获取用户在 since 之后提交的所有申请
+func QueryApplicationsByUsers(user_ids []int32, since time.Time) ([]*TeamApplication, error) {
+	var applications []*TeamApplication
+	if len(user_ids) == 0 {
+		return applications, nil
+	}
+	if err := DB.Where("user_id IN ? AND created_time >= ?", user_ids, since).
+		Find(&applications).Error; err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// QueryUserImpressions 获取用户在赛事中 since 之后的曝光记录
+func QueryUserImpressions(user_id int32, contest_id int32, since time.Time) ([]*RecommendImpression, error) {
+	return queryImpressions(DB.Model(&events.Event{}).
+		Where("user_id = ? AND contest_id = ? AND created_time >= ?", user_id, contest_id, since))
+}
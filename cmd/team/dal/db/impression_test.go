@@ -0,0 +1,28 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/events"
+)
+
+// TestGroupImpressions 测试曝光事件按 request_id 合并，并从实验标签中解析实验与分组
+func TestGroupImpressions(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	rows := []*events.Event{
+		{RequestID: "a", UserID: 1, ContestID: 2, TeamID: 10, Experiment: "team_ranking/hybrid", CreatedTime: now},
+		{RequestID: "a", UserID: 1, ContestID: 2, TeamID: 11, Experiment: "team_ranking/hybrid", CreatedTime: now},
+		{RequestID: "b", UserID: 1, ContestID: 2, TeamID: 12, CreatedTime: now.Add(time.Minute)},
+	}
+	got := groupImpressions(rows)
+	if len(got) != 2 {
+		t.Fatalf("groupImpressions() = %d impressions, want 2", len(got))
+	}
+	if a := got[0]; a.Experiment != "team_ranking" || a.Variant != "hybrid" || len(a.TeamIDs) != 2 || a.TeamIDs[1] != 11 {
+		t.Errorf("groupImpressions()[0] = %+v", a)
+	}
+	if b := got[1]; b.Experiment != "" || b.Variant != "" || len(b.TeamIDs) != 1 || !b.CreatedTime.Equal(now.Add(time.Minute)) {
+		t.Errorf("groupImpressions()[1] = %+v", b)
+	}
+}
@@ -1,7 +1,6 @@
 package db
 
 import (
-    "fmt"
     "github.com/Yra-A/Fusion_Go/pkg/constants"
     "gorm.io/driver/mysql"
     "gorm.io/gorm"
@@ -10,25 +9,41 @@ import (
 
 var DB *gorm.DB
 
-// Init init DB
-func Init() {
+// Init 连接数据库并执行表结构与数据迁移，任一步骤失败时返回错误
+func Init() error {
+    if err := Connect(); err != nil {
+        return err
+    }
+    return Migrate()
+}
+
+// Connect 只连接数据库，不修改表结构与数据
+func Connect() error {
     var err error
     DB, err = gorm.Open(mysql.Open(constants.MySQLDefaultDSN),
         &gorm.Config{
             PrepareStmt:            true,
             SkipDefaultTransaction: true,
+            TranslateError:         true,
         },
     )
     if err != nil {
-        fmt.Println(err)
+        return err
     }
+    return DB.Use(gormopentracing.New())
+}
 
-    if err = DB.Use(gormopentracing.New()); err != nil {
-        fmt.Println(err)
+// Migrate 执行表结构与数据迁移
+func Migrate() error {
+    if err := migrateRelationshipContest(); err != nil {
+        return err
     }
-
-    err = DB.AutoMigrate(&TeamInfo{}, &TeamApplication{}, &TeamUserRelationship{})
-    if err != nil {
-        fmt.Println(err)
+    // 唯一索引 idx_user_contest 要求每个用户在同一赛事中只有一条成员关系，创建前检查历史数据
+    if err := checkDuplicateMemberships(); err != nil {
+        return err
+    }
+    if err := DB.AutoMigrate(&TeamInfo{}, &TeamApplication{}, &TeamUserRelationship{}, &EmbeddingJob{}, &TeamInvitation{}); err != nil {
+        return err
     }
+    return migrateApplicationStatus()
 }
@@ -0,0 +1,256 @@
+package db
+
+import (
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"gorm.io/gorm"
+)
+
+// 入队邀请状态，待处理与已过期的取值与申请相同
+const (
+	InvitationStatusPending   = ApplicationStatusPending
+	InvitationStatusAccepted  = "accepted"
+	InvitationStatusDeclined  = "declined"
+	InvitationStatusCancelled = "cancelled"
+	InvitationStatusExpired   = ApplicationStatusExpired
+)
+
+// TeamInvitation 队长向指定用户发出的入队邀请
+type TeamInvitation struct {
+	InvitationID int32      `gorm:"primary_key;column:invitation_id;autoIncrement"`
+	TeamID       int32      `gorm:"column:team_id;index"`
+	InviterID    int32      `gorm:"column:inviter_id"`
+	InviteeID    int32      `gorm:"column:invitee_id;index"`
+	Message      string     `gorm:"column:message;type:text"`
+	CreatedTime  time.Time  `gorm:"column:created_time"`
+	Status       string     `gorm:"column:status;type:varchar(16);index"`
+	DecidedTime  *time.Time `gorm:"column:decided_time"`
+}
+
+func (TeamInvitation) TableName() string {
+	return "team_invitation"
+}
+
+// UserInvitation 用户收到的邀请及邀请队伍的信息
+type UserInvitation struct {
+	TeamInvitation `gorm:"embedded"`
+	TeamTitle      string `gorm:"column:team_title"`
+	ContestID      int32  `gorm:"column:contest_id"`
+}
+
+// CreateTeamInvitation 队长邀请用户加入队伍，返回邀请 id
+// 被邀请人已加入该赛事的队伍时返回 errno.AlreadyInContestTeamErr，已有 since 之后发出的待处理邀请时返回 errno.InvitationDuplicateErr
+func CreateTeamInvitation(user_id int32, team_id int32, invitee_id int32, message string, since time.Time) (int32, error) {
+	if invitee_id == user_id {
+		return 0, errno.ParamErr
+	}
+	invitation := &TeamInvitation{
+		TeamID:      team_id,
+		InviterID:   user_id,
+		InviteeID:   invitee_id,
+		Message:     message,
+		CreatedTime: time.Now(),
+		Status:      InvitationStatusPending,
+	}
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		teamInfo, err := lockTeamAsLeader(tx, user_id, team_id)
+		if err != nil {
+			return err
+		}
+		joined, err := userContestTeams(tx, invitee_id, teamInfo.ContestID)
+		if err != nil {
+			return err
+		}
+		if len(joined) > 0 {
+			return errno.AlreadyInContestTeamErr
+		}
+		q := tx.Model(&TeamInvitation{}).Where("team_id = ? AND invitee_id = ? AND status = ?", team_id, invitee_id, InvitationStatusPending)
+		if !since.IsZero() {
+			q = q.Where("created_time >= ?", since)
+		}
+		var count int64
+		if err := q.Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errno.InvitationDuplicateErr
+		}
+		return tx.Create(invitation).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return invitation.InvitationID, nil
+}
+
+// QueryInvitation 获取邀请
+func QueryInvitation(invitation_id int32) (*TeamInvitation, error) {
+	var invitation TeamInvitation
+	if err := DB.Where("invitation_id = ?", invitation_id).First(&invitation).Error; err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// RespondTeamInvitation 被邀请人处理 since 之后发出的待处理邀请，accept 为 true 时接受并加入队伍，否则拒绝，返回被处理的邀请
+// 加入队伍时与接受入队申请遵循相同的人数上限与成员唯一性规则，加入失败时邀请保持待处理
+func RespondTeamInvitation(user_id int32, invitation_id int32, accept bool, since time.Time, team_size_max int32) (*TeamInvitation, error) {
+	invitation, err := QueryInvitation(invitation_id)
+	if err != nil {
+		return nil, err
+	}
+	// 只有被邀请人才能处理邀请
+	if invitation.InviteeID != user_id {
+		return nil, errno.AuthorizationFailedErr
+	}
+	status := InvitationStatusDeclined
+	if accept {
+		status = InvitationStatusAccepted
+	}
+	now := time.Now()
+	err = DB.Transaction(func(tx *gorm.DB) error {
+		q := tx.Model(&TeamInvitation{}).Where("invitation_id = ? AND status = ?", invitation_id, InvitationStatusPending)
+		if !since.IsZero() {
+			q = q.Where("created_time >= ?", since)
+		}
+		res := q.Updates(map[string]interface{}{"status": status, "decided_time": now})
+		if res.Error != nil {
+			return res.Error
+		}
+		// 邀请已被处理、取消或已过期
+		if res.RowsAffected == 0 {
+			return errno.InvitationNotPendingErr
+		}
+		if accept {
+			return teamAddUser(tx, invitation.TeamID, invitation.InviteeID, team_size_max)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	invitation.Status = status
+	invitation.DecidedTime = &now
+	return invitation, nil
+}
+
+// QueryUserInvitations 按发出时间倒序分页获取用户收到的邀请及邀请队伍的标题与所属赛事，返回邀请总数
+func QueryUserInvitations(user_id int32, status string, since time.Time, limit int32, offset int32) ([]*UserInvitation, int64, error) {
+	q := DB.Table(TeamInvitation{}.TableName()).
+		Joins("LEFT JOIN team_info ON team_info.team_id = team_invitation.team_id").
+		Where("team_invitation.invitee_id = ?", user_id)
+	// 计数与分页查询复用同一组条件
+	q = statusFilter(q, TeamInvitation{}.TableName(), status, since).Session(&gorm.Session{})
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var invitations []*UserInvitation
+	if err := q.Select("team_invitation.*, team_info.title AS team_title, team_info.contest_id AS contest_id").
+		Order("team_invitation.created_time DESC").Limit(int(limit)).Offset(int(offset)).
+		Scan(&invitations).Error; err != nil {
+		return nil, 0, err
+	}
+	return invitations, total, nil
+}
+
+// QueryTeamInvitations 按发出时间倒序分页获取队伍发出的邀请，返回邀请总数，只有队长可以查看
+func QueryTeamInvitations(user_id int32, team_id int32, status string, since time.Time, limit int32, offset int32) ([]*UserInvitation, int64, error) {
+	var teamInfo TeamInfo
+	if err := DB.Select("leader_id").Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
+		return nil, 0, err
+	}
+	if teamInfo.LeaderID != user_id {
+		return nil, 0, errno.AuthorizationFailedErr
+	}
+	q := DB.Table(TeamInvitation{}.TableName()).
+		Joins("LEFT JOIN team_info ON team_info.team_id = team_invitation.team_id").
+		Where("team_invitation.team_id = ?", team_id)
+	q = statusFilter(q, TeamInvitation{}.TableName(), status, since).Session(&gorm.Session{})
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var invitations []*UserInvitation
+	if err := q.Select("team_invitation.*, team_info.title AS team_title, team_info.contest_id AS contest_id").
+		Order("team_invitation.created_time DESC").Limit(int(limit)).Offset(int(offset)).
+		Scan(&invitations).Error; err != nil {
+		return nil, 0, err
+	}
+	return invitations, total, nil
+}
+
+// CancelTeamInvitation 队长取消队伍发出的 since 之后仍待处理的邀请，返回被取消的邀请
+// user_id 不是队长时返回 errno.AuthorizationFailedErr，邀请已被处理、取消或已过期时返回 errno.InvitationNotPendingErr
+func CancelTeamInvitation(user_id int32, invitation_id int32, since time.Time) (*TeamInvitation, error) {
+	invitation, err := QueryInvitation(invitation_id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	err = DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockTeamAsLeader(tx, user_id, invitation.TeamID); err != nil {
+			return err
+		}
+		q := tx.Model(&TeamInvitation{}).Where("invitation_id = ? AND status = ?", invitation_id, InvitationStatusPending)
+		if !since.IsZero() {
+			q = q.Where("created_time >= ?", since)
+		}
+		res := q.Updates(map[string]interface{}{"status": InvitationStatusCancelled, "decided_time": now})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errno.InvitationNotPendingErr
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	invitation.Status = InvitationStatusCancelled
+	invitation.DecidedTime = &now
+	return invitation, nil
+}
+
+// QueryInvitationContestID 获取邀请的队伍所属的赛事 id
+func QueryInvitationContestID(invitation_id int32) (int32, error) {
+	var teamInfo TeamInfo
+	if err := DB.Select("team_info.contest_id").
+		Joins("JOIN team_invitation ON team_invitation.team_id = team_info.team_id").
+		Where("team_invitation.invitation_id = ?", invitation_id).First(&teamInfo).Error; err != nil {
+		return 0, err
+	}
+	return teamInfo.ContestID, nil
+}
+
+// ExpireTeamInvitations 将 before 之前发出且仍待处理的邀请标记为过期，返回过期的邀请数
+func ExpireTeamInvitations(before time.Time) (int64, error) {
+	res := DB.Model(&TeamInvitation{}).
+		Where("status = ? AND created_time < ?", InvitationStatusPending, before).
+		Updates(map[string]interface{}{"status": InvitationStatusExpired, "decided_time": time.Now()})
+	return res.RowsAffected, res.Error
+}
+
+// cancelTeamInvitations 在事务中取消队伍所有待处理的邀请
+func cancelTeamInvitations(tx *gorm.DB, team_id int32) error {
+	return tx.Model(&TeamInvitation{}).Where("team_id = ? AND status = ?", team_id, InvitationStatusPending).
+		Updates(map[string]interface{}{"status": InvitationStatusCancelled, "decided_time": time.Now()}).Error
+}
+
+// cancelContestInvitations 用户加入赛事的队伍后，取消其收到的该赛事其他队伍待处理的邀请
+func cancelContestInvitations(tx *gorm.DB, user_id int32, contest_id int32) error {
+	teams := tx.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id)
+	return tx.Model(&TeamInvitation{}).
+		Where("invitee_id = ? AND status = ? AND team_id IN (?)", user_id, InvitationStatusPending, teams).
+		Updates(map[string]interface{}{"status": InvitationStatusCancelled, "decided_time": time.Now()}).Error
+}
+
+// cancelInviterInvitations 在事务中取消 inviter_id 以队长身份代表队伍发出的待处理邀请，用于队长转让或离开队伍
+func cancelInviterInvitations(tx *gorm.DB, team_id int32, inviter_id int32) error {
+	return tx.Model(&TeamInvitation{}).Where("team_id = ? AND inviter_id = ? AND status = ?", team_id, inviter_id, InvitationStatusPending).
+		Updates(map[string]interface{}{"status": InvitationStatusCancelled, "decided_time": time.Now()}).Error
+}
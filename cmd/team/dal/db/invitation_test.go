@@ -0,0 +1,200 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+// invitationStatusOf 返回邀请当前的状态
+func invitationStatusOf(t *testing.T, invitation_id int32) string {
+	t.Helper()
+	invitation, err := QueryInvitation(invitation_id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return invitation.Status
+}
+
+// TestCreateTeamInvitation 测试只有队长可以邀请，不能重复邀请，不能邀请已加入该赛事队伍的用户
+func TestCreateTeamInvitation(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	if _, err := CreateTeamInvitation(2, team_id, 10, "", time.Time{}); err != errno.AuthorizationFailedErr {
+		t.Fatalf("CreateTeamInvitation() by a non-leader = %v, want AuthorizationFailedErr", err)
+	}
+	if _, err := CreateTeamInvitation(1, team_id, 1, "", time.Time{}); err != errno.ParamErr {
+		t.Fatalf("CreateTeamInvitation() to the leader = %v, want ParamErr", err)
+	}
+	if _, err := CreateTeamInvitation(1, team_id, 10, "", time.Time{}); err != nil {
+		t.Fatalf("CreateTeamInvitation() = %v", err)
+	}
+	if _, err := CreateTeamInvitation(1, team_id, 10, "", time.Time{}); err != errno.InvitationDuplicateErr {
+		t.Fatalf("CreateTeamInvitation() again = %v, want InvitationDuplicateErr", err)
+	}
+	// since 之前发出的待处理邀请视为已过期，可以重新邀请
+	if _, err := CreateTeamInvitation(1, team_id, 10, "", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("CreateTeamInvitation() after the previous one expired = %v", err)
+	}
+
+	other := createTestTeam(t, 2, 1)
+	if err := TeamAddUser(other, 11, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CreateTeamInvitation(1, team_id, 11, "", time.Time{}); err != errno.AlreadyInContestTeamErr {
+		t.Errorf("CreateTeamInvitation() to a member of the contest = %v, want AlreadyInContestTeamErr", err)
+	}
+}
+
+// TestRespondTeamInvitation 测试接受邀请后加入队伍，并取消该赛事其他待处理的邀请、撤回待处理的申请，其他赛事不受影响
+func TestRespondTeamInvitation(t *testing.T) {
+	setupTestDB(t)
+	a := createTestTeam(t, 1, 1)
+	b := createTestTeam(t, 2, 1)
+	other := createTestTeam(t, 3, 2)
+	inviteA, err := CreateTeamInvitation(1, a, 10, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inviteB, err := CreateTeamInvitation(2, b, 10, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inviteOther, err := CreateTeamInvitation(3, other, 10, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	applyB, err := CreateTeamApplication(10, b, "", time.Now().Unix(), 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := RespondTeamInvitation(11, inviteA, true, time.Time{}, 0); err != errno.AuthorizationFailedErr {
+		t.Fatalf("RespondTeamInvitation() by another user = %v, want AuthorizationFailedErr", err)
+	}
+	if _, err := RespondTeamInvitation(10, inviteA, true, time.Time{}, 0); err != nil {
+		t.Fatalf("RespondTeamInvitation() = %v", err)
+	}
+	if got := teamOf(t, a).CurPeopleNum; got != 2 {
+		t.Errorf("cur_people_num after accepting = %d, want 2", got)
+	}
+	for id, want := range map[int32]string{
+		inviteA:     InvitationStatusAccepted,
+		inviteB:     InvitationStatusCancelled,
+		inviteOther: InvitationStatusPending,
+	} {
+		if got := invitationStatusOf(t, id); got != want {
+			t.Errorf("invitation %d status = %s, want %s", id, got, want)
+		}
+	}
+	if got := applicationStatusOf(t, applyB); got != ApplicationStatusWithdrawn {
+		t.Errorf("application status = %s, want %s", got, ApplicationStatusWithdrawn)
+	}
+	if _, err := RespondTeamInvitation(10, inviteB, true, time.Time{}, 0); err != errno.InvitationNotPendingErr {
+		t.Errorf("RespondTeamInvitation() on a cancelled invitation = %v, want InvitationNotPendingErr", err)
+	}
+	if _, err := RespondTeamInvitation(10, inviteOther, false, time.Time{}, 0); err != nil {
+		t.Fatalf("RespondTeamInvitation() decline = %v", err)
+	}
+	if got := invitationStatusOf(t, inviteOther); got != InvitationStatusDeclined {
+		t.Errorf("declined invitation status = %s, want %s", got, InvitationStatusDeclined)
+	}
+}
+
+// TestRespondTeamInvitationRollback 测试加入队伍失败时邀请保持待处理，已过期的邀请不能接受
+func TestRespondTeamInvitationRollback(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	invitation_id, err := CreateTeamInvitation(1, team_id, 10, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RespondTeamInvitation(10, invitation_id, true, time.Time{}, 1); err != errno.TeamFullErr {
+		t.Fatalf("RespondTeamInvitation() to a full team = %v, want TeamFullErr", err)
+	}
+	if got := invitationStatusOf(t, invitation_id); got != InvitationStatusPending {
+		t.Errorf("invitation status after a failed join = %s, want %s", got, InvitationStatusPending)
+	}
+	if _, err := RespondTeamInvitation(10, invitation_id, true, time.Now().Add(time.Hour), 0); err != errno.InvitationNotPendingErr {
+		t.Errorf("RespondTeamInvitation() on an expired invitation = %v, want InvitationNotPendingErr", err)
+	}
+}
+
+// TestCancelTeamInvitation 测试只有队长可以取消待处理的邀请，队伍发出的邀请列表按状态过滤
+func TestCancelTeamInvitation(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	invitation_id, err := CreateTeamInvitation(1, team_id, 10, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CreateTeamInvitation(1, team_id, 11, "", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CancelTeamInvitation(2, invitation_id, time.Time{}); err != errno.AuthorizationFailedErr {
+		t.Fatalf("CancelTeamInvitation() by a non-leader = %v, want AuthorizationFailedErr", err)
+	}
+	if _, err := CancelTeamInvitation(1, invitation_id, time.Time{}); err != nil {
+		t.Fatalf("CancelTeamInvitation() = %v", err)
+	}
+	if _, err := CancelTeamInvitation(1, invitation_id, time.Time{}); err != errno.InvitationNotPendingErr {
+		t.Errorf("CancelTeamInvitation() again = %v, want InvitationNotPendingErr", err)
+	}
+
+	if _, _, err := QueryTeamInvitations(2, team_id, "", time.Time{}, 10, 0); err != errno.AuthorizationFailedErr {
+		t.Errorf("QueryTeamInvitations() by a non-leader = %v, want AuthorizationFailedErr", err)
+	}
+	for status, want := range map[string]int64{"": 2, InvitationStatusPending: 1, InvitationStatusCancelled: 1} {
+		if _, total, err := QueryTeamInvitations(1, team_id, status, time.Time{}, 10, 0); err != nil || total != want {
+			t.Errorf("QueryTeamInvitations(%q) total = %d, %v, want %d", status, total, err, want)
+		}
+	}
+	// since 之后发出的邀请才视为待处理，之前的按已过期统计
+	since := time.Now().Add(time.Hour)
+	for status, want := range map[string]int64{InvitationStatusPending: 0, InvitationStatusExpired: 1} {
+		if _, total, err := QueryTeamInvitations(1, team_id, status, since, 10, 0); err != nil || total != want {
+			t.Errorf("QueryTeamInvitations(%q, since) total = %d, %v, want %d", status, total, err, want)
+		}
+	}
+}
+
+// TestLeaderChangeCancelsInvitations 测试转让队长后原队长发出的邀请被取消，新队长发出的邀请在其离队后被取消
+func TestLeaderChangeCancelsInvitations(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	if err := TeamAddUser(team_id, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	before, err := CreateTeamInvitation(1, team_id, 10, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := TransferTeamLeader(1, team_id, 2); err != nil {
+		t.Fatalf("TransferTeamLeader() = %v", err)
+	}
+	if got := invitationStatusOf(t, before); got != InvitationStatusCancelled {
+		t.Errorf("invitation by the former leader = %s, want %s", got, InvitationStatusCancelled)
+	}
+
+	after, err := CreateTeamInvitation(2, team_id, 10, "", time.Time{})
+	if err != nil {
+		t.Fatalf("CreateTeamInvitation() by the new leader = %v", err)
+	}
+	if err := TransferTeamLeader(2, team_id, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := invitationStatusOf(t, after); got != InvitationStatusCancelled {
+		t.Errorf("invitation after transferring back = %s, want %s", got, InvitationStatusCancelled)
+	}
+	again, err := CreateTeamInvitation(1, team_id, 11, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := RemoveTeamMember(1, team_id, 2); err != nil {
+		t.Fatalf("RemoveTeamMember() = %v", err)
+	}
+	if got := invitationStatusOf(t, again); got != InvitationStatusPending {
+		t.Errorf("invitation by the current leader after removing a member = %s, want %s", got, InvitationStatusPending)
+	}
+}
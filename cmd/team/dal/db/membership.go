@@ -0,0 +1,220 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"gorm.io/gorm"
+)
+
+// userContestTeams 返回用户在赛事中已加入的队伍
+func userContestTeams(tx *gorm.DB, user_id int32, contest_id int32) ([]int32, error) {
+	var teamIDs []int32
+	if err := tx.Model(&TeamUserRelationship{}).Where("user_id = ? AND contest_id = ?", user_id, contest_id).
+		Pluck("team_id", &teamIDs).Error; err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
+// withdrawContestApplications 用户加入赛事的队伍后，撤回其向该赛事其他队伍提交的待处理申请
+func withdrawContestApplications(tx *gorm.DB, user_id int32, contest_id int32) error {
+	teams := tx.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id)
+	return tx.Model(&TeamApplication{}).
+		Where("user_id = ? AND status = ? AND team_id IN (?)", user_id, ApplicationStatusPending, teams).
+		Updates(map[string]interface{}{"status": ApplicationStatusWithdrawn, "decided_by": 0, "decided_time": time.Now()}).Error
+}
+
+// QueryContestMemberIDs 获取已加入赛事中任一队伍的用户，包括队长
+func QueryContestMemberIDs(contest_id int32) ([]int32, error) {
+	var userIDs []int32
+	if err := DB.Model(&TeamUserRelationship{}).Where("contest_id = ?", contest_id).
+		Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// migrateRelationshipContest 为引入 contest_id 之前的成员关系补充所属赛事，需要在创建唯一索引之前执行
+func migrateRelationshipContest() error {
+	m := DB.Migrator()
+	if !m.HasTable(&TeamUserRelationship{}) || m.HasColumn(&TeamUserRelationship{}, "ContestID") {
+		return nil
+	}
+	if err := m.AddColumn(&TeamUserRelationship{}, "ContestID"); err != nil {
+		return err
+	}
+	return DB.Exec("UPDATE team_user_relationship r JOIN team_info t ON t.team_id = r.team_id SET r.contest_id = t.contest_id").Error
+}
+
+// maxReportedDuplicates 唯一索引创建失败时最多列出的重复成员关系数
+const maxReportedDuplicates = 20
+
+// DuplicateMembership 用户在同一赛事中加入的多个队伍
+type DuplicateMembership struct {
+	UserID    int32
+	ContestID int32
+	TeamIDs   string
+}
+
+// QueryDuplicateMemberships 获取在同一赛事中加入了多个队伍的用户，最多返回 limit 条
+func QueryDuplicateMemberships(limit int) ([]*DuplicateMembership, error) {
+	var duplicates []*DuplicateMembership
+	if err := DB.Model(&TeamUserRelationship{}).
+		Select("user_id, contest_id, GROUP_CONCAT(team_id ORDER BY team_id) AS team_ids").
+		Group("user_id, contest_id").Having("COUNT(*) > 1").
+		Order("user_id, contest_id").Limit(limit).Scan(&duplicates).Error; err != nil {
+		return nil, err
+	}
+	return duplicates, nil
+}
+
+// checkDuplicateMemberships 在创建唯一索引 idx_user_contest 之前检查历史数据，
+// 存在同一用户在同一赛事中加入多个队伍的记录时返回列出这些记录的错误，需要人工处理后再启动
+func checkDuplicateMemberships() error {
+	m := DB.Migrator()
+	if !m.HasTable(&TeamUserRelationship{}) || m.HasIndex(&TeamUserRelationship{}, "idx_user_contest") {
+		return nil
+	}
+	duplicates, err := QueryDuplicateMemberships(maxReportedDuplicates)
+	if err != nil {
+		return err
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	items := make([]string, len(duplicates))
+	for i, d := range duplicates {
+		items[i] = fmt.Sprintf("user_id=%d contest_id=%d team_ids=[%s]", d.UserID, d.ContestID, d.TeamIDs)
+	}
+	return fmt.Errorf("无法创建唯一索引 idx_user_contest, 以下用户在同一赛事中加入了多个队伍(最多列出 %d 条), 请移除多余的成员关系后重新启动: %s",
+		maxReportedDuplicates, strings.Join(items, "; "))
+}
+
+// recountMembers 按成员关系重新统计队伍人数
+func recountMembers(tx *gorm.DB, team_id int32) error {
+	var count int64
+	if err := tx.Model(&TeamUserRelationship{}).Where("team_id = ?", team_id).Count(&count).Error; err != nil {
+		return err
+	}
+	return tx.Model(&TeamInfo{}).Where("team_id = ?", team_id).Update("cur_people_num", count).Error
+}
+
+// lockTeamAsLeader 在事务中锁定队伍记录，user_id 不是队长时返回 errno.AuthorizationFailedErr
+func lockTeamAsLeader(tx *gorm.DB, user_id int32, team_id int32) (*TeamInfo, error) {
+	teamInfo, err := lockTeam(tx, team_id)
+	if err != nil {
+		return nil, err
+	}
+	if teamInfo.LeaderID != user_id {
+		return nil, errno.AuthorizationFailedErr
+	}
+	return teamInfo, nil
+}
+
+// teamRemoveUser 将用户移出队伍并更新队伍人数，用户不是队员时返回 errno.NotTeamMemberErr
+// 用户曾担任队长时以队伍名义发出且仍待处理的邀请一并取消
+func teamRemoveUser(tx *gorm.DB, team_id int32, member_id int32) error {
+	res := tx.Where("team_id = ? AND user_id = ?", team_id, member_id).Delete(&TeamUserRelationship{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errno.NotTeamMemberErr
+	}
+	if err := cancelInviterInvitations(tx, team_id, member_id); err != nil {
+		return err
+	}
+	return recountMembers(tx, team_id)
+}
+
+// LeaveTeam 队员退出队伍，队长退出时返回 errno.LeaderCannotLeaveErr
+func LeaveTeam(user_id int32, team_id int32) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		teamInfo, err := lockTeam(tx, team_id)
+		if err != nil {
+			return err
+		}
+		if teamInfo.LeaderID == user_id {
+			return errno.LeaderCannotLeaveErr
+		}
+		return teamRemoveUser(tx, team_id, user_id)
+	})
+}
+
+// RemoveTeamMember 队长将队员移出队伍，队长不能移出自己
+func RemoveTeamMember(user_id int32, team_id int32, member_id int32) error {
+	if member_id == user_id {
+		return errno.ParamErr
+	}
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockTeamAsLeader(tx, user_id, team_id); err != nil {
+			return err
+		}
+		return teamRemoveUser(tx, team_id, member_id)
+	})
+}
+
+// TransferTeamLeader 队长将队长身份转让给队伍中的其他成员，新队长不是队员时返回 errno.NotTeamMemberErr
+// 原队长发出且仍待处理的邀请随之取消，由新队长决定是否重新邀请
+func TransferTeamLeader(user_id int32, team_id int32, member_id int32) error {
+	if member_id == user_id {
+		return errno.ParamErr
+	}
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockTeamAsLeader(tx, user_id, team_id); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&TeamUserRelationship{}).Where("team_id = ? AND user_id = ?", team_id, member_id).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return errno.NotTeamMemberErr
+		}
+		if err := cancelInviterInvitations(tx, team_id, user_id); err != nil {
+			return err
+		}
+		return tx.Model(&TeamInfo{}).Where("team_id = ?", team_id).Update("leader_id", member_id).Error
+	})
+}
+
+// CloseTeam 队长停止或恢复队伍的招募
+func CloseTeam(user_id int32, team_id int32, closed bool) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockTeamAsLeader(tx, user_id, team_id); err != nil {
+			return err
+		}
+		return tx.Model(&TeamInfo{}).Where("team_id = ?", team_id).Update("closed", closed).Error
+	})
+}
+
+// DisbandTeam 队长解散队伍：拒绝所有待处理的申请，取消所有待处理的邀请，删除成员关系、招募岗位、未执行的 embedding 任务以及队伍本身
+// 申请与邀请记录保留，供申请人与被邀请人查看历史记录
+func DisbandTeam(user_id int32, team_id int32) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockTeamAsLeader(tx, user_id, team_id); err != nil {
+			return err
+		}
+		if err := tx.Model(&TeamApplication{}).Where("team_id = ? AND status = ?", team_id, ApplicationStatusPending).
+			Updates(map[string]interface{}{"status": ApplicationStatusRejected, "decided_by": user_id, "decided_time": time.Now()}).Error; err != nil {
+			return err
+		}
+		if err := cancelTeamInvitations(tx, team_id); err != nil {
+			return err
+		}
+		if err := tx.Where("team_id = ?", team_id).Delete(&TeamUserRelationship{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("team_id = ?", team_id).Delete(&TeamSkills{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("team_id = ? AND status = ?", team_id, JobStatusPending).Delete(&EmbeddingJob{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("team_id = ?", team_id).Delete(&TeamInfo{}).Error
+	})
+}
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+// teamOf 返回队伍当前的队长与人数
+func teamOf(t *testing.T, team_id int32) *TeamInfo {
+	t.Helper()
+	var teamInfo TeamInfo
+	if err := DB.Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &teamInfo
+}
+
+// TestLeaveTeam 测试队长不能退出队伍，队员退出后人数重新统计且不能重复退出
+func TestLeaveTeam(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	if err := TeamAddUser(team_id, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := LeaveTeam(1, team_id); err != errno.LeaderCannotLeaveErr {
+		t.Fatalf("LeaveTeam() by the leader = %v, want LeaderCannotLeaveErr", err)
+	}
+	if err := LeaveTeam(2, team_id); err != nil {
+		t.Fatalf("LeaveTeam() = %v", err)
+	}
+	if got := teamOf(t, team_id).CurPeopleNum; got != 1 {
+		t.Errorf("cur_people_num after leaving = %d, want 1", got)
+	}
+	if err := LeaveTeam(2, team_id); err != errno.NotTeamMemberErr {
+		t.Errorf("LeaveTeam() by a non-member = %v, want NotTeamMemberErr", err)
+	}
+}
+
+// TestRemoveTeamMember 测试只有队长可以移出队员，移出后人数重新统计，被移出的用户可以加入赛事的其他队伍
+func TestRemoveTeamMember(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	other := createTestTeam(t, 3, 1)
+	for _, id := range []int32{2, 4} {
+		if err := TeamAddUser(team_id, id, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := RemoveTeamMember(2, team_id, 4); err != errno.AuthorizationFailedErr {
+		t.Fatalf("RemoveTeamMember() by a member = %v, want AuthorizationFailedErr", err)
+	}
+	if err := RemoveTeamMember(1, team_id, 1); err != errno.ParamErr {
+		t.Fatalf("RemoveTeamMember() of the leader = %v, want ParamErr", err)
+	}
+	if err := RemoveTeamMember(1, team_id, 2); err != nil {
+		t.Fatalf("RemoveTeamMember() = %v", err)
+	}
+	if got := teamOf(t, team_id).CurPeopleNum; got != 2 {
+		t.Errorf("cur_people_num after removal = %d, want 2", got)
+	}
+	if err := RemoveTeamMember(1, team_id, 2); err != errno.NotTeamMemberErr {
+		t.Errorf("RemoveTeamMember() of a non-member = %v, want NotTeamMemberErr", err)
+	}
+	if err := TeamAddUser(other, 2, 0); err != nil {
+		t.Errorf("TeamAddUser() after removal = %v", err)
+	}
+}
+
+// TestTransferTeamLeader 测试队长身份只能转让给队员，转让后原队长成为普通队员且人数不变
+func TestTransferTeamLeader(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	if err := TeamAddUser(team_id, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := TransferTeamLeader(1, team_id, 5); err != errno.NotTeamMemberErr {
+		t.Fatalf("TransferTeamLeader() to a non-member = %v, want NotTeamMemberErr", err)
+	}
+	if got := teamOf(t, team_id).LeaderID; got != 1 {
+		t.Fatalf("leader after a rejected transfer = %d, want 1", got)
+	}
+	if err := TransferTeamLeader(2, team_id, 1); err != errno.AuthorizationFailedErr {
+		t.Fatalf("TransferTeamLeader() by a member = %v, want AuthorizationFailedErr", err)
+	}
+	if err := TransferTeamLeader(1, team_id, 2); err != nil {
+		t.Fatalf("TransferTeamLeader() = %v", err)
+	}
+	teamInfo := teamOf(t, team_id)
+	if teamInfo.LeaderID != 2 || teamInfo.CurPeopleNum != 2 {
+		t.Errorf("after transfer leader = %d, cur_people_num = %d, want 2, 2", teamInfo.LeaderID, teamInfo.CurPeopleNum)
+	}
+	// 原队长成为队员后可以退出
+	if err := LeaveTeam(1, team_id); err != nil {
+		t.Errorf("LeaveTeam() by the former leader = %v", err)
+	}
+}
+
+// TestMigrateDuplicateMemberships 测试唯一索引创建前存在同一赛事的重复成员关系时，迁移返回列出这些记录的错误
+func TestMigrateDuplicateMemberships(t *testing.T) {
+	setupTestDB(t)
+	m := DB.Migrator()
+	if err := m.DropIndex(&TeamUserRelationship{}, "idx_user_contest"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		DB.Exec("DELETE FROM team_user_relationship")
+		if err := m.CreateIndex(&TeamUserRelationship{}, "idx_user_contest"); err != nil {
+			t.Error(err)
+		}
+	})
+	for _, team_id := range []int32{101, 102} {
+		if err := DB.Create(&TeamUserRelationship{UserID: 5, TeamID: team_id, ContestID: 1}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := Migrate()
+	if err == nil || !strings.Contains(err.Error(), "user_id=5 contest_id=1 team_ids=[101,102]") {
+		t.Fatalf("Migrate() = %v, want an error listing user 5 in teams 101 and 102", err)
+	}
+	if m.HasIndex(&TeamUserRelationship{}, "idx_user_contest") {
+		t.Error("idx_user_contest should not be created while duplicates exist")
+	}
+}
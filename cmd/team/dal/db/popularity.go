@@ -0,0 +1,25 @@
+package db
+
+import "time"
+
+// TeamPopularity 队伍的人数、创建时间与近期收到的申请数
+type TeamPopularity struct {
+	TeamID       int32
+	CurPeopleNum int32
+	CreatedTime  time.Time
+	Applications int64
+}
+
+// QueryTeamPopularity 获取赛事下每个队伍的人数、创建时间以及 since 之后收到的申请数
+func QueryTeamPopularity(contest_id int32, since time.Time) ([]*TeamPopularity, error) {
+	var stats []*TeamPopularity
+	if err := DB.Table("team_info AS t").
+		Select("t.team_id, t.cur_people_num, t.created_time, COUNT(a.application_id) AS applications").
+		Joins("LEFT JOIN team_application AS a ON a.team_id = t.team_id AND a.created_time >= ?", since).
+		Where("t.contest_id = ?", contest_id).
+		Group("t.team_id, t.cur_people_num, t.created_time").
+		Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -3,14 +3,16 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"time"
 
 	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
-	"github.com/Yra-A/Fusion_Go/pkg/configs/openai"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
 	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"gorm.io/gorm"
 )
 
 // PositionEmbedding 存储单个岗位的 embedding 信息
@@ -20,8 +22,35 @@ type PositionEmbedding struct {
 }
 
 // TeamEmbedding 存储整个队伍的 embedding 信息
+// Model、Dimension、PromptVersion 记录生成时的元信息，旧数据中这些字段为空
 type TeamEmbedding struct {
-	Positions []PositionEmbedding `json:"positions"`
+	Model         string              `json:"model"`
+	Dimension     int                 `json:"dimension"`
+	PromptVersion string              `json:"prompt_version"`
+	Positions     []PositionEmbedding `json:"positions"`
+}
+
+// CheckCompatible 检查队伍 embedding 能否与由 model 生成的 dim 维向量比较，不能时返回原因
+func (te *TeamEmbedding) CheckCompatible(model string, dim int) error {
+	if te.Model == "" {
+		return fmt.Errorf("embedding 缺少模型信息")
+	}
+	if te.Model != model {
+		return fmt.Errorf("embedding 模型 %s 与当前模型 %s 不一致", te.Model, model)
+	}
+	// 没有招募岗位时无需比较维度
+	if len(te.Positions) == 0 {
+		return nil
+	}
+	if te.Dimension != dim {
+		return fmt.Errorf("embedding 维度 %d 与当前维度 %d 不一致", te.Dimension, dim)
+	}
+	for _, p := range te.Positions {
+		if len(p.Embedding) != dim {
+			return fmt.Errorf("岗位 %s 的 embedding 维度 %d 与当前维度 %d 不一致", p.Job, len(p.Embedding), dim)
+		}
+	}
+	return nil
 }
 
 type TeamInfo struct {
@@ -35,6 +64,8 @@ type TeamInfo struct {
 	Description         string    `gorm:"column:description"`
 	Embedding           string    `gorm:"column:embedding;type:json"` // 存储为 JSON 字符串
 	EmbeddingUpdatedTime time.Time `gorm:"column:embedding_updated_time"`
+	EmbeddingVersion    string    `gorm:"column:embedding_version"` // 生成 embedding 时的模型与模板版本
+	Closed              bool      `gorm:"column:closed;not null;default:false"` // 队伍已停止招募
 }
 
 func (TeamInfo) TableName() string {
@@ -55,12 +86,15 @@ func (TeamSkills) TableName() string {
 }
 
 type TeamApplication struct {
-	ApplicationID   int32     `gorm:"primary_key;column:application_id"`
-	UserID          int32     `gorm:"column:user_id"`
-	TeamID          int32     `gorm:"column:team_id"`
-	Reason          string    `gorm:"column:reason"`
-	CreatedTime     time.Time `gorm:"column:created_time"`
-	ApplicationType int32     `gorm:"column:application_type"`
+	ApplicationID   int32      `gorm:"primary_key;column:application_id"`
+	UserID          int32      `gorm:"column:user_id"`
+	TeamID          int32      `gorm:"column:team_id"`
+	Reason          string     `gorm:"column:reason"`
+	CreatedTime     time.Time  `gorm:"column:created_time"`
+	ApplicationType int32      `gorm:"column:application_type"`
+	Status          string     `gorm:"column:status;type:varchar(16);index"`
+	DecidedBy       int32      `gorm:"column:decided_by"` // 处理申请的用户：接受或拒绝时为队长，撤回时为申请人，过期时为 0
+	DecidedTime     *time.Time `gorm:"column:decided_time"`
 }
 
 func (TeamApplication) TableName() string {
@@ -69,8 +103,9 @@ func (TeamApplication) TableName() string {
 
 type TeamUserRelationship struct {
 	TeamUserID int32 `gorm:"primary_key;column:team_user_id"`
-	UserID     int32 `gorm:"column:user_id"`
-	TeamID     int32 `gorm:"column:team_id"`
+	UserID     int32 `gorm:"column:user_id;uniqueIndex:idx_user_contest"`
+	TeamID     int32 `gorm:"column:team_id;index"`
+	ContestID  int32 `gorm:"column:contest_id;not null;default:0;uniqueIndex:idx_user_contest"` // 队伍所属赛事，保证每个用户在同一赛事中只加入一个队伍
 }
 
 func (TeamUserRelationship) TableName() string {
@@ -78,7 +113,10 @@ func (TeamUserRelationship) TableName() string {
 }
 
 // TeamDB 实现TeamInfoProvider接口
-type TeamDB struct{}
+type TeamDB struct {
+	// Embedder 生成岗位 embedding 使用的后端，为空时使用 embedder.Default()
+	Embedder embedder.Embedder
+}
 
 func NewTeamDB() *TeamDB {
 	return &TeamDB{}
@@ -94,8 +132,44 @@ func (t *TeamDB) GetContestTeamsWithEmbedding(contestID int32) ([]*team.TeamInfo
 	return GetContestTeamsWithEmbedding(contestID)
 }
 
-// UpdateTeamEmbedding 实现TeamInfoProvider接口
+// BuildTeamEmbedding 使用 svc 为队伍的每个岗位生成 embedding
+func BuildTeamEmbedding(svc *embedding.Service, teamInfo *TeamInfo, teamSkills []*TeamSkills) (*TeamEmbedding, error) {
+	var positionEmbeddings []PositionEmbedding
+	for _, skill := range teamSkills {
+		positionEmbedding, err := svc.GeneratePositionEmbedding(
+			skill.Job, skill.Skill, skill.Category, teamInfo.Description, teamInfo.Goal)
+		if err != nil {
+			// 返回错误由调用方重试，避免只写入部分岗位的 embedding
+			return nil, fmt.Errorf("生成岗位 %s 的 embedding 失败: %v", skill.Job, err)
+		}
+		positionEmbeddings = append(positionEmbeddings, PositionEmbedding{
+			Job:       skill.Job,
+			Embedding: positionEmbedding,
+		})
+	}
+
+	teamEmbedding := &TeamEmbedding{
+		Model:         svc.Embedder.Model(),
+		PromptVersion: embedding.PromptVersion,
+		Positions:     positionEmbeddings,
+	}
+	if len(positionEmbeddings) > 0 {
+		teamEmbedding.Dimension = len(positionEmbeddings[0].Embedding)
+	}
+	return teamEmbedding, nil
+}
+
+// UpdateTeamEmbedding 实现TeamInfoProvider接口，忽略传入的队伍级 embedding，按岗位重新生成
 func (t *TeamDB) UpdateTeamEmbedding(teamID int32, _ []float64, updatedTime time.Time) error {
+	e := t.Embedder
+	if e == nil {
+		e = embedder.Default()
+	}
+	return RegenerateTeamEmbedding(context.Background(), e, teamID, updatedTime)
+}
+
+// RegenerateTeamEmbedding 使用 e 为队伍的每个岗位生成 embedding 并写入数据库，embedding 后端的调用受 ctx 控制
+func RegenerateTeamEmbedding(ctx context.Context, e embedder.Embedder, teamID int32, updatedTime time.Time) error {
 	fmt.Printf("开始更新数据库中的embedding，teamID: %d\n", teamID)
 	
 	// 获取队伍信息
@@ -112,41 +186,11 @@ func (t *TeamDB) UpdateTeamEmbedding(teamID int32, _ []float64, updatedTime time
 		return err
 	}
 	
-	// 创建 embeddingService 实例
-	client, err := openai.NewClient()
+	// 为每个岗位生成 embedding
+	teamEmbedding, err := BuildTeamEmbedding(embedding.NewService(ctx, NewTeamDB(), e), &teamInfo, teamSkills)
 	if err != nil {
-		fmt.Printf("创建 OpenAI 客户端失败: %v\n", err)
 		return err
 	}
-	embeddingService := embedding.NewService(context.Background(), t, client)
-	
-	// 为每个岗位生成 embedding
-	var positionEmbeddings []PositionEmbedding
-	for _, skill := range teamSkills {
-		// 生成岗位的 embedding
-		positionEmbedding32, err := embeddingService.GeneratePositionEmbedding(
-			skill.Job, skill.Skill, skill.Category, teamInfo.Description, teamInfo.Goal)
-		if err != nil {
-			fmt.Printf("生成岗位 %s 的 embedding 失败: %v\n", skill.Job, err)
-			continue
-		}
-		
-		// 将 float32 转换为 float64
-		positionEmbedding64 := make([]float64, len(positionEmbedding32))
-		for i, v := range positionEmbedding32 {
-			positionEmbedding64[i] = float64(v)
-		}
-		
-		positionEmbeddings = append(positionEmbeddings, PositionEmbedding{
-			Job:       skill.Job,
-			Embedding: positionEmbedding64,
-		})
-	}
-	
-	// 构建 TeamEmbedding 结构
-	teamEmbedding := TeamEmbedding{
-		Positions: positionEmbeddings,
-	}
 	
 	// 序列化为 JSON
 	embeddingJSON, err := json.Marshal(teamEmbedding)
@@ -159,6 +203,7 @@ func (t *TeamDB) UpdateTeamEmbedding(teamID int32, _ []float64, updatedTime time
 	if err := DB.Model(&TeamInfo{}).Where("team_id = ?", teamID).Updates(map[string]interface{}{
 		"embedding": string(embeddingJSON),
 		"embedding_updated_time": updatedTime,
+		"embedding_version": embedding.Version(e),
 	}).Error; err != nil {
 		fmt.Printf("更新数据库失败: %v\n", err)
 		return err
@@ -170,8 +215,12 @@ func (t *TeamDB) UpdateTeamEmbedding(teamID int32, _ []float64, updatedTime time
 
 // CreateTeamSkills 创建团队技能需求
 func CreateTeamSkills(teamID int32, skills []*team.TeamSkill) error {
+	return createTeamSkills(DB, teamID, skills)
+}
+
+func createTeamSkills(tx *gorm.DB, teamID int32, skills []*team.TeamSkill) error {
 	for _, skill := range skills {
-		if err := DB.Create(&TeamSkills{
+		if err := tx.Create(&TeamSkills{
 			TeamID:   teamID,
 			Skill:    skill.Skill,
 			Category: skill.Category,
@@ -202,7 +251,7 @@ func GetTeamSkills(teamID int32) ([]*team.TeamSkill, error) {
 	return skills, nil
 }
 
-// CreateTeam 创建团队
+// CreateTeam 创建团队，用户已加入该赛事的队伍时返回 errno.AlreadyInContestTeamErr
 func CreateTeam(user_id int32, contest_id int32, title string, goal string, description string, skills []*team.TeamSkill) (int32, error) {
 	team := &TeamInfo{
 		Title:        title,
@@ -214,32 +263,37 @@ func CreateTeam(user_id int32, contest_id int32, title string, goal string, desc
 		Description:  description,
 		EmbeddingUpdatedTime: time.Now(), // 初始化 embedding 更新时间
 	}
-	if err := DB.Create(team).Error; err != nil {
-		return 0, err
-	}
 	var teamInfo TeamInfo
-	// 获取最新创建的 team 的 team_id，Last 会返回按主键排序的最后一个满足条件的记录
-	if err := DB.Select("team_id").Where("leader_id = ?", user_id).Last(&teamInfo).Error; err != nil {
-		return 0, err
-	}
-	
-	// 创建团队技能需求
-	if err := CreateTeamSkills(teamInfo.TeamID, skills); err != nil {
-		return 0, err
-	}
-	
-	TeamAddUser(teamInfo.TeamID, user_id)
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		joined, err := userContestTeams(tx, user_id, contest_id)
+		if err != nil {
+			return err
+		}
+		if len(joined) > 0 {
+			return errno.AlreadyInContestTeamErr
+		}
+		if err := tx.Create(team).Error; err != nil {
+			return err
+		}
+		// 获取最新创建的 team 的 team_id，Last 会返回按主键排序的最后一个满足条件的记录
+		if err := tx.Select("team_id").Where("leader_id = ?", user_id).Last(&teamInfo).Error; err != nil {
+			return err
+		}
+
+		// 创建团队技能需求
+		if err := createTeamSkills(tx, teamInfo.TeamID, skills); err != nil {
+			return err
+		}
 
-	// 生成并更新embedding
-	client, err := openai.NewClient()
+		return teamAddUser(tx, teamInfo.TeamID, user_id, 0)
+	})
 	if err != nil {
-		fmt.Printf("Warning: %v, skipping embedding generation for team %d\n", err, teamInfo.TeamID)
-		return teamInfo.TeamID, nil
+		return 0, err
 	}
-	embeddingService := embedding.NewService(context.Background(), NewTeamDB(), client)
-	if err := embeddingService.UpdateTeamEmbedding(teamInfo.TeamID); err != nil {
-		// 如果embedding生成失败，记录错误但不影响队伍创建
-		fmt.Printf("Failed to generate embedding for team %d: %v\n", teamInfo.TeamID, err)
+
+	// 异步生成 embedding，失败时由任务队列负责重试
+	if err := EnqueueEmbeddingJob(teamInfo.TeamID); err != nil {
+		return 0, err
 	}
 
 	return teamInfo.TeamID, nil
@@ -265,16 +319,9 @@ func ModifyTeam(team_id int32, title string, goal string, description string, sk
 		return err
 	}
 
-	// 更新embedding
-	client, err := openai.NewClient()
-	if err != nil {
-		fmt.Printf("Warning: %v, skipping embedding update for team %d\n", err, team_id)
-		return nil
-	}
-	embeddingService := embedding.NewService(context.Background(), NewTeamDB(), client)
-	if err := embeddingService.UpdateTeamEmbedding(team_id); err != nil {
-		// 如果embedding生成失败，记录错误但不影响队伍修改
-		fmt.Printf("Failed to update embedding for team %d: %v\n", team_id, err)
+	// 异步更新 embedding，失败时由任务队列负责重试
+	if err := EnqueueEmbeddingJob(team_id); err != nil {
+		return err
 	}
 
 	return nil
@@ -282,7 +329,8 @@ func ModifyTeam(team_id int32, title string, goal string, description string, sk
 
 func QueryTeamList(contest_id int32, user_id int32) ([]*team.TeamBriefInfo, error) {
 	var teamList []*TeamInfo
-	if err := DB.Where("contest_id = ?", contest_id).Find(&teamList).Error; err != nil {
+	// 停止招募的队伍不出现在队伍列表中
+	if err := DB.Where("contest_id = ? AND closed = ?", contest_id, false).Find(&teamList).Error; err != nil {
 		return nil, err
 	}
 	var teamBriefInfoList []*team.TeamBriefInfo
@@ -302,6 +350,39 @@ func QueryTeamList(contest_id int32, user_id int32) ([]*team.TeamBriefInfo, erro
 	return teamBriefInfoList, nil
 }
 
+// QueryUserTeamIDs 获取用户在赛事中已加入的队伍
+func QueryUserTeamIDs(contest_id int32, user_id int32) ([]int32, error) {
+	var teamIDs []int32
+	if err := DB.Model(&TeamUserRelationship{}).
+		Where("user_id = ? AND team_id IN (?)", user_id, DB.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id)).
+		Pluck("team_id", &teamIDs).Error; err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
+// QueryPendingApplicationTeamIDs 获取用户在赛事中有待处理申请的队伍
+func QueryPendingApplicationTeamIDs(contest_id int32, user_id int32) ([]int32, error) {
+	var teamIDs []int32
+	if err := DB.Model(&TeamApplication{}).
+		Where("user_id = ? AND status = ? AND team_id IN (?)", user_id, ApplicationStatusPending, DB.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id)).
+		Pluck("team_id", &teamIDs).Error; err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
+// QueryAppliedTeamIDs 获取用户在赛事中申请过的队伍，包括已处理的申请
+func QueryAppliedTeamIDs(contest_id int32, user_id int32) ([]int32, error) {
+	var teamIDs []int32
+	if err := DB.Model(&TeamApplication{}).
+		Where("user_id = ? AND team_id IN (?)", user_id, DB.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id)).
+		Pluck("team_id", &teamIDs).Error; err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
 // QueryTeamInfo 查询队伍信息
 func QueryTeamInfo(team_id int32) (*team.TeamInfo, error) {
 	var teamInfo TeamInfo
@@ -336,6 +417,7 @@ func QueryTeamInfo(team_id int32) (*team.TeamInfo, error) {
 			LeaderInfo: &team.MemberInfo{
 				UserId: teamInfo.LeaderID,
 			},
+			Closed: teamInfo.Closed,
 		},
 		Description: teamInfo.Description,
 		TeamSkills:  teamSkills,
@@ -343,20 +425,74 @@ func QueryTeamInfo(team_id int32) (*team.TeamInfo, error) {
 	}, nil
 }
 
-func CreateTeamApplication(user_id int32, team_id int32, reason string, created_time int64, application_type int32) error {
-	if err := DB.Create(&TeamApplication{
+// CreateTeamApplication 创建待处理的入队申请，返回申请 id
+// 用户对同一队伍已有 since 之后提交的待处理申请时返回 errno.ApplicationDuplicateErr，队伍已停止招募时返回 errno.TeamClosedErr
+// 用户已加入该赛事的队伍时返回 errno.AlreadyInContestTeamErr
+func CreateTeamApplication(user_id int32, team_id int32, reason string, created_time int64, application_type int32, since time.Time) (int32, error) {
+	createdTime := time.Unix(created_time, 0)
+	if created_time <= 0 {
+		createdTime = time.Now()
+	}
+	application := &TeamApplication{
 		UserID:          user_id,
 		TeamID:          team_id,
 		Reason:          reason,
-		CreatedTime:     time.Unix(created_time, 0),
+		CreatedTime:     createdTime,
 		ApplicationType: application_type,
-	}).Error; err != nil {
-		return err
+		Status:          ApplicationStatusPending,
 	}
-	return nil
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		teamInfo, err := lockTeam(tx, team_id)
+		if err != nil {
+			return err
+		}
+		if teamInfo.Closed {
+			return errno.TeamClosedErr
+		}
+		joined, err := userContestTeams(tx, user_id, teamInfo.ContestID)
+		if err != nil {
+			return err
+		}
+		if len(joined) > 0 {
+			return errno.AlreadyInContestTeamErr
+		}
+		duplicate, err := hasPendingApplication(tx, user_id, team_id, since)
+		if err != nil {
+			return err
+		}
+		if duplicate {
+			return errno.ApplicationDuplicateErr
+		}
+		return tx.Create(application).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return application.ApplicationID, nil
+}
+
+// QueryTeamContestID 获取队伍所属的赛事 id
+func QueryTeamContestID(team_id int32) (int32, error) {
+	var teamInfo TeamInfo
+	if err := DB.Select("contest_id").Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
+		return 0, err
+	}
+	return teamInfo.ContestID, nil
 }
 
-func GetTeamApplicationList(user_id int32, team_id int32) ([]*team.TeamApplication, error) {
+// QueryApplicationContestID 获取申请的队伍所属的赛事 id
+func QueryApplicationContestID(application_id int32) (int32, error) {
+	var teamInfo TeamInfo
+	if err := DB.Select("team_info.contest_id").
+		Joins("JOIN team_application ON team_application.team_id = team_info.team_id").
+		Where("team_application.application_id = ?", application_id).First(&teamInfo).Error; err != nil {
+		return 0, err
+	}
+	return teamInfo.ContestID, nil
+}
+
+// GetTeamApplicationList 获取队伍中 since 之后提交且仍待处理的申请，只有队长可以查看
+func GetTeamApplicationList(user_id int32, team_id int32, since time.Time) ([]*team.TeamApplication, error) {
 	var teamInfo TeamInfo
 	if err := DB.Select("leader_id").Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
 		return nil, err
@@ -365,74 +501,140 @@ func GetTeamApplicationList(user_id int32, team_id int32) ([]*team.TeamApplicati
 	if teamInfo.LeaderID != user_id {
 		return nil, errno.AuthorizationFailedErr
 	}
+	q := DB.Where("team_id = ? AND status = ?", team_id, ApplicationStatusPending)
+	if !since.IsZero() {
+		q = q.Where("created_time >= ?", since)
+	}
 	var teamApplicationList []*TeamApplication
-	if err := DB.Where("team_id = ?", team_id).Find(&teamApplicationList).Error; err != nil {
+	if err := q.Find(&teamApplicationList).Error; err != nil {
 		return nil, err
 	}
 	var teamApplications []*team.TeamApplication
 	for _, t := range teamApplicationList {
-		if t.ApplicationType != 0 {
-			teamApplications = append(teamApplications, &team.TeamApplication{
-				ApplicationId:   t.ApplicationID,
-				TeamId:          t.TeamID,
-				Reason:          t.Reason,
-				CreatedTime:     t.CreatedTime.Unix(),
-				ApplicationType: t.ApplicationType,
-				MemberInfo: &team.MemberInfo{
-					UserId: t.UserID,
-				},
-			})
-		}
+		teamApplications = append(teamApplications, ConvertTeamApplication(t))
 	}
 	return teamApplications, nil
 }
-func TeamAddUser(team_id int32, member_id int32) error {
-	var record TeamUserRelationship
-	DB.Where("team_id = ? AND user_id = ?", team_id, member_id).First(&record)
-	if record.TeamUserID != 0 {
-		return nil
+
+// ConvertTeamApplication 将数据库中的申请转换为 rpc 返回的申请，申请人只包含 user_id
+func ConvertTeamApplication(t *TeamApplication) *team.TeamApplication {
+	application := &team.TeamApplication{
+		ApplicationId:   t.ApplicationID,
+		TeamId:          t.TeamID,
+		Reason:          t.Reason,
+		CreatedTime:     t.CreatedTime.Unix(),
+		ApplicationType: t.ApplicationType,
+		MemberInfo: &team.MemberInfo{
+			UserId: t.UserID,
+		},
+		Status:    t.Status,
+		DecidedBy: t.DecidedBy,
 	}
-	if err := DB.Create(&TeamUserRelationship{
-		UserID: member_id,
-		TeamID: team_id,
-	}).Error; err != nil {
+	if t.DecidedTime != nil {
+		application.DecidedTime = t.DecidedTime.Unix()
+	}
+	return application
+}
+
+func TeamAddUser(team_id int32, member_id int32, team_size_max int32) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		return teamAddUser(tx, team_id, member_id, team_size_max)
+	})
+}
+
+// teamAddUser 在事务中锁定队伍与用户的成员关系后将用户加入队伍并更新队伍人数，用户已是队员时不做任何修改
+// 加入后撤回用户在该赛事中其他待处理的申请，并取消其收到的该赛事其他待处理的邀请
+// 用户已加入同一赛事的其他队伍时返回 errno.AlreadyInContestTeamErr，队伍人数达到 team_size_max 时返回 errno.TeamFullErr，team_size_max 为 0 时不限制人数
+func teamAddUser(tx *gorm.DB, team_id int32, member_id int32, team_size_max int32) error {
+	teamInfo, err := lockTeam(tx, team_id)
+	if err != nil {
+		return err
+	}
+	joined, err := userContestTeams(tx, member_id, teamInfo.ContestID)
+	if err != nil {
 		return err
 	}
-	// 更新 cur number
 	var count int64
-	if err := DB.Model(&TeamUserRelationship{}).Where("team_id = ?", team_id).Count(&count).Error; err != nil {
+	if team_size_max > 0 {
+		if err := tx.Model(&TeamUserRelationship{}).Where("team_id = ?", team_id).Count(&count).Error; err != nil {
+			return err
+		}
+	}
+	member, err := checkJoin(team_id, joined, count, team_size_max)
+	if err != nil || member {
 		return err
 	}
-
-	if err := DB.Model(&TeamInfo{}).Where("team_id = ?", team_id).Update("cur_people_num", count).Error; err != nil {
+	// 并发加入同一赛事的其他队伍时由唯一索引 (user_id, contest_id) 拒绝
+	if err := tx.Create(&TeamUserRelationship{
+		UserID:    member_id,
+		TeamID:    team_id,
+		ContestID: teamInfo.ContestID,
+	}).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.AlreadyInContestTeamErr
+		}
 		return err
 	}
-	return nil
+	if err := withdrawContestApplications(tx, member_id, teamInfo.ContestID); err != nil {
+		return err
+	}
+	if err := cancelContestInvitations(tx, member_id, teamInfo.ContestID); err != nil {
+		return err
+	}
+	return recountMembers(tx, team_id)
 }
 
-func TeamManageAction(user_id int32, application_id int32, action_type int32) error {
+// checkJoin 根据用户在赛事中已加入的队伍与队伍当前人数判断用户能否加入队伍，用户已是队伍成员时返回 true
+// team_size_max 不大于 0 时不限制人数
+func checkJoin(team_id int32, joined []int32, count int64, team_size_max int32) (bool, error) {
+	for _, id := range joined {
+		if id == team_id {
+			return true, nil
+		}
+	}
+	if len(joined) > 0 {
+		return false, errno.AlreadyInContestTeamErr
+	}
+	if team_size_max > 0 && count >= int64(team_size_max) {
+		return false, errno.TeamFullErr
+	}
+	return false, nil
+}
+
+// TeamManageAction 队长处理 since 之后提交的待处理申请，action_type 为 1 时接受并将申请人加入队伍，否则拒绝，返回被处理的申请
+// 接受申请时队伍人数不能超过 team_size_max，申请人不能已加入同一赛事的其他队伍，否则申请保持待处理
+func TeamManageAction(user_id int32, application_id int32, action_type int32, since time.Time, team_size_max int32) (*TeamApplication, error) {
 	var teamApplication TeamApplication
 	if err := DB.Where("application_id = ?", application_id).First(&teamApplication).Error; err != nil {
-		return err
+		return nil, err
 	}
 	var teamInfo TeamInfo
 	if err := DB.Select("leader_id").Where("team_id = ?", teamApplication.TeamID).First(&teamInfo).Error; err != nil {
-		return err
+		return nil, err
 	}
 	// 只有队长才能处理申请
 	if teamInfo.LeaderID != user_id {
-		return errno.AuthorizationFailedErr
+		return nil, errno.AuthorizationFailedErr
 	}
 
-	// 接受申请
+	status := ApplicationStatusRejected
 	if action_type == 1 {
-		TeamAddUser(teamApplication.TeamID, teamApplication.UserID)
+		status = ApplicationStatusAccepted
 	}
-
-	if err := DB.Model(&teamApplication).Update("application_type", 0).Error; err != nil {
-		return err
+	err := DB.Transaction(func(tx *gorm.DB) error {
+		if err := transitApplication(tx, &teamApplication, status, user_id, since); err != nil {
+			return err
+		}
+		// 接受申请
+		if status == ApplicationStatusAccepted {
+			return teamAddUser(tx, teamApplication.TeamID, teamApplication.UserID, team_size_max)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return &teamApplication, nil
 }
 
 // GetContestTeamsWithEmbedding 获取竞赛下的队伍及其 embedding
@@ -494,7 +696,8 @@ func GetContestTeamsWithEmbedding(contestID int32) ([]*team.TeamInfo, error) {
 
 // CalculatePositionMatchScore 计算用户与岗位的匹配度
 func CalculatePositionMatchScore(userEmbedding []float64, positionEmbedding PositionEmbedding) float64 {
-	if len(userEmbedding) == 0 || len(positionEmbedding.Embedding) == 0 {
+	// 维度不一致说明两个向量来自不同的模型，无法比较
+	if len(userEmbedding) == 0 || len(userEmbedding) != len(positionEmbedding.Embedding) {
 		return 0.0
 	}
 
@@ -512,3 +715,62 @@ func CalculatePositionMatchScore(userEmbedding []float64, positionEmbedding Posi
 
 	return dotProduct / (math.Sqrt(userNorm) * math.Sqrt(positionNorm))
 }
+
+// QueryTeamsForReindex 按 team_id 递增分批获取队伍的 embedding 元信息，contest_id 为 0 时遍历所有赛事
+func QueryTeamsForReindex(contest_id int32, after_id int32, limit int) ([]*TeamInfo, error) {
+	tx := DB.Select("team_id", "contest_id", "embedding", "embedding_updated_time", "embedding_version").
+		Where("team_id > ?", after_id)
+	if contest_id != 0 {
+		tx = tx.Where("contest_id = ?", contest_id)
+	}
+	var teams []*TeamInfo
+	if err := tx.Order("team_id").Limit(limit).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// QueryTeamEmbeddings 获取赛事下所有队伍的 embedding，contest_id 为 0 时获取所有赛事的队伍
+func QueryTeamEmbeddings(contest_id int32) ([]*TeamInfo, error) {
+	tx := DB.Select("team_id", "contest_id", "created_time", "embedding")
+	if contest_id != 0 {
+		tx = tx.Where("contest_id = ?", contest_id)
+	}
+	var teams []*TeamInfo
+	if err := tx.Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// QueryTeamEmbedding 获取单个队伍的 embedding
+func QueryTeamEmbedding(team_id int32) (*TeamInfo, error) {
+	t := &TeamInfo{}
+	if err := DB.Select("team_id", "contest_id", "created_time", "embedding").
+		Where("team_id = ?", team_id).First(t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// QueryTeamSkillsByContest 获取赛事下所有队伍的技能需求，contest_id 为 0 时获取所有赛事的队伍
+func QueryTeamSkillsByContest(contest_id int32) ([]*TeamSkills, error) {
+	tx := DB.Model(&TeamSkills{})
+	if contest_id != 0 {
+		tx = tx.Where("team_id IN (?)", DB.Model(&TeamInfo{}).Select("team_id").Where("contest_id = ?", contest_id))
+	}
+	var skills []*TeamSkills
+	if err := tx.Find(&skills).Error; err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+// QueryTeamSkillsByTeam 获取单个队伍的技能需求
+func QueryTeamSkillsByTeam(team_id int32) ([]*TeamSkills, error) {
+	var skills []*TeamSkills
+	if err := DB.Where("team_id = ?", team_id).Find(&skills).Error; err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
@@ -0,0 +1,139 @@
+package db
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+// TestCheckJoin 测试加入队伍时的成员唯一性与人数上限判断
+func TestCheckJoin(t *testing.T) {
+	tests := []struct {
+		name       string
+		joined     []int32
+		count      int64
+		max        int32
+		wantMember bool
+		wantErr    error
+	}{
+		{name: "可以加入", count: 2, max: 3},
+		{name: "不限人数", count: 10, max: 0},
+		{name: "已是队伍成员", joined: []int32{1}, count: 3, max: 3, wantMember: true},
+		{name: "已加入赛事其他队伍", joined: []int32{2}, count: 1, max: 3, wantErr: errno.AlreadyInContestTeamErr},
+		{name: "人数已满", count: 3, max: 3, wantErr: errno.TeamFullErr},
+	}
+	for _, tt := range tests {
+		member, err := checkJoin(1, tt.joined, tt.count, tt.max)
+		if member != tt.wantMember || err != tt.wantErr {
+			t.Errorf("%s: checkJoin() = %v, %v, want %v, %v", tt.name, member, err, tt.wantMember, tt.wantErr)
+		}
+	}
+}
+
+// TestTeamAddUserCapacity 测试队伍人数达到上限后不能再加入，人数按成员关系统计
+func TestTeamAddUserCapacity(t *testing.T) {
+	setupTestDB(t)
+	team_id := createTestTeam(t, 1, 1)
+	if err := TeamAddUser(team_id, 2, 2); err != nil {
+		t.Fatalf("TeamAddUser() = %v", err)
+	}
+	if err := TeamAddUser(team_id, 3, 2); err != errno.TeamFullErr {
+		t.Fatalf("TeamAddUser() on a full team = %v, want TeamFullErr", err)
+	}
+	// 已是队伍成员时不重复加入
+	if err := TeamAddUser(team_id, 2, 2); err != nil {
+		t.Fatalf("TeamAddUser() for an existing member = %v", err)
+	}
+	var teamInfo TeamInfo
+	if err := DB.Where("team_id = ?", team_id).First(&teamInfo).Error; err != nil {
+		t.Fatal(err)
+	}
+	if teamInfo.CurPeopleNum != 2 {
+		t.Errorf("cur_people_num = %d, want 2", teamInfo.CurPeopleNum)
+	}
+}
+
+// TestTeamAddUserUniqueness 测试同一用户在同一赛事中只能加入一个队伍，包括并发加入的情况
+func TestTeamAddUserUniqueness(t *testing.T) {
+	setupTestDB(t)
+	a := createTestTeam(t, 1, 1)
+	b := createTestTeam(t, 2, 1)
+	other := createTestTeam(t, 3, 2)
+	if err := TeamAddUser(a, 10, 0); err != nil {
+		t.Fatalf("TeamAddUser() = %v", err)
+	}
+	if err := TeamAddUser(b, 10, 0); err != errno.AlreadyInContestTeamErr {
+		t.Fatalf("TeamAddUser() to another team in the contest = %v, want AlreadyInContestTeamErr", err)
+	}
+	if err := TeamAddUser(other, 10, 0); err != nil {
+		t.Fatalf("TeamAddUser() to a team in another contest = %v", err)
+	}
+	if _, err := CreateTeam(10, 1, "team", "", "", nil); err != errno.AlreadyInContestTeamErr {
+		t.Fatalf("CreateTeam() by a member of the contest = %v, want AlreadyInContestTeamErr", err)
+	}
+
+	// 并发加入同一赛事的两个队伍时只有一个成功
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, team_id := range []int32{a, b} {
+		wg.Add(1)
+		go func(i int, team_id int32) {
+			defer wg.Done()
+			errs[i] = TeamAddUser(team_id, 11, 0)
+		}(i, team_id)
+	}
+	wg.Wait()
+	var succeeded int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, errno.AlreadyInContestTeamErr):
+			t.Errorf("concurrent TeamAddUser() = %v, want nil or AlreadyInContestTeamErr", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d concurrent joins succeeded, want 1", succeeded)
+	}
+}
+
+// TestJoinWithdrawsContestApplications 测试申请人加入队伍后，其向同一赛事其他队伍的待处理申请被撤回，其他赛事的申请不受影响
+func TestJoinWithdrawsContestApplications(t *testing.T) {
+	setupTestDB(t)
+	a := createTestTeam(t, 1, 1)
+	b := createTestTeam(t, 2, 1)
+	other := createTestTeam(t, 3, 2)
+	now := time.Now().Unix()
+	applyA, err := CreateTeamApplication(10, a, "", now, 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	applyB, err := CreateTeamApplication(10, b, "", now, 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	applyOther, err := CreateTeamApplication(10, other, "", now, 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := TeamManageAction(1, applyA, 1, time.Time{}, 0); err != nil {
+		t.Fatalf("TeamManageAction() = %v", err)
+	}
+	for id, want := range map[int32]string{
+		applyA:     ApplicationStatusAccepted,
+		applyB:     ApplicationStatusWithdrawn,
+		applyOther: ApplicationStatusPending,
+	} {
+		if got := applicationStatusOf(t, id); got != want {
+			t.Errorf("application %d status = %s, want %s", id, got, want)
+		}
+	}
+	// 被撤回的申请不能再被接受
+	if _, err := TeamManageAction(2, applyB, 1, time.Time{}, 0); err != errno.ApplicationNotPendingErr {
+		t.Errorf("TeamManageAction() on a withdrawn application = %v, want ApplicationNotPendingErr", err)
+	}
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"os"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// setupTestDB 连接 TEAM_TEST_MYSQL_DSN 指定的 MySQL 测试库并清空队伍相关的表，未设置时跳过测试
+// 测试库中的数据会被删除，不要指向正在使用的数据库
+func setupTestDB(t *testing.T) {
+	t.Helper()
+	dsn := os.Getenv("TEAM_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("未设置 TEAM_TEST_MYSQL_DSN, 跳过需要数据库的测试")
+	}
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{SkipDefaultTransaction: true, TranslateError: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	DB = db
+	if err := DB.AutoMigrate(&TeamInfo{}, &TeamSkills{}, &TeamApplication{}, &TeamUserRelationship{}, &EmbeddingJob{}, &TeamInvitation{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []string{"team_info", "team_skills", "team_application", "team_user_relationship", "team_embedding_job", "team_invitation"} {
+		if err := DB.Exec("DELETE FROM " + table).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// createTestTeam 创建队长为 leader_id 的队伍，返回 team_id
+func createTestTeam(t *testing.T, leader_id int32, contest_id int32) int32 {
+	t.Helper()
+	team_id, err := CreateTeam(leader_id, contest_id, "team", "", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return team_id
+}
+
+// applicationStatusOf 返回申请当前的状态
+func applicationStatusOf(t *testing.T, application_id int32) string {
+	t.Helper()
+	var application TeamApplication
+	if err := DB.Where("application_id = ?", application_id).First(&application).Error; err != nil {
+		t.Fatal(err)
+	}
+	return application.Status
+}
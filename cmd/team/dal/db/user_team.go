@@ -0,0 +1,86 @@
+package db
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 用户在队伍中的角色
+const (
+	RoleLeader = "leader"
+	RoleMember = "member"
+)
+
+// UserApplication 用户提交的申请及申请队伍的信息
+type UserApplication struct {
+	TeamApplication `gorm:"embedded"`
+	TeamTitle       string `gorm:"column:team_title"`
+	ContestID       int32  `gorm:"column:contest_id"`
+}
+
+// statusFilter 按状态过滤 table 中的申请或邀请，since 之前创建且仍待处理的记录视为已过期，status 为空时不过滤
+// 申请与邀请的待处理、已过期状态取值相同，共用同一套过滤条件
+func statusFilter(tx *gorm.DB, table string, status string, since time.Time) *gorm.DB {
+	switch {
+	case status == "":
+		return tx
+	case since.IsZero():
+		return tx.Where(table+".status = ?", status)
+	case status == ApplicationStatusPending:
+		return tx.Where(table+".status = ? AND "+table+".created_time >= ?", ApplicationStatusPending, since)
+	case status == ApplicationStatusExpired:
+		return tx.Where(table+".status = ? OR ("+table+".status = ? AND "+table+".created_time < ?)",
+			ApplicationStatusExpired, ApplicationStatusPending, since)
+	default:
+		return tx.Where(table+".status = ?", status)
+	}
+}
+
+// QueryUserApplications 按提交时间倒序分页获取用户提交的申请及申请队伍的标题与所属赛事，返回申请总数
+func QueryUserApplications(user_id int32, status string, since time.Time, limit int32, offset int32) ([]*UserApplication, int64, error) {
+	q := DB.Table(TeamApplication{}.TableName()).
+		Joins("LEFT JOIN team_info ON team_info.team_id = team_application.team_id").
+		Where("team_application.user_id = ?", user_id)
+	// 计数与分页查询复用同一组条件
+	q = statusFilter(q, TeamApplication{}.TableName(), status, since).Session(&gorm.Session{})
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var applications []*UserApplication
+	if err := q.Select("team_application.*, team_info.title AS team_title, team_info.contest_id AS contest_id").
+		Order("team_application.created_time DESC").Limit(int(limit)).Offset(int(offset)).
+		Scan(&applications).Error; err != nil {
+		return nil, 0, err
+	}
+	return applications, total, nil
+}
+
+// QueryUserTeams 按创建时间倒序分页获取用户担任队长或加入的所有赛事的队伍，role 为空时不按角色过滤，返回队伍总数
+func QueryUserTeams(user_id int32, role string, limit int32, offset int32) ([]*TeamInfo, int64, error) {
+	q := DB.Model(&TeamInfo{}).
+		Joins("JOIN team_user_relationship ON team_user_relationship.team_id = team_info.team_id").
+		Where("team_user_relationship.user_id = ?", user_id)
+	switch role {
+	case RoleLeader:
+		q = q.Where("team_info.leader_id = ?", user_id)
+	case RoleMember:
+		q = q.Where("team_info.leader_id != ?", user_id)
+	}
+	q = q.Session(&gorm.Session{})
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var teams []*TeamInfo
+	if err := q.Select("team_info.team_id", "team_info.contest_id", "team_info.title", "team_info.goal",
+		"team_info.cur_people_num", "team_info.created_time", "team_info.leader_id", "team_info.closed").
+		Order("team_info.created_time DESC").Limit(int(limit)).Offset(int(offset)).
+		Find(&teams).Error; err != nil {
+		return nil, 0, err
+	}
+	return teams, total, nil
+}
@@ -1,7 +1,24 @@
 package dal
 
-import "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+import (
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/redis"
+)
 
-func Init() {
-    db.Init()
+// Init 连接数据库并执行迁移，迁移失败时返回错误
+func Init() error {
+    if err := db.Init(); err != nil {
+        return err
+    }
+    redis.Init()
+    return nil
+}
+
+// Connect 只连接数据库与 redis，不执行表结构与数据迁移，供只读的离线命令使用
+func Connect() error {
+    if err := db.Connect(); err != nil {
+        return err
+    }
+    redis.Init()
+    return nil
 }
@@ -0,0 +1,30 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+)
+
+const teamListSnapshotPrefix = "team_list_snapshot:"
+
+type (
+	TeamListSnapshot struct{}
+)
+
+func getTeamListSnapshotKeyStr(contest_id int32, user_id int32) string {
+	return teamListSnapshotPrefix + strconv.Itoa(int(contest_id)) + ":" + strconv.Itoa(int(user_id))
+}
+
+// SetTeamListSnapshot 缓存用户在赛事中的队伍排序快照到 team_list_snapshot:contest_id:user_id，覆盖之前的快照
+func (s TeamListSnapshot) SetTeamListSnapshot(contest_id int32, user_id int32, value string, ttl time.Duration) error {
+	return rdb.Set(getTeamListSnapshotKeyStr(contest_id, user_id), value, ttl).Err()
+}
+
+// GetTeamListSnapshot 获取用户在赛事中的队伍排序快照，不存在、已过期或出错时返回 false
+func (s TeamListSnapshot) GetTeamListSnapshot(contest_id int32, user_id int32) (string, bool) {
+	v, err := rdb.Get(getTeamListSnapshotKeyStr(contest_id, user_id)).Result()
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
@@ -7,32 +7,40 @@ import (
 	"time"
 
 	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
-	"github.com/Yra-A/Fusion_Go/pkg/configs/openai"
-	openaiClient "github.com/sashabaranov/go-openai"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
 )
 
+// PromptVersion 岗位描述模板的版本，修改 GeneratePositionEmbedding 中的模板后需要递增，
+// 以便 reindex 识别出需要重新生成的队伍
+const PromptVersion = "v1"
+
+// Version 返回 embedding 的版本标记，由模型名称和模板版本组成
+func Version(e embedder.Embedder) string {
+	return e.Model() + "@" + PromptVersion
+}
+
 // Service 负责生成和管理队伍的embedding
 type Service struct {
-	Ctx            context.Context
+	Ctx              context.Context
 	TeamInfoProvider TeamInfoProvider
-	OpenAIClient   *openaiClient.Client
+	Embedder         embedder.Embedder
 }
 
 // NewService 创建一个新的 Service 实例
-func NewService(ctx context.Context, provider TeamInfoProvider, client *openaiClient.Client) *Service {
+func NewService(ctx context.Context, provider TeamInfoProvider, e embedder.Embedder) *Service {
 	return &Service{
-		Ctx:            ctx,
+		Ctx:              ctx,
 		TeamInfoProvider: provider,
-		OpenAIClient:   client,
+		Embedder:         e,
 	}
 }
 
 // GenerateTeamEmbedding 生成队伍的embedding
-func (s *Service) GenerateTeamEmbedding(teamInfo *team.TeamInfo) ([]float32, error) {
+func (s *Service) GenerateTeamEmbedding(teamInfo *team.TeamInfo) ([]float64, error) {
 	// 1. 构建队伍的文本描述
 	description := s.buildTeamDescription(teamInfo)
 
-	// 2. 调用LLM API生成embedding
+	// 2. 调用 embedding 后端生成embedding
 	embedding, err := s.callLLMAPI(description)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate embedding: %v", err)
@@ -42,12 +50,12 @@ func (s *Service) GenerateTeamEmbedding(teamInfo *team.TeamInfo) ([]float32, err
 }
 
 // GeneratePositionEmbedding 生成单个岗位的embedding
-func (s *Service) GeneratePositionEmbedding(job, skill, category, description, goal string) ([]float32, error) {
+func (s *Service) GeneratePositionEmbedding(job, skill, category, description, goal string) ([]float64, error) {
 	// 构建岗位的文本描述
 	embeddingText := fmt.Sprintf("岗位名称：%s\n技能要求：%s\n技能分类：%s\n队伍详细介绍：%s\n目标：%s",
 		job, skill, category, description, goal)
 	
-	// 调用LLM API生成embedding
+	// 调用 embedding 后端生成embedding
 	return s.callLLMAPI(embeddingText)
 }
 
@@ -71,25 +79,9 @@ func (s *Service) buildTeamDescription(teamInfo *team.TeamInfo) string {
 	return sb.String()
 }
 
-// callLLMAPI 调用LLM API生成embedding
-func (s *Service) callLLMAPI(text string) ([]float32, error) {
-	// 调用OpenAI API生成embedding
-	resp, err := s.OpenAIClient.CreateEmbeddings(
-		context.Background(),
-		openaiClient.EmbeddingRequest{
-			Input: text,
-			Model: openai.EmbeddingModel,
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create embedding: %v", err)
-	}
-
-	if len(resp.Data) == 0 {
-		return nil, fmt.Errorf("no embedding data returned")
-	}
-
-	return resp.Data[0].Embedding, nil
+// callLLMAPI 调用 embedding 后端生成embedding
+func (s *Service) callLLMAPI(text string) ([]float64, error) {
+	return s.Embedder.Embed(s.Ctx, text)
 }
 
 // UpdateTeamEmbedding 更新队伍的embedding
@@ -104,26 +96,19 @@ func (s *Service) UpdateTeamEmbedding(teamID int32) error {
 	fmt.Printf("成功获取队伍信息: %+v\n", teamInfo)
 
 	// 2. 生成embedding
-	embedding32, err := s.GenerateTeamEmbedding(teamInfo)
+	embedding, err := s.GenerateTeamEmbedding(teamInfo)
 	if err != nil {
 		return fmt.Errorf("生成embedding失败: %v", err)
 	}
-	fmt.Printf("成功生成embedding，长度: %d\n", len(embedding32))
+	fmt.Printf("成功生成embedding，长度: %d\n", len(embedding))
 
 	// 3. 检查embedding长度
-	if len(embedding32) == 0 {
+	if len(embedding) == 0 {
 		return fmt.Errorf("empty embedding vector")
 	}
 
-	// 4. 将 float32 转换为 float64
-	embedding64 := make([]float64, len(embedding32))
-	for i, v := range embedding32 {
-		embedding64[i] = float64(v)
-	}
-	fmt.Printf("转换后的embedding64长度: %d\n", len(embedding64))
-
-	// 5. 更新数据库
-	if err := s.TeamInfoProvider.UpdateTeamEmbedding(teamID, embedding64, time.Now()); err != nil {
+	// 4. 更新数据库
+	if err := s.TeamInfoProvider.UpdateTeamEmbedding(teamID, embedding, time.Now()); err != nil {
 		return fmt.Errorf("更新数据库失败: %v", err)
 	}
 	fmt.Printf("成功更新数据库中的embedding\n")
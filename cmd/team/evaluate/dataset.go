@@ -0,0 +1,122 @@
+package evaluate
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/service"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// profileBatchSize 加载数据集时每次 rpc 获取的用户档案数
+const profileBatchSize = 200
+
+// Case 一条历史组队记录：用户在赛事中最终加入的队伍即为推荐的正样本
+type Case struct {
+	UserID    int32
+	ContestID int32
+	// Time 回放的时间点，取用户最后一次被接受的申请时间，没有申请记录时为当前时间
+	// 该时间之后创建的队伍不参与排序，时间衰减也以该时间计算
+	Time      time.Time
+	Positives map[int32]bool
+}
+
+// Dataset 回放所需的历史数据
+type Dataset struct {
+	Cases    []*Case
+	Teams    []*db.TeamInfo
+	Skills   []*db.TeamSkills
+	Profiles map[int32]*user.UserProfileInfo // 按 user_id 索引的用户档案
+}
+
+// BuildCases 按用户与赛事汇总队长以外的队员关系，并以被接受的申请时间作为回放时间点
+func BuildCases(members []*db.TeamMember, applications []*db.TeamApplication, now time.Time) []*Case {
+	type key struct{ userID, contestID int32 }
+	joined := make(map[key]*Case)
+	var keys []key
+	contestOf := make(map[int32]int32)
+	for _, m := range members {
+		k := key{m.UserID, m.ContestID}
+		c, ok := joined[k]
+		if !ok {
+			c = &Case{UserID: m.UserID, ContestID: m.ContestID, Positives: make(map[int32]bool)}
+			joined[k] = c
+			keys = append(keys, k)
+		}
+		c.Positives[m.TeamID] = true
+		contestOf[m.TeamID] = m.ContestID
+	}
+
+	// 已处理且申请人已成为队员的申请即为被接受的申请
+	for _, a := range applications {
+		c, ok := joined[key{a.UserID, contestOf[a.TeamID]}]
+		if !ok || !c.Positives[a.TeamID] {
+			continue
+		}
+		if a.CreatedTime.After(c.Time) {
+			c.Time = a.CreatedTime
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].contestID != keys[j].contestID {
+			return keys[i].contestID < keys[j].contestID
+		}
+		return keys[i].userID < keys[j].userID
+	})
+	cases := make([]*Case, 0, len(keys))
+	for _, k := range keys {
+		c := joined[k]
+		if c.Time.IsZero() {
+			c.Time = now
+		}
+		cases = append(cases, c)
+	}
+	return cases
+}
+
+// LoadDataset 从数据库读取队伍与组队记录，并通过用户服务获取用户档案，contestID 为 0 时读取所有赛事
+func LoadDataset(ctx context.Context, contestID int32) (*Dataset, error) {
+	members, err := db.QueryTeamMembers(contestID)
+	if err != nil {
+		return nil, err
+	}
+	applications, err := db.QueryHandledApplications(contestID)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := db.QueryTeamsForEvaluation(contestID)
+	if err != nil {
+		return nil, err
+	}
+	skills, err := db.QueryTeamSkillsByContest(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &Dataset{
+		Cases:    BuildCases(members, applications, time.Now()),
+		Teams:    teams,
+		Skills:   skills,
+		Profiles: make(map[int32]*user.UserProfileInfo),
+	}
+	// 用户档案分批获取，用户不存在时该用户的记录被跳过
+	seen := make(map[int32]bool)
+	var ids []int32
+	for _, c := range ds.Cases {
+		if !seen[c.UserID] {
+			seen[c.UserID] = true
+			ids = append(ids, c.UserID)
+		}
+	}
+	profiles, err := service.FetchUserProfiles(ctx, ids, profileBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		ds.Profiles[id] = profiles[id]
+	}
+	return ds, nil
+}
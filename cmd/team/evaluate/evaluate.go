@@ -0,0 +1,214 @@
+package evaluate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal"
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rpc"
+	conf "github.com/Yra-A/Fusion_Go/pkg/configs/embedding"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+)
+
+// 报告格式
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+// Options evaluate 子命令的参数
+type Options struct {
+	ContestID int32  // 为 0 时评估所有赛事
+	K         []int  // 计算 precision、recall、NDCG 的截断位置
+	Format    string // 报告格式，json 或 markdown
+	Output    string // 报告输出的文件，为空时输出到标准输出
+	Dimension int    // 本地 embedding 的维度
+}
+
+// ParseFlags 解析 evaluate 子命令的参数
+func ParseFlags(args []string, output io.Writer) (*Options, error) {
+	opts := &Options{}
+	var contestID int
+	var ks string
+	fs := flag.NewFlagSet("evaluate", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.IntVar(&contestID, "contest", 0, "只评估该赛事下的组队记录，为 0 时评估所有赛事")
+	fs.StringVar(&ks, "k", "1,5,10", "计算 precision、recall、NDCG 的截断位置，以逗号分隔")
+	fs.StringVar(&opts.Format, "format", FormatMarkdown, "报告格式: json 或 markdown")
+	fs.StringVar(&opts.Output, "o", "", "报告输出的文件，默认输出到标准输出")
+	fs.IntVar(&opts.Dimension, "dim", conf.LocalDimension, "本地 embedding 的维度")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	opts.ContestID = int32(contestID)
+	for _, s := range strings.Split(ks, ",") {
+		k, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || k <= 0 {
+			return nil, fmt.Errorf("-k 格式错误: %q", s)
+		}
+		opts.K = append(opts.K, k)
+	}
+	if opts.Format != FormatJSON && opts.Format != FormatMarkdown {
+		return nil, fmt.Errorf("-format 只能为 %s 或 %s", FormatJSON, FormatMarkdown)
+	}
+	if opts.Dimension <= 0 {
+		return nil, errors.New("-dim 必须大于 0")
+	}
+	return opts, nil
+}
+
+// Main evaluate 子命令入口，返回进程退出码
+func Main(args []string) int {
+	opts, err := ParseFlags(args, os.Stderr)
+	if err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return 2
+	}
+	// 评估只读取数据，不执行迁移
+	if err := dal.Connect(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	rpc.InitRPC()
+
+	ctx := context.Background()
+	ds, err := LoadDataset(ctx, opts.ContestID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	// 使用本地 embedding 重新生成所有向量，评估无需访问远程 embedding 接口
+	report, err := Run(ctx, opts, ds, embedder.NewLocal(opts.Dimension), DefaultScorers())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	out := io.Writer(os.Stdout)
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+	if opts.Format == FormatJSON {
+		err = report.WriteJSON(out)
+	} else {
+		err = report.WriteMarkdown(out)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// Run 使用 e 重新生成队伍与用户的 embedding，回放每条组队记录，计算各排序方式的平均指标
+func Run(ctx context.Context, opts *Options, ds *Dataset, e embedder.Embedder, scorers []Scorer) (*Report, error) {
+	svc := embedding.NewService(ctx, nil, e)
+	teams := make([]*db.TeamInfo, 0, len(ds.Teams))
+	skillsByTeam := make(map[int32][]*db.TeamSkills)
+	for _, s := range ds.Skills {
+		skillsByTeam[s.TeamID] = append(skillsByTeam[s.TeamID], s)
+	}
+	leaders := make(map[int32]int32, len(ds.Teams))
+	for _, t := range ds.Teams {
+		leaders[t.TeamID] = t.LeaderID
+		te, err := db.BuildTeamEmbedding(svc, t, skillsByTeam[t.TeamID])
+		if err != nil {
+			return nil, fmt.Errorf("生成队伍 %d 的 embedding 失败: %v", t.TeamID, err)
+		}
+		b, err := json.Marshal(te)
+		if err != nil {
+			return nil, err
+		}
+		copied := *t
+		copied.Embedding = string(b)
+		teams = append(teams, &copied)
+	}
+	idx := index.New(time.Hour, index.NewMemoryLoader(teams, ds.Skills))
+
+	report := &Report{
+		GeneratedAt:   time.Now(),
+		Model:         e.Model(),
+		PromptVersion: embedding.PromptVersion,
+		ContestID:     opts.ContestID,
+		K:             append([]int(nil), opts.K...),
+	}
+	sort.Ints(report.K)
+	for _, s := range scorers {
+		report.Scorers = append(report.Scorers, &ScorerReport{
+			Name:        s.Name,
+			Description: s.Description,
+			Precision:   make(map[int]float64),
+			Recall:      make(map[int]float64),
+			NDCG:        make(map[int]float64),
+		})
+	}
+
+	for _, c := range ds.Cases {
+		profile := ds.Profiles[c.UserID]
+		if profile == nil || profile.UserInfo == nil || !profile.UserInfo.HasProfile {
+			report.Skipped++
+			continue
+		}
+		query, err := e.Embed(ctx, embedder.UserProfileText(profile))
+		if err != nil {
+			return nil, fmt.Errorf("生成用户 %d 的 embedding 失败: %v", c.UserID, err)
+		}
+		results, err := idx.Search(c.ContestID, query, e.Model(), 0)
+		if err != nil {
+			return nil, err
+		}
+		// 只保留回放时间点之前已创建、且不由用户担任队长的队伍
+		candidates := make([]*index.Result, 0, len(results))
+		for _, r := range results {
+			if r.CreatedTime > c.Time.Unix() || leaders[r.TeamID] == c.UserID {
+				continue
+			}
+			candidates = append(candidates, r)
+		}
+
+		report.Cases++
+		for i, s := range scorers {
+			ranked := s.Rank(profile, candidates, c.Time)
+			sr := report.Scorers[i]
+			for _, k := range report.K {
+				sr.Precision[k] += precisionAtK(ranked, c.Positives, k)
+				sr.Recall[k] += recallAtK(ranked, c.Positives, k)
+				sr.NDCG[k] += ndcgAtK(ranked, c.Positives, k)
+			}
+			sr.MRR += reciprocalRank(ranked, c.Positives)
+		}
+	}
+
+	if report.Cases > 0 {
+		n := float64(report.Cases)
+		for _, sr := range report.Scorers {
+			for _, k := range report.K {
+				sr.Precision[k] /= n
+				sr.Recall[k] /= n
+				sr.NDCG[k] /= n
+			}
+			sr.MRR /= n
+		}
+	}
+	return report, nil
+}
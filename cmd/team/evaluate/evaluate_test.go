@@ -0,0 +1,122 @@
+package evaluate
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+)
+
+// TestMetrics 测试排序指标的计算
+func TestMetrics(t *testing.T) {
+	ranked := []int32{3, 1, 4, 2}
+	positives := map[int32]bool{1: true, 2: true}
+
+	if got := precisionAtK(ranked, positives, 2); got != 0.5 {
+		t.Errorf("precisionAtK() = %v, want 0.5", got)
+	}
+	if got := recallAtK(ranked, positives, 2); got != 0.5 {
+		t.Errorf("recallAtK() = %v, want 0.5", got)
+	}
+	if got := recallAtK(ranked, positives, 10); got != 1 {
+		t.Errorf("recallAtK() beyond list = %v, want 1", got)
+	}
+	// 正样本位于第 2、4 位，理想排序位于第 1、2 位
+	want := (1/math.Log2(3) + 1/math.Log2(5)) / (1 + 1/math.Log2(3))
+	if got := ndcgAtK(ranked, positives, 4); math.Abs(got-want) > 1e-9 {
+		t.Errorf("ndcgAtK() = %v, want %v", got, want)
+	}
+	if got := reciprocalRank(ranked, positives); got != 0.5 {
+		t.Errorf("reciprocalRank() = %v, want 0.5", got)
+	}
+	if got := reciprocalRank(ranked, map[int32]bool{9: true}); got != 0 {
+		t.Errorf("reciprocalRank() without hits = %v, want 0", got)
+	}
+}
+
+// TestBuildCases 测试按用户与赛事汇总正样本，并只使用被接受的申请时间作为回放时间点
+func TestBuildCases(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	members := []*db.TeamMember{
+		{UserID: 1, TeamID: 10, ContestID: 100},
+		{UserID: 1, TeamID: 11, ContestID: 100},
+		{UserID: 2, TeamID: 10, ContestID: 100},
+	}
+	applications := []*db.TeamApplication{
+		{UserID: 1, TeamID: 10, CreatedTime: now.Add(-48 * time.Hour)},
+		{UserID: 1, TeamID: 11, CreatedTime: now.Add(-24 * time.Hour)},
+		// 被拒绝的申请，用户不在该队伍中
+		{UserID: 1, TeamID: 12, CreatedTime: now.Add(-time.Hour)},
+	}
+
+	cases := BuildCases(members, applications, now)
+	if len(cases) != 2 || cases[0].UserID != 1 || cases[1].UserID != 2 {
+		t.Fatalf("BuildCases() = %v, want cases for users 1 and 2", cases)
+	}
+	if len(cases[0].Positives) != 2 || !cases[0].Time.Equal(now.Add(-24*time.Hour)) {
+		t.Errorf("BuildCases() case for user 1 = %+v", cases[0])
+	}
+	if !cases[1].Time.Equal(now) {
+		t.Errorf("BuildCases() case without applications time = %v, want %v", cases[1].Time, now)
+	}
+}
+
+// TestRun 测试在内存数据上回放，技能与档案匹配的队伍排在前面，回放时间点之后创建的队伍不参与排序
+func TestRun(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ds := &Dataset{
+		Cases: []*Case{
+			{UserID: 1, ContestID: 100, Time: now, Positives: map[int32]bool{10: true}},
+			{UserID: 2, ContestID: 100, Time: now, Positives: map[int32]bool{11: true}},
+		},
+		Teams: []*db.TeamInfo{
+			{TeamID: 10, ContestID: 100, LeaderID: 3, CreatedTime: now.Add(-time.Hour), Goal: "后端开发", Description: "使用 Go 和 MySQL 开发后端服务"},
+			{TeamID: 11, ContestID: 100, LeaderID: 4, CreatedTime: now.Add(-2 * time.Hour), Goal: "前端开发", Description: "使用 React 开发网页"},
+			{TeamID: 12, ContestID: 100, LeaderID: 5, CreatedTime: now.Add(time.Hour), Goal: "后端开发", Description: "使用 Go 开发后端服务"},
+		},
+		Skills: []*db.TeamSkills{
+			{TeamID: 10, Skill: "Go", Category: "编程语言", Job: "后端"},
+			{TeamID: 11, Skill: "React", Category: "前端框架", Job: "前端"},
+			{TeamID: 12, Skill: "Go", Category: "编程语言", Job: "后端"},
+		},
+		Profiles: map[int32]*user.UserProfileInfo{
+			1: {
+				UserInfo:     &user.UserInfo{UserId: 1, HasProfile: true},
+				Introduction: "熟悉 Go 后端开发",
+				UserSkills:   []*user.UserSkill{{Skill: "Go", Category: "编程语言", Proficiency: "精通"}},
+			},
+			2: {
+				UserInfo:     &user.UserInfo{UserId: 2, HasProfile: true},
+				Introduction: "熟悉 React 网页开发",
+				UserSkills:   []*user.UserSkill{{Skill: "React", Category: "前端框架", Proficiency: "熟练"}},
+			},
+		},
+	}
+	opts := &Options{K: []int{1}}
+
+	report, err := Run(context.Background(), opts, ds, embedder.NewLocal(256), DefaultScorers())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.Cases != 2 || report.Skipped != 0 {
+		t.Fatalf("Run() cases = %d, skipped = %d, want 2 and 0", report.Cases, report.Skipped)
+	}
+	base := report.Scorers[0]
+	if base.Name != "hybrid-base" || base.Precision[1] != 1 || base.MRR != 1 {
+		t.Errorf("Run() hybrid-base scorer = %+v, want perfect ranking", base)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "| hybrid-base | 1.0000 |") {
+		t.Errorf("WriteMarkdown() = %s", buf.String())
+	}
+}
@@ -0,0 +1,57 @@
+package evaluate
+
+import "math"
+
+// precisionAtK 前 k 个推荐结果中正样本所占的比例
+func precisionAtK(ranked []int32, positives map[int32]bool, k int) float64 {
+	if k <= 0 {
+		return 0
+	}
+	return float64(hits(ranked, positives, k)) / float64(k)
+}
+
+// recallAtK 前 k 个推荐结果覆盖的正样本比例
+func recallAtK(ranked []int32, positives map[int32]bool, k int) float64 {
+	if len(positives) == 0 {
+		return 0
+	}
+	return float64(hits(ranked, positives, k)) / float64(len(positives))
+}
+
+// ndcgAtK 前 k 个推荐结果的归一化折损累计增益，正样本的相关度为 1
+func ndcgAtK(ranked []int32, positives map[int32]bool, k int) float64 {
+	var dcg, idcg float64
+	for i := 0; i < k && i < len(ranked); i++ {
+		if positives[ranked[i]] {
+			dcg += 1 / math.Log2(float64(i+2))
+		}
+	}
+	for i := 0; i < k && i < len(positives); i++ {
+		idcg += 1 / math.Log2(float64(i+2))
+	}
+	if idcg == 0 {
+		return 0
+	}
+	return dcg / idcg
+}
+
+// reciprocalRank 第一个正样本排名的倒数，推荐结果中没有正样本时为 0
+func reciprocalRank(ranked []int32, positives map[int32]bool) float64 {
+	for i, id := range ranked {
+		if positives[id] {
+			return 1 / float64(i+1)
+		}
+	}
+	return 0
+}
+
+// hits 前 k 个推荐结果中正样本的数量
+func hits(ranked []int32, positives map[int32]bool, k int) int {
+	n := 0
+	for i := 0; i < k && i < len(ranked); i++ {
+		if positives[ranked[i]] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,75 @@
+package evaluate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Report 离线评估的结果
+type Report struct {
+	GeneratedAt   time.Time       `json:"generated_at"`
+	Model         string          `json:"model"`          // 回放时生成 embedding 使用的模型
+	PromptVersion string          `json:"prompt_version"` // 岗位描述模板的版本
+	ContestID     int32           `json:"contest_id"`     // 为 0 时表示所有赛事
+	Cases         int             `json:"cases"`          // 参与评估的组队记录数
+	Skipped       int             `json:"skipped"`        // 用户没有档案等原因无法评估的记录数
+	K             []int           `json:"k"`
+	Scorers       []*ScorerReport `json:"scorers"`
+}
+
+// ScorerReport 单个排序方式在所有记录上的平均指标，按 K 索引
+type ScorerReport struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Precision   map[int]float64 `json:"precision"`
+	Recall      map[int]float64 `json:"recall"`
+	NDCG        map[int]float64 `json:"ndcg"`
+	MRR         float64         `json:"mrr"`
+}
+
+// WriteJSON 以 JSON 格式输出评估结果
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteMarkdown 以 Markdown 表格输出评估结果
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("# 队伍推荐离线评估\n\n")
+	contest := "全部"
+	if r.ContestID != 0 {
+		contest = fmt.Sprint(r.ContestID)
+	}
+	fmt.Fprintf(&sb, "- 生成时间: %s\n", r.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(&sb, "- 模型: %s, 模板版本: %s\n", r.Model, r.PromptVersion)
+	fmt.Fprintf(&sb, "- 赛事: %s\n", contest)
+	fmt.Fprintf(&sb, "- 记录数: %d, 跳过: %d\n\n", r.Cases, r.Skipped)
+
+	header := []string{"排序方式"}
+	for _, k := range r.K {
+		header = append(header, fmt.Sprintf("P@%d", k), fmt.Sprintf("R@%d", k), fmt.Sprintf("NDCG@%d", k))
+	}
+	header = append(header, "MRR")
+	sb.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	sb.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, s := range r.Scorers {
+		row := []string{s.Name}
+		for _, k := range r.K {
+			row = append(row, fmt.Sprintf("%.4f", s.Precision[k]), fmt.Sprintf("%.4f", s.Recall[k]), fmt.Sprintf("%.4f", s.NDCG[k]))
+		}
+		row = append(row, fmt.Sprintf("%.4f", s.MRR))
+		sb.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+
+	sb.WriteString("\n")
+	for _, s := range r.Scorers {
+		fmt.Fprintf(&sb, "- %s: %s\n", s.Name, s.Description)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,80 @@
+package evaluate
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
+	"github.com/Yra-A/Fusion_Go/cmd/team/service"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// Scorer 参与对比的一种排序方式
+type Scorer struct {
+	Name        string
+	Description string
+	// Rank 对检索结果排序，返回按推荐顺序排列的 team_id
+	Rank func(profile *user.UserProfileInfo, results []*index.Result, now time.Time) []int32
+}
+
+// optionsScorer 使用线上的基础打分 service.RankTeams，以 opts 作为打分参数
+func optionsScorer(name string, description string, opts service.ScoreOptions) Scorer {
+	return Scorer{
+		Name:        name,
+		Description: description,
+		Rank: func(profile *user.UserProfileInfo, results []*index.Result, now time.Time) []int32 {
+			ranked := service.RankTeams(profile.UserSkills, results, opts, now)
+			ids := make([]int32, len(ranked))
+			for i, r := range ranked {
+				ids[i] = r.TeamID
+			}
+			return ids
+		},
+	}
+}
+
+// recencyScorer 按队伍创建时间降序排列，作为不使用用户信息的基线
+func recencyScorer() Scorer {
+	return Scorer{
+		Name:        "recency",
+		Description: "按队伍创建时间降序排列的基线",
+		Rank: func(_ *user.UserProfileInfo, results []*index.Result, _ time.Time) []int32 {
+			sorted := append([]*index.Result(nil), results...)
+			sort.SliceStable(sorted, func(i, j int) bool {
+				return sorted[i].CreatedTime > sorted[j].CreatedTime
+			})
+			ids := make([]int32, len(sorted))
+			for i, r := range sorted {
+				ids[i] = r.TeamID
+			}
+			return ids
+		},
+	}
+}
+
+// DefaultScorers 返回线上默认参数的基础打分及用于对比的替代方案，第一个为基础打分
+// 线上推荐在基础打分之后还会经过重排模型、MMR 多样化、已看过队伍降权与热度混合，这些环节不在离线评估的范围内
+func DefaultScorers() []Scorer {
+	base := service.DefaultScoreOptions()
+
+	semanticOnly := base
+	semanticOnly.SkillWeight = 0
+	skillOnly := base
+	skillOnly.SemanticWeight = 0
+	noDecay := base
+	noDecay.HalfLife = 0
+	shortHalfLife := base
+	shortHalfLife.HalfLife = 7 * 24 * time.Hour
+	longHalfLife := base
+	longHalfLife.HalfLife = 90 * 24 * time.Hour
+
+	return []Scorer{
+		optionsScorer("hybrid-base", "线上默认参数的基础打分，不含重排、多样化、降权与热度混合", base),
+		optionsScorer("semantic-only", "只使用语义相似度", semanticOnly),
+		optionsScorer("skill-only", "只使用技能匹配度，队伍没有技能要求时使用语义相似度", skillOnly),
+		optionsScorer("no-time-decay", "不做时间衰减", noDecay),
+		optionsScorer("half-life-7d", "时间衰减半衰期为 7 天", shortHalfLife),
+		optionsScorer("half-life-90d", "时间衰减半衰期为 90 天", longHalfLife),
+		recencyScorer(),
+	}
+}
@@ -0,0 +1,204 @@
+package experiment
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal"
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+// VariantStats 单个实验分组的曝光与申请转化
+type VariantStats struct {
+	Variant        string  `json:"variant"`
+	Impressions    int     `json:"impressions"`     // 曝光次数
+	Users          int     `json:"users"`           // 曝光的用户数
+	Applications   int     `json:"applications"`    // 曝光后在归因窗口内对曝光队伍提交的申请数
+	ConvertedUsers int     `json:"converted_users"` // 提交过上述申请的用户数
+	ConversionRate float64 `json:"conversion_rate"` // ConvertedUsers / Users
+}
+
+// Report 实验的转化报告
+type Report struct {
+	Experiment string          `json:"experiment"`
+	Since      time.Time       `json:"since"`
+	Window     string          `json:"window"` // 归因窗口
+	Variants   []*VariantStats `json:"variants"`
+}
+
+// Conversion 按分组统计曝光与申请转化
+// 申请的队伍出现在同一用户此前 window 时间内的某次曝光中时，该申请归因于这次曝光所在的分组，每个申请只归因一次
+func Conversion(impressions []*db.RecommendImpression, applications []*db.TeamApplication, window time.Duration) []*VariantStats {
+	type shown struct {
+		variant string
+		time    time.Time
+	}
+	byVariant := make(map[string]*VariantStats)
+	users := make(map[string]map[int32]bool)
+	converted := make(map[string]map[int32]bool)
+	// 按用户与队伍索引曝光
+	shownTo := make(map[int32]map[int32][]shown)
+	for _, imp := range impressions {
+		v, ok := byVariant[imp.Variant]
+		if !ok {
+			v = &VariantStats{Variant: imp.Variant}
+			byVariant[imp.Variant] = v
+			users[imp.Variant] = make(map[int32]bool)
+			converted[imp.Variant] = make(map[int32]bool)
+		}
+		v.Impressions++
+		users[imp.Variant][imp.UserID] = true
+		if shownTo[imp.UserID] == nil {
+			shownTo[imp.UserID] = make(map[int32][]shown)
+		}
+		for _, teamID := range imp.TeamIDs {
+			shownTo[imp.UserID][teamID] = append(shownTo[imp.UserID][teamID], shown{variant: imp.Variant, time: imp.CreatedTime})
+		}
+	}
+
+	for _, a := range applications {
+		// 归因于申请之前最近的一次曝光
+		var best *shown
+		for i, s := range shownTo[a.UserID][a.TeamID] {
+			if s.time.After(a.CreatedTime) || a.CreatedTime.Sub(s.time) > window {
+				continue
+			}
+			if best == nil || s.time.After(best.time) {
+				best = &shownTo[a.UserID][a.TeamID][i]
+			}
+		}
+		if best == nil {
+			continue
+		}
+		byVariant[best.variant].Applications++
+		converted[best.variant][a.UserID] = true
+	}
+
+	stats := make([]*VariantStats, 0, len(byVariant))
+	for name, v := range byVariant {
+		v.Users = len(users[name])
+		v.ConvertedUsers = len(converted[name])
+		if v.Users > 0 {
+			v.ConversionRate = float64(v.ConvertedUsers) / float64(v.Users)
+		}
+		stats = append(stats, v)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Variant < stats[j].Variant
+	})
+	return stats
+}
+
+// BuildReport 从数据库读取实验在 since 之后的曝光与申请，生成转化报告
+func BuildReport(name string, since time.Time, window time.Duration) (*Report, error) {
+	impressions, err := db.QueryRecommendImpressions(name, since)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[int32]bool)
+	var userIDs []int32
+	for _, imp := range impressions {
+		if !seen[imp.UserID] {
+			seen[imp.UserID] = true
+			userIDs = append(userIDs, imp.UserID)
+		}
+	}
+	applications, err := db.QueryApplicationsByUsers(userIDs, since)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		Experiment: name,
+		Since:      since,
+		Window:     window.String(),
+		Variants:   Conversion(impressions, applications, window),
+	}, nil
+}
+
+// WriteMarkdown 以 Markdown 表格输出转化报告
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# 实验 %s 的申请转化\n\n", r.Experiment)
+	fmt.Fprintf(&sb, "- 统计起始时间: %s\n", r.Since.Format(time.RFC3339))
+	fmt.Fprintf(&sb, "- 归因窗口: %s\n\n", r.Window)
+	sb.WriteString("| 分组 | 曝光次数 | 曝光用户数 | 申请数 | 转化用户数 | 转化率 |\n")
+	sb.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+	for _, v := range r.Variants {
+		fmt.Fprintf(&sb, "| %s | %d | %d | %d | %d | %.4f |\n",
+			v.Variant, v.Impressions, v.Users, v.Applications, v.ConvertedUsers, v.ConversionRate)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Main experiment 子命令入口，输出各实验分组的申请转化，返回进程退出码
+func Main(args []string) int {
+	var name, since, format string
+	var window time.Duration
+	fs := flag.NewFlagSet("experiment", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.StringVar(&name, "name", "", "实验名称，默认统计所有已注册的实验")
+	fs.StringVar(&since, "since", "", "只统计该时间(RFC3339)之后的曝光，默认统计最近 30 天")
+	fs.DurationVar(&window, "window", 7*24*time.Hour, "曝光后多长时间内的申请计入转化")
+	fs.StringVar(&format, "format", "markdown", "报告格式: json 或 markdown")
+	if err := fs.Parse(args); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return 2
+	}
+	if format != "json" && format != "markdown" {
+		fmt.Fprintln(os.Stderr, "-format 只能为 json 或 markdown")
+		return 2
+	}
+	from := time.Now().Add(-30 * 24 * time.Hour)
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "-since 格式错误: %v\n", err)
+			return 2
+		}
+		from = t
+	}
+	names := Names()
+	if name != "" {
+		names = []string{name}
+	}
+
+	if err := dal.Init(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	var reports []*Report
+	for _, n := range names {
+		r, err := BuildReport(n, from, window)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		reports = append(reports, r)
+	}
+	if format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		return 0
+	}
+	for _, r := range reports {
+		if err := r.WriteMarkdown(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+	return 0
+}
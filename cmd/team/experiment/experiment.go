@@ -0,0 +1,81 @@
+package experiment
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// Variant 实验中的一个分组
+type Variant struct {
+	Name   string
+	Weight int // 流量权重，分组的流量占比为 Weight / 所有分组 Weight 之和
+}
+
+// Experiment 按 user_id 哈希分桶的实验，同一用户在同一实验中总是落入同一分组
+type Experiment struct {
+	Name     string
+	Variants []Variant
+}
+
+// Assign 返回用户所在的分组，实验没有分组时返回空字符串
+func (e *Experiment) Assign(userID int32) string {
+	var total int
+	for _, v := range e.Variants {
+		total += v.Weight
+	}
+	if total <= 0 {
+		return ""
+	}
+	// 以实验名作为盐，使用户在不同实验中的分组相互独立
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s:%d", e.Name, userID)
+	bucket := int(h.Sum32() % uint32(total))
+	for _, v := range e.Variants {
+		if bucket < v.Weight {
+			return v.Name
+		}
+		bucket -= v.Weight
+	}
+	return ""
+}
+
+// Tag 返回用于响应与日志的实验标签，格式为 实验名/分组名
+func Tag(experiment string, variant string) string {
+	if experiment == "" || variant == "" {
+		return ""
+	}
+	return experiment + "/" + variant
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]*Experiment)
+)
+
+// Register 注册实验，同名的实验会被替换
+func Register(e *Experiment) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[e.Name] = e
+}
+
+// Lookup 返回已注册的实验，不存在时返回 nil
+func Lookup(name string) *Experiment {
+	mu.RLock()
+	defer mu.RUnlock()
+	return registry[name]
+}
+
+// Names 返回所有已注册实验的名称
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,62 @@
+package experiment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+// TestAssign 测试分组的确定性以及按权重分配流量
+func TestAssign(t *testing.T) {
+	e := &Experiment{Name: "test", Variants: []Variant{{Name: "a", Weight: 80}, {Name: "b", Weight: 20}}}
+	counts := make(map[string]int)
+	for id := int32(1); id <= 10000; id++ {
+		v := e.Assign(id)
+		if v != e.Assign(id) {
+			t.Fatalf("Assign(%d) is not deterministic", id)
+		}
+		counts[v]++
+	}
+	if counts["a"] < 7500 || counts["a"] > 8500 || counts["a"]+counts["b"] != 10000 {
+		t.Errorf("Assign() distribution = %v, want about 80/20", counts)
+	}
+
+	if v := (&Experiment{Name: "empty"}).Assign(1); v != "" {
+		t.Errorf("Assign() without variants = %q, want empty", v)
+	}
+	if tag := Tag("test", "a"); tag != "test/a" {
+		t.Errorf("Tag() = %q, want test/a", tag)
+	}
+}
+
+// TestConversion 测试申请只归因于窗口内、包含该队伍的最近一次曝光
+func TestConversion(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	impressions := []*db.RecommendImpression{
+		{UserID: 1, Variant: "a", TeamIDs: []int32{10, 11}, CreatedTime: now},
+		{UserID: 1, Variant: "a", TeamIDs: []int32{11}, CreatedTime: now.Add(time.Hour)},
+		{UserID: 2, Variant: "b", TeamIDs: []int32{10}, CreatedTime: now},
+		{UserID: 3, Variant: "b", TeamIDs: []int32{12}, CreatedTime: now},
+	}
+	applications := []*db.TeamApplication{
+		{UserID: 1, TeamID: 11, CreatedTime: now.Add(2 * time.Hour)},
+		// 未曝光的队伍
+		{UserID: 1, TeamID: 13, CreatedTime: now.Add(2 * time.Hour)},
+		// 超出归因窗口
+		{UserID: 2, TeamID: 10, CreatedTime: now.Add(48 * time.Hour)},
+		{UserID: 3, TeamID: 12, CreatedTime: now.Add(time.Hour)},
+	}
+
+	stats := Conversion(impressions, applications, 24*time.Hour)
+	if len(stats) != 2 {
+		t.Fatalf("Conversion() = %v, want 2 variants", stats)
+	}
+	a, b := stats[0], stats[1]
+	if a.Variant != "a" || a.Impressions != 2 || a.Users != 1 || a.Applications != 1 || a.ConversionRate != 1 {
+		t.Errorf("Conversion() variant a = %+v", a)
+	}
+	if b.Variant != "b" || b.Impressions != 2 || b.Users != 2 || b.Applications != 1 || b.ConversionRate != 0.5 {
+		t.Errorf("Conversion() variant b = %+v", b)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Yra-A/Fusion_Go/cmd/team/service"
 	team "github.com/Yra-A/Fusion_Go/kitex_gen/team"
@@ -12,14 +13,22 @@ import (
 // TeamServiceImpl implements the last service interface defined in the IDL.
 type TeamServiceImpl struct{}
 
+// failure 返回失败响应的状态码与信息，业务错误（ErrNo）通过状态码返回给调用方，不作为 rpc 错误；其他错误返回 Fail 并保留原错误
+func failure(err error) (int32, string, error) {
+	var e errno.ErrNo
+	if errors.As(err, &e) {
+		return e.ErrCode, e.ErrMsg, nil
+	}
+	return errno.Fail.ErrCode, errno.Fail.ErrMsg, err
+}
+
 // TeamCreate implements the TeamServiceImpl interface.
 func (s *TeamServiceImpl) TeamCreate(ctx context.Context, req *team.TeamCreateRequest) (resp *team.TeamCreateResponse, err error) {
 	klog.CtxDebugf(ctx, "TeamCreate called")
 	resp = new(team.TeamCreateResponse)
 	team_id, err := service.NewCreateTeamService(ctx).CreateTeam(req.UserId, req.TeamId, req.ContestId, req.Title, req.Goal, req.Description, req.TeamSkills)
 	if err != nil {
-		resp.StatusCode = errno.Fail.ErrCode
-		resp.StatusMsg = errno.Fail.ErrMsg
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
 		return resp, err
 	}
 	resp.TeamId = team_id
@@ -32,16 +41,18 @@ func (s *TeamServiceImpl) TeamCreate(ctx context.Context, req *team.TeamCreateRe
 func (s *TeamServiceImpl) TeamList(ctx context.Context, req *team.TeamListRequest) (resp *team.TeamListResponse, err error) {
 	klog.CtxDebugf(ctx, "TeamList called")
 	resp = new(team.TeamListResponse)
-	teamList, total, err := service.NewTeamListService(ctx).TeamList(req.ContestId, req.Limit, req.Offset, req.UserId)
+	result, err := service.NewTeamListService(ctx).TeamList(req.ContestId, req.Limit, req.Offset, req.UserId, req.WithRecommendation, req.Cursor)
 	if err != nil {
-		resp.StatusCode = errno.Fail.ErrCode
-		resp.StatusMsg = errno.Fail.ErrMsg
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
 		return resp, err
 	}
 	resp.StatusCode = errno.Success.ErrCode
 	resp.StatusMsg = errno.Success.ErrMsg
-	resp.TeamList = teamList
-	resp.Total = total
+	resp.TeamList = result.TeamList
+	resp.Total = result.Total
+	resp.Experiment = result.Experiment
+	resp.Strategy = result.Strategy
+	resp.NextCursor = result.NextCursor
 	return resp, nil
 }
 
@@ -51,8 +62,7 @@ func (s *TeamServiceImpl) TeamInfo(ctx context.Context, req *team.TeamInfoReques
 	resp = new(team.TeamInfoResponse)
 	teamInfo, err := service.NewTeamInfoService(ctx).TeamInfo(req.TeamId)
 	if err != nil {
-		resp.StatusCode = errno.Fail.ErrCode
-		resp.StatusMsg = errno.Fail.ErrMsg
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
 		return resp, err
 	}
 	resp.StatusCode = errno.Success.ErrCode
@@ -67,8 +77,7 @@ func (s *TeamServiceImpl) TeamApplicationSubmit(ctx context.Context, req *team.T
 	resp = new(team.TeamApplicationSubmitResponse)
 	err = service.NewTeamApplicationSubmitService(ctx).TeamApplicationSubmit(req.TeamId, req.Reason, req.CreatedTime, req.ApplicationType, req.MemberInfo.UserId)
 	if err != nil {
-		resp.StatusCode = errno.Fail.ErrCode
-		resp.StatusMsg = errno.Fail.ErrMsg
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
 		return resp, err
 	}
 	resp.StatusCode = errno.Success.ErrCode
@@ -82,8 +91,7 @@ func (s *TeamServiceImpl) TeamManageList(ctx context.Context, req *team.TeamMana
 	resp = new(team.TeamManageListResponse)
 	teamManageList, err := service.NewTeamManageListService(ctx).TeamManageList(req.UserId, req.TeamId)
 	if err != nil {
-		resp.StatusCode = errno.Fail.ErrCode
-		resp.StatusMsg = errno.Fail.ErrMsg
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
 		return resp, err
 	}
 	resp.StatusCode = errno.Success.ErrCode
@@ -92,17 +100,236 @@ func (s *TeamServiceImpl) TeamManageList(ctx context.Context, req *team.TeamMana
 	return
 }
 
+// TeamApplicationWithdraw implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamApplicationWithdraw(ctx context.Context, req *team.TeamApplicationWithdrawRequest) (resp *team.TeamApplicationWithdrawResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamApplicationWithdraw called")
+	resp = new(team.TeamApplicationWithdrawResponse)
+	err = service.NewTeamApplicationWithdrawService(ctx).TeamApplicationWithdraw(req.UserId, req.ApplicationId)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// MyApplications implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) MyApplications(ctx context.Context, req *team.MyApplicationsRequest) (resp *team.MyApplicationsResponse, err error) {
+	klog.CtxDebugf(ctx, "MyApplications called")
+	resp = new(team.MyApplicationsResponse)
+	applicationList, total, err := service.NewMyApplicationsService(ctx).MyApplications(req.UserId, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.Total = total
+	resp.ApplicationList = applicationList
+	return resp, nil
+}
+
+// MyTeamList implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) MyTeamList(ctx context.Context, req *team.MyTeamListRequest) (resp *team.MyTeamListResponse, err error) {
+	klog.CtxDebugf(ctx, "MyTeamList called")
+	resp = new(team.MyTeamListResponse)
+	teamList, total, err := service.NewMyTeamListService(ctx).MyTeamList(req.UserId, req.Role, req.Limit, req.Offset)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.Total = total
+	resp.TeamList = teamList
+	return resp, nil
+}
+
+// TeamLeave implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamLeave(ctx context.Context, req *team.TeamLeaveRequest) (resp *team.TeamLeaveResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamLeave called")
+	resp = new(team.TeamLeaveResponse)
+	err = service.NewTeamLeaveService(ctx).TeamLeave(req.UserId, req.TeamId)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamMemberRemove implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamMemberRemove(ctx context.Context, req *team.TeamMemberRemoveRequest) (resp *team.TeamMemberRemoveResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamMemberRemove called")
+	resp = new(team.TeamMemberRemoveResponse)
+	err = service.NewTeamMemberRemoveService(ctx).TeamMemberRemove(req.UserId, req.TeamId, req.MemberId)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamLeaderTransfer implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamLeaderTransfer(ctx context.Context, req *team.TeamLeaderTransferRequest) (resp *team.TeamLeaderTransferResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamLeaderTransfer called")
+	resp = new(team.TeamLeaderTransferResponse)
+	err = service.NewTeamLeaderTransferService(ctx).TeamLeaderTransfer(req.UserId, req.TeamId, req.MemberId)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamClose implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamClose(ctx context.Context, req *team.TeamCloseRequest) (resp *team.TeamCloseResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamClose called")
+	resp = new(team.TeamCloseResponse)
+	err = service.NewTeamCloseService(ctx).TeamClose(req.UserId, req.TeamId, req.Closed)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamDisband implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamDisband(ctx context.Context, req *team.TeamDisbandRequest) (resp *team.TeamDisbandResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamDisband called")
+	resp = new(team.TeamDisbandResponse)
+	err = service.NewTeamDisbandService(ctx).TeamDisband(req.UserId, req.TeamId)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamInvitationSend implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamInvitationSend(ctx context.Context, req *team.TeamInvitationSendRequest) (resp *team.TeamInvitationSendResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamInvitationSend called")
+	resp = new(team.TeamInvitationSendResponse)
+	invitationID, err := service.NewTeamInvitationSendService(ctx).TeamInvitationSend(req.UserId, req.TeamId, req.InviteeId, req.Message)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.InvitationId = invitationID
+	return resp, nil
+}
+
+// TeamInvitationRespond implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamInvitationRespond(ctx context.Context, req *team.TeamInvitationRespondRequest) (resp *team.TeamInvitationRespondResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamInvitationRespond called")
+	resp = new(team.TeamInvitationRespondResponse)
+	err = service.NewTeamInvitationRespondService(ctx).TeamInvitationRespond(req.UserId, req.InvitationId, req.ActionType)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamInvitationList implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamInvitationList(ctx context.Context, req *team.TeamInvitationListRequest) (resp *team.TeamInvitationListResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamInvitationList called")
+	resp = new(team.TeamInvitationListResponse)
+	invitationList, total, err := service.NewTeamInvitationListService(ctx).TeamInvitationList(req.UserId, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.Total = total
+	resp.InvitationList = invitationList
+	return resp, nil
+}
+
+// TeamSentInvitationList implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamSentInvitationList(ctx context.Context, req *team.TeamSentInvitationListRequest) (resp *team.TeamSentInvitationListResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamSentInvitationList called")
+	resp = new(team.TeamSentInvitationListResponse)
+	invitationList, total, err := service.NewTeamSentInvitationListService(ctx).TeamSentInvitationList(req.UserId, req.TeamId, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.Total = total
+	resp.InvitationList = invitationList
+	return resp, nil
+}
+
+// TeamInvitationCancel implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamInvitationCancel(ctx context.Context, req *team.TeamInvitationCancelRequest) (resp *team.TeamInvitationCancelResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamInvitationCancel called")
+	resp = new(team.TeamInvitationCancelResponse)
+	err = service.NewTeamInvitationCancelService(ctx).TeamInvitationCancel(req.UserId, req.InvitationId)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
 // TeamManageAction implements the TeamServiceImpl interface.
 func (s *TeamServiceImpl) TeamManageAction(ctx context.Context, req *team.TeamManageActionRequest) (resp *team.TeamManageActionResponse, err error) {
 	klog.CtxDebugf(ctx, "TeamManageAction called")
 	resp = new(team.TeamManageActionResponse)
 	err = service.NewTeamManageActionService(ctx).TeamManageAction(req.UserId, req.ApplicationId, req.ActionType)
 	if err != nil {
-		resp.StatusCode = errno.Fail.ErrCode
-		resp.StatusMsg = errno.Fail.ErrMsg
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
 		return resp, err
 	}
 	resp.StatusCode = errno.Success.ErrCode
 	resp.StatusMsg = errno.Success.ErrMsg
 	return
 }
+
+// TeamEmbeddingJobStatus implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamEmbeddingJobStatus(ctx context.Context, req *team.TeamEmbeddingJobStatusRequest) (resp *team.TeamEmbeddingJobStatusResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamEmbeddingJobStatus called")
+	resp, err = service.NewEmbeddingJobStatusService(ctx).EmbeddingJobStatus(req.TeamId)
+	if err != nil {
+		resp = new(team.TeamEmbeddingJobStatusResponse)
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	return resp, nil
+}
+
+// TeamCandidates implements the TeamServiceImpl interface.
+func (s *TeamServiceImpl) TeamCandidates(ctx context.Context, req *team.TeamCandidatesRequest) (resp *team.TeamCandidatesResponse, err error) {
+	klog.CtxDebugf(ctx, "TeamCandidates called")
+	resp = new(team.TeamCandidatesResponse)
+	jobCandidates, err := service.NewTeamCandidatesService(ctx).TeamCandidates(req.UserId, req.TeamId, req.Limit)
+	if err != nil {
+		resp.StatusCode, resp.StatusMsg, err = failure(err)
+		return resp, err
+	}
+	resp.StatusCode = errno.Success.ErrCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.JobCandidates = jobCandidates
+	return resp, nil
+}
@@ -0,0 +1,410 @@
+package index
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+// defaultTTL 赛事索引的有效期，过期后下一次查询时从数据库重新加载，
+// 用于同步其他实例上发生的修改
+const defaultTTL = 5 * time.Minute
+
+var defaultIndex = New(defaultTTL, dbLoader{})
+
+// Init 在后台预热所有赛事的索引
+func Init() {
+	go func() {
+		if err := defaultIndex.Warm(); err != nil {
+			klog.Errorf("预热队伍向量索引失败: %v", err)
+		}
+	}()
+}
+
+// Default 返回默认的队伍向量索引
+func Default() *Index {
+	return defaultIndex
+}
+
+// Loader 从存储中加载队伍的 embedding 与技能需求
+type Loader interface {
+	// LoadTeams 加载赛事下的所有队伍及其技能需求，contestID 为 0 时加载所有赛事
+	LoadTeams(contestID int32) ([]*db.TeamInfo, []*db.TeamSkills, error)
+	// LoadTeam 加载单个队伍及其技能需求，队伍不存在时返回 gorm.ErrRecordNotFound
+	LoadTeam(teamID int32) (*db.TeamInfo, []*db.TeamSkills, error)
+}
+
+// dbLoader 从 MySQL 中加载队伍
+type dbLoader struct{}
+
+func (dbLoader) LoadTeams(contestID int32) ([]*db.TeamInfo, []*db.TeamSkills, error) {
+	teams, err := db.QueryTeamEmbeddings(contestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	skills, err := db.QueryTeamSkillsByContest(contestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return teams, skills, nil
+}
+
+func (dbLoader) LoadTeam(teamID int32) (*db.TeamInfo, []*db.TeamSkills, error) {
+	t, err := db.QueryTeamEmbedding(teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+	skills, err := db.QueryTeamSkillsByTeam(teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, skills, nil
+}
+
+// MemoryLoader 从内存中的队伍数据加载，用于离线评估与训练
+type MemoryLoader struct {
+	teams  []*db.TeamInfo
+	skills []*db.TeamSkills
+}
+
+// NewMemoryLoader 创建从 teams 与 skills 中加载队伍的 Loader
+func NewMemoryLoader(teams []*db.TeamInfo, skills []*db.TeamSkills) *MemoryLoader {
+	return &MemoryLoader{teams: teams, skills: skills}
+}
+
+func (l *MemoryLoader) LoadTeams(contestID int32) ([]*db.TeamInfo, []*db.TeamSkills, error) {
+	var teams []*db.TeamInfo
+	for _, t := range l.teams {
+		if contestID == 0 || t.ContestID == contestID {
+			teams = append(teams, t)
+		}
+	}
+	return teams, l.skills, nil
+}
+
+func (l *MemoryLoader) LoadTeam(teamID int32) (*db.TeamInfo, []*db.TeamSkills, error) {
+	for _, t := range l.teams {
+		if t.TeamID == teamID {
+			var skills []*db.TeamSkills
+			for _, s := range l.skills {
+				if s.TeamID == teamID {
+					skills = append(skills, s)
+				}
+			}
+			return t, skills, nil
+		}
+	}
+	return nil, nil, gorm.ErrRecordNotFound
+}
+
+// position 单个岗位的向量及预先计算的范数
+type position struct {
+	job  string
+	vec  []float64
+	norm float64
+}
+
+// entry 单个队伍在索引中的数据
+type entry struct {
+	teamID      int32
+	contestID   int32
+	createdTime int64
+	emb         db.TeamEmbedding
+	positions   []position
+	skills      []*db.TeamSkills
+	err         error // 不为空时队伍的 embedding 不可用
+}
+
+type contestIndex struct {
+	teams    map[int32]*entry
+	loadedAt time.Time
+}
+
+// Result 单个队伍的检索结果
+type Result struct {
+	TeamID      int32
+	CreatedTime int64
+	Score       float64          // 与岗位向量余弦相似度的最大值
+	Job         string           // 匹配度最高的岗位
+	Skills      []*db.TeamSkills // 队伍招募岗位所需要的技能
+	Err         error            // 不为空时队伍的 embedding 不可用，Score 无意义
+	Stale       bool             // embedding 的模板版本已过期，需要重新生成
+}
+
+// Index 按赛事划分的队伍向量索引，岗位向量的范数在加载时预先计算，查询时只做点积
+type Index struct {
+	mu       sync.RWMutex
+	contests map[int32]*contestIndex
+	ttl      time.Duration
+	loader   Loader
+	// loading 合并同一赛事并发的加载，索引过期时只有一个请求访问数据库
+	loading singleflight.Group
+}
+
+// New 创建一个新的 Index 实例
+func New(ttl time.Duration, loader Loader) *Index {
+	return &Index{
+		contests: make(map[int32]*contestIndex),
+		ttl:      ttl,
+		loader:   loader,
+	}
+}
+
+// groupSkills 按队伍对技能需求分组
+func groupSkills(skills []*db.TeamSkills) map[int32][]*db.TeamSkills {
+	byTeam := make(map[int32][]*db.TeamSkills)
+	for _, s := range skills {
+		byTeam[s.TeamID] = append(byTeam[s.TeamID], s)
+	}
+	return byTeam
+}
+
+// newEntry 解析队伍的 embedding 并计算各岗位向量的范数
+func newEntry(t *db.TeamInfo, skills []*db.TeamSkills) *entry {
+	e := &entry{
+		teamID:      t.TeamID,
+		contestID:   t.ContestID,
+		createdTime: t.CreatedTime.Unix(),
+		skills:      skills,
+	}
+	if t.Embedding == "" {
+		e.err = errors.New("队伍尚未生成 embedding")
+		return e
+	}
+	if err := json.Unmarshal([]byte(t.Embedding), &e.emb); err != nil {
+		e.err = fmt.Errorf("解析 embedding 失败: %v", err)
+		return e
+	}
+	for _, p := range e.emb.Positions {
+		var norm float64
+		for _, v := range p.Embedding {
+			norm += v * v
+		}
+		e.positions = append(e.positions, position{job: p.Job, vec: p.Embedding, norm: math.Sqrt(norm)})
+	}
+	return e
+}
+
+// Warm 加载所有赛事的队伍
+func (x *Index) Warm() error {
+	teams, skills, err := x.loader.LoadTeams(0)
+	if err != nil {
+		return err
+	}
+	byTeam := groupSkills(skills)
+	contests := make(map[int32]*contestIndex)
+	now := time.Now()
+	for _, t := range teams {
+		c, ok := contests[t.ContestID]
+		if !ok {
+			c = &contestIndex{teams: make(map[int32]*entry), loadedAt: now}
+			contests[t.ContestID] = c
+		}
+		c.teams[t.TeamID] = newEntry(t, byTeam[t.TeamID])
+	}
+	x.mu.Lock()
+	for id, c := range contests {
+		x.contests[id] = c
+	}
+	x.mu.Unlock()
+	klog.Infof("队伍向量索引预热完成, 共 %d 个赛事 %d 个队伍", len(contests), len(teams))
+	return nil
+}
+
+// contest 返回赛事的索引，不存在或已过期时从数据库加载，同一赛事并发的加载只执行一次
+func (x *Index) contest(contestID int32) (*contestIndex, error) {
+	if c, ok := x.fresh(contestID); ok {
+		return c, nil
+	}
+	v, err, _ := x.loading.Do(strconv.Itoa(int(contestID)), func() (interface{}, error) {
+		// 等待期间其他请求可能已经加载完成
+		if c, ok := x.fresh(contestID); ok {
+			return c, nil
+		}
+		return x.load(contestID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*contestIndex), nil
+}
+
+// fresh 返回赛事未过期的索引
+func (x *Index) fresh(contestID int32) (*contestIndex, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	c, ok := x.contests[contestID]
+	if !ok || time.Since(c.loadedAt) >= x.ttl {
+		return nil, false
+	}
+	return c, true
+}
+
+// load 从数据库加载赛事下的所有队伍并替换原有的索引
+func (x *Index) load(contestID int32) (*contestIndex, error) {
+	teams, skills, err := x.loader.LoadTeams(contestID)
+	if err != nil {
+		return nil, err
+	}
+	byTeam := groupSkills(skills)
+	c := &contestIndex{teams: make(map[int32]*entry, len(teams)), loadedAt: time.Now()}
+	for _, t := range teams {
+		c.teams[t.TeamID] = newEntry(t, byTeam[t.TeamID])
+	}
+	x.mu.Lock()
+	x.contests[contestID] = c
+	x.mu.Unlock()
+	return c, nil
+}
+
+// Refresh 从数据库重新加载单个队伍，队伍不存在时将其从索引中移除
+func (x *Index) Refresh(teamID int32) error {
+	t, skills, err := x.loader.LoadTeam(teamID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		x.Remove(teamID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	e := newEntry(t, skills)
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// 赛事尚未加载时等待下一次查询整体加载
+	if c, ok := x.contests[t.ContestID]; ok {
+		c.teams[teamID] = e
+	}
+	return nil
+}
+
+// Remove 将队伍从索引中移除
+func (x *Index) Remove(teamID int32) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, c := range x.contests {
+		delete(c.teams, teamID)
+	}
+}
+
+// Search 计算 query 与赛事下每个队伍的匹配度，model 为生成 query 的模型
+// 可用的结果按 Score 降序排列，k > 0 时只保留前 k 个；embedding 不可用的队伍排在最后
+func (x *Index) Search(contestID int32, query []float64, model string, k int) ([]*Result, error) {
+	c, err := x.contest(contestID)
+	if err != nil {
+		return nil, err
+	}
+	var qnorm float64
+	for _, v := range query {
+		qnorm += v * v
+	}
+	qnorm = math.Sqrt(qnorm)
+
+	x.mu.RLock()
+	var scored, unavailable []*Result
+	for _, e := range c.teams {
+		r := &Result{TeamID: e.teamID, CreatedTime: e.createdTime, Skills: e.skills}
+		if e.err != nil {
+			r.Err = e.err
+			unavailable = append(unavailable, r)
+			continue
+		}
+		if err := e.emb.CheckCompatible(model, len(query)); err != nil {
+			r.Err = err
+			unavailable = append(unavailable, r)
+			continue
+		}
+		r.Stale = e.emb.PromptVersion != embedding.PromptVersion
+		for _, p := range e.positions {
+			if qnorm == 0 || p.norm == 0 {
+				continue
+			}
+			var dot float64
+			for i, v := range query {
+				dot += v * p.vec[i]
+			}
+			if score := dot / (qnorm * p.norm); score > r.Score {
+				r.Score = score
+				r.Job = p.job
+			}
+		}
+		scored = append(scored, r)
+	}
+	x.mu.RUnlock()
+
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].Score != scored[j].Score {
+			return scored[i].Score > scored[j].Score
+		}
+		return scored[i].TeamID < scored[j].TeamID
+	})
+	if k > 0 && len(scored) > k {
+		scored = scored[:k]
+	}
+	sort.Slice(unavailable, func(i, j int) bool {
+		return unavailable[i].TeamID < unavailable[j].TeamID
+	})
+	return append(scored, unavailable...), nil
+}
+
+// Similarities 计算赛事下队伍两两之间的相似度，即两个队伍岗位向量余弦相似度的最大值，不小于 0
+// 返回的矩阵与 teamIDs 的顺序一致，embedding 不可用、维度或模型不同的队伍之间相似度为 0
+func (x *Index) Similarities(contestID int32, teamIDs []int32) ([][]float64, error) {
+	c, err := x.contest(contestID)
+	if err != nil {
+		return nil, err
+	}
+	x.mu.RLock()
+	entries := make([]*entry, len(teamIDs))
+	for i, id := range teamIDs {
+		if e, ok := c.teams[id]; ok && e.err == nil {
+			entries[i] = e
+		}
+	}
+	x.mu.RUnlock()
+
+	sims := make([][]float64, len(teamIDs))
+	for i := range sims {
+		sims[i] = make([]float64, len(teamIDs))
+	}
+	for i := range entries {
+		sims[i][i] = 1
+		for j := i + 1; j < len(entries); j++ {
+			s := teamSimilarity(entries[i], entries[j])
+			sims[i][j], sims[j][i] = s, s
+		}
+	}
+	return sims, nil
+}
+
+func teamSimilarity(a, b *entry) float64 {
+	if a == nil || b == nil || a.emb.Model != b.emb.Model {
+		return 0
+	}
+	var best float64
+	for _, p := range a.positions {
+		for _, q := range b.positions {
+			if p.norm == 0 || q.norm == 0 || len(p.vec) != len(q.vec) {
+				continue
+			}
+			var dot float64
+			for i, v := range p.vec {
+				dot += v * q.vec[i]
+			}
+			if s := dot / (p.norm * q.norm); s > best {
+				best = s
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,185 @@
+package index
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
+	"gorm.io/gorm"
+)
+
+// fakeLoader 从内存中加载队伍，同时记录整体加载的次数
+type fakeLoader struct {
+	teams  map[int32]*db.TeamInfo
+	skills []*db.TeamSkills
+	loads  int
+}
+
+func (l *fakeLoader) LoadTeams(contestID int32) ([]*db.TeamInfo, []*db.TeamSkills, error) {
+	l.loads++
+	var res []*db.TeamInfo
+	for _, info := range l.teams {
+		if contestID == 0 || info.ContestID == contestID {
+			res = append(res, info)
+		}
+	}
+	return res, l.skills, nil
+}
+
+func (l *fakeLoader) LoadTeam(teamID int32) (*db.TeamInfo, []*db.TeamSkills, error) {
+	info, ok := l.teams[teamID]
+	if !ok {
+		return nil, nil, gorm.ErrRecordNotFound
+	}
+	var skills []*db.TeamSkills
+	for _, s := range l.skills {
+		if s.TeamID == teamID {
+			skills = append(skills, s)
+		}
+	}
+	return info, skills, nil
+}
+
+func teamInfo(t *testing.T, teamID int32, contestID int32, te *db.TeamEmbedding) *db.TeamInfo {
+	info := &db.TeamInfo{TeamID: teamID, ContestID: contestID, CreatedTime: time.Unix(1700000000, 0)}
+	if te != nil {
+		b, err := json.Marshal(te)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		info.Embedding = string(b)
+	}
+	return info
+}
+
+// TestIndexSearch 测试检索结果的排序、top-K 截断以及不可用 embedding 的处理
+func TestIndexSearch(t *testing.T) {
+	teams := map[int32]*db.TeamInfo{
+		1: teamInfo(t, 1, 10, &db.TeamEmbedding{Model: "m", Dimension: 2, PromptVersion: embedding.PromptVersion,
+			Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{1, 0}}, {Job: "前端", Embedding: []float64{0, 1}}}}),
+		2: teamInfo(t, 2, 10, &db.TeamEmbedding{Model: "m", Dimension: 2, PromptVersion: "v0",
+			Positions: []db.PositionEmbedding{{Job: "算法", Embedding: []float64{1, 1}}}}),
+		3: teamInfo(t, 3, 10, &db.TeamEmbedding{Model: "other", Dimension: 2,
+			Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{1, 0}}}}),
+		4: teamInfo(t, 4, 10, nil),
+		5: teamInfo(t, 5, 20, &db.TeamEmbedding{Model: "m", Dimension: 2, PromptVersion: embedding.PromptVersion,
+			Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{1, 0}}}}),
+	}
+	loader := &fakeLoader{teams: teams, skills: []*db.TeamSkills{{TeamID: 1, Skill: "Go", Category: "编程语言", Job: "后端"}}}
+	x := New(time.Hour, loader)
+
+	results, err := x.Search(10, []float64{2, 0}, "m", 0)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("Search() returned %d results, want 4", len(results))
+	}
+	if results[0].TeamID != 1 || results[0].Job != "后端" || results[0].Score < 0.999 || results[0].Stale {
+		t.Errorf("results[0] = %+v, want team 1 matching 后端 with score 1", results[0])
+	}
+	if len(results[0].Skills) != 1 || results[0].Skills[0].Skill != "Go" {
+		t.Errorf("results[0].Skills = %+v, want the skills of team 1", results[0].Skills)
+	}
+	if results[1].TeamID != 2 || !results[1].Stale {
+		t.Errorf("results[1] = %+v, want stale team 2", results[1])
+	}
+	if results[2].TeamID != 3 || results[2].Err == nil || results[3].TeamID != 4 || results[3].Err == nil {
+		t.Errorf("incompatible and missing embeddings should be ranked last with Err set, got %+v %+v", results[2], results[3])
+	}
+
+	results, _ = x.Search(10, []float64{2, 0}, "m", 1)
+	if len(results) != 3 || results[0].TeamID != 1 {
+		t.Errorf("Search(k=1) should keep only the best scored team followed by unavailable ones, got %d results", len(results))
+	}
+	if loader.loads != 1 {
+		t.Errorf("contest should be loaded once while fresh, loaded %d times", loader.loads)
+	}
+
+	// 修改队伍后刷新，结果应立即生效
+	teams[4] = teamInfo(t, 4, 10, &db.TeamEmbedding{Model: "m", Dimension: 2, PromptVersion: embedding.PromptVersion,
+		Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{3, 0}}}})
+	if err := x.Refresh(4); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	delete(teams, 1)
+	if err := x.Refresh(1); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	results, _ = x.Search(10, []float64{2, 0}, "m", 0)
+	if len(results) != 3 || results[0].TeamID != 4 || results[0].Err != nil {
+		t.Errorf("Search() after Refresh() = %+v, want team 4 first and team 1 removed", results[0])
+	}
+}
+
+// TestIndexSimilarities 测试队伍之间取岗位向量相似度的最大值，不同模型或缺少 embedding 的队伍相似度为 0
+func TestIndexSimilarities(t *testing.T) {
+	teams := map[int32]*db.TeamInfo{
+		1: teamInfo(t, 1, 10, &db.TeamEmbedding{Model: "m", Dimension: 2,
+			Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{1, 0}}, {Job: "前端", Embedding: []float64{0, 1}}}}),
+		2: teamInfo(t, 2, 10, &db.TeamEmbedding{Model: "m", Dimension: 2,
+			Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{2, 0}}}}),
+		3: teamInfo(t, 3, 10, &db.TeamEmbedding{Model: "other", Dimension: 2,
+			Positions: []db.PositionEmbedding{{Job: "后端", Embedding: []float64{1, 0}}}}),
+		4: teamInfo(t, 4, 10, nil),
+	}
+	x := New(time.Hour, &fakeLoader{teams: teams})
+
+	sims, err := x.Similarities(10, []int32{1, 2, 3, 4, 99})
+	if err != nil {
+		t.Fatalf("Similarities() error = %v", err)
+	}
+	if sims[0][1] < 0.999 || sims[1][0] != sims[0][1] {
+		t.Errorf("Similarities() teams 1 and 2 = %v, want 1", sims[0][1])
+	}
+	if sims[0][2] != 0 || sims[0][3] != 0 || sims[0][4] != 0 {
+		t.Errorf("Similarities() with incompatible teams = %v, want 0", sims[0])
+	}
+}
+
+// blockingLoader 整体加载时阻塞到 release 关闭，用于模拟并发请求同时触发加载
+type blockingLoader struct {
+	fakeLoader
+	release chan struct{}
+	calls   int32
+}
+
+func (l *blockingLoader) LoadTeams(contestID int32) ([]*db.TeamInfo, []*db.TeamSkills, error) {
+	atomic.AddInt32(&l.calls, 1)
+	<-l.release
+	return l.fakeLoader.LoadTeams(contestID)
+}
+
+// TestIndexConcurrentLoad 测试索引不存在或过期时，并发的检索只触发一次加载
+func TestIndexConcurrentLoad(t *testing.T) {
+	l := &blockingLoader{
+		fakeLoader: fakeLoader{teams: map[int32]*db.TeamInfo{1: teamInfo(t, 1, 10, nil)}},
+		release:    make(chan struct{}),
+	}
+	x := New(time.Hour, l)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if results, err := x.Search(10, []float64{1, 0}, "m", 0); err != nil || len(results) != 1 {
+				t.Errorf("Search() = %d results, %v, want 1", len(results), err)
+			}
+		}()
+	}
+	// 第一个请求进入加载后，留出时间让其余请求到达再放行
+	for atomic.LoadInt32(&l.calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(l.release)
+	wg.Wait()
+	if calls := atomic.LoadInt32(&l.calls); calls != 1 {
+		t.Errorf("LoadTeams() called %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/application"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// expireInterval 清理过期入队申请与邀请的间隔
+const expireInterval = time.Hour
+
+var expireOnce sync.Once
+
+// StartApplicationExpiry 启动后台任务，定期将超过有效期仍未处理的入队申请与邀请标记为过期，重复调用只会启动一次
+// 申请不会过期时不启动
+func StartApplicationExpiry() {
+	if application.ExpireAfter() == 0 {
+		return
+	}
+	expireOnce.Do(func() {
+		go func() {
+			expireApplications()
+			ticker := time.NewTicker(expireInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				expireApplications()
+			}
+		}()
+	})
+}
+
+func expireApplications() {
+	n, err := db.ExpireTeamApplications(time.Now().Add(-application.ExpireAfter()))
+	if err != nil {
+		klog.Errorf("清理过期的入队申请失败: %v", err)
+		return
+	}
+	if n > 0 {
+		klog.Infof("%d 个入队申请已过期", n)
+	}
+	n, err = db.ExpireTeamInvitations(time.Now().Add(-application.ExpireAfter()))
+	if err != nil {
+		klog.Errorf("清理过期的入队邀请失败: %v", err)
+		return
+	}
+	if n > 0 {
+		klog.Infof("%d 个入队邀请已过期", n)
+	}
+}
@@ -0,0 +1,99 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	pollInterval = 2 * time.Second  // 轮询任务表的间隔
+	batchSize    = 10               // 每次轮询最多取出的任务数
+	jobTimeout   = 2 * time.Minute  // 单个任务的执行超时，超过后视为实例崩溃并重新入队
+	baseBackoff  = 5 * time.Second  // 第一次重试的等待时间
+	maxBackoff   = 10 * time.Minute // 重试等待时间的上限
+)
+
+var startOnce sync.Once
+
+// Start 启动后台 embedding 任务 worker，重复调用只会启动一次
+func Start() {
+	startOnce.Do(func() {
+		go run()
+	})
+}
+
+func run() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if n, err := db.ResetStaleEmbeddingJobs(jobTimeout); err != nil {
+			klog.Errorf("重置超时的 embedding 任务失败: %v", err)
+		} else if n > 0 {
+			klog.Warnf("%d 个超时的 embedding 任务已重新入队", n)
+		}
+		jobs, err := db.QueryDueEmbeddingJobs(batchSize)
+		if err != nil {
+			klog.Errorf("获取待处理的 embedding 任务失败: %v", err)
+			continue
+		}
+		for _, j := range jobs {
+			process(j)
+		}
+	}
+}
+
+// process 抢占并执行一个任务，失败时按指数退避重新入队，超过最大尝试次数后标记为失败
+func process(j *db.EmbeddingJob) {
+	ok, err := db.ClaimEmbeddingJob(j)
+	if err != nil {
+		klog.Errorf("抢占 embedding 任务 %d 失败: %v", j.JobID, err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+	defer cancel()
+	err = db.RegenerateTeamEmbedding(ctx, embedder.Default(), j.TeamID, time.Now())
+	if err == nil {
+		if err := db.FinishEmbeddingJob(j.JobID); err != nil {
+			klog.Errorf("更新 embedding 任务 %d 状态失败: %v", j.JobID, err)
+		}
+		if err := index.Default().Refresh(j.TeamID); err != nil {
+			klog.Errorf("刷新队伍 %d 的向量索引失败: %v", j.TeamID, err)
+		}
+		return
+	}
+
+	reason := fmt.Sprintf("第 %d 次尝试失败: %v", j.Attempts, err)
+	if j.Attempts >= j.MaxAttempts {
+		klog.Errorf("队伍 %d 的 embedding 任务 %d 已达到最大尝试次数: %s", j.TeamID, j.JobID, reason)
+		err = db.FailEmbeddingJob(j.JobID, reason)
+	} else {
+		klog.Warnf("队伍 %d 的 embedding 任务 %d 将重试: %s", j.TeamID, j.JobID, reason)
+		err = db.RetryEmbeddingJob(j.JobID, reason, time.Now().Add(Backoff(j.Attempts)))
+	}
+	if err != nil {
+		klog.Errorf("更新 embedding 任务 %d 状态失败: %v", j.JobID, err)
+	}
+}
+
+// Backoff 返回第 attempts 次失败后的重试等待时间，每次翻倍且不超过 maxBackoff
+func Backoff(attempts int32) time.Duration {
+	d := baseBackoff
+	for i := int32(1); i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
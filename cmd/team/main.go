@@ -17,9 +17,21 @@ package main
 
 import (
     "github.com/Yra-A/Fusion_Go/cmd/team/dal"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/cmd/team/evaluate"
+    "github.com/Yra-A/Fusion_Go/cmd/team/experiment"
+    "github.com/Yra-A/Fusion_Go/cmd/team/index"
+    "github.com/Yra-A/Fusion_Go/cmd/team/job"
+    "github.com/Yra-A/Fusion_Go/cmd/team/reindex"
+    "github.com/Yra-A/Fusion_Go/cmd/team/rerank"
     "github.com/Yra-A/Fusion_Go/cmd/team/rpc"
+    "github.com/Yra-A/Fusion_Go/cmd/team/train"
+    "github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+    "github.com/Yra-A/Fusion_Go/pkg/embedder"
+    "github.com/Yra-A/Fusion_Go/pkg/events"
     "github.com/kitex-contrib/obs-opentelemetry/tracing"
     "net"
+    "os"
 
     team "github.com/Yra-A/Fusion_Go/kitex_gen/team/teamservice"
     "github.com/Yra-A/Fusion_Go/pkg/constants"
@@ -35,11 +47,36 @@ import (
 func Init() {
     klog.SetLogger(kitexlogrus.NewLogger())
     klog.SetLevel(klog.LevelDebug)
-    dal.Init()
+    if err := dal.Init(); err != nil {
+        panic(err)
+    }
     rpc.InitRPC()
+    embedder.Init()
+    rerank.Init(recommend.RerankModelPath(), embedder.Default().Model())
+    index.Init()
+    job.Start()
+    job.StartApplicationExpiry()
+    events.InitTable(constants.TeamServiceName, db.DB)
 }
 
 func main() {
+    // team reindex [flags] 重新生成已有队伍的 embedding
+    if len(os.Args) > 1 && os.Args[1] == "reindex" {
+        os.Exit(reindex.Main(os.Args[2:]))
+    }
+    // team evaluate [flags] 回放历史组队记录，离线评估推荐排序
+    if len(os.Args) > 1 && os.Args[1] == "evaluate" {
+        os.Exit(evaluate.Main(os.Args[2:]))
+    }
+    // team experiment [flags] 统计推荐实验各分组的申请转化
+    if len(os.Args) > 1 && os.Args[1] == "experiment" {
+        os.Exit(experiment.Main(os.Args[2:]))
+    }
+    // team train [flags] 使用已处理的入队申请训练重排模型
+    if len(os.Args) > 1 && os.Args[1] == "train" {
+        os.Exit(train.Main(os.Args[2:]))
+    }
+
     r, err := etcd.NewEtcdRegistry([]string{constants.EtcdAddress})
     if err != nil {
         panic(err)
@@ -63,6 +100,8 @@ func main() {
         server.WithRegistry(r), // registry
     )
     err = svr.Run()
+    // 服务退出前写入尚未落盘的事件
+    events.Close()
     if err != nil {
         klog.Fatal(err)
     }
@@ -0,0 +1,212 @@
+package reindex
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal"
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+)
+
+// Options reindex 子命令的参数
+type Options struct {
+	ContestID   int32
+	All         bool
+	BatchSize   int
+	Concurrency int
+	QPS         float64   // embedding 后端每秒最多调用次数
+	Since       time.Time // embedding_updated_time 早于该时间的队伍视为过期
+	Force       bool      // 忽略版本与时间，全部重新生成
+	DryRun      bool      // 只统计需要重新生成的队伍，不调用 embedding 后端
+}
+
+// Store reindex 读取队伍与写入 embedding 所需的存储
+type Store interface {
+	// QueryTeamsForReindex 按 team_id 升序获取 after_id 之后的一批队伍，contest_id 为 0 时不限赛事
+	QueryTeamsForReindex(contest_id int32, after_id int32, limit int) ([]*db.TeamInfo, error)
+	// RegenerateTeamEmbedding 使用 e 重新生成队伍各岗位的 embedding 并保存
+	RegenerateTeamEmbedding(ctx context.Context, e embedder.Embedder, team_id int32, updatedTime time.Time) error
+}
+
+// dbStore 基于数据库的 Store
+type dbStore struct{}
+
+func (dbStore) QueryTeamsForReindex(contest_id int32, after_id int32, limit int) ([]*db.TeamInfo, error) {
+	return db.QueryTeamsForReindex(contest_id, after_id, limit)
+}
+
+func (dbStore) RegenerateTeamEmbedding(ctx context.Context, e embedder.Embedder, team_id int32, updatedTime time.Time) error {
+	return db.RegenerateTeamEmbedding(ctx, e, team_id, updatedTime)
+}
+
+// Report 重新生成的结果统计
+type Report struct {
+	Scanned   int
+	Skipped   int
+	Succeeded int
+	Failed    int
+	Failures  map[int32]string
+}
+
+// ParseFlags 解析 reindex 子命令的参数
+func ParseFlags(args []string, output io.Writer) (*Options, error) {
+	opts := &Options{}
+	var contestID int
+	var since string
+	fs := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.IntVar(&contestID, "contest", 0, "只重新生成该赛事下的队伍")
+	fs.BoolVar(&opts.All, "all", false, "重新生成所有赛事下的队伍")
+	fs.IntVar(&opts.BatchSize, "batch", 50, "每批从数据库读取的队伍数")
+	fs.IntVar(&opts.Concurrency, "concurrency", 4, "同时生成 embedding 的队伍数")
+	fs.Float64Var(&opts.QPS, "qps", 5, "embedding 后端每秒最多调用次数")
+	fs.StringVar(&since, "since", "", "embedding_updated_time 早于该时间(RFC3339)的队伍视为过期")
+	fs.BoolVar(&opts.Force, "force", false, "忽略版本与时间，全部重新生成")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "只统计需要重新生成的队伍")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	opts.ContestID = int32(contestID)
+	if (opts.ContestID == 0) == !opts.All {
+		return nil, errors.New("必须且只能指定 -contest 或 -all 其中之一")
+	}
+	if opts.BatchSize <= 0 || opts.Concurrency <= 0 || opts.QPS <= 0 {
+		return nil, errors.New("-batch、-concurrency、-qps 必须大于 0")
+	}
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, fmt.Errorf("-since 格式错误: %v", err)
+		}
+		opts.Since = t
+	}
+	return opts, nil
+}
+
+// Main reindex 子命令入口，返回进程退出码
+func Main(args []string) int {
+	opts, err := ParseFlags(args, os.Stderr)
+	if err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return 2
+	}
+	if err := dal.Init(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	embedder.Init()
+
+	// 收到中断信号后不再分发新的队伍，进行中的 embedding 调用随之取消
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	report, err := Run(ctx, opts, dbStore{}, embedder.Default(), os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if report.Failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// Stale 判断队伍的 embedding 是否需要重新生成
+func Stale(t *db.TeamInfo, version string, since time.Time) bool {
+	if t.Embedding == "" || t.EmbeddingVersion != version {
+		return true
+	}
+	return !since.IsZero() && t.EmbeddingUpdatedTime.Before(since)
+}
+
+// Run 分批遍历队伍，并发重新生成过期的 embedding，进度与失败原因写入 out；ctx 取消后不再分发新的队伍，等待进行中的队伍结束后返回
+func Run(ctx context.Context, opts *Options, store Store, e embedder.Embedder, out io.Writer) (*Report, error) {
+	limited := embedder.NewRateLimited(e, opts.QPS)
+	version := embedding.Version(e)
+	report := &Report{Failures: make(map[int32]string)}
+	var mu sync.Mutex
+
+	fmt.Fprintf(out, "开始重新生成 embedding, 版本: %s\n", version)
+	var afterID int32
+	for {
+		teams, err := store.QueryTeamsForReindex(opts.ContestID, afterID, opts.BatchSize)
+		if err != nil {
+			return report, err
+		}
+		if len(teams) == 0 {
+			break
+		}
+		afterID = teams[len(teams)-1].TeamID
+
+		ch := make(chan int32)
+		var wg sync.WaitGroup
+		for i := 0; i < opts.Concurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for teamID := range ch {
+					err := store.RegenerateTeamEmbedding(ctx, limited, teamID, time.Now())
+					mu.Lock()
+					if err != nil {
+						report.Failed++
+						report.Failures[teamID] = err.Error()
+					} else {
+						report.Succeeded++
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		for _, t := range teams {
+			if ctx.Err() != nil {
+				break
+			}
+			report.Scanned++
+			if !opts.Force && !Stale(t, version, opts.Since) {
+				report.Skipped++
+				continue
+			}
+			if opts.DryRun {
+				fmt.Fprintf(out, "队伍 %d 需要重新生成 (版本: %q, 更新时间: %s)\n",
+					t.TeamID, t.EmbeddingVersion, t.EmbeddingUpdatedTime.Format(time.RFC3339))
+				continue
+			}
+			ch <- t.TeamID
+		}
+		close(ch)
+		wg.Wait()
+
+		fmt.Fprintf(out, "进度: 已扫描 %d, 跳过 %d, 成功 %d, 失败 %d\n",
+			report.Scanned, report.Skipped, report.Succeeded, report.Failed)
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+	}
+
+	if len(report.Failures) > 0 {
+		ids := make([]int, 0, len(report.Failures))
+		for id := range report.Failures {
+			ids = append(ids, int(id))
+		}
+		sort.Ints(ids)
+		fmt.Fprintln(out, "失败的队伍:")
+		for _, id := range ids {
+			fmt.Fprintf(out, "  %d: %s\n", id, report.Failures[int32(id)])
+		}
+	}
+	fmt.Fprintf(out, "完成: 已扫描 %d, 跳过 %d, 成功 %d, 失败 %d\n",
+		report.Scanned, report.Skipped, report.Succeeded, report.Failed)
+	return report, nil
+}
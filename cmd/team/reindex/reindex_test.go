@@ -0,0 +1,162 @@
+package reindex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+)
+
+type ctxKey struct{}
+
+// fakeStore 在内存中保存队伍，每个队伍生成一次岗位 embedding
+type fakeStore struct {
+	teams []*db.TeamInfo
+	// failTeam 重新生成时返回错误的队伍
+	failTeam int32
+
+	mu          sync.Mutex
+	regenerated []int32
+	ctxValues   []interface{}
+}
+
+func (s *fakeStore) QueryTeamsForReindex(contest_id int32, after_id int32, limit int) ([]*db.TeamInfo, error) {
+	var result []*db.TeamInfo
+	for _, t := range s.teams {
+		if t.TeamID > after_id && (contest_id == 0 || t.ContestID == contest_id) && len(result) < limit {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeStore) RegenerateTeamEmbedding(ctx context.Context, e embedder.Embedder, team_id int32, _ time.Time) error {
+	s.mu.Lock()
+	s.regenerated = append(s.regenerated, team_id)
+	s.ctxValues = append(s.ctxValues, ctx.Value(ctxKey{}))
+	s.mu.Unlock()
+	if team_id == s.failTeam {
+		return errors.New("boom")
+	}
+	_, err := e.Embed(ctx, "岗位")
+	return err
+}
+
+// TestParseFlags 测试必须且只能指定 -contest 或 -all，并解析 -since
+func TestParseFlags(t *testing.T) {
+	if _, err := ParseFlags([]string{}, io.Discard); err == nil {
+		t.Error("ParseFlags() without -contest or -all should fail")
+	}
+	if _, err := ParseFlags([]string{"-contest", "1", "-all"}, io.Discard); err == nil {
+		t.Error("ParseFlags() with both -contest and -all should fail")
+	}
+	if _, err := ParseFlags([]string{"-all", "-qps", "0"}, io.Discard); err == nil {
+		t.Error("ParseFlags() with -qps 0 should fail")
+	}
+	if _, err := ParseFlags([]string{"-all", "-since", "yesterday"}, io.Discard); err == nil {
+		t.Error("ParseFlags() with a malformed -since should fail")
+	}
+	opts, err := ParseFlags([]string{"-contest", "3", "-since", "2024-05-01T00:00:00+08:00"}, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.ContestID != 3 || opts.Since.IsZero() {
+		t.Errorf("ParseFlags() = %+v, want contest 3 with since", opts)
+	}
+}
+
+// TestStale 测试 embedding 为空、版本不一致或早于 since 时需要重新生成
+func TestStale(t *testing.T) {
+	now := time.Now()
+	fresh := &db.TeamInfo{Embedding: "{}", EmbeddingVersion: "v", EmbeddingUpdatedTime: now}
+	if Stale(fresh, "v", time.Time{}) {
+		t.Error("Stale() for an up-to-date team = true, want false")
+	}
+	if !Stale(&db.TeamInfo{EmbeddingVersion: "v"}, "v", time.Time{}) {
+		t.Error("Stale() for an empty embedding = false, want true")
+	}
+	if !Stale(fresh, "v2", time.Time{}) {
+		t.Error("Stale() for another version = false, want true")
+	}
+	if !Stale(fresh, "v", now.Add(time.Hour)) {
+		t.Error("Stale() for an embedding older than since = false, want true")
+	}
+}
+
+// TestRun 测试只为过期队伍调用一次存储，且只产生岗位 embedding 的调用，并使用传入的 ctx
+func TestRun(t *testing.T) {
+	e := embedder.NewFake(4)
+	version := embedding.Version(e)
+	store := &fakeStore{
+		teams: []*db.TeamInfo{
+			{TeamID: 1, Embedding: "{}", EmbeddingVersion: version, EmbeddingUpdatedTime: time.Now()},
+			{TeamID: 2, Embedding: "{}", EmbeddingVersion: "old"},
+			{TeamID: 3},
+			{TeamID: 4},
+		},
+		failTeam: 4,
+	}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "cmd")
+	var out bytes.Buffer
+	report, err := Run(ctx, &Options{All: true, BatchSize: 2, Concurrency: 2, QPS: 1000}, store, e, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Scanned != 4 || report.Skipped != 1 || report.Succeeded != 2 || report.Failed != 1 {
+		t.Errorf("Run() report = %+v, want 4 scanned, 1 skipped, 2 succeeded, 1 failed", report)
+	}
+	if report.Failures[4] != "boom" {
+		t.Errorf("Run() failures = %v, want team 4 failed with boom", report.Failures)
+	}
+	if len(store.regenerated) != 3 {
+		t.Errorf("regenerated teams = %v, want 2, 3 and 4", store.regenerated)
+	}
+	if got := e.Calls(); got != 2 {
+		t.Errorf("embedder calls = %d, want 2 (one per regenerated team, no team-level call)", got)
+	}
+	for _, v := range store.ctxValues {
+		if v != "cmd" {
+			t.Errorf("RegenerateTeamEmbedding() got ctx value %v, want the ctx passed to Run", v)
+		}
+	}
+	if !strings.Contains(out.String(), "4: boom") {
+		t.Errorf("Run() output = %q, want the failure of team 4", out.String())
+	}
+}
+
+// TestRunDryRun 测试 -dry-run 只列出需要重新生成的队伍
+func TestRunDryRun(t *testing.T) {
+	e := embedder.NewFake(4)
+	store := &fakeStore{teams: []*db.TeamInfo{{TeamID: 1}, {TeamID: 2}}}
+	var out bytes.Buffer
+	report, err := Run(context.Background(), &Options{All: true, BatchSize: 10, Concurrency: 1, QPS: 1000, DryRun: true}, store, e, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Scanned != 2 || len(store.regenerated) != 0 || e.Calls() != 0 {
+		t.Errorf("dry run scanned %d, regenerated %v with %d embedder calls, want 2 scanned and nothing regenerated",
+			report.Scanned, store.regenerated, e.Calls())
+	}
+}
+
+// TestRunCanceled 测试 ctx 取消后不再分发新的队伍并返回 ctx 的错误
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	store := &fakeStore{teams: []*db.TeamInfo{{TeamID: 1}, {TeamID: 2}}}
+	report, err := Run(ctx, &Options{All: true, BatchSize: 10, Concurrency: 1, QPS: 1000}, store, embedder.NewFake(4), io.Discard)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() error = %v, want context.Canceled", err)
+	}
+	if len(store.regenerated) != 0 || report.Scanned != 0 {
+		t.Errorf("Run() after cancel regenerated %v and scanned %d, want nothing", store.regenerated, report.Scanned)
+	}
+}
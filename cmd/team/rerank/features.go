@@ -0,0 +1,52 @@
+package rerank
+
+import (
+	"math"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// FeatureNames 模型使用的特征及其顺序，模型文件中的特征必须与之一致
+var FeatureNames = []string{
+	"cosine",         // 用户档案与队伍岗位的语义相似度
+	"skill_overlap",  // 用户技能与岗位所需技能的匹配度
+	"team_age_days",  // 队伍创建至今的天数
+	"fill_ratio",     // 队伍当前人数占赛事人数上限的比例，赛事未设置上限时为 0
+	"same_college",   // 用户与队长是否来自同一学院
+	"enrollment_gap", // 用户与队长入学年份之差的绝对值
+}
+
+// FeatureInput 计算单个用户与队伍的特征所需的数据
+type FeatureInput struct {
+	Cosine       float64
+	SkillOverlap float64
+	TeamAge      time.Duration
+	Members      int32 // 队伍当前人数，包括队长
+	TeamSizeMax  int32 // 赛事的队伍人数上限，未设置时为 0
+	User         *user.UserInfo
+	Leader       *user.UserInfo // 队长信息，获取失败时为 nil
+}
+
+// Features 按 FeatureNames 的顺序返回特征向量
+func Features(in FeatureInput) []float64 {
+	age := in.TeamAge.Hours() / 24
+	if age < 0 {
+		age = 0
+	}
+	var fill float64
+	if in.TeamSizeMax > 0 {
+		fill = math.Min(float64(in.Members)/float64(in.TeamSizeMax), 1)
+	}
+	var sameCollege, gap float64
+	if in.User != nil && in.Leader != nil {
+		if in.User.College != "" && in.User.College == in.Leader.College {
+			sameCollege = 1
+		}
+		// 入学年份未填写时不计算差值
+		if in.User.EnrollmentYear > 0 && in.Leader.EnrollmentYear > 0 {
+			gap = math.Abs(float64(in.User.EnrollmentYear - in.Leader.EnrollmentYear))
+		}
+	}
+	return []float64{in.Cosine, in.SkillOverlap, age, fill, sameCollege, gap}
+}
@@ -0,0 +1,110 @@
+package rerank
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// Model 逻辑回归重排模型，特征在打分前按训练集的均值与标准差标准化
+type Model struct {
+	Features  []string  `json:"features"`
+	Weights   []float64 `json:"weights"`
+	Bias      float64   `json:"bias"`
+	Mean      []float64 `json:"mean"`
+	Std       []float64 `json:"std"`
+	Embedding string    `json:"embedding"` // 训练时计算语义相似度所使用的 embedding 模型
+	Samples   int       `json:"samples"`   // 训练样本数
+	TrainedAt time.Time `json:"trained_at"`
+}
+
+// Validate 检查模型的特征与当前代码一致
+func (m *Model) Validate() error {
+	if len(m.Features) != len(FeatureNames) {
+		return fmt.Errorf("模型包含 %d 个特征, 期望 %d 个", len(m.Features), len(FeatureNames))
+	}
+	for i, name := range FeatureNames {
+		if m.Features[i] != name {
+			return fmt.Errorf("模型的第 %d 个特征为 %q, 期望 %q", i+1, m.Features[i], name)
+		}
+	}
+	n := len(FeatureNames)
+	if len(m.Weights) != n || len(m.Mean) != n || len(m.Std) != n {
+		return fmt.Errorf("模型参数的长度与特征数 %d 不一致", n)
+	}
+	return nil
+}
+
+// Predict 返回申请被接受的概率
+func (m *Model) Predict(x []float64) float64 {
+	z := m.Bias
+	for i, w := range m.Weights {
+		z += w * standardize(x[i], m.Mean[i], m.Std[i])
+	}
+	return sigmoid(z)
+}
+
+// Save 以 JSON 格式保存模型
+func (m *Model) Save(path string) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// Load 读取并校验模型文件
+func Load(path string) (*Model, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m Model
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("解析重排模型 %s 失败: %v", path, err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("重排模型 %s 不可用: %v", path, err)
+	}
+	return &m, nil
+}
+
+var defaultModel *Model
+
+// Init 加载 path 中的重排模型，path 为空时不启用重排，模型不可用时记录错误并不启用重排
+// embeddingModel 为线上使用的 embedding 模型，与训练时不一致时语义相似度的分布可能不同
+func Init(path string, embeddingModel string) {
+	if path == "" {
+		return
+	}
+	m, err := Load(path)
+	if err != nil {
+		klog.Errorf("%v, 不使用重排模型", err)
+		return
+	}
+	klog.Infof("已加载重排模型 %s, 训练于 %s, 样本数 %d", path, m.TrainedAt.Format(time.RFC3339), m.Samples)
+	if m.Embedding != embeddingModel {
+		klog.Warnf("重排模型训练时使用的 embedding 模型为 %s, 线上为 %s", m.Embedding, embeddingModel)
+	}
+	defaultModel = m
+}
+
+// Default 返回 Init 加载的重排模型，未启用重排时返回 nil
+func Default() *Model {
+	return defaultModel
+}
+
+func standardize(x, mean, std float64) float64 {
+	if std == 0 {
+		return 0
+	}
+	return (x - mean) / std
+}
+
+func sigmoid(z float64) float64 {
+	return 1 / (1 + math.Exp(-z))
+}
@@ -0,0 +1,83 @@
+package rerank
+
+import (
+	"math"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// TestFeatures 测试特征的计算，缺失的队长信息与人数上限不产生特征值
+func TestFeatures(t *testing.T) {
+	u := &user.UserInfo{College: "计算机学院", EnrollmentYear: 2021}
+	got := Features(FeatureInput{
+		Cosine:       0.8,
+		SkillOverlap: 0.5,
+		TeamAge:      36 * time.Hour,
+		Members:      6,
+		TeamSizeMax:  4,
+		User:         u,
+		Leader:       &user.UserInfo{College: "计算机学院", EnrollmentYear: 2023},
+	})
+	want := []float64{0.8, 0.5, 1.5, 1, 1, 2}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Features() = %v, want %v", got, want)
+		}
+	}
+
+	got = Features(FeatureInput{User: u, TeamAge: -time.Hour})
+	for i, x := range got {
+		if x != 0 {
+			t.Errorf("Features() without leader %s = %v, want 0", FeatureNames[i], x)
+		}
+	}
+}
+
+// TestTrain 测试在可分的样本上训练后模型能区分正负样本，并能保存与加载
+func TestTrain(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var samples []*Sample
+	for i := 0; i < 400; i++ {
+		label := float64(i % 2)
+		x := make([]float64, len(FeatureNames))
+		for j := range x {
+			x[j] = r.Float64()
+		}
+		// 被接受的申请语义相似度更高
+		x[0] += label
+		samples = append(samples, &Sample{Features: x, Label: label})
+	}
+
+	m, err := Train(samples, DefaultTrainOptions())
+	if err != nil {
+		t.Fatalf("Train() error = %v", err)
+	}
+	met := Evaluate(m, samples)
+	if met.AUC < 0.95 || met.Positives != 200 || met.LogLoss >= math.Ln2 {
+		t.Errorf("Evaluate() = %+v, want AUC >= 0.95", met)
+	}
+	if m.Weights[0] <= 0 {
+		t.Errorf("Train() cosine weight = %v, want positive", m.Weights[0])
+	}
+
+	path := filepath.Join(t.TempDir(), "model.json")
+	if err := m.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if p, q := m.Predict(samples[0].Features), loaded.Predict(samples[0].Features); math.Abs(p-q) > 1e-12 {
+		t.Errorf("loaded model Predict() = %v, want %v", q, p)
+	}
+
+	loaded.Features[0] = "unknown"
+	if err := loaded.Validate(); err == nil {
+		t.Error("Validate() with mismatched features error = nil")
+	}
+}
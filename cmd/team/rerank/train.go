@@ -0,0 +1,152 @@
+package rerank
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// Sample 一条训练样本，Label 为 1 表示申请被接受，0 表示被拒绝
+type Sample struct {
+	Features []float64
+	Label    float64
+}
+
+// TrainOptions 训练参数
+type TrainOptions struct {
+	Epochs       int     // 全量梯度下降的轮数
+	LearningRate float64 // 学习率
+	L2           float64 // L2 正则系数，不作用于偏置
+}
+
+// DefaultTrainOptions 返回默认的训练参数
+func DefaultTrainOptions() TrainOptions {
+	return TrainOptions{Epochs: 500, LearningRate: 0.1, L2: 0.01}
+}
+
+// Train 使用全量梯度下降训练逻辑回归模型
+func Train(samples []*Sample, opts TrainOptions) (*Model, error) {
+	if len(samples) == 0 {
+		return nil, errors.New("没有训练样本")
+	}
+	n := len(FeatureNames)
+	for _, s := range samples {
+		if len(s.Features) != n {
+			return nil, errors.New("训练样本的特征数与 FeatureNames 不一致")
+		}
+	}
+	m := &Model{
+		Features:  append([]string(nil), FeatureNames...),
+		Weights:   make([]float64, n),
+		Mean:      make([]float64, n),
+		Std:       make([]float64, n),
+		Samples:   len(samples),
+		TrainedAt: time.Now(),
+	}
+
+	// 计算每个特征的均值与标准差
+	total := float64(len(samples))
+	for _, s := range samples {
+		for i, x := range s.Features {
+			m.Mean[i] += x / total
+		}
+	}
+	for _, s := range samples {
+		for i, x := range s.Features {
+			d := x - m.Mean[i]
+			m.Std[i] += d * d / total
+		}
+	}
+	for i := range m.Std {
+		m.Std[i] = math.Sqrt(m.Std[i])
+	}
+
+	xs := make([][]float64, len(samples))
+	for j, s := range samples {
+		xs[j] = make([]float64, n)
+		for i, x := range s.Features {
+			xs[j][i] = standardize(x, m.Mean[i], m.Std[i])
+		}
+	}
+	grad := make([]float64, n)
+	for epoch := 0; epoch < opts.Epochs; epoch++ {
+		for i := range grad {
+			grad[i] = opts.L2 * m.Weights[i]
+		}
+		var gradBias float64
+		for j, s := range samples {
+			z := m.Bias
+			for i, x := range xs[j] {
+				z += m.Weights[i] * x
+			}
+			d := (sigmoid(z) - s.Label) / total
+			for i, x := range xs[j] {
+				grad[i] += d * x
+			}
+			gradBias += d
+		}
+		for i := range m.Weights {
+			m.Weights[i] -= opts.LearningRate * grad[i]
+		}
+		m.Bias -= opts.LearningRate * gradBias
+	}
+	return m, nil
+}
+
+// Metrics 模型在一组样本上的表现
+type Metrics struct {
+	Samples   int     `json:"samples"`
+	Positives int     `json:"positives"`
+	LogLoss   float64 `json:"log_loss"`
+	AUC       float64 `json:"auc"` // 样本全为同一类别时为 0
+}
+
+// Evaluate 计算模型在样本上的 log loss 与 AUC
+func Evaluate(m *Model, samples []*Sample) Metrics {
+	met := Metrics{Samples: len(samples)}
+	if len(samples) == 0 {
+		return met
+	}
+	type scored struct {
+		p     float64
+		label float64
+	}
+	ps := make([]scored, len(samples))
+	const eps = 1e-12
+	for i, s := range samples {
+		p := m.Predict(s.Features)
+		ps[i] = scored{p, s.Label}
+		if s.Label > 0.5 {
+			met.Positives++
+			met.LogLoss -= math.Log(math.Max(p, eps))
+		} else {
+			met.LogLoss -= math.Log(math.Max(1-p, eps))
+		}
+	}
+	met.LogLoss /= float64(len(samples))
+
+	// AUC 等于随机取一对正负样本时正样本分数更高的概率，分数相同的样本取平均排名
+	negatives := len(samples) - met.Positives
+	if met.Positives == 0 || negatives == 0 {
+		return met
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].p < ps[j].p })
+	var rankSum float64
+	for i := 0; i < len(ps); {
+		j := i
+		for j < len(ps) && ps[j].p == ps[i].p {
+			j++
+		}
+		avgRank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if ps[k].label > 0.5 {
+				rankSum += avgRank
+			}
+		}
+		i = j
+	}
+	pos := float64(met.Positives)
+	met.AUC = (rankSum - pos*(pos+1)/2) / (pos * float64(negatives))
+	return met
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/contest"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/contest/contestservice"
+    "github.com/Yra-A/Fusion_Go/pkg/constants"
+    "github.com/Yra-A/Fusion_Go/pkg/errno"
+    "github.com/Yra-A/Fusion_Go/pkg/middleware"
+    "github.com/cloudwego/kitex/client"
+    "github.com/cloudwego/kitex/pkg/retry"
+    "github.com/kitex-contrib/obs-opentelemetry/tracing"
+    etcd "github.com/kitex-contrib/registry-etcd"
+    "time"
+)
+
+var contestClient contestservice.Client
+
+func initContestRpc() {
+	r, err := etcd.NewEtcdResolver([]string{constants.EtcdAddress}) // 服务发现
+	if err != nil {
+		panic(err)
+	}
+
+	c, err := contestservice.NewClient(
+		constants.ContestServiceName,
+		client.WithMiddleware(middleware.CommonMiddleware),
+		client.WithInstanceMW(middleware.ClientMiddleware),
+		client.WithMuxConnection(1),                       // mux
+		client.WithRPCTimeout(3*time.Second),              // rpc timeout
+		client.WithConnectTimeout(50*time.Millisecond),    // conn timeout
+		client.WithFailureRetry(retry.NewFailurePolicy()), // retry
+		client.WithSuite(tracing.NewClientSuite()),        // tracer
+		client.WithResolver(r),                            // resolver
+	)
+	if err != nil {
+		panic(err)
+	}
+	contestClient = c
+}
+
+// ContestInfo 比赛详情【rpc 客户端】
+func ContestInfo(ctx context.Context, req *contest.ContestInfoRequest) (*contest.ContestInfoResponse, error) {
+	resp, err := contestClient.ContestInfo(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ContestBatchInfo 批量获取比赛简要信息【rpc 客户端】
+func ContestBatchInfo(ctx context.Context, req *contest.ContestBatchInfoRequest) (*contest.ContestBatchInfoResponse, error) {
+	resp, err := contestClient.ContestBatchInfo(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode != errno.Success.ErrCode {
+		return resp, errno.NewErrNo(resp.StatusCode, resp.StatusMsg)
+	}
+	return resp, nil
+}
@@ -46,3 +46,21 @@ func UserProfileInfo(ctx context.Context, req *user.UserProfileInfoRequest) (*us
 	}
 	return resp, nil
 }
+
+// UserProfileInfoBatch 批量获取用户资料信息【rpc 客户端】
+func UserProfileInfoBatch(ctx context.Context, req *user.UserProfileInfoBatchRequest) (*user.UserProfileInfoBatchResponse, error) {
+	resp, err := userClient.UserProfileInfoBatch(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// UserEmbedding 获取用户档案 embedding【rpc 客户端】
+func UserEmbedding(ctx context.Context, req *user.UserEmbeddingRequest) (*user.UserEmbeddingResponse, error) {
+	resp, err := userClient.UserEmbedding(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
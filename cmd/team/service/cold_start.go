@@ -0,0 +1,165 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+)
+
+// 队伍推荐使用的策略
+const (
+	// StrategyPersonalized 按用户档案个性化排序
+	StrategyPersonalized = "personalized"
+	// StrategyBlended 用户档案不完整，个性化分数与热度分数按档案完整度混合
+	StrategyBlended = "blended"
+	// StrategyPopularity 用户没有可用的档案，按队伍热度排序
+	StrategyPopularity = "popularity"
+)
+
+// profileCompleteness 返回用户档案的完整度，取值范围 [0, 1]
+// 未填写档案或自我介绍与技能都为空时返回 0，此时档案不足以生成有意义的 embedding
+func profileCompleteness(p *user.UserProfileInfo) float64 {
+	if p == nil || p.UserInfo == nil || !p.UserInfo.HasProfile {
+		return 0
+	}
+	hasIntro := strings.TrimSpace(p.Introduction) != ""
+	var skills int
+	for _, s := range p.UserSkills {
+		if s != nil && strings.TrimSpace(s.Skill) != "" {
+			skills++
+		}
+	}
+	if !hasIntro && skills == 0 {
+		return 0
+	}
+	var c float64
+	if hasIntro {
+		c += 0.3
+	}
+	// 填写 3 个技能即视为完整
+	c += 0.4 * math.Min(float64(skills)/3, 1)
+	if strings.TrimSpace(p.UserInfo.College) != "" {
+		c += 0.15
+	}
+	if len(p.Honors) > 0 {
+		c += 0.15
+	}
+	return math.Min(c, 1)
+}
+
+// popularReason 热度是主要排序依据时的推荐理由
+const popularReason = "本赛事热门队伍"
+
+// popularity 计算赛事下每个队伍的热度分数，人数占比使用调用方传入的队伍人数上限
+func (s *RecommenderService) popularity(contestID int32) (map[int32]float64, error) {
+	now := time.Now()
+	stats, err := db.QueryTeamPopularity(contestID, now.Add(-recommend.PopularityWindow()))
+	if err != nil {
+		return nil, err
+	}
+	return popularityScores(stats, s.teamSizeMax, now, s.options.HalfLife), nil
+}
+
+// popularityScores 按近期申请数、人数占比与创建时间计算热度分数，取值范围 [0, 1]
+// 申请数取对数后按赛事内的最大值归一化，赛事未设置人数上限时人数按赛事内的最大人数归一化
+func popularityScores(stats []*db.TeamPopularity, teamSizeMax int32, now time.Time, halfLife time.Duration) map[int32]float64 {
+	var maxApplications int64
+	maxPeople := teamSizeMax
+	for _, t := range stats {
+		if t.Applications > maxApplications {
+			maxApplications = t.Applications
+		}
+		if teamSizeMax <= 0 && t.CurPeopleNum > maxPeople {
+			maxPeople = t.CurPeopleNum
+		}
+	}
+	wApp, wFill, wRecency := recommend.PopularityApplicationWeight(), recommend.PopularityFillWeight(), recommend.PopularityRecencyWeight()
+	total := wApp + wFill + wRecency
+	scores := make(map[int32]float64, len(stats))
+	if total == 0 {
+		return scores
+	}
+	for _, t := range stats {
+		var applications, fill float64
+		if maxApplications > 0 {
+			applications = math.Log1p(float64(t.Applications)) / math.Log1p(float64(maxApplications))
+		}
+		if maxPeople > 0 {
+			fill = math.Min(float64(t.CurPeopleNum)/float64(maxPeople), 1)
+		}
+		// 将时间衰减从 [minTimeBoost, 1] 映射到 [0, 1]
+		recency := (calculateTimeBoost(t.CreatedTime.Unix(), now, halfLife) - minTimeBoost) / (1 - minTimeBoost)
+		scores[t.TeamID] = (wApp*applications + wFill*fill + wRecency*recency) / total
+	}
+	return scores
+}
+
+// rankByPopularity 按热度分数降序排列赛事下的队伍，excluded 中的队伍不参与排序
+func rankByPopularity(scores map[int32]float64, excluded map[int32]bool) []*RankedTeam {
+	result := make([]*RankedTeam, 0, len(scores))
+	for id, score := range scores {
+		if excluded[id] {
+			continue
+		}
+		result = append(result, &RankedTeam{
+			TeamID: id,
+			Score:  score,
+			Recommendation: &team.TeamRecommendation{
+				Score:           score,
+				PopularityScore: score,
+				Reason:          popularReason,
+			},
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].TeamID < result[j].TeamID
+	})
+	return result
+}
+
+// blendPopularity 将个性化分数归一化到 [0, 1] 后与热度分数按 weight 混合并重新排序
+// weight 为个性化分数所占的比重，embedding 不可用的队伍保持在最后；热度贡献的分数更多时附上热门队伍的推荐理由
+func blendPopularity(ranked []*RankedTeam, scores map[int32]float64, weight float64) []*RankedTeam {
+	var scored, failed []*RankedTeam
+	for _, r := range ranked {
+		if r.Recommendation == nil {
+			failed = append(failed, r)
+		} else {
+			scored = append(scored, r)
+		}
+	}
+	if len(scored) == 0 {
+		return ranked
+	}
+	minScore, maxScore := scored[0].Score, scored[0].Score
+	for _, r := range scored {
+		minScore = math.Min(minScore, r.Score)
+		maxScore = math.Max(maxScore, r.Score)
+	}
+	for _, r := range scored {
+		personal := 1.0
+		if maxScore > minScore {
+			personal = (r.Score - minScore) / (maxScore - minScore)
+		}
+		popular := (1 - weight) * scores[r.TeamID]
+		r.Score = weight*personal + popular
+		r.Recommendation.Score = r.Score
+		r.Recommendation.PopularityScore = scores[r.TeamID]
+		if popular > weight*personal {
+			r.Recommendation.Reason = popularReason
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
+	})
+	return append(scored, failed...)
+}
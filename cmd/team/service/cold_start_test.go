@@ -0,0 +1,69 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// TestProfileCompleteness 测试没有自我介绍与技能的档案视为冷启动，填写越完整完整度越高
+func TestProfileCompleteness(t *testing.T) {
+	skills := []*user.UserSkill{{Skill: "Go"}, {Skill: "MySQL"}, {Skill: "Redis"}}
+	tests := []struct {
+		name    string
+		profile *user.UserProfileInfo
+		want    float64
+	}{
+		{"未填写档案", &user.UserProfileInfo{UserInfo: &user.UserInfo{HasProfile: false}, Introduction: "你好"}, 0},
+		{"只有学院", &user.UserProfileInfo{UserInfo: &user.UserInfo{HasProfile: true, College: "计算机学院"}, Introduction: " "}, 0},
+		{"只有自我介绍", &user.UserProfileInfo{UserInfo: &user.UserInfo{HasProfile: true}, Introduction: "熟悉后端开发"}, 0.3},
+		{"完整档案", &user.UserProfileInfo{UserInfo: &user.UserInfo{HasProfile: true, College: "计算机学院"}, Introduction: "熟悉后端开发",
+			UserSkills: skills, Honors: []string{"ACM 铜奖"}}, 1},
+	}
+	for _, tt := range tests {
+		if got := profileCompleteness(tt.profile); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("profileCompleteness(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestPopularity 测试热度分数综合近期申请数、人数占比与创建时间，并与个性化分数混合
+func TestPopularity(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	stats := []*db.TeamPopularity{
+		{TeamID: 1, CurPeopleNum: 4, CreatedTime: now, Applications: 9},
+		{TeamID: 2, CurPeopleNum: 1, CreatedTime: now.Add(-365 * 24 * time.Hour), Applications: 0},
+		{TeamID: 3, CurPeopleNum: 2, CreatedTime: now, Applications: 0},
+	}
+	scores := popularityScores(stats, 4, now, defaultHalfLife)
+	if math.Abs(scores[1]-1) > 1e-9 || scores[2] != 0.2*0.25 {
+		t.Errorf("popularityScores() = %v, want team 1 = 1 and team 2 = 0.05", scores)
+	}
+
+	ranked := rankByPopularity(scores, map[int32]bool{1: true})
+	if len(ranked) != 2 || ranked[0].TeamID != 3 || ranked[0].Recommendation.Score != ranked[0].Score {
+		t.Errorf("rankByPopularity() = %+v, want team 3 first without excluded team 1", ranked)
+	}
+	if rec := ranked[0].Recommendation; rec.Reason != popularReason || rec.PopularityScore != scores[3] {
+		t.Errorf("rankByPopularity() recommendation = %+v, want the popular reason and popularity score", rec)
+	}
+
+	personal := []*RankedTeam{
+		{TeamID: 2, Score: 0.9, Recommendation: &team.TeamRecommendation{}},
+		{TeamID: 1, Score: 0.5, Recommendation: &team.TeamRecommendation{}},
+		{TeamID: 4},
+	}
+	blended := blendPopularity(personal, scores, 0.3)
+	if blended[0].TeamID != 1 || blended[1].TeamID != 2 || blended[2].TeamID != 4 {
+		t.Errorf("blendPopularity() order = %v, want [1 2 4]", teamIDs(blended))
+	}
+	// 队伍 1 的分数主要来自热度，队伍 2 主要来自个性化分数
+	if blended[0].Recommendation.Reason != popularReason || blended[1].Recommendation.Reason != "" {
+		t.Errorf("blendPopularity() reasons = %q, %q, want only team 1 marked popular",
+			blended[0].Recommendation.Reason, blended[1].Recommendation.Reason)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
 	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type CreateTeamService struct {
@@ -17,11 +19,21 @@ func NewCreateTeamService(ctx context.Context) *CreateTeamService {
 }
 
 func (s *CreateTeamService) CreateTeam(user_id int32, team_id int32, contest_id int32, title string, goal string, description string, skills []*team.TeamSkill) (int32, error) {
+	var err error
 	// team_id == 0 代表创建团队
 	if team_id == 0 {
-		return db.CreateTeam(user_id, contest_id, title, goal, description, skills)
+		team_id, err = db.CreateTeam(user_id, contest_id, title, goal, description, skills)
 	} else if team_id > 0 {
-		return team_id, db.ModifyTeam(team_id, title, goal, description, skills)
+		err = db.ModifyTeam(team_id, title, goal, description, skills)
+	} else {
+		return 0, errno.ParamErr
 	}
-	return 0, errno.ParamErr
+	if err != nil {
+		return 0, err
+	}
+	// 让新队伍立即出现在推荐索引中，embedding 生成完成后会再次刷新
+	if err := index.Default().Refresh(team_id); err != nil {
+		klog.CtxErrorf(s.ctx, "刷新队伍 %d 的向量索引失败: %v", team_id, err)
+	}
+	return team_id, nil
 }
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// diversifyTeams 对排在前面的队伍做多样性重排，避免列表前部都是招募相同岗位的队伍
+func (s *RecommenderService) diversifyTeams(ctx context.Context, contestID int32, ranked []*RankedTeam) []*RankedTeam {
+	if s.options.DiversityLambda >= 1 {
+		return ranked
+	}
+	depth := recommend.DiversityDepth()
+	var ids []int32
+	for _, r := range ranked {
+		if len(ids) >= depth || r.Recommendation == nil {
+			break
+		}
+		ids = append(ids, r.TeamID)
+	}
+	sims, err := s.index.Similarities(contestID, ids)
+	if err != nil {
+		klog.CtxWarnf(ctx, "计算队伍相似度失败, 不做多样性重排: %v", err)
+		return ranked
+	}
+	return diversify(ranked, sims, s.options.DiversityLambda)
+}
+
+// diversify 使用最大边际相关性(MMR)重排 ranked 的前 len(sims) 个队伍，其余队伍保持原有顺序
+// 每次选择 lambda*相关性 - (1-lambda)*与已选队伍的最大相似度 最大的队伍，相关性为归一化到 [0, 1] 的推荐分数
+func diversify(ranked []*RankedTeam, sims [][]float64, lambda float64) []*RankedTeam {
+	n := len(sims)
+	if n < 2 {
+		return ranked
+	}
+	minScore, maxScore := ranked[0].Score, ranked[0].Score
+	for _, r := range ranked[:n] {
+		if r.Score < minScore {
+			minScore = r.Score
+		}
+		if r.Score > maxScore {
+			maxScore = r.Score
+		}
+	}
+	relevance := make([]float64, n)
+	for i, r := range ranked[:n] {
+		if maxScore > minScore {
+			relevance[i] = (r.Score - minScore) / (maxScore - minScore)
+		} else {
+			relevance[i] = 1
+		}
+	}
+
+	result := make([]*RankedTeam, 0, len(ranked))
+	selected := make([]bool, n)
+	// redundancy[i] 为队伍 i 与已选队伍的最大相似度
+	redundancy := make([]float64, n)
+	for len(result) < n {
+		best, bestValue := -1, 0.0
+		for i := 0; i < n; i++ {
+			if selected[i] {
+				continue
+			}
+			v := lambda*relevance[i] - (1-lambda)*redundancy[i]
+			if best < 0 || v > bestValue {
+				best, bestValue = i, v
+			}
+		}
+		selected[best] = true
+		result = append(result, ranked[best])
+		for i := 0; i < n; i++ {
+			if sims[best][i] > redundancy[i] {
+				redundancy[i] = sims[best][i]
+			}
+		}
+	}
+	return append(result, ranked[n:]...)
+}
+
+// demoteSeenTeams 将用户多次看到却没有申请过的队伍降到列表末尾
+func demoteSeenTeams(ctx context.Context, user_id int32, contest_id int32, ranked []*RankedTeam) []*RankedTeam {
+	limit := recommend.SeenLimit()
+	if limit == 0 {
+		return ranked
+	}
+	impressions, err := db.QueryUserImpressions(user_id, contest_id, time.Now().Add(-recommend.SeenWindow()))
+	if err != nil {
+		klog.CtxWarnf(ctx, "获取用户 %v 的曝光记录失败, 不降级看过的队伍: %v", user_id, err)
+		return ranked
+	}
+	applied, err := db.QueryAppliedTeamIDs(contest_id, user_id)
+	if err != nil {
+		klog.CtxWarnf(ctx, "获取用户 %v 申请过的队伍失败, 不降级看过的队伍: %v", user_id, err)
+		return ranked
+	}
+	seen := seenWithoutAction(impressions, applied, limit)
+	if len(seen) > 0 {
+		klog.CtxInfof(ctx, "用户 %v 看过 %d 次以上未申请的 %d 个队伍被降到列表末尾", user_id, limit, len(seen))
+	}
+	return demote(ranked, seen)
+}
+
+// seenWithoutAction 返回曝光至少 limit 次且没有被申请过的队伍
+func seenWithoutAction(impressions []*db.RecommendImpression, applied []int32, limit int) map[int32]bool {
+	counts := make(map[int32]int)
+	for _, imp := range impressions {
+		for _, id := range imp.TeamIDs {
+			counts[id]++
+		}
+	}
+	for _, id := range applied {
+		delete(counts, id)
+	}
+	seen := make(map[int32]bool)
+	for id, c := range counts {
+		if c >= limit {
+			seen[id] = true
+		}
+	}
+	return seen
+}
+
+// demote 将 teams 中的队伍保持相对顺序移到列表末尾
+func demote(ranked []*RankedTeam, teams map[int32]bool) []*RankedTeam {
+	if len(teams) == 0 {
+		return ranked
+	}
+	result := make([]*RankedTeam, 0, len(ranked))
+	var demoted []*RankedTeam
+	for _, r := range ranked {
+		if teams[r.TeamID] {
+			demoted = append(demoted, r)
+		} else {
+			result = append(result, r)
+		}
+	}
+	return append(result, demoted...)
+}
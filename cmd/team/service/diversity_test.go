@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+func teamIDs(ranked []*RankedTeam) []int32 {
+	ids := make([]int32, len(ranked))
+	for i, r := range ranked {
+		ids[i] = r.TeamID
+	}
+	return ids
+}
+
+func equalIDs(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestDiversify 测试与已选队伍高度相似的队伍被后移，lambda 为 1 时保持按分数排序
+func TestDiversify(t *testing.T) {
+	ranked := func() []*RankedTeam {
+		return []*RankedTeam{{TeamID: 1, Score: 0.9}, {TeamID: 2, Score: 0.85}, {TeamID: 3, Score: 0.6}, {TeamID: 4}}
+	}
+	// 队伍 1 与 2 招募相同的岗位
+	sims := [][]float64{
+		{1, 0.95, 0.1},
+		{0.95, 1, 0.2},
+		{0.1, 0.2, 1},
+	}
+
+	if got := teamIDs(diversify(ranked(), sims, 0.5)); !equalIDs(got, []int32{1, 3, 2, 4}) {
+		t.Errorf("diversify(lambda=0.5) = %v, want [1 3 2 4]", got)
+	}
+	if got := teamIDs(diversify(ranked(), sims, 1)); !equalIDs(got, []int32{1, 2, 3, 4}) {
+		t.Errorf("diversify(lambda=1) = %v, want [1 2 3 4]", got)
+	}
+}
+
+// TestDemoteSeen 测试多次曝光且未申请的队伍被降到末尾
+func TestDemoteSeen(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	impressions := []*db.RecommendImpression{
+		{TeamIDs: []int32{1, 2, 3}, CreatedTime: now},
+		{TeamIDs: []int32{1, 2}, CreatedTime: now},
+		{TeamIDs: []int32{1}, CreatedTime: now},
+	}
+	seen := seenWithoutAction(impressions, []int32{1}, 2)
+	if len(seen) != 1 || !seen[2] {
+		t.Fatalf("seenWithoutAction() = %v, want only team 2", seen)
+	}
+
+	ranked := []*RankedTeam{{TeamID: 2}, {TeamID: 1}, {TeamID: 3}}
+	if got := teamIDs(demote(ranked, seen)); !equalIDs(got, []int32{1, 3, 2}) {
+		t.Errorf("demote() = %v, want [1 3 2]", got)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rpc"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/contest"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// teamSizeMax 获取赛事的队伍人数上限，获取失败或未设置时返回 0，表示不限制人数
+func (s *TeamListService) teamSizeMax(contest_id int32) int32 {
+	return ContestTeamSizeMax(s.ctx, contest_id)
+}
+
+// ContestTeamSizeMax 通过 contest 服务获取赛事的队伍人数上限，获取失败或未设置时返回 0
+func ContestTeamSizeMax(ctx context.Context, contest_id int32) int32 {
+	sizeMax, err := fetchTeamSizeMax(ctx, contest_id)
+	if err != nil {
+		klog.CtxWarnf(ctx, "获取赛事 %d 信息失败, 不限制队伍人数: %v", contest_id, err)
+		return 0
+	}
+	return sizeMax
+}
+
+// fetchTeamSizeMax 通过 contest 服务获取赛事的队伍人数上限，赛事未设置上限时返回 0，获取失败时返回错误
+// 用于加入队伍时的人数校验，获取失败时不能放行
+func fetchTeamSizeMax(ctx context.Context, contest_id int32) (int32, error) {
+	kresp, err := rpc.ContestInfo(ctx, &contest.ContestInfoRequest{ContestId: contest_id})
+	if err != nil {
+		return 0, err
+	}
+	if kresp.StatusCode != errno.SuccessCode {
+		return 0, errno.NewErrNo(kresp.StatusCode, kresp.StatusMsg)
+	}
+	if kresp.Contest == nil || kresp.Contest.ContestCoreInfo == nil || kresp.Contest.ContestCoreInfo.TeamSize == nil {
+		klog.CtxWarnf(ctx, "赛事 %d 未设置队伍人数上限, 不限制队伍人数", contest_id)
+		return 0, nil
+	}
+	return kresp.Contest.ContestCoreInfo.TeamSize.Max, nil
+}
+
+// ineligibleTeams 获取用户无法加入的队伍：已加入的队伍以及有待处理申请的队伍
+func (s *TeamListService) ineligibleTeams(contest_id int32, user_id int32) (map[int32]bool, error) {
+	joined, err := db.QueryUserTeamIDs(contest_id, user_id)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := db.QueryPendingApplicationTeamIDs(contest_id, user_id)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[int32]bool, len(joined)+len(pending))
+	for _, id := range joined {
+		excluded[id] = true
+	}
+	for _, id := range pending {
+		excluded[id] = true
+	}
+	return excluded, nil
+}
+
+// filterEligibleTeams 过滤掉人数已满、由用户担任队长以及 excluded 中的队伍，teamSizeMax 为 0 时不按人数过滤
+// 被过滤的队伍会加入 excluded
+func filterEligibleTeams(teamList []*team.TeamBriefInfo, user_id int32, teamSizeMax int32, excluded map[int32]bool) []*team.TeamBriefInfo {
+	eligible := make([]*team.TeamBriefInfo, 0, len(teamList))
+	for _, t := range teamList {
+		switch {
+		case excluded[t.TeamId]:
+		case teamSizeMax > 0 && t.CurPeopleNum >= teamSizeMax:
+			excluded[t.TeamId] = true
+		case t.LeaderInfo != nil && t.LeaderInfo.UserId == user_id:
+			excluded[t.TeamId] = true
+		default:
+			eligible = append(eligible, t)
+		}
+	}
+	return eligible
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+)
+
+// TestFilterEligibleTeams 测试人数已满、用户担任队长、已加入或已申请的队伍会被过滤
+func TestFilterEligibleTeams(t *testing.T) {
+	teamList := []*team.TeamBriefInfo{
+		{TeamId: 1, CurPeopleNum: 2, LeaderInfo: &team.MemberInfo{UserId: 10}},
+		{TeamId: 2, CurPeopleNum: 5, LeaderInfo: &team.MemberInfo{UserId: 11}},
+		{TeamId: 3, CurPeopleNum: 1, LeaderInfo: &team.MemberInfo{UserId: 7}},
+		{TeamId: 4, CurPeopleNum: 3, LeaderInfo: &team.MemberInfo{UserId: 12}},
+		{TeamId: 5, CurPeopleNum: 4, LeaderInfo: &team.MemberInfo{UserId: 13}},
+	}
+	excluded := map[int32]bool{4: true}
+
+	eligible := filterEligibleTeams(teamList, 7, 5, excluded)
+	if len(eligible) != 2 || eligible[0].TeamId != 1 || eligible[1].TeamId != 5 {
+		t.Fatalf("filterEligibleTeams() = %v, want teams 1 and 5", eligible)
+	}
+	for _, id := range []int32{2, 3, 4} {
+		if !excluded[id] {
+			t.Errorf("team %d should be excluded", id)
+		}
+	}
+
+	// 未设置人数上限时不按人数过滤
+	eligible = filterEligibleTeams(teamList, 7, 0, map[int32]bool{})
+	if len(eligible) != 4 {
+		t.Errorf("filterEligibleTeams() without size limit returned %d teams, want 4", len(eligible))
+	}
+}
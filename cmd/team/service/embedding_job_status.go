@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+)
+
+type EmbeddingJobStatusService struct {
+	ctx context.Context
+}
+
+func NewEmbeddingJobStatusService(ctx context.Context) *EmbeddingJobStatusService {
+	return &EmbeddingJobStatusService{ctx: ctx}
+}
+
+// EmbeddingJobStatus 获取队伍最近一次 embedding 任务的状态
+func (s *EmbeddingJobStatusService) EmbeddingJobStatus(team_id int32) (*team.TeamEmbeddingJobStatusResponse, error) {
+	job, err := db.QueryLatestEmbeddingJob(team_id)
+	if err != nil {
+		return nil, err
+	}
+	updatedTime, err := db.QueryTeamEmbeddingUpdatedTime(team_id)
+	if err != nil {
+		return nil, err
+	}
+	return &team.TeamEmbeddingJobStatusResponse{
+		JobId:                job.JobID,
+		Status:               job.Status,
+		Attempts:             job.Attempts,
+		MaxAttempts:          job.MaxAttempts,
+		LastError:            job.LastError,
+		NextRunTime:          job.NextRunTime.Unix(),
+		UpdatedTime:          job.UpdatedTime.Unix(),
+		EmbeddingUpdatedTime: updatedTime.Unix(),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"github.com/Yra-A/Fusion_Go/cmd/team/experiment"
+)
+
+// TeamRankingExperiment 队伍推荐排序实验的名称
+const TeamRankingExperiment = "team_ranking"
+
+// rankingVariants 队伍推荐排序实验中各分组对默认打分参数的调整
+var rankingVariants = map[string]func(ScoreOptions) ScoreOptions{
+	// 对照组：语义相似度与技能匹配度混合
+	"hybrid": func(o ScoreOptions) ScoreOptions { return o },
+	// 只使用语义相似度
+	"cosine": func(o ScoreOptions) ScoreOptions {
+		o.SkillWeight = 0
+		return o
+	},
+}
+
+func init() {
+	experiment.Register(&experiment.Experiment{
+		Name: TeamRankingExperiment,
+		Variants: []experiment.Variant{
+			{Name: "hybrid", Weight: 50},
+			{Name: "cosine", Weight: 50},
+		},
+	})
+}
+
+// rankingOptions 返回用户在队伍推荐排序实验中的分组及该分组的打分参数
+// 实验未注册或分组没有对应的参数时返回空分组与默认参数
+func rankingOptions(user_id int32) (string, ScoreOptions) {
+	opts := DefaultScoreOptions()
+	e := experiment.Lookup(TeamRankingExperiment)
+	if e == nil {
+		return "", opts
+	}
+	variant := e.Assign(user_id)
+	adjust, ok := rankingVariants[variant]
+	if !ok {
+		return "", opts
+	}
+	return variant, adjust(opts)
+}
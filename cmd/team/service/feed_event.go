@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/pkg/events"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// recordListImpressions 记录一次列表请求中用户看到的每个队伍及其位置，offset 为本页第一个队伍在完整列表中的下标
+// scores 为推荐排序给出的分数，未使用推荐排序时为 nil
+func recordListImpressions(contest_id int32, user_id int32, offset int32, tag string, teamList []*team.TeamBriefInfo, scores map[int32]float64) {
+	requestID := events.NewRequestID()
+	for i, t := range teamList {
+		events.Record(&events.Event{
+			Type:       events.TypeImpression,
+			RequestID:  requestID,
+			UserID:     user_id,
+			ContestID:  contest_id,
+			TeamID:     t.TeamId,
+			Rank:       offset + int32(i) + 1,
+			Experiment: tag,
+			Score:      scores[t.TeamId],
+		})
+	}
+}
+
+// recordWithContest 在后台查询队伍所属的赛事后记录事件，不阻塞请求，查询失败时赛事记为 0
+func recordWithContest(ctx context.Context, team_id int32, e *events.Event) {
+	e.CreatedTime = time.Now()
+	go func() {
+		contestID, err := db.QueryTeamContestID(team_id)
+		if err != nil {
+			klog.CtxWarnf(ctx, "获取队伍所属赛事失败, teamID=%v: %v", team_id, err)
+		}
+		e.ContestID = contestID
+		events.Record(e)
+	}()
+}
+
+// recordApplication 记录用户提交的入队申请
+func recordApplication(ctx context.Context, user_id int32, team_id int32, application_id int32) {
+	recordWithContest(ctx, team_id, &events.Event{
+		Type:          events.TypeApplication,
+		UserID:        user_id,
+		TeamID:        team_id,
+		ApplicationID: application_id,
+	})
+}
+
+// recordWithdraw 记录申请人撤回入队申请
+func recordWithdraw(ctx context.Context, application *db.TeamApplication) {
+	recordWithContest(ctx, application.TeamID, &events.Event{
+		Type:          events.TypeWithdraw,
+		UserID:        application.UserID,
+		TeamID:        application.TeamID,
+		ApplicationID: application.ApplicationID,
+		ApplicantID:   application.UserID,
+	})
+}
+
+// recordManageAction 记录队长对入队申请的处理，action_type 为 1 时为接受，否则为拒绝
+func recordManageAction(ctx context.Context, user_id int32, action_type int32, application *db.TeamApplication) {
+	eventType := events.TypeReject
+	if action_type == 1 {
+		eventType = events.TypeAccept
+	}
+	recordWithContest(ctx, application.TeamID, &events.Event{
+		Type:          eventType,
+		UserID:        user_id,
+		TeamID:        application.TeamID,
+		ApplicationID: application.ApplicationID,
+		ApplicantID:   application.UserID,
+	})
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/redis"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// listSnapshot 用户在赛事中的一次个性化排序结果，翻页时按快照中的顺序返回，避免分数随时间变化导致重复或遗漏
+type listSnapshot struct {
+	ID              string                             `json:"id"`
+	TeamIDs         []int32                            `json:"team_ids"`
+	Recommendations map[int32]*team.TeamRecommendation `json:"recommendations,omitempty"`
+	Scores          map[int32]float64                  `json:"scores,omitempty"`
+	Variant         string                             `json:"variant"`
+	Strategy        string                             `json:"strategy"`
+}
+
+// listCursor 分页游标的内容，编码后对调用方不透明
+type listCursor struct {
+	SnapshotID string `json:"s,omitempty"` // 为空表示未使用快照，按 offset 分页
+	Offset     int32  `json:"o"`
+}
+
+// encodeCursor 将游标编码为 URL 安全的字符串
+func encodeCursor(c listCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor 解析 encodeCursor 生成的游标，格式错误时返回 errno.ParamErr
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errno.ParamErr
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.Offset < 0 {
+		return listCursor{}, errno.ParamErr
+	}
+	return c, nil
+}
+
+// nextCursor 返回下一页的游标，已是最后一页时返回空字符串
+func nextCursor(snapshotID string, offset int32, limit int32, total int) string {
+	if limit <= 0 || int(offset)+int(limit) >= total {
+		return ""
+	}
+	return encodeCursor(listCursor{SnapshotID: snapshotID, Offset: offset + limit})
+}
+
+// newSnapshot 根据排序后的队伍列表生成快照，recommendations 与 scores 按 team_id 保存，翻页时无需重新打分
+func newSnapshot(teamList []*team.TeamBriefInfo, ranked []*RankedTeam, variant string, strategy string) *listSnapshot {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	snap := &listSnapshot{
+		ID:              hex.EncodeToString(b),
+		TeamIDs:         make([]int32, len(teamList)),
+		Recommendations: make(map[int32]*team.TeamRecommendation, len(ranked)),
+		Scores:          make(map[int32]float64, len(ranked)),
+		Variant:         variant,
+		Strategy:        strategy,
+	}
+	for i, t := range teamList {
+		snap.TeamIDs[i] = t.TeamId
+	}
+	for _, r := range ranked {
+		if r.Recommendation != nil {
+			snap.Recommendations[r.TeamID] = r.Recommendation
+		}
+		snap.Scores[r.TeamID] = r.Score
+	}
+	return snap
+}
+
+// page 从快照的第 offset 个队伍开始按顺序取 limit 个仍存在的队伍，已被删除的队伍跳过并由后面的队伍补足
+// 返回的 scanned 为本页在快照中扫过的队伍数，下一页从 offset+scanned 开始
+// withRecommendation 为 true 时附带快照中保存的推荐分数及理由
+func (snap *listSnapshot) page(teamList []*team.TeamBriefInfo, offset int32, limit int32, withRecommendation bool) (result []*team.TeamBriefInfo, scanned int32) {
+	byID := make(map[int32]*team.TeamBriefInfo, len(teamList))
+	for _, t := range teamList {
+		byID[t.TeamId] = t
+	}
+	i := offset
+	for ; i < int32(len(snap.TeamIDs)) && int32(len(result)) < limit; i++ {
+		id := snap.TeamIDs[i]
+		t, ok := byID[id]
+		if !ok {
+			continue
+		}
+		if withRecommendation {
+			t.Recommendation = snap.Recommendations[id]
+		}
+		result = append(result, t)
+	}
+	return result, i - offset
+}
+
+// loadSnapshot 读取用户在赛事中的排序快照，id 不为空时只接受该 id 的快照
+func loadSnapshot(ctx context.Context, contest_id int32, user_id int32, id string) *listSnapshot {
+	v, ok := redis.TeamListSnapshot{}.GetTeamListSnapshot(contest_id, user_id)
+	if !ok {
+		return nil
+	}
+	snap := new(listSnapshot)
+	if err := json.Unmarshal([]byte(v), snap); err != nil {
+		klog.CtxWarnf(ctx, "解析队伍列表快照失败, contestID=%v, userID=%v: %v", contest_id, user_id, err)
+		return nil
+	}
+	if id != "" && snap.ID != id {
+		return nil
+	}
+	return snap
+}
+
+// saveSnapshot 保存用户在赛事中的排序快照，覆盖之前的快照，失败时只记录日志
+func saveSnapshot(ctx context.Context, contest_id int32, user_id int32, snap *listSnapshot) {
+	b, err := json.Marshal(snap)
+	if err != nil {
+		klog.CtxErrorf(ctx, "序列化队伍列表快照失败: %v", err)
+		return
+	}
+	if err := (redis.TeamListSnapshot{}).SetTeamListSnapshot(contest_id, user_id, string(b), recommend.SnapshotTTL()); err != nil {
+		klog.CtxWarnf(ctx, "保存队伍列表快照失败, contestID=%v, userID=%v: %v", contest_id, user_id, err)
+	}
+}
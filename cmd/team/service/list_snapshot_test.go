@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+)
+
+func briefIDs(teamList []*team.TeamBriefInfo) []int32 {
+	ids := make([]int32, len(teamList))
+	for i, t := range teamList {
+		ids[i] = t.TeamId
+	}
+	return ids
+}
+
+// TestCursor 测试游标编码后可以还原，格式错误的游标返回参数错误
+func TestCursor(t *testing.T) {
+	c, err := decodeCursor(encodeCursor(listCursor{SnapshotID: "abc", Offset: 20}))
+	if err != nil || c.SnapshotID != "abc" || c.Offset != 20 {
+		t.Fatalf("decodeCursor = %+v, %v", c, err)
+	}
+	for _, s := range []string{"not base64!", "bm90IGpzb24", encodeCursor(listCursor{Offset: -1})} {
+		if _, err := decodeCursor(s); err == nil {
+			t.Errorf("decodeCursor(%q) 应返回错误", s)
+		}
+	}
+
+	if got := nextCursor("abc", 0, 10, 25); got == "" {
+		t.Error("未到最后一页时应返回游标")
+	}
+	if got := nextCursor("abc", 20, 10, 25); got != "" {
+		t.Errorf("最后一页不应返回游标, got %q", got)
+	}
+}
+
+// TestSnapshotPage 测试按快照顺序分页，已删除的队伍被跳过并由后面的队伍补足，新增的队伍不影响翻页
+func TestSnapshotPage(t *testing.T) {
+	ranked := []*RankedTeam{
+		{TeamID: 3, Score: 0.9, Recommendation: &team.TeamRecommendation{Score: 0.9}},
+		{TeamID: 1, Score: 0.8, Recommendation: &team.TeamRecommendation{Score: 0.8}},
+		{TeamID: 2, Score: 0.7, Recommendation: &team.TeamRecommendation{Score: 0.7}},
+	}
+	teamList := []*team.TeamBriefInfo{{TeamId: 1}, {TeamId: 2}, {TeamId: 3}, {TeamId: 4}}
+	snap := newSnapshot(orderByRecommendation(teamList, ranked), ranked, "control", StrategyPersonalized)
+	if !equalIDs(snap.TeamIDs, []int32{3, 1, 2, 4}) {
+		t.Fatalf("TeamIDs = %v", snap.TeamIDs)
+	}
+
+	// 第二页请求时队伍 2 已被删除，新增了队伍 5
+	fresh := []*team.TeamBriefInfo{{TeamId: 1}, {TeamId: 3}, {TeamId: 4}, {TeamId: 5}}
+	page, scanned := snap.page(fresh, 2, 2, true)
+	if !equalIDs(briefIDs(page), []int32{4}) || scanned != 2 {
+		t.Fatalf("page = %v, scanned = %d", briefIDs(page), scanned)
+	}
+	if page[0].Recommendation != nil {
+		t.Error("未参与推荐排序的队伍不应附带推荐理由")
+	}
+
+	page, scanned = snap.page(fresh, 0, 2, true)
+	if !equalIDs(briefIDs(page), []int32{3, 1}) || scanned != 2 || page[0].Recommendation == nil || page[0].Recommendation.Score != 0.9 {
+		t.Fatalf("page = %v, scanned = %d", briefIDs(page), scanned)
+	}
+	// 队伍 1 被删除时第一页由队伍 2 补足，下一页从队伍 4 开始
+	page, scanned = snap.page([]*team.TeamBriefInfo{{TeamId: 2}, {TeamId: 3}, {TeamId: 4}}, 0, 2, false)
+	if !equalIDs(briefIDs(page), []int32{3, 2}) || scanned != 3 {
+		t.Fatalf("page = %v, scanned = %d, want [3 2] and 3", briefIDs(page), scanned)
+	}
+	if got := nextCursor(snap.ID, 0, scanned, len(snap.TeamIDs)); got == "" {
+		t.Error("未到最后一页时应返回游标")
+	} else if c, _ := decodeCursor(got); c.Offset != 3 {
+		t.Errorf("next offset = %d, want 3", c.Offset)
+	}
+	if got, _ := snap.page(fresh, 4, 2, true); len(got) != 0 {
+		t.Errorf("超出范围的 offset 应返回空列表, got %v", briefIDs(got))
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+    "context"
+    "time"
+
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/cmd/team/rpc"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/contest"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/team"
+    "github.com/Yra-A/Fusion_Go/pkg/errno"
+    "github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+    // defaultMyListLimit 未指定 limit 时每页返回的申请数或队伍数
+    defaultMyListLimit = 20
+    // maxMyListLimit 每页最多返回的申请数或队伍数
+    maxMyListLimit = 100
+)
+
+// myListLimit 返回实际使用的分页大小，未指定时使用 defaultMyListLimit，超过 maxMyListLimit 时截断
+func myListLimit(limit int32) int32 {
+    if limit <= 0 {
+        return defaultMyListLimit
+    }
+    if limit > maxMyListLimit {
+        return maxMyListLimit
+    }
+    return limit
+}
+
+type MyApplicationsService struct {
+    ctx context.Context
+}
+
+func NewMyApplicationsService(ctx context.Context) *MyApplicationsService {
+    return &MyApplicationsService{ctx: ctx}
+}
+
+// validApplicationStatus 判断 status 是否为合法的申请状态过滤条件，空字符串表示不过滤
+func validApplicationStatus(status string) bool {
+    switch status {
+    case "", db.ApplicationStatusPending, db.ApplicationStatusAccepted, db.ApplicationStatusRejected,
+        db.ApplicationStatusWithdrawn, db.ApplicationStatusExpired:
+        return true
+    }
+    return false
+}
+
+// displayStatus 返回申请或邀请对用户展示的状态，since 之前创建且仍待处理的记录尚未被定时任务清理，展示为已过期
+// 申请与邀请的待处理、已过期状态取值相同
+func displayStatus(status string, created_time time.Time, since time.Time) string {
+    if status == db.ApplicationStatusPending && !since.IsZero() && created_time.Before(since) {
+        return db.ApplicationStatusExpired
+    }
+    return status
+}
+
+// fetchContestTitles 批量获取比赛标题，按 contest_id 索引，获取失败时只记录日志并返回空结果
+func fetchContestTitles(ctx context.Context, contest_ids []int32) map[int32]string {
+    titles := make(map[int32]string, len(contest_ids))
+    seen := make(map[int32]bool, len(contest_ids))
+    ids := make([]int32, 0, len(contest_ids))
+    for _, id := range contest_ids {
+        if id != 0 && !seen[id] {
+            seen[id] = true
+            ids = append(ids, id)
+        }
+    }
+    if len(ids) == 0 {
+        return titles
+    }
+    kresp, err := rpc.ContestBatchInfo(ctx, &contest.ContestBatchInfoRequest{ContestIds: ids})
+    if err != nil {
+        klog.CtxWarnf(ctx, "获取比赛标题失败, contestIDs=%v: %v", ids, err)
+        return titles
+    }
+    for _, c := range kresp.ContestList {
+        if c.ContestBriefInfo != nil {
+            titles[c.ContestBriefInfo.ContestId] = c.ContestBriefInfo.Title
+        }
+    }
+    return titles
+}
+
+// MyApplications 按提交时间倒序分页获取用户提交的入队申请，status 不合法时返回 errno.ParamErr
+func (s *MyApplicationsService) MyApplications(user_id int32, status string, limit int32, offset int32) ([]*team.MyApplication, int32, error) {
+    if !validApplicationStatus(status) || offset < 0 {
+        return nil, 0, errno.ParamErr
+    }
+    limit = myListLimit(limit)
+    since := applicationSince()
+    applications, total, err := db.QueryUserApplications(user_id, status, since, limit, offset)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    contestIDs := make([]int32, len(applications))
+    for i, a := range applications {
+        contestIDs[i] = a.ContestID
+    }
+    titles := fetchContestTitles(s.ctx, contestIDs)
+
+    result := make([]*team.MyApplication, len(applications))
+    for i, a := range applications {
+        application := db.ConvertTeamApplication(&a.TeamApplication)
+        application.Status = displayStatus(a.Status, a.CreatedTime, since)
+        result[i] = &team.MyApplication{
+            Application:  application,
+            TeamTitle:    a.TeamTitle,
+            ContestId:    a.ContestID,
+            ContestTitle: titles[a.ContestID],
+        }
+    }
+    return result, int32(total), nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+// TestDisplayStatus 测试超过有效期仍待处理的申请或邀请展示为已过期，其余状态保持不变
+func TestDisplayStatus(t *testing.T) {
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	tests := []struct {
+		status  string
+		created time.Time
+		since   time.Time
+		want    string
+	}{
+		{db.ApplicationStatusPending, now, since, db.ApplicationStatusPending},
+		{db.ApplicationStatusPending, now.Add(-2 * time.Hour), since, db.ApplicationStatusExpired},
+		{db.ApplicationStatusPending, now.Add(-2 * time.Hour), time.Time{}, db.ApplicationStatusPending},
+		{db.ApplicationStatusAccepted, now.Add(-2 * time.Hour), since, db.ApplicationStatusAccepted},
+		{db.InvitationStatusPending, now.Add(-2 * time.Hour), since, db.InvitationStatusExpired},
+		{db.InvitationStatusDeclined, now.Add(-2 * time.Hour), since, db.InvitationStatusDeclined},
+	}
+	for _, tt := range tests {
+		if got := displayStatus(tt.status, tt.created, tt.since); got != tt.want {
+			t.Errorf("displayStatus(%q, %v, %v) = %q, want %q", tt.status, tt.created, tt.since, got, tt.want)
+		}
+	}
+}
+
+// TestValidApplicationStatus 测试只接受已定义的申请状态及空字符串
+func TestValidApplicationStatus(t *testing.T) {
+	for _, s := range []string{"", db.ApplicationStatusPending, db.ApplicationStatusWithdrawn, db.ApplicationStatusExpired} {
+		if !validApplicationStatus(s) {
+			t.Errorf("validApplicationStatus(%q) = false", s)
+		}
+	}
+	if validApplicationStatus("done") {
+		t.Error(`validApplicationStatus("done") = true`)
+	}
+}
+
+// TestMyListLimit 测试未指定 limit 时使用默认值，超过上限时截断
+func TestMyListLimit(t *testing.T) {
+	for _, tt := range []struct{ limit, want int32 }{
+		{0, defaultMyListLimit},
+		{-1, defaultMyListLimit},
+		{10, 10},
+		{maxMyListLimit, maxMyListLimit},
+		{maxMyListLimit + 1, maxMyListLimit},
+	} {
+		if got := myListLimit(tt.limit); got != tt.want {
+			t.Errorf("myListLimit(%d) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
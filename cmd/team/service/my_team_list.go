@@ -0,0 +1,68 @@
+package service
+
+import (
+    "context"
+
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/team"
+    "github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+type MyTeamListService struct {
+    ctx context.Context
+}
+
+func NewMyTeamListService(ctx context.Context) *MyTeamListService {
+    return &MyTeamListService{ctx: ctx}
+}
+
+// MyTeamList 按创建时间倒序分页获取用户担任队长或加入的队伍，role 不合法时返回 errno.ParamErr
+func (s *MyTeamListService) MyTeamList(user_id int32, role string, limit int32, offset int32) ([]*team.MyTeam, int32, error) {
+    if (role != "" && role != db.RoleLeader && role != db.RoleMember) || offset < 0 {
+        return nil, 0, errno.ParamErr
+    }
+    limit = myListLimit(limit)
+    teams, total, err := db.QueryUserTeams(user_id, role, limit, offset)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    leaderIDs := make([]int32, len(teams))
+    contestIDs := make([]int32, len(teams))
+    for i, t := range teams {
+        leaderIDs[i] = t.LeaderID
+        contestIDs[i] = t.ContestID
+    }
+    profiles, err := fetchUserProfiles(s.ctx, leaderIDs)
+    if err != nil {
+        return nil, 0, err
+    }
+    titles := fetchContestTitles(s.ctx, contestIDs)
+
+    result := make([]*team.MyTeam, len(teams))
+    for i, t := range teams {
+        leader := &team.MemberInfo{UserId: t.LeaderID}
+        if u, ok := profiles[t.LeaderID]; ok {
+            leader = convertUserProfileInfoToMemberInfo(u)
+        }
+        role := db.RoleMember
+        if t.LeaderID == user_id {
+            role = db.RoleLeader
+        }
+        result[i] = &team.MyTeam{
+            TeamBriefInfo: &team.TeamBriefInfo{
+                TeamId:       t.TeamID,
+                Title:        t.Title,
+                Goal:         t.Goal,
+                CurPeopleNum: t.CurPeopleNum,
+                CreatedTime:  t.CreatedTime.Unix(),
+                ContestId:    t.ContestID,
+                LeaderInfo:   leader,
+                Closed:       t.Closed,
+            },
+            ContestTitle: titles[t.ContestID],
+            Role:         role,
+        }
+    }
+    return result, int32(total), nil
+}
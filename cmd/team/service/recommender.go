@@ -2,49 +2,97 @@ package service
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"math"
 	"sort"
 	"time"
 
 	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rerank"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rpc"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
-	"github.com/Yra-A/Fusion_Go/pkg/configs/openai"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
 	"github.com/cloudwego/kitex/pkg/klog"
-	openaiClient "github.com/sashabaranov/go-openai"
+)
+
+const (
+	// reindexCooldown 队伍 embedding 任务结束后，在该时间内不再因推荐请求重新入队
+	reindexCooldown = time.Hour
+	// defaultHalfLife 队伍推荐分数时间衰减的半衰期
+	defaultHalfLife = 30 * 24 * time.Hour
+	// minTimeBoost 时间衰减的最小值
+	minTimeBoost = 0.5
 )
 
 // RecommenderService 推荐服务
 type RecommenderService struct {
-	openaiClient *openaiClient.Client
-	db           *db.TeamDB
+	embedder embedder.Embedder
+	index    *index.Index
+	options  ScoreOptions
+	// reranker 为 nil 时不使用重排模型
+	reranker *rerank.Model
+	// teamSizeMax 赛事的队伍人数上限，由调用方获取一次后传入，为 0 时不限制
+	teamSizeMax int32
 }
 
-// NewRecommenderService 创建推荐服务实例
-func NewRecommenderService(db *db.TeamDB) *RecommenderService {
-	client, err := openai.NewClient()
-	if err != nil {
-		panic(fmt.Sprintf("Failed to create OpenAI client: %v", err))
+// ScoreOptions 队伍推荐打分的参数
+type ScoreOptions struct {
+	// SemanticWeight、SkillWeight 混合语义相似度与技能匹配度时的权重
+	SemanticWeight float64
+	SkillWeight    float64
+	// HalfLife 时间衰减的半衰期，为 0 时不做时间衰减
+	HalfLife time.Duration
+	// DiversityLambda 多样性重排中相关性所占的比重，为 1 时不做多样性重排
+	DiversityLambda float64
+}
+
+// DefaultScoreOptions 返回线上推荐使用的打分参数
+func DefaultScoreOptions() ScoreOptions {
+	return ScoreOptions{
+		SemanticWeight:  recommend.SemanticWeight(),
+		SkillWeight:     recommend.SkillWeight(),
+		HalfLife:        defaultHalfLife,
+		DiversityLambda: recommend.DiversityLambda(),
 	}
+}
 
+// NewRecommenderService 创建推荐服务实例
+func NewRecommenderService(idx *index.Index, e embedder.Embedder) *RecommenderService {
 	return &RecommenderService{
-		openaiClient: client,
-		db:           db,
+		embedder: e,
+		index:    idx,
+		options:  DefaultScoreOptions(),
+		reranker: rerank.Default(),
 	}
 }
 
-// getGenderText 将性别数字转换为文字描述
-func getGenderText(gender int32) string {
-	switch gender {
-	case 1:
-		return "男"
-	case 2:
-		return "女"
-	default:
-		return "未知"
+// WithScoreOptions 使用 opts 替换默认的打分参数
+func (s *RecommenderService) WithScoreOptions(opts ScoreOptions) *RecommenderService {
+	s.options = opts
+	return s
+}
+
+// WithTeamSizeMax 设置赛事的队伍人数上限，用于计算与队伍人数占比相关的分数
+func (s *RecommenderService) WithTeamSizeMax(teamSizeMax int32) *RecommenderService {
+	s.teamSizeMax = teamSizeMax
+	return s
+}
+
+// GetUserEmbedding 获取用户embedding，优先使用用户服务中持久化的结果，不可用时在本地生成
+func (s *RecommenderService) GetUserEmbedding(ctx context.Context, userProfile *user.UserProfileInfo) ([]float64, error) {
+	kresp, err := rpc.UserEmbedding(ctx, &user.UserEmbeddingRequest{UserId: userProfile.UserInfo.UserId})
+	if err != nil {
+		klog.CtxWarnf(ctx, "获取持久化的用户embedding失败, 改为本地生成: %v", err)
+		return s.GenerateUserEmbedding(ctx, userProfile)
+	}
+	if kresp.StatusCode != errno.SuccessCode || len(kresp.Embedding) == 0 || kresp.Model != s.embedder.Model() {
+		klog.CtxWarnf(ctx, "持久化的用户embedding不可用(status=%v, model=%v), 改为本地生成", kresp.StatusCode, kresp.Model)
+		return s.GenerateUserEmbedding(ctx, userProfile)
 	}
+	return kresp.Embedding, nil
 }
 
 // GenerateUserEmbedding 生成用户embedding
@@ -52,132 +100,179 @@ func (s *RecommenderService) GenerateUserEmbedding(ctx context.Context, userProf
 	klog.CtxInfof(ctx, "开始生成用户embedding, userID=%v", userProfile.UserInfo.UserId)
 	
 	// 构建用户描述
-	description := fmt.Sprintf("性别: %s, 入学年份: %d, 学院: %s, 昵称: %s, 自我介绍: %s, 荣誉: %v, 技能: %v", 
-		getGenderText(userProfile.UserInfo.Gender),
-		userProfile.UserInfo.EnrollmentYear,
-		userProfile.UserInfo.College,
-		userProfile.UserInfo.Nickname,
-		userProfile.Introduction,
-		userProfile.Honors,
-		userProfile.UserSkills)
+	description := embedder.UserProfileText(userProfile)
 	
 	klog.CtxInfof(ctx, "用户描述构建完成: %s", description)
 
-	// 调用OpenAI API生成embedding
-	resp, err := s.openaiClient.CreateEmbeddings(ctx, openaiClient.EmbeddingRequest{
-		Input: description,
-		Model: openai.EmbeddingModel,
-	})
+	// 调用 embedding 后端生成embedding
+	embedding, err := s.embedder.Embed(ctx, description)
 	if err != nil {
-		klog.CtxErrorf(ctx, "OpenAI API调用失败: %v", err)
-		return nil, fmt.Errorf("failed to create embedding: %v", err)
+		klog.CtxErrorf(ctx, "embedding 后端(%s)调用失败: %v", s.embedder.Model(), err)
+		return nil, err
 	}
 
-	if len(resp.Data) == 0 {
-		klog.CtxErrorf(ctx, "OpenAI API返回空数据")
-		return nil, fmt.Errorf("no embedding data returned")
-	}
+	klog.CtxInfof(ctx, "成功生成用户embedding, 维度: %d", len(embedding))
+
+	return embedding, nil
+}
+
+// RankedTeam 推荐排序后的队伍
+type RankedTeam struct {
+	TeamID int32
+	Score  float64
+	// Recommendation 推荐分数的组成及推荐理由，embedding 不可用的队伍为 nil
+	Recommendation *team.TeamRecommendation
+}
 
-	klog.CtxInfof(ctx, "成功生成用户embedding, 维度: %d", len(resp.Data[0].Embedding))
+// RecommendTeams 推荐队伍，excluded 中的队伍不参与排序，同时返回所使用的推荐策略
+// 用户没有可用的档案时按队伍热度排序，档案不完整时个性化分数与热度分数按档案完整度混合
+func (s *RecommenderService) RecommendTeams(ctx context.Context, userProfile *user.UserProfileInfo, contestID int32, excluded map[int32]bool) ([]*RankedTeam, string, error) {
+	klog.CtxInfof(ctx, "开始推荐队伍, userID=%v, contestID=%v", userProfile.UserInfo.UserId, contestID)
 
-	// 将float32转换为float64
-	embedding := make([]float64, len(resp.Data[0].Embedding))
-	for i, v := range resp.Data[0].Embedding {
-		embedding[i] = float64(v)
+	var result []*RankedTeam
+	strategy := StrategyPersonalized
+	completeness := profileCompleteness(userProfile)
+	if completeness == 0 {
+		klog.CtxInfof(ctx, "用户 %v 没有可用的档案, 按队伍热度排序", userProfile.UserInfo.UserId)
+		scores, err := s.popularity(contestID)
+		if err != nil {
+			klog.CtxErrorf(ctx, "计算队伍热度失败: %v", err)
+			return nil, "", err
+		}
+		result = rankByPopularity(scores, excluded)
+		strategy = StrategyPopularity
+	} else {
+		var err error
+		result, err = s.personalizedTeams(ctx, userProfile, contestID, excluded)
+		if err != nil {
+			return nil, "", err
+		}
+		if completeness < 1 {
+			scores, err := s.popularity(contestID)
+			if err != nil {
+				klog.CtxWarnf(ctx, "计算队伍热度失败, 只使用个性化排序: %v", err)
+			} else {
+				klog.CtxInfof(ctx, "用户 %v 的档案完整度为 %.2f, 与队伍热度混合排序", userProfile.UserInfo.UserId, completeness)
+				result = blendPopularity(result, scores, completeness)
+				strategy = StrategyBlended
+			}
+		}
 	}
 
-	return embedding, nil
+	result = s.diversifyTeams(ctx, contestID, result)
+	result = demoteSeenTeams(ctx, userProfile.UserInfo.UserId, contestID, result)
+	for _, r := range result {
+		if rec := r.Recommendation; rec != nil {
+			klog.CtxInfof(ctx, "队伍 %v 的推荐分数: %v (语义: %v, 最匹配岗位: %s, 技能: %v, 命中技能: %v, 时间衰减: %v)",
+				r.TeamID, r.Score, rec.SemanticScore, rec.Job, rec.SkillScore, rec.MatchedSkills, rec.TimeBoost)
+		}
+	}
+	klog.CtxInfof(ctx, "推荐完成, 策略: %s, 共推荐 %d 个队伍", strategy, len(result))
+	return result, strategy, nil
 }
 
-// RecommendTeams 推荐队伍
-func (s *RecommenderService) RecommendTeams(ctx context.Context, userProfile *user.UserProfileInfo, contestID int32) ([]*team.TeamInfo, error) {
-	klog.CtxInfof(ctx, "开始推荐队伍, userID=%v, contestID=%v", userProfile.UserInfo.UserId, contestID)
-	
+// personalizedTeams 按用户档案与队伍岗位的匹配度排序，加载了重排模型时使用模型重新打分
+func (s *RecommenderService) personalizedTeams(ctx context.Context, userProfile *user.UserProfileInfo, contestID int32, excluded map[int32]bool) ([]*RankedTeam, error) {
 	// 获取用户嵌入向量
-	userEmbedding, err := s.GenerateUserEmbedding(ctx, userProfile)
+	userEmbedding, err := s.GetUserEmbedding(ctx, userProfile)
 	if err != nil {
 		return nil, err
 	}
 
-	// 获取所有队伍信息
-	teams, err := s.db.GetContestTeamsWithEmbedding(contestID)
+	// 从向量索引中取出与用户最匹配的前 K 个队伍，多取被排除的队伍数，避免被排除的队伍占用名额
+	results, err := s.index.Search(contestID, userEmbedding, s.embedder.Model(), recommend.SearchTopK()+len(excluded))
 	if err != nil {
-		klog.CtxErrorf(ctx, "获取队伍信息失败: %v", err)
+		klog.CtxErrorf(ctx, "检索队伍向量索引失败: %v", err)
 		return nil, err
 	}
-	klog.CtxInfof(ctx, "获取到 %d 个队伍", len(teams))
+	klog.CtxInfof(ctx, "获取到 %d 个队伍", len(results))
 
-	// 计算相似度并排序
-	type teamScore struct {
-		team  *team.TeamInfo
-		score float64
-	}
-	var scores []teamScore
-	var failedTeams []*team.TeamInfo
-
-	for _, team := range teams {
-		// 解析队伍的嵌入向量
-		var teamEmbedding db.TeamEmbedding
-		if err := json.Unmarshal([]byte(team.Embedding), &teamEmbedding); err != nil {
-			klog.CtxErrorf(ctx, "解析队伍embedding失败, teamID=%v: %v", team.TeamBriefInfo.TeamId, err)
-			// 将解析失败的队伍添加到失败列表
-			failedTeams = append(failedTeams, team)
+	candidates := make([]*index.Result, 0, len(results))
+	for _, r := range results {
+		if excluded[r.TeamID] {
 			continue
 		}
+		// embedding 缺失、无法解析或与用户向量不兼容的队伍等待重新生成
+		if r.Err != nil {
+			klog.CtxWarnf(ctx, "队伍embedding不可用, teamID=%v: %v", r.TeamID, r.Err)
+			s.flagForReindex(ctx, r.TeamID)
+		} else if r.Stale {
+			// 模板版本过期的向量仍可比较，但需要重新生成
+			s.flagForReindex(ctx, r.TeamID)
+		}
+		candidates = append(candidates, r)
+	}
 
-		// 计算用户与队伍中每个岗位的匹配度，取最大值
-		var maxScore float64
-		for _, position := range teamEmbedding.Positions {
-			score := db.CalculatePositionMatchScore(userEmbedding, position)
-			klog.CtxInfof(ctx, "队伍 %v 的岗位 %s 与用户的匹配度: %v", 
-				team.TeamBriefInfo.TeamId, position.Job, score)
-			if score > maxScore {
-				maxScore = score
-			}
+	result := RankTeams(userProfile.UserSkills, candidates, s.options, time.Now())
+	if s.reranker != nil {
+		result = s.rerankTeams(ctx, userProfile, result)
+	}
+	return result, nil
+}
+
+// RankTeams 按推荐分数降序排列检索结果，embedding 不可用的队伍放在最后，now 为计算时间衰减的当前时间
+func RankTeams(userSkills []*user.UserSkill, results []*index.Result, opts ScoreOptions, now time.Time) []*RankedTeam {
+	var scores []*RankedTeam
+	var failedTeams []*RankedTeam
+
+	for _, r := range results {
+		if r.Err != nil {
+			failedTeams = append(failedTeams, &RankedTeam{TeamID: r.TeamID})
+			continue
 		}
 
+		// 混合语义相似度与技能匹配度
+		skills, hasSkills := skillMatchScore(userSkills, r.Skills)
+		score := blendScores(r.Score, skills.Score, hasSkills, opts.SemanticWeight, opts.SkillWeight)
+
 		// 应用时间衰减
-		timeBoost := calculateTimeBoost(team.TeamBriefInfo.CreatedTime)
-		maxScore *= timeBoost
+		timeBoost := calculateTimeBoost(r.CreatedTime, now, opts.HalfLife)
+		score *= timeBoost
 
-		scores = append(scores, teamScore{team: team, score: maxScore})
-		klog.CtxInfof(ctx, "队伍 %v 的推荐分数: %v (时间衰减: %v)", team.TeamBriefInfo.TeamId, maxScore, timeBoost)
+		// 有技能命中时以技能最匹配的岗位作为推荐理由，否则使用语义最匹配的岗位
+		job := r.Job
+		if len(skills.Matched) > 0 {
+			job = skills.Job
+		}
+		scores = append(scores, &RankedTeam{
+			TeamID: r.TeamID,
+			Score:  score,
+			Recommendation: &team.TeamRecommendation{
+				Score:         score,
+				SemanticScore: r.Score,
+				SkillScore:    skills.Score,
+				Job:           job,
+				MatchedSkills: skills.Matched,
+				TimeBoost:     timeBoost,
+			},
+		})
 	}
 
-	// 按相似度排序
-	sort.Slice(scores, func(i, j int) bool {
-		return scores[i].score > scores[j].score
+	// 按推荐分数排序
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
 	})
+	return append(scores, failedTeams...)
+}
 
-	// 返回所有队伍,解析失败的队伍放在最后
-	result := make([]*team.TeamInfo, 0, len(teams))
-	for i := 0; i < len(scores); i++ {
-		result = append(result, scores[i].team)
-		klog.CtxInfof(ctx, "最终推荐队伍 %v, 分数: %v", scores[i].team.TeamBriefInfo.TeamId, scores[i].score)
-	}
-	
-	// 将解析失败的队伍添加到结果末尾
-	result = append(result, failedTeams...)
-	for _, team := range failedTeams {
-		klog.CtxInfof(ctx, "解析失败的队伍 %v 被添加到末尾", team.TeamBriefInfo.TeamId)
-	}
-
-	klog.CtxInfof(ctx, "推荐完成, 共推荐 %d 个队伍", len(result))
-	return result, nil
+// flagForReindex 异步为 embedding 不可用或过期的队伍创建重新生成任务
+func (s *RecommenderService) flagForReindex(ctx context.Context, teamID int32) {
+	go func() {
+		if err := db.FlagTeamForReindex(teamID, reindexCooldown); err != nil {
+			klog.CtxErrorf(ctx, "标记队伍 %v 重新生成embedding失败: %v", teamID, err)
+		}
+	}()
 }
 
-// calculateTimeBoost 计算时间衰减
-func calculateTimeBoost(createdTime int64) float64 {
-	now := time.Now().Unix()
-	days := float64(now-createdTime) / (24 * 60 * 60)
-	
-	// 使用指数衰减函数，半衰期为30天，并设置最小衰减值为0.5
-	halfLife := 30.0
-	decay := math.Exp(-math.Ln2 * days / halfLife)
-	
-	// 设置最小衰减值
-	if decay < 0.5 {
-		return 0.5
+// calculateTimeBoost 计算队伍创建时间相对 now 的指数时间衰减，最小为 minTimeBoost，halfLife 为 0 时不衰减
+func calculateTimeBoost(createdTime int64, now time.Time, halfLife time.Duration) float64 {
+	if halfLife <= 0 {
+		return 1
+	}
+	age := now.Sub(time.Unix(createdTime, 0))
+	decay := math.Exp(-math.Ln2 * age.Hours() / halfLife.Hours())
+	if decay < minTimeBoost {
+		return minTimeBoost
 	}
 	return decay
-} 
\ No newline at end of file
+}
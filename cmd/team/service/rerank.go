@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rerank"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	// leaderCacheTTL 重排时队长信息缓存的有效期
+	leaderCacheTTL = 10 * time.Minute
+	// maxLeaderCache 缓存的队长信息数量上限
+	maxLeaderCache = 1000
+)
+
+type cachedLeader struct {
+	info    *user.UserInfo
+	expires time.Time
+}
+
+var (
+	leaderMu    sync.Mutex
+	leaderCache = make(map[int32]cachedLeader)
+)
+
+// leaderInfos 获取队长的基本信息，缓存中没有的队长通过一次 rpc 获取，获取失败的队长不在结果中
+func leaderInfos(ctx context.Context, leader_ids []int32) map[int32]*user.UserInfo {
+	now := time.Now()
+	infos := make(map[int32]*user.UserInfo, len(leader_ids))
+	var missing []int32
+	leaderMu.Lock()
+	for _, id := range leader_ids {
+		if c, ok := leaderCache[id]; ok && now.Before(c.expires) {
+			infos[id] = c.info
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	leaderMu.Unlock()
+	if len(missing) == 0 {
+		return infos
+	}
+
+	profiles, err := fetchUserProfiles(ctx, missing)
+	if err != nil {
+		klog.CtxWarnf(ctx, "获取队长信息失败, 不计算与队长相关的重排特征: %v", err)
+		return infos
+	}
+	leaderMu.Lock()
+	defer leaderMu.Unlock()
+	for id, p := range profiles {
+		if p.UserInfo == nil {
+			continue
+		}
+		infos[id] = p.UserInfo
+		storeLeader(id, p.UserInfo, now)
+	}
+	return infos
+}
+
+// storeLeader 缓存队长信息，缓存已满时先清理过期的队长，仍然已满时随机淘汰，调用方需持有 leaderMu
+func storeLeader(leader_id int32, info *user.UserInfo, now time.Time) {
+	if _, ok := leaderCache[leader_id]; !ok && len(leaderCache) >= maxLeaderCache {
+		for id, c := range leaderCache {
+			if !now.Before(c.expires) {
+				delete(leaderCache, id)
+			}
+		}
+		for id := range leaderCache {
+			if len(leaderCache) < maxLeaderCache {
+				break
+			}
+			delete(leaderCache, id)
+		}
+	}
+	leaderCache[leader_id] = cachedLeader{info: info, expires: now.Add(leaderCacheTTL)}
+}
+
+// rerankTeams 使用重排模型为排在前面的候选队伍重新打分，特征获取失败时保持原有排序
+func (s *RecommenderService) rerankTeams(ctx context.Context, userProfile *user.UserProfileInfo, ranked []*RankedTeam) []*RankedTeam {
+	depth := recommend.RerankDepth()
+	var ids []int32
+	for _, r := range ranked {
+		if len(ids) >= depth {
+			break
+		}
+		if r.Recommendation != nil {
+			ids = append(ids, r.TeamID)
+		}
+	}
+	teams, err := db.QueryTeamsByIDs(ids)
+	if err != nil {
+		klog.CtxErrorf(ctx, "获取重排特征失败, 保持原有排序: %v", err)
+		return ranked
+	}
+
+	leaderIDs := make([]int32, 0, len(teams))
+	for _, t := range teams {
+		leaderIDs = append(leaderIDs, t.LeaderID)
+	}
+	leaders := leaderInfos(ctx, leaderIDs)
+	scores := make(map[int32]*RankedTeam, len(ranked))
+	for _, r := range ranked {
+		scores[r.TeamID] = r
+	}
+	now := time.Now()
+	inputs := make(map[int32]rerank.FeatureInput, len(teams))
+	for _, t := range teams {
+		rec := scores[t.TeamID].Recommendation
+		inputs[t.TeamID] = rerank.FeatureInput{
+			Cosine:       rec.SemanticScore,
+			SkillOverlap: rec.SkillScore,
+			TeamAge:      now.Sub(t.CreatedTime),
+			Members:      t.CurPeopleNum,
+			TeamSizeMax:  s.teamSizeMax,
+			User:         userProfile.UserInfo,
+			Leader:       leaders[t.LeaderID],
+		}
+	}
+	return applyReranker(s.reranker, ranked, inputs, depth)
+}
+
+// applyReranker 用模型分数替换前 depth 个队伍中有特征的队伍的推荐分数并重新排序，
+// 其余队伍保持原有顺序排在后面
+func applyReranker(m *rerank.Model, ranked []*RankedTeam, inputs map[int32]rerank.FeatureInput, depth int) []*RankedTeam {
+	if depth > len(ranked) {
+		depth = len(ranked)
+	}
+	rescored := make([]*RankedTeam, 0, depth)
+	rest := make([]*RankedTeam, 0, len(ranked))
+	for i, r := range ranked {
+		in, ok := inputs[r.TeamID]
+		if i >= depth || !ok || r.Recommendation == nil {
+			rest = append(rest, r)
+			continue
+		}
+		r.Score = m.Predict(rerank.Features(in))
+		r.Recommendation.Score = r.Score
+		rescored = append(rescored, r)
+	}
+	sort.SliceStable(rescored, func(i, j int) bool {
+		return rescored[i].Score > rescored[j].Score
+	})
+	return append(rescored, rest...)
+}
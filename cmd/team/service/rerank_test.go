@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/rerank"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// TestApplyReranker 测试只为前 depth 个有特征的队伍重新打分，其余队伍保持原有顺序
+func TestApplyReranker(t *testing.T) {
+	n := len(rerank.FeatureNames)
+	m := &rerank.Model{
+		Features: rerank.FeatureNames,
+		Weights:  make([]float64, n),
+		Mean:     make([]float64, n),
+		Std:      make([]float64, n),
+	}
+	// 只看同学院特征
+	m.Weights[4], m.Std[4] = 2, 1
+
+	ranked := []*RankedTeam{
+		{TeamID: 1, Score: 0.9, Recommendation: &team.TeamRecommendation{Score: 0.9}},
+		{TeamID: 2, Score: 0.8, Recommendation: &team.TeamRecommendation{Score: 0.8}},
+		{TeamID: 3, Score: 0.7, Recommendation: &team.TeamRecommendation{Score: 0.7}},
+		{TeamID: 4, Score: 0.6, Recommendation: &team.TeamRecommendation{Score: 0.6}},
+		{TeamID: 5},
+	}
+	// 队伍 2 缺少特征，队伍 4 超出重排范围，队伍 3 与用户同学院
+	inputs := map[int32]rerank.FeatureInput{1: {}, 4: {}}
+	college := &user.UserInfo{College: "计算机学院"}
+	inputs[3] = rerank.FeatureInput{User: college, Leader: college}
+
+	got := applyReranker(m, ranked, inputs, 3)
+	want := []int32{3, 1, 2, 4, 5}
+	for i, r := range got {
+		if r.TeamID != want[i] {
+			t.Fatalf("applyReranker() order[%d] = %d, want %v", i, r.TeamID, want)
+		}
+	}
+	if got[0].Score <= 0.5 || got[0].Recommendation.Score != got[0].Score {
+		t.Errorf("applyReranker() rescored team = %+v", got[0])
+	}
+	if got[3].Score != 0.6 {
+		t.Errorf("applyReranker() team beyond depth score = %v, want 0.6", got[3].Score)
+	}
+}
+
+// TestStoreLeader 测试队长信息缓存已满时先淘汰过期的队长，缓存数量不超过上限
+func TestStoreLeader(t *testing.T) {
+	leaderMu.Lock()
+	defer leaderMu.Unlock()
+	saved := leaderCache
+	defer func() { leaderCache = saved }()
+	leaderCache = make(map[int32]cachedLeader)
+
+	now := time.Now()
+	for i := int32(0); i < maxLeaderCache; i++ {
+		leaderCache[i] = cachedLeader{expires: now.Add(time.Minute)}
+	}
+	leaderCache[0] = cachedLeader{expires: now.Add(-time.Minute)}
+	storeLeader(maxLeaderCache, &user.UserInfo{}, now)
+	if _, ok := leaderCache[0]; ok {
+		t.Error("expired leader should be evicted first")
+	}
+	if len(leaderCache) != maxLeaderCache {
+		t.Errorf("cache size = %d, want %d", len(leaderCache), maxLeaderCache)
+	}
+
+	// 没有过期的队长时随机淘汰
+	storeLeader(maxLeaderCache+1, &user.UserInfo{}, now)
+	if _, ok := leaderCache[maxLeaderCache+1]; !ok || len(leaderCache) != maxLeaderCache {
+		t.Errorf("cache size = %d, want %d with the new leader", len(leaderCache), maxLeaderCache)
+	}
+}
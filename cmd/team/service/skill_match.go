@@ -0,0 +1,195 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+)
+
+const (
+	skillExactMatch    = 1.0 // 技能名称相同
+	skillPartialMatch  = 0.8 // 一方的词完整出现在另一方中，如 Go 与 Go语言、Spring 与 Spring Boot
+	skillCategoryMatch = 0.3 // 技能名称不同但类别相同
+)
+
+// normalizeSkill 统一技能名称的大小写与空白，便于比较
+func normalizeSkill(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), ""))
+}
+
+// skillAliases 常见技能的别名，比较前统一为同一名称
+var skillAliases = map[string]string{
+	"golang":     "go",
+	"js":         "javascript",
+	"ts":         "typescript",
+	"py":         "python",
+	"python3":    "python",
+	"cpp":        "c++",
+	"csharp":     "c#",
+	"node":       "nodejs",
+	"node.js":    "nodejs",
+	"vue.js":     "vue",
+	"vuejs":      "vue",
+	"react.js":   "react",
+	"reactjs":    "react",
+	"postgres":   "postgresql",
+	"pg":         "postgresql",
+	"mongo":      "mongodb",
+	"k8s":        "kubernetes",
+	"tf":         "tensorflow",
+	"springboot": "spring boot",
+}
+
+// canonicalSkill 返回统一大小写、空白与别名后的技能名称
+func canonicalSkill(s string) string {
+	n := normalizeSkill(s)
+	if a, ok := skillAliases[n]; ok {
+		return normalizeSkill(a)
+	}
+	return n
+}
+
+// skillTokens 将技能名称拆分为词，按空白、标点以及中英文交界处切分，每个词按别名统一
+func skillTokens(s string) []string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if a, ok := skillAliases[normalizeSkill(s)]; ok {
+		s = a
+	}
+	var tokens []string
+	var cur []rune
+	var curHan bool
+	flush := func() {
+		if len(cur) > 0 {
+			t := string(cur)
+			if a, ok := skillAliases[t]; ok {
+				t = a
+			}
+			tokens = append(tokens, t)
+			cur = cur[:0]
+		}
+	}
+	for _, r := range s {
+		// + 与 # 属于 C++、C# 等技能名称的一部分
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' {
+			flush()
+			continue
+		}
+		han := unicode.Is(unicode.Han, r)
+		if len(cur) > 0 && han != curHan {
+			flush()
+		}
+		curHan = han
+		cur = append(cur, r)
+	}
+	flush()
+	return tokens
+}
+
+// containsTokens 判断 sub 中的每个词是否都出现在 tokens 中
+func containsTokens(tokens []string, sub []string) bool {
+	if len(sub) == 0 {
+		return false
+	}
+	set := make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		set[t] = true
+	}
+	for _, t := range sub {
+		if !set[t] {
+			return false
+		}
+	}
+	return true
+}
+
+// proficiencyWeight 返回熟练度对应的权重
+func proficiencyWeight(proficiency string) float64 {
+	if w, ok := recommend.ProficiencyWeights[strings.TrimSpace(proficiency)]; ok {
+		return w
+	}
+	return recommend.DefaultProficiencyWeight
+}
+
+// matchSkill 计算单个用户技能对单个岗位技能要求的满足程度，取值 [0, 1]，byName 表示技能名称是否匹配
+func matchSkill(us *user.UserSkill, ts *db.TeamSkills) (score float64, byName bool) {
+	u, t := canonicalSkill(us.Skill), canonicalSkill(ts.Skill)
+	var m float64
+	switch {
+	case u == "" || t == "":
+	case u == t:
+		m = skillExactMatch
+	default:
+		// 按词比较而不是子串，避免 Go 与 MongoDB、Java 与 JavaScript 被误判为匹配
+		ut, tt := skillTokens(us.Skill), skillTokens(ts.Skill)
+		if containsTokens(ut, tt) || containsTokens(tt, ut) {
+			m = skillPartialMatch
+		}
+	}
+	byName = m > 0
+	if m == 0 && us.Category != "" && normalizeSkill(us.Category) == normalizeSkill(ts.Category) {
+		m = skillCategoryMatch
+	}
+	return m * proficiencyWeight(us.Proficiency), byName
+}
+
+// skillMatch 用户技能与队伍技能要求的匹配结果
+type skillMatch struct {
+	Score   float64
+	Job     string   // 匹配度最高的岗位
+	Matched []string // 该岗位中技能名称与用户技能匹配的技能要求
+}
+
+// skillMatchScore 计算用户技能与队伍各岗位技能要求的匹配度，取匹配度最高的岗位
+// 岗位的匹配度为其每项技能要求被用户满足程度的平均值；队伍没有技能要求时 ok 为 false
+func skillMatchScore(userSkills []*user.UserSkill, teamSkills []*db.TeamSkills) (m skillMatch, ok bool) {
+	byJob := make(map[string][]*db.TeamSkills)
+	var jobs []string
+	for _, ts := range teamSkills {
+		if _, seen := byJob[ts.Job]; !seen {
+			jobs = append(jobs, ts.Job)
+		}
+		byJob[ts.Job] = append(byJob[ts.Job], ts)
+	}
+	if len(jobs) == 0 {
+		return m, false
+	}
+
+	m.Job = jobs[0]
+	for _, j := range jobs {
+		var sum float64
+		var matched []string
+		for _, ts := range byJob[j] {
+			var best float64
+			var named bool
+			for _, us := range userSkills {
+				if us == nil {
+					continue
+				}
+				v, byName := matchSkill(us, ts)
+				if v > best {
+					best = v
+				}
+				named = named || byName
+			}
+			if named {
+				matched = append(matched, ts.Skill)
+			}
+			sum += best
+		}
+		if s := sum / float64(len(byJob[j])); s > m.Score {
+			m = skillMatch{Score: s, Job: j, Matched: matched}
+		}
+	}
+	return m, true
+}
+
+// blendScores 按权重混合语义相似度与技能匹配度，队伍没有技能要求时只使用语义相似度
+func blendScores(semantic float64, skill float64, hasSkills bool, semanticWeight float64, skillWeight float64) float64 {
+	if !hasSkills || semanticWeight+skillWeight == 0 {
+		return semantic
+	}
+	return (semantic*semanticWeight + skill*skillWeight) / (semanticWeight + skillWeight)
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// TestSkillMatchScore 测试技能名称、类别与熟练度对匹配度的影响
+func TestSkillMatchScore(t *testing.T) {
+	userSkills := []*user.UserSkill{
+		{Skill: "Go语言", Category: "编程语言", Proficiency: "精通"},
+		{Skill: "MySQL", Category: "数据库", Proficiency: "一般"},
+	}
+	teamSkills := []*db.TeamSkills{
+		{Skill: "Go", Category: "编程语言", Job: "后端"},
+		{Skill: "mysql", Category: "数据库", Job: "后端"},
+		{Skill: "Java", Category: "编程语言", Job: "安卓"},
+	}
+
+	m, ok := skillMatchScore(userSkills, teamSkills)
+	if !ok || m.Job != "后端" {
+		t.Fatalf("skillMatchScore() job = %q, ok = %v, want 后端", m.Job, ok)
+	}
+	// Go语言 与 Go 按词部分匹配且精通 0.8*1.0，MySQL 完全匹配但一般 1.0*0.4
+	if want := (0.8 + 0.4) / 2; math.Abs(m.Score-want) > 1e-9 {
+		t.Errorf("skillMatchScore() score = %v, want %v", m.Score, want)
+	}
+	if len(m.Matched) != 2 || m.Matched[0] != "Go" || m.Matched[1] != "mysql" {
+		t.Errorf("skillMatchScore() matched = %v, want [Go mysql]", m.Matched)
+	}
+
+	// 只有类别相同时给予较低的匹配度
+	m, _ = skillMatchScore(userSkills, teamSkills[2:])
+	if want := 0.3; math.Abs(m.Score-want) > 1e-9 {
+		t.Errorf("skillMatchScore() category-only score = %v, want %v", m.Score, want)
+	}
+	if len(m.Matched) != 0 {
+		t.Errorf("skillMatchScore() category-only matched = %v, want none", m.Matched)
+	}
+
+	if _, ok := skillMatchScore(userSkills, nil); ok {
+		t.Errorf("skillMatchScore() should report ok = false for teams without skills")
+	}
+}
+
+// TestMatchSkillName 测试技能名称的别名与按词匹配
+func TestMatchSkillName(t *testing.T) {
+	cases := []struct {
+		user, team string
+		want       float64
+	}{
+		{"golang", "Go", skillExactMatch},
+		{"JS", "JavaScript", skillExactMatch},
+		{"k8s", "Kubernetes", skillExactMatch},
+		{"Go语言", "go", skillPartialMatch},
+		{"Spring", "Spring Boot", skillPartialMatch},
+		{"Go", "MongoDB", 0},
+		{"Java", "JavaScript", 0},
+		{"C", "C++", 0},
+		{"Node.js", "JavaScript", 0},
+	}
+	for _, c := range cases {
+		got, _ := matchSkill(&user.UserSkill{Skill: c.user, Proficiency: "精通"}, &db.TeamSkills{Skill: c.team})
+		if math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("matchSkill(%q, %q) = %v, want %v", c.user, c.team, got, c.want)
+		}
+	}
+}
+
+// TestBlendScores 测试没有技能要求的队伍只使用语义相似度
+func TestBlendScores(t *testing.T) {
+	if got := blendScores(0.5, 1, true, 0.6, 0.4); math.Abs(got-0.7) > 1e-9 {
+		t.Errorf("blendScores() = %v, want 0.7", got)
+	}
+	if got := blendScores(0.5, 0, false, 0.6, 0.4); got != 0.5 {
+		t.Errorf("blendScores() without skills = %v, want 0.5", got)
+	}
+}
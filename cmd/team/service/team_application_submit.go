@@ -2,7 +2,10 @@ package service
 
 import (
     "context"
+    "time"
+
     "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/pkg/configs/application"
 )
 
 type TeamApplicationSubmitService struct {
@@ -13,10 +16,21 @@ func NewTeamApplicationSubmitService(ctx context.Context) *TeamApplicationSubmit
     return &TeamApplicationSubmitService{ctx: ctx}
 }
 
+// TeamApplicationSubmit 提交入队申请，用户对同一队伍已有待处理的申请时返回 errno.ApplicationDuplicateErr
 func (s *TeamApplicationSubmitService) TeamApplicationSubmit(team_id int32, reason string, created_time int64, application_type int32, user_id int32) error {
-    err := db.CreateTeamApplication(user_id, team_id, reason, created_time, application_type)
+    application_id, err := db.CreateTeamApplication(user_id, team_id, reason, created_time, application_type, applicationSince())
     if err != nil {
         return err
     }
+    recordApplication(s.ctx, user_id, team_id, application_id)
     return nil
 }
+
+// applicationSince 返回仍有效的申请的最早提交时间，在此之前提交的待处理申请视为已过期，申请不会过期时返回零值
+func applicationSince() time.Time {
+    d := application.ExpireAfter()
+    if d == 0 {
+        return time.Time{}
+    }
+    return time.Now().Add(-d)
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamApplicationWithdrawService struct {
+    ctx context.Context
+}
+
+func NewTeamApplicationWithdrawService(ctx context.Context) *TeamApplicationWithdrawService {
+    return &TeamApplicationWithdrawService{ctx: ctx}
+}
+
+// TeamApplicationWithdraw 申请人撤回自己待处理的入队申请，申请已被处理、撤回或已过期时返回 errno.ApplicationNotPendingErr
+func (s *TeamApplicationWithdrawService) TeamApplicationWithdraw(user_id int32, application_id int32) error {
+    application, err := db.WithdrawTeamApplication(user_id, application_id, applicationSince())
+    if err != nil {
+        return err
+    }
+    recordWithdraw(s.ctx, application)
+    return nil
+}
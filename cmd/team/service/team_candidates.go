@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rpc"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/favorite"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/recommend"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultCandidateLimit 未指定 limit 时每个岗位返回的候选人数
+	defaultCandidateLimit = 10
+	// candidateEmbeddingConcurrency 同时获取候选队员 embedding 的最大并发数
+	candidateEmbeddingConcurrency = 8
+)
+
+type TeamCandidatesService struct {
+	ctx context.Context
+}
+
+func NewTeamCandidatesService(ctx context.Context) *TeamCandidatesService {
+	return &TeamCandidatesService{ctx: ctx}
+}
+
+// candidate 参与排序的候选队员
+type candidate struct {
+	member    *team.MemberInfo
+	embedding []float64
+}
+
+// TeamCandidates 在收藏了队伍所在赛事且已填写档案的用户中，为队伍的每个岗位推荐匹配度最高的候选队员，只有队长可以查看
+func (s *TeamCandidatesService) TeamCandidates(user_id int32, team_id int32, limit int32) ([]*team.JobCandidates, error) {
+	teamInfo, err := db.QueryTeamInfo(team_id)
+	if err != nil {
+		return nil, err
+	}
+	if teamInfo.TeamBriefInfo.LeaderInfo.UserId != user_id {
+		return nil, errno.AuthorizationFailedErr
+	}
+	t, err := db.QueryTeamEmbedding(team_id)
+	if err != nil {
+		return nil, err
+	}
+	recommender := NewRecommenderService(index.Default(), embedder.Default())
+	// 队伍 embedding 尚未生成或与当前模型不兼容时无法比较，等待重新生成
+	var teamEmbedding db.TeamEmbedding
+	if t.Embedding == "" || json.Unmarshal([]byte(t.Embedding), &teamEmbedding) != nil {
+		recommender.flagForReindex(s.ctx, team_id)
+		return nil, errno.TeamEmbeddingNotReadyErr
+	}
+	if err := teamEmbedding.CheckCompatible(recommender.embedder.Model(), teamEmbedding.Dimension); err != nil {
+		klog.CtxWarnf(s.ctx, "队伍 %d 的embedding不可用: %v", team_id, err)
+		recommender.flagForReindex(s.ctx, team_id)
+		return nil, errno.TeamEmbeddingNotReadyErr
+	}
+
+	kresp, err := rpc.QueryContestFavoriteUsers(s.ctx, &favorite.QueryContestFavoriteUsersRequest{ContestId: teamInfo.TeamBriefInfo.ContestId})
+	if err != nil {
+		return nil, err
+	}
+	if kresp.StatusCode != errno.SuccessCode {
+		return nil, errno.NewErrNo(kresp.StatusCode, kresp.StatusMsg)
+	}
+
+	// 已在队伍中的用户以及已加入该赛事其他队伍的用户不再推荐
+	members := map[int32]bool{user_id: true}
+	for _, m := range teamInfo.Members {
+		members[m.UserId] = true
+	}
+	joined, err := db.QueryContestMemberIDs(teamInfo.TeamBriefInfo.ContestId)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range joined {
+		members[id] = true
+	}
+
+	// 按收藏顺序分批获取档案，参与打分的已填写档案的用户数受 CandidatePoolSize 限制
+	ids := make([]int32, 0, len(kresp.UserIds))
+	for _, id := range kresp.UserIds {
+		if !members[id] {
+			ids = append(ids, id)
+		}
+	}
+	withProfile, err := profiledUsers(ids, recommend.CandidatePoolSize(), func(batch []int32) (map[int32]*user.UserProfileInfo, error) {
+		return fetchUserProfiles(s.ctx, batch)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	embeddings := make([][]float64, len(withProfile))
+	var g errgroup.Group
+	g.SetLimit(candidateEmbeddingConcurrency)
+	for i, p := range withProfile {
+		i, p := i, p
+		g.Go(func() error {
+			emb, err := recommender.GetUserEmbedding(s.ctx, p)
+			if err != nil {
+				klog.CtxWarnf(s.ctx, "获取用户 %d 的embedding失败, 跳过: %v", p.UserInfo.UserId, err)
+				return nil
+			}
+			embeddings[i] = emb
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var candidates []*candidate
+	for i, emb := range embeddings {
+		if emb == nil {
+			continue
+		}
+		// 用户 embedding 由其他模型或维度生成时跳过该用户
+		if err := teamEmbedding.CheckCompatible(recommender.embedder.Model(), len(emb)); err != nil {
+			klog.CtxWarnf(s.ctx, "用户 %d 的embedding与队伍 %d 不兼容, 跳过: %v", withProfile[i].UserInfo.UserId, team_id, err)
+			continue
+		}
+		candidates = append(candidates, &candidate{
+			member:    convertUserProfileInfoToMemberInfo(withProfile[i]),
+			embedding: emb,
+		})
+	}
+	klog.CtxInfof(s.ctx, "队伍 %d 共有 %d 个候选队员", team_id, len(candidates))
+
+	if limit <= 0 {
+		limit = defaultCandidateLimit
+	}
+	return rankCandidates(teamEmbedding.Positions, candidates, int(limit)), nil
+}
+
+// profiledUsers 按 ids 的顺序每次获取 pool 个用户的档案，返回已填写档案的前 pool 个用户，凑满后不再获取剩余用户的档案
+func profiledUsers(ids []int32, pool int, fetch func([]int32) (map[int32]*user.UserProfileInfo, error)) ([]*user.UserProfileInfo, error) {
+	var result []*user.UserProfileInfo
+	for start := 0; start < len(ids) && len(result) < pool; start += pool {
+		end := start + pool
+		if end > len(ids) {
+			end = len(ids)
+		}
+		profiles, err := fetch(ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids[start:end] {
+			p, ok := profiles[id]
+			if !ok || p.UserInfo == nil || !p.UserInfo.HasProfile {
+				continue
+			}
+			result = append(result, p)
+			if len(result) == pool {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// rankCandidates 计算每个候选队员与每个岗位的匹配度，每个岗位保留匹配度最高的 limit 个候选队员
+func rankCandidates(positions []db.PositionEmbedding, candidates []*candidate, limit int) []*team.JobCandidates {
+	result := make([]*team.JobCandidates, 0, len(positions))
+	for _, p := range positions {
+		scored := make([]*team.TeamCandidate, 0, len(candidates))
+		for _, c := range candidates {
+			scored = append(scored, &team.TeamCandidate{
+				MemberInfo: c.member,
+				Score:      db.CalculatePositionMatchScore(c.embedding, p),
+			})
+		}
+		sort.SliceStable(scored, func(i, j int) bool {
+			return scored[i].Score > scored[j].Score
+		})
+		if len(scored) > limit {
+			scored = scored[:limit]
+		}
+		result = append(result, &team.JobCandidates{Job: p.Job, Candidates: scored})
+	}
+	return result
+}
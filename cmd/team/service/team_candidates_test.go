@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// TestRankCandidates 测试每个岗位按匹配度降序返回前 limit 个候选队员
+func TestRankCandidates(t *testing.T) {
+	positions := []db.PositionEmbedding{
+		{Job: "后端", Embedding: []float64{1, 0}},
+		{Job: "前端", Embedding: []float64{0, 1}},
+	}
+	candidates := []*candidate{
+		{member: &team.MemberInfo{UserId: 1}, embedding: []float64{1, 0.1}},
+		{member: &team.MemberInfo{UserId: 2}, embedding: []float64{0.1, 1}},
+		{member: &team.MemberInfo{UserId: 3}, embedding: []float64{1, 1}},
+	}
+
+	result := rankCandidates(positions, candidates, 2)
+	if len(result) != 2 || result[0].Job != "后端" || result[1].Job != "前端" {
+		t.Fatalf("rankCandidates() = %v, want one entry per position", result)
+	}
+	want := map[string][]int32{"后端": {1, 3}, "前端": {2, 3}}
+	for _, jc := range result {
+		if len(jc.Candidates) != 2 {
+			t.Fatalf("job %s has %d candidates, want 2", jc.Job, len(jc.Candidates))
+		}
+		for i, c := range jc.Candidates {
+			if c.MemberInfo.UserId != want[jc.Job][i] {
+				t.Errorf("job %s candidate %d = user %d, want user %d", jc.Job, i, c.MemberInfo.UserId, want[jc.Job][i])
+			}
+		}
+	}
+}
+
+// TestProfiledUsers 测试前面的用户没有填写档案时继续获取后面的用户，凑满 pool 个后停止
+func TestProfiledUsers(t *testing.T) {
+	profiled := map[int32]bool{3: true, 5: true, 6: true, 7: true}
+	var fetched [][]int32
+	fetch := func(batch []int32) (map[int32]*user.UserProfileInfo, error) {
+		fetched = append(fetched, batch)
+		profiles := make(map[int32]*user.UserProfileInfo, len(batch))
+		for _, id := range batch {
+			profiles[id] = &user.UserProfileInfo{UserInfo: &user.UserInfo{UserId: id, HasProfile: profiled[id]}}
+		}
+		return profiles, nil
+	}
+
+	got, err := profiledUsers([]int32{1, 2, 3, 4, 5, 6, 7, 8}, 2, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].UserInfo.UserId != 3 || got[1].UserInfo.UserId != 5 {
+		t.Fatalf("profiledUsers() = %v, want users 3 and 5", got)
+	}
+	if len(fetched) != 3 {
+		t.Errorf("profiledUsers() fetched %v, want 3 batches", fetched)
+	}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamCloseService struct {
+    ctx context.Context
+}
+
+func NewTeamCloseService(ctx context.Context) *TeamCloseService {
+    return &TeamCloseService{ctx: ctx}
+}
+
+// TeamClose 队长停止或恢复队伍的招募，停止招募的队伍不再出现在队伍列表中，也不再接受入队申请
+func (s *TeamCloseService) TeamClose(user_id int32, team_id int32, closed bool) error {
+    return db.CloseTeam(user_id, team_id, closed)
+}
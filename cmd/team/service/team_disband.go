@@ -0,0 +1,24 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/cmd/team/index"
+)
+
+type TeamDisbandService struct {
+    ctx context.Context
+}
+
+func NewTeamDisbandService(ctx context.Context) *TeamDisbandService {
+    return &TeamDisbandService{ctx: ctx}
+}
+
+// TeamDisband 队长解散队伍，并将队伍从推荐索引中移除
+func (s *TeamDisbandService) TeamDisband(user_id int32, team_id int32) error {
+    if err := db.DisbandTeam(user_id, team_id); err != nil {
+        return err
+    }
+    index.Default().Remove(team_id)
+    return nil
+}
@@ -6,6 +6,7 @@ import (
     "github.com/Yra-A/Fusion_Go/cmd/team/rpc"
     "github.com/Yra-A/Fusion_Go/kitex_gen/team"
     "github.com/Yra-A/Fusion_Go/kitex_gen/user"
+    "github.com/Yra-A/Fusion_Go/pkg/errno"
 )
 
 type TeamInfoService struct {
@@ -28,25 +29,70 @@ func convertUserProfileInfoToMemberInfo(u *user.UserProfileInfo) *team.MemberInf
     }
 }
 
+// fetchUserProfiles 通过一次批量 rpc 获取用户档案，按 user_id 索引，重复的 user_id 只查询一次，不存在的用户不包含在结果中
+func fetchUserProfiles(ctx context.Context, user_ids []int32) (map[int32]*user.UserProfileInfo, error) {
+    seen := make(map[int32]bool, len(user_ids))
+    ids := make([]int32, 0, len(user_ids))
+    for _, id := range user_ids {
+        if !seen[id] {
+            seen[id] = true
+            ids = append(ids, id)
+        }
+    }
+    if len(ids) == 0 {
+        return map[int32]*user.UserProfileInfo{}, nil
+    }
+    kresp, err := rpc.UserProfileInfoBatch(ctx, &user.UserProfileInfoBatchRequest{UserIds: ids})
+    if err != nil {
+        return nil, err
+    }
+    if kresp.StatusCode != errno.SuccessCode {
+        return nil, errno.NewErrNo(kresp.StatusCode, kresp.StatusMsg)
+    }
+    return kresp.UserProfileInfos, nil
+}
+
+// FetchUserProfiles 每次最多 batch_size 个用户分批获取档案，用于离线评估与训练时加载大量用户，不存在的用户不在结果中
+func FetchUserProfiles(ctx context.Context, user_ids []int32, batch_size int) (map[int32]*user.UserProfileInfo, error) {
+    result := make(map[int32]*user.UserProfileInfo, len(user_ids))
+    for start := 0; start < len(user_ids); start += batch_size {
+        end := start + batch_size
+        if end > len(user_ids) {
+            end = len(user_ids)
+        }
+        profiles, err := fetchUserProfiles(ctx, user_ids[start:end])
+        if err != nil {
+            return nil, err
+        }
+        for id, u := range profiles {
+            result[id] = u
+        }
+    }
+    return result, nil
+}
+
 func (s *TeamInfoService) TeamInfo(team_id int32) (*team.TeamInfo, error) {
     teamInfo, err := db.QueryTeamInfo(team_id)
     if err != nil {
         return nil, err
     }
 
-    kresp, err := rpc.UserProfileInfo(context.Background(), &user.UserProfileInfoRequest{UserId: teamInfo.TeamBriefInfo.LeaderInfo.UserId})
+    // 队长与所有队员的档案通过一次 rpc 获取
+    ids := []int32{teamInfo.TeamBriefInfo.LeaderInfo.UserId}
+    for _, t := range teamInfo.Members {
+        ids = append(ids, t.UserId)
+    }
+    profiles, err := fetchUserProfiles(s.ctx, ids)
     if err != nil {
         return nil, err
     }
-    teamInfo.TeamBriefInfo.LeaderInfo = convertUserProfileInfoToMemberInfo(kresp.UserProfileInfo)
-
+    if u, ok := profiles[teamInfo.TeamBriefInfo.LeaderInfo.UserId]; ok {
+        teamInfo.TeamBriefInfo.LeaderInfo = convertUserProfileInfoToMemberInfo(u)
+    }
     for i, t := range teamInfo.Members {
-        kresp, err := rpc.UserProfileInfo(context.Background(), &user.UserProfileInfoRequest{UserId: t.UserId})
-        if err != nil {
-            return nil, err
+        if u, ok := profiles[t.UserId]; ok {
+            teamInfo.Members[i] = convertUserProfileInfoToMemberInfo(u)
         }
-        u := convertUserProfileInfoToMemberInfo(kresp.UserProfileInfo)
-        teamInfo.Members[i] = u
     }
     return teamInfo, nil
 }
@@ -0,0 +1,21 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamInvitationCancelService struct {
+    ctx context.Context
+}
+
+func NewTeamInvitationCancelService(ctx context.Context) *TeamInvitationCancelService {
+    return &TeamInvitationCancelService{ctx: ctx}
+}
+
+// TeamInvitationCancel 队长取消队伍发出的待处理邀请，非队长操作时返回 errno.AuthorizationFailedErr
+// 邀请已被处理、取消或已过期时返回 errno.InvitationNotPendingErr
+func (s *TeamInvitationCancelService) TeamInvitationCancel(user_id int32, invitation_id int32) error {
+    _, err := db.CancelTeamInvitation(user_id, invitation_id, applicationSince())
+    return err
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+    "context"
+    "time"
+
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/team"
+    "github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+type TeamInvitationListService struct {
+    ctx context.Context
+}
+
+func NewTeamInvitationListService(ctx context.Context) *TeamInvitationListService {
+    return &TeamInvitationListService{ctx: ctx}
+}
+
+// validInvitationStatus 判断 status 是否为合法的邀请状态过滤条件，空字符串表示不过滤
+func validInvitationStatus(status string) bool {
+    switch status {
+    case "", db.InvitationStatusPending, db.InvitationStatusAccepted, db.InvitationStatusDeclined,
+        db.InvitationStatusCancelled, db.InvitationStatusExpired:
+        return true
+    }
+    return false
+}
+
+// TeamInvitationList 按发出时间倒序分页获取用户收到的入队邀请，status 不合法时返回 errno.ParamErr
+func (s *TeamInvitationListService) TeamInvitationList(user_id int32, status string, limit int32, offset int32) ([]*team.TeamInvitation, int32, error) {
+    if !validInvitationStatus(status) || offset < 0 {
+        return nil, 0, errno.ParamErr
+    }
+    limit = myListLimit(limit)
+    since := applicationSince()
+    invitations, total, err := db.QueryUserInvitations(user_id, status, since, limit, offset)
+    if err != nil {
+        return nil, 0, err
+    }
+    result, err := convertInvitations(s.ctx, invitations, since)
+    if err != nil {
+        return nil, 0, err
+    }
+    return result, int32(total), nil
+}
+
+// convertInvitations 补充发出邀请的队长信息，并将 since 之前发出且仍待处理的邀请展示为已过期
+func convertInvitations(ctx context.Context, invitations []*db.UserInvitation, since time.Time) ([]*team.TeamInvitation, error) {
+    ids := make([]int32, len(invitations))
+    for i, v := range invitations {
+        ids[i] = v.InviterID
+    }
+    profiles, err := fetchUserProfiles(ctx, ids)
+    if err != nil {
+        return nil, err
+    }
+
+    result := make([]*team.TeamInvitation, len(invitations))
+    for i, v := range invitations {
+        inviter := &team.MemberInfo{UserId: v.InviterID}
+        if u, ok := profiles[v.InviterID]; ok {
+            inviter = convertUserProfileInfoToMemberInfo(u)
+        }
+        result[i] = &team.TeamInvitation{
+            InvitationId: v.InvitationID,
+            TeamId:       v.TeamID,
+            TeamTitle:    v.TeamTitle,
+            ContestId:    v.ContestID,
+            InviterInfo:  inviter,
+            InviteeId:    v.InviteeID,
+            Message:      v.Message,
+            CreatedTime:  v.CreatedTime.Unix(),
+            Status:       displayStatus(v.Status, v.CreatedTime, since),
+        }
+        if v.DecidedTime != nil {
+            result[i].DecidedTime = v.DecidedTime.Unix()
+        }
+    }
+    return result, nil
+}
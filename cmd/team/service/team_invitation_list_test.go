@@ -0,0 +1,19 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+// TestValidInvitationStatus 测试只接受已定义的邀请状态及空字符串
+func TestValidInvitationStatus(t *testing.T) {
+	for _, s := range []string{"", db.InvitationStatusPending, db.InvitationStatusCancelled, db.InvitationStatusExpired} {
+		if !validInvitationStatus(s) {
+			t.Errorf("validInvitationStatus(%q) = false", s)
+		}
+	}
+	if validInvitationStatus(db.ApplicationStatusRejected) {
+		t.Errorf("validInvitationStatus(%q) = true", db.ApplicationStatusRejected)
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamInvitationRespondService struct {
+    ctx context.Context
+}
+
+func NewTeamInvitationRespondService(ctx context.Context) *TeamInvitationRespondService {
+    return &TeamInvitationRespondService{ctx: ctx}
+}
+
+// TeamInvitationRespond 被邀请人接受或拒绝待处理的邀请，邀请已被处理、取消或已过期时返回 errno.InvitationNotPendingErr
+// 接受邀请时与接受入队申请一样按赛事的队伍人数上限与每个赛事只能加入一个队伍的规则校验
+func (s *TeamInvitationRespondService) TeamInvitationRespond(user_id int32, invitation_id int32, action_type int32) error {
+    accept := action_type == 1
+    var teamSizeMax int32
+    if accept {
+        contestID, err := db.QueryInvitationContestID(invitation_id)
+        if err != nil {
+            return err
+        }
+        if teamSizeMax, err = fetchTeamSizeMax(s.ctx, contestID); err != nil {
+            return err
+        }
+    }
+    _, err := db.RespondTeamInvitation(user_id, invitation_id, accept, applicationSince(), teamSizeMax)
+    return err
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamInvitationSendService struct {
+    ctx context.Context
+}
+
+func NewTeamInvitationSendService(ctx context.Context) *TeamInvitationSendService {
+    return &TeamInvitationSendService{ctx: ctx}
+}
+
+// TeamInvitationSend 队长邀请用户加入队伍，返回邀请 id，邀请与申请使用相同的有效期
+// 被邀请人已加入该赛事的队伍时返回 errno.AlreadyInContestTeamErr，已有待处理的邀请时返回 errno.InvitationDuplicateErr
+func (s *TeamInvitationSendService) TeamInvitationSend(user_id int32, team_id int32, invitee_id int32, message string) (int32, error) {
+    return db.CreateTeamInvitation(user_id, team_id, invitee_id, message, applicationSince())
+}
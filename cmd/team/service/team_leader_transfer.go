@@ -0,0 +1,19 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamLeaderTransferService struct {
+    ctx context.Context
+}
+
+func NewTeamLeaderTransferService(ctx context.Context) *TeamLeaderTransferService {
+    return &TeamLeaderTransferService{ctx: ctx}
+}
+
+// TeamLeaderTransfer 队长将队长身份转让给队伍中的其他成员，新队长不是队员时返回 errno.NotTeamMemberErr
+func (s *TeamLeaderTransferService) TeamLeaderTransfer(user_id int32, team_id int32, member_id int32) error {
+    return db.TransferTeamLeader(user_id, team_id, member_id)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamLeaveService struct {
+    ctx context.Context
+}
+
+func NewTeamLeaveService(ctx context.Context) *TeamLeaveService {
+    return &TeamLeaveService{ctx: ctx}
+}
+
+// TeamLeave 队员退出队伍，队长需要先转让队长身份或解散队伍，否则返回 errno.LeaderCannotLeaveErr
+func (s *TeamLeaveService) TeamLeave(user_id int32, team_id int32) error {
+    return db.LeaveTeam(user_id, team_id)
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/experiment"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
 	"github.com/Yra-A/Fusion_Go/cmd/team/rpc"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/team"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/Yra-A/Fusion_Go/pkg/errno"
 	"github.com/cloudwego/kitex/pkg/klog"
 )
 
@@ -18,82 +22,168 @@ func NewTeamListService(ctx context.Context) *TeamListService {
 	return &TeamListService{ctx: ctx}
 }
 
-func (s *TeamListService) TeamList(contest_id int32, limit int32, offset int32, user_id int32) ([]*team.TeamBriefInfo, int32, error) {
+// TeamListResult 队伍列表的一页结果
+type TeamListResult struct {
+	TeamList   []*team.TeamBriefInfo
+	Total      int32
+	Experiment string // 使用推荐排序时为用户所在的实验标签，否则为空
+	Strategy   string // 使用推荐排序时为推荐策略，否则为空
+	NextCursor string // 下一页的游标，已是最后一页时为空
+}
+
+// TeamList 获取赛事的队伍列表，with_recommendation 为 true 时在推荐排序的队伍中附带推荐分数及理由
+// 提供 user_id 时按推荐排序，排序结果按用户与赛事保存为快照，翻页期间顺序保持不变
+// cursor 为上一页返回的 NextCursor，不为空时忽略 offset，游标对应的快照已过期时返回 errno.ListCursorExpiredErr
+// 不使用游标且 offset 为 0 时重新排序
+func (s *TeamListService) TeamList(contest_id int32, limit int32, offset int32, user_id int32, with_recommendation bool, cursor string) (*TeamListResult, error) {
+	var snapshotID string
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		offset, snapshotID = c.Offset, c.SnapshotID
+	}
+
 	// 获取基础队伍列表
 	teamList, err := db.QueryTeamList(contest_id, user_id)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	var userProfile *user.UserProfileInfo
+	var snap *listSnapshot
 	// 如果提供了 user_id，使用推荐系统进行个性化排序
 	if user_id != 0 {
-		klog.CtxInfof(s.ctx, "使用推荐系统进行个性化排序, userID=%v", user_id)
-		
-		// 获取用户信息
-		kresp, err := rpc.UserProfileInfo(s.ctx, &user.UserProfileInfoRequest{UserId: user_id})
-		if err != nil {
-			klog.CtxErrorf(s.ctx, "获取用户信息失败: %v", err)
-			// 如果获取用户信息失败，继续使用原有逻辑
-		} else {
-			userProfile = kresp.UserProfileInfo
-			recommender := NewRecommenderService(db.NewTeamDB())
-			recommendedTeams, err := recommender.RecommendTeams(s.ctx, userProfile, contest_id)
-			if err != nil {
-				klog.CtxErrorf(s.ctx, "推荐系统调用失败: %v", err)
-				// 如果推荐失败，继续使用原有逻辑
-			} else {
-				// 将推荐结果转换为 TeamBriefInfo
-				recommendedBriefInfos := make([]*team.TeamBriefInfo, 0, len(recommendedTeams))
-				for _, t := range recommendedTeams {
-					recommendedBriefInfos = append(recommendedBriefInfos, t.TeamBriefInfo)
+		// 翻页时优先使用已有的排序快照，旧版按 offset 翻页的请求使用最近一次的快照
+		if snapshotID != "" || (cursor == "" && offset > 0) {
+			snap = loadSnapshot(s.ctx, contest_id, user_id, snapshotID)
+			if snap == nil {
+				// 重新排序后原游标的位置不再对应原来的顺序，由调用方从第一页重新获取
+				if snapshotID != "" {
+					return nil, errno.ListCursorExpiredErr
 				}
-				teamList = recommendedBriefInfos
+				klog.CtxInfof(s.ctx, "队伍列表快照不存在或已过期, 重新排序, userID=%v, contestID=%v", user_id, contest_id)
+			}
+		}
+		if snap == nil {
+			teamList, snap, userProfile, err = s.rank(contest_id, user_id, teamList)
+			if err != nil {
+				return nil, err
+			}
+			if snap != nil {
+				saveSnapshot(s.ctx, contest_id, user_id, snap)
 			}
 		}
 	}
 
-	total := len(teamList)
-	if offset < int32(len(teamList)) {
-		if offset+limit >= int32(len(teamList)) {
-			teamList = teamList[offset:]
+	result := &TeamListResult{}
+	var variant string
+	var scores map[int32]float64
+	if snap != nil {
+		variant, scores = snap.Variant, snap.Scores
+		result.Strategy = snap.Strategy
+		result.Total = int32(len(snap.TeamIDs))
+		var scanned int32
+		teamList, scanned = snap.page(teamList, offset, limit, with_recommendation)
+		result.NextCursor = nextCursor(snap.ID, offset, scanned, len(snap.TeamIDs))
+	} else {
+		total := len(teamList)
+		if offset < int32(len(teamList)) {
+			if offset+limit >= int32(len(teamList)) {
+				teamList = teamList[offset:]
+			} else {
+				teamList = teamList[offset : offset+limit]
+			}
 		} else {
-			teamList = teamList[offset : offset+limit]
+			teamList = nil
 		}
-	} else {
-		teamList = nil
+		result.Total = int32(total)
+		result.NextCursor = nextCursor("", offset, limit, total)
 	}
+	recordListImpressions(contest_id, user_id, offset, experiment.Tag(TeamRankingExperiment, variant), teamList, scores)
+	result.TeamList = teamList
+	result.Experiment = experiment.Tag(TeamRankingExperiment, variant)
 
-	// 补充队长信息
+	// 补充队长信息，除当前用户外的队长档案通过一次 rpc 获取
+	profiles := make(map[int32]*user.UserProfileInfo)
+	if userProfile != nil {
+		profiles[user_id] = userProfile
+	}
+	var leaderIDs []int32
 	for _, t := range teamList {
-		// 如果队长是当前用户且已获取用户信息,直接使用已有的用户信息
-		if t.LeaderInfo.UserId == user_id && userProfile != nil {
-			t.LeaderInfo = &team.MemberInfo{
-				UserId:         userProfile.UserInfo.UserId,
-				Nickname:       userProfile.UserInfo.Nickname,
-				AvatarUrl:      userProfile.UserInfo.AvatarUrl,
-				College:        userProfile.UserInfo.College,
-				EnrollmentYear: userProfile.UserInfo.EnrollmentYear,
-				Gender:         userProfile.UserInfo.Gender,
-				Honors:         userProfile.Honors,
-			}
-		} else {
-			// 否则获取队长信息
-			kresp, err := rpc.UserProfileInfo(context.Background(), &user.UserProfileInfoRequest{UserId: t.LeaderInfo.UserId})
-			if err != nil {
-				return nil, 0, err
-			}
-			u := kresp.UserProfileInfo
-			t.LeaderInfo = &team.MemberInfo{
-				UserId:         u.UserInfo.UserId,
-				Nickname:       u.UserInfo.Nickname,
-				AvatarUrl:      u.UserInfo.AvatarUrl,
-				College:        u.UserInfo.College,
-				EnrollmentYear: u.UserInfo.EnrollmentYear,
-				Gender:         u.UserInfo.Gender,
-				Honors:         u.Honors,
-			}
+		if _, ok := profiles[t.LeaderInfo.UserId]; !ok {
+			leaderIDs = append(leaderIDs, t.LeaderInfo.UserId)
+		}
+	}
+	fetched, err := fetchUserProfiles(s.ctx, leaderIDs)
+	if err != nil {
+		return nil, err
+	}
+	for id, u := range fetched {
+		profiles[id] = u
+	}
+	for _, t := range teamList {
+		if u, ok := profiles[t.LeaderInfo.UserId]; ok {
+			t.LeaderInfo = convertUserProfileInfoToMemberInfo(u)
+		}
+	}
+	return result, nil
+}
+
+// rank 过滤掉用户无法加入的队伍并按推荐结果排序，返回排序后的列表、排序快照以及用户档案
+// 获取用户档案或推荐失败时返回过滤后的原有顺序，快照为 nil
+func (s *TeamListService) rank(contest_id int32, user_id int32, teamList []*team.TeamBriefInfo) ([]*team.TeamBriefInfo, *listSnapshot, *user.UserProfileInfo, error) {
+	klog.CtxInfof(s.ctx, "使用推荐系统进行个性化排序, userID=%v", user_id)
+
+	// 先过滤掉用户无法加入的队伍，再进行排序
+	excluded, err := s.ineligibleTeams(contest_id, user_id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	teamSizeMax := s.teamSizeMax(contest_id)
+	teamList = filterEligibleTeams(teamList, user_id, teamSizeMax, excluded)
+	klog.CtxInfof(s.ctx, "过滤后剩余 %d 个可加入的队伍, 过滤掉 %d 个", len(teamList), len(excluded))
+
+	// 获取用户信息
+	kresp, err := rpc.UserProfileInfo(s.ctx, &user.UserProfileInfoRequest{UserId: user_id})
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "获取用户信息失败: %v", err)
+		// 如果获取用户信息失败，继续使用原有逻辑
+		return teamList, nil, nil, nil
+	}
+	userProfile := kresp.UserProfileInfo
+	v, opts := rankingOptions(user_id)
+	klog.CtxInfof(s.ctx, "用户 %v 的实验分组: %s", user_id, experiment.Tag(TeamRankingExperiment, v))
+	recommender := NewRecommenderService(index.Default(), embedder.Default()).WithScoreOptions(opts).WithTeamSizeMax(teamSizeMax)
+	recommendedTeams, strategy, err := recommender.RecommendTeams(s.ctx, userProfile, contest_id, excluded)
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "推荐系统调用失败: %v", err)
+		// 如果推荐失败，继续使用原有逻辑
+		return teamList, nil, userProfile, nil
+	}
+	teamList = orderByRecommendation(teamList, recommendedTeams)
+	return teamList, newSnapshot(teamList, recommendedTeams, v, strategy), userProfile, nil
+}
+
+// orderByRecommendation 按推荐结果对队伍列表排序，索引中尚未收录的队伍保持原有顺序放在最后
+// 推荐分数及理由保存在排序快照中，由 listSnapshot.page 按需附带
+func orderByRecommendation(teamList []*team.TeamBriefInfo, ranked []*RankedTeam) []*team.TeamBriefInfo {
+	byID := make(map[int32]*team.TeamBriefInfo, len(teamList))
+	for _, t := range teamList {
+		byID[t.TeamId] = t
+	}
+	result := make([]*team.TeamBriefInfo, 0, len(teamList))
+	for _, r := range ranked {
+		if t, ok := byID[r.TeamID]; ok {
+			result = append(result, t)
+			delete(byID, r.TeamID)
+		}
+	}
+	for _, t := range teamList {
+		if _, ok := byID[t.TeamId]; ok {
+			result = append(result, t)
 		}
 	}
-	return teamList, int32(total), nil
+	return result
 }
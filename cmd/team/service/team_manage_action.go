@@ -13,10 +13,23 @@ func NewTeamManageActionService(ctx context.Context) *TeamManageActionService {
     return &TeamManageActionService{ctx: ctx}
 }
 
+// TeamManageAction 队长接受或拒绝待处理的入队申请，申请已被处理、撤回或已过期时返回 errno.ApplicationNotPendingErr
+// 接受申请时按赛事的队伍人数上限校验，人数已满时返回 errno.TeamFullErr，申请人已加入该赛事的其他队伍时返回 errno.AlreadyInContestTeamErr
 func (s *TeamManageActionService) TeamManageAction(user_id int32, application_id int32, action_type int32) error {
-    err := db.TeamManageAction(user_id, application_id, action_type)
+    var teamSizeMax int32
+    if action_type == 1 {
+        contestID, err := db.QueryApplicationContestID(application_id)
+        if err != nil {
+            return err
+        }
+        if teamSizeMax, err = fetchTeamSizeMax(s.ctx, contestID); err != nil {
+            return err
+        }
+    }
+    application, err := db.TeamManageAction(user_id, application_id, action_type, applicationSince(), teamSizeMax)
     if err != nil {
         return err
     }
+    recordManageAction(s.ctx, user_id, action_type, application)
     return nil
 }
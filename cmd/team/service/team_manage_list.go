@@ -3,9 +3,7 @@ package service
 import (
     "context"
     "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
-    "github.com/Yra-A/Fusion_Go/cmd/team/rpc"
     "github.com/Yra-A/Fusion_Go/kitex_gen/team"
-    "github.com/Yra-A/Fusion_Go/kitex_gen/user"
 )
 
 type TeamManageListService struct {
@@ -17,17 +15,22 @@ func NewTeamManageListService(ctx context.Context) *TeamManageListService {
 }
 
 func (s *TeamManageListService) TeamManageList(user_id int32, team_id int32) ([]*team.TeamApplication, error) {
-    teamApplicationList, err := db.GetTeamApplicationList(user_id, team_id)
+    teamApplicationList, err := db.GetTeamApplicationList(user_id, team_id, applicationSince())
+    if err != nil {
+        return nil, err
+    }
+    ids := make([]int32, len(teamApplicationList))
+    for i, t := range teamApplicationList {
+        ids[i] = t.MemberInfo.UserId
+    }
+    profiles, err := fetchUserProfiles(s.ctx, ids)
     if err != nil {
         return nil, err
     }
     for _, t := range teamApplicationList {
-        kresp, err := rpc.UserProfileInfo(context.Background(), &user.UserProfileInfoRequest{UserId: t.MemberInfo.UserId})
-        if err != nil {
-            return nil, err
+        if u, ok := profiles[t.MemberInfo.UserId]; ok {
+            t.MemberInfo = convertUserProfileInfoToMemberInfo(u)
         }
-        u := convertUserProfileInfoToMemberInfo(kresp.UserProfileInfo)
-        t.MemberInfo = u
     }
 
     return teamApplicationList, nil
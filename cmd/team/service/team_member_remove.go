@@ -0,0 +1,19 @@
+package service
+
+import (
+    "context"
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+)
+
+type TeamMemberRemoveService struct {
+    ctx context.Context
+}
+
+func NewTeamMemberRemoveService(ctx context.Context) *TeamMemberRemoveService {
+    return &TeamMemberRemoveService{ctx: ctx}
+}
+
+// TeamMemberRemove 队长将队员移出队伍，非队长操作时返回 errno.AuthorizationFailedErr
+func (s *TeamMemberRemoveService) TeamMemberRemove(user_id int32, team_id int32, member_id int32) error {
+    return db.RemoveTeamMember(user_id, team_id, member_id)
+}
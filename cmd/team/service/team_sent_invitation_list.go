@@ -0,0 +1,35 @@
+package service
+
+import (
+    "context"
+
+    "github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+    "github.com/Yra-A/Fusion_Go/kitex_gen/team"
+    "github.com/Yra-A/Fusion_Go/pkg/errno"
+)
+
+type TeamSentInvitationListService struct {
+    ctx context.Context
+}
+
+func NewTeamSentInvitationListService(ctx context.Context) *TeamSentInvitationListService {
+    return &TeamSentInvitationListService{ctx: ctx}
+}
+
+// TeamSentInvitationList 按发出时间倒序分页获取队伍发出的入队邀请，只有队长可以查看，status 不合法时返回 errno.ParamErr
+func (s *TeamSentInvitationListService) TeamSentInvitationList(user_id int32, team_id int32, status string, limit int32, offset int32) ([]*team.TeamInvitation, int32, error) {
+    if !validInvitationStatus(status) || offset < 0 {
+        return nil, 0, errno.ParamErr
+    }
+    limit = myListLimit(limit)
+    since := applicationSince()
+    invitations, total, err := db.QueryTeamInvitations(user_id, team_id, status, since, limit, offset)
+    if err != nil {
+        return nil, 0, err
+    }
+    result, err := convertInvitations(s.ctx, invitations, since)
+    if err != nil {
+        return nil, 0, err
+    }
+    return result, int32(total), nil
+}
@@ -0,0 +1,134 @@
+package train
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/service"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// profileBatchSize 加载数据集时每次 rpc 获取的用户档案数
+const profileBatchSize = 200
+
+// Example 一条已处理的入队申请及其结果
+type Example struct {
+	Application *db.TeamApplication
+	ContestID   int32
+	Accepted    bool
+	// Members 申请提交时队伍的估计人数，包括队长
+	Members int32
+}
+
+// Dataset 训练所需的历史数据
+type Dataset struct {
+	Examples    []*Example
+	Teams       []*db.TeamInfo
+	Skills      []*db.TeamSkills
+	Profiles    map[int32]*user.UserProfileInfo // 申请人与队长的档案，获取失败时为 nil
+	TeamSizeMax map[int32]int32                 // 按 contest_id 索引的队伍人数上限
+}
+
+// BuildExamples 以已处理的申请作为样本，按申请状态标注是否被接受；之后离队或被移出的申请人仍视为被接受
+// 历史人数没有记录，申请提交时的队伍人数按队长、此前被接受的申请数以及未通过申请加入的现有队员（如受邀加入，加入时间未知）估计
+func BuildExamples(applications []*db.TeamApplication, members []*db.TeamMember, teams []*db.TeamInfo) []*Example {
+	type key struct{ userID, teamID int32 }
+	applied := make(map[key]bool, len(applications))
+	for _, a := range applications {
+		if a.Status == db.ApplicationStatusAccepted {
+			applied[key{a.UserID, a.TeamID}] = true
+		}
+	}
+	invited := make(map[int32]int32)
+	for _, m := range members {
+		if !applied[key{m.UserID, m.TeamID}] {
+			invited[m.TeamID]++
+		}
+	}
+	contestOf := make(map[int32]int32, len(teams))
+	for _, t := range teams {
+		contestOf[t.TeamID] = t.ContestID
+	}
+
+	sorted := make([]*db.TeamApplication, 0, len(applications))
+	for _, a := range applications {
+		// 队伍已删除的申请无法计算特征
+		if _, ok := contestOf[a.TeamID]; ok {
+			sorted = append(sorted, a)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedTime.Before(sorted[j].CreatedTime)
+	})
+
+	accepted := make(map[int32]int32)
+	examples := make([]*Example, 0, len(sorted))
+	for _, a := range sorted {
+		ok := a.Status == db.ApplicationStatusAccepted
+		examples = append(examples, &Example{
+			Application: a,
+			ContestID:   contestOf[a.TeamID],
+			Accepted:    ok,
+			Members:     1 + invited[a.TeamID] + accepted[a.TeamID],
+		})
+		if ok {
+			accepted[a.TeamID]++
+		}
+	}
+	return examples
+}
+
+// LoadDataset 从数据库读取已处理的申请与队伍，并通过 user 与 contest 服务获取用户档案与人数上限，contestID 为 0 时读取所有赛事
+func LoadDataset(ctx context.Context, contestID int32) (*Dataset, error) {
+	applications, err := db.QueryHandledApplications(contestID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := db.QueryTeamMembers(contestID)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := db.QueryTeamsForEvaluation(contestID)
+	if err != nil {
+		return nil, err
+	}
+	skills, err := db.QueryTeamSkillsByContest(contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &Dataset{
+		Examples:    BuildExamples(applications, members, teams),
+		Teams:       teams,
+		Skills:      skills,
+		Profiles:    make(map[int32]*user.UserProfileInfo),
+		TeamSizeMax: make(map[int32]int32),
+	}
+	leaders := make(map[int32]int32, len(teams))
+	for _, t := range teams {
+		leaders[t.TeamID] = t.LeaderID
+	}
+	// 申请人与队长的档案分批获取，用户不存在时与该用户相关的样本被跳过
+	seen := make(map[int32]bool)
+	var ids []int32
+	for _, ex := range ds.Examples {
+		for _, id := range []int32{ex.Application.UserID, leaders[ex.Application.TeamID]} {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if _, ok := ds.TeamSizeMax[ex.ContestID]; !ok {
+			ds.TeamSizeMax[ex.ContestID] = service.ContestTeamSizeMax(ctx, ex.ContestID)
+		}
+	}
+	profiles, err := service.FetchUserProfiles(ctx, ids, profileBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		ds.Profiles[id] = profiles[id]
+	}
+	return ds, nil
+}
@@ -0,0 +1,218 @@
+package train
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal"
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/embedding"
+	"github.com/Yra-A/Fusion_Go/cmd/team/index"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rerank"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rpc"
+	"github.com/Yra-A/Fusion_Go/cmd/team/service"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+)
+
+// Options train 子命令的参数
+type Options struct {
+	ContestID int32   // 为 0 时使用所有赛事的申请
+	Output    string  // 模型文件的路径
+	Holdout   float64 // 按申请时间留出最后这部分样本用于评估
+	QPS       float64 // embedding 后端每秒最多调用次数
+	Train     rerank.TrainOptions
+}
+
+// ParseFlags 解析 train 子命令的参数
+func ParseFlags(args []string, output io.Writer) (*Options, error) {
+	opts := &Options{Train: rerank.DefaultTrainOptions()}
+	var contestID int
+	fs := flag.NewFlagSet("train", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.IntVar(&contestID, "contest", 0, "只使用该赛事下的申请，为 0 时使用所有赛事")
+	fs.StringVar(&opts.Output, "o", "rerank_model.json", "模型文件的路径")
+	fs.Float64Var(&opts.Holdout, "holdout", 0.2, "按申请时间留出最后这部分样本用于评估")
+	fs.Float64Var(&opts.QPS, "qps", 5, "embedding 后端每秒最多调用次数")
+	fs.IntVar(&opts.Train.Epochs, "epochs", opts.Train.Epochs, "梯度下降的轮数")
+	fs.Float64Var(&opts.Train.LearningRate, "lr", opts.Train.LearningRate, "学习率")
+	fs.Float64Var(&opts.Train.L2, "l2", opts.Train.L2, "L2 正则系数")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	opts.ContestID = int32(contestID)
+	if opts.Holdout < 0 || opts.Holdout >= 1 {
+		return nil, errors.New("-holdout 必须在 [0, 1) 之间")
+	}
+	if opts.Train.Epochs <= 0 || opts.Train.LearningRate <= 0 || opts.Train.L2 < 0 {
+		return nil, errors.New("-epochs 与 -lr 必须大于 0，-l2 不能为负数")
+	}
+	return opts, nil
+}
+
+// Report 训练结果
+type Report struct {
+	Examples int            `json:"examples"` // 已处理的申请数
+	Skipped  int            `json:"skipped"`  // 缺少用户档案或队伍 embedding 而跳过的申请数
+	Model    *rerank.Model  `json:"model"`
+	Train    rerank.Metrics `json:"train"`
+	Holdout  rerank.Metrics `json:"holdout"`
+}
+
+// Main train 子命令入口，训练重排模型并写入模型文件，返回进程退出码
+func Main(args []string) int {
+	opts, err := ParseFlags(args, os.Stderr)
+	if err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return 2
+	}
+	// 训练只读取数据，不执行迁移
+	if err := dal.Connect(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	rpc.InitRPC()
+	embedder.Init()
+
+	ctx := context.Background()
+	ds, err := LoadDataset(ctx, opts.ContestID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	// 使用线上的 embedding 后端计算语义相似度，使特征与线上一致
+	report, err := Run(ctx, opts, ds, embedder.NewRateLimited(embedder.Default(), opts.QPS))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := report.Model.Save(opts.Output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// Run 计算每条申请的特征，按申请时间划分训练集与评估集并训练模型
+func Run(ctx context.Context, opts *Options, ds *Dataset, e embedder.Embedder) (*Report, error) {
+	samples, skipped, err := BuildSamples(ctx, ds, e)
+	if err != nil {
+		return nil, err
+	}
+	// 样本已按申请时间排序，留出最近的样本评估模型
+	split := len(samples) - int(float64(len(samples))*opts.Holdout)
+	trainSet, holdout := samples[:split], samples[split:]
+	m, err := rerank.Train(trainSet, opts.Train)
+	if err != nil {
+		return nil, err
+	}
+	m.Embedding = e.Model()
+	return &Report{
+		Examples: len(ds.Examples),
+		Skipped:  skipped,
+		Model:    m,
+		Train:    rerank.Evaluate(m, trainSet),
+		Holdout:  rerank.Evaluate(m, holdout),
+	}, nil
+}
+
+// BuildSamples 使用 e 重新生成队伍与申请人的 embedding，按申请提交时的状态计算特征
+// 返回的样本与 ds.Examples 的顺序一致，缺少申请人档案或队伍 embedding 的申请被跳过
+func BuildSamples(ctx context.Context, ds *Dataset, e embedder.Embedder) ([]*rerank.Sample, int, error) {
+	svc := embedding.NewService(ctx, nil, e)
+	skillsByTeam := make(map[int32][]*db.TeamSkills)
+	for _, s := range ds.Skills {
+		skillsByTeam[s.TeamID] = append(skillsByTeam[s.TeamID], s)
+	}
+	teamByID := make(map[int32]*db.TeamInfo, len(ds.Teams))
+	teams := make([]*db.TeamInfo, 0, len(ds.Teams))
+	for _, t := range ds.Teams {
+		teamByID[t.TeamID] = t
+		te, err := db.BuildTeamEmbedding(svc, t, skillsByTeam[t.TeamID])
+		if err != nil {
+			return nil, 0, fmt.Errorf("生成队伍 %d 的 embedding 失败: %v", t.TeamID, err)
+		}
+		b, err := json.Marshal(te)
+		if err != nil {
+			return nil, 0, err
+		}
+		copied := *t
+		copied.Embedding = string(b)
+		teams = append(teams, &copied)
+	}
+	idx := index.New(time.Hour, index.NewMemoryLoader(teams, ds.Skills))
+
+	type searchKey struct{ userID, contestID int32 }
+	searched := make(map[searchKey]map[int32]*index.Result)
+	opts := service.DefaultScoreOptions()
+	var samples []*rerank.Sample
+	var skipped int
+	for _, ex := range ds.Examples {
+		a := ex.Application
+		profile := ds.Profiles[a.UserID]
+		if profile == nil || profile.UserInfo == nil || !profile.UserInfo.HasProfile {
+			skipped++
+			continue
+		}
+		k := searchKey{a.UserID, ex.ContestID}
+		results, ok := searched[k]
+		if !ok {
+			query, err := e.Embed(ctx, embedder.UserProfileText(profile))
+			if err != nil {
+				return nil, 0, fmt.Errorf("生成用户 %d 的 embedding 失败: %v", a.UserID, err)
+			}
+			rs, err := idx.Search(ex.ContestID, query, e.Model(), 0)
+			if err != nil {
+				return nil, 0, err
+			}
+			results = make(map[int32]*index.Result, len(rs))
+			for _, r := range rs {
+				results[r.TeamID] = r
+			}
+			searched[k] = results
+		}
+		r := results[a.TeamID]
+		if r == nil || r.Err != nil {
+			skipped++
+			continue
+		}
+		// 与线上使用相同的语义相似度与技能匹配度
+		rec := service.RankTeams(profile.UserSkills, []*index.Result{r}, opts, a.CreatedTime)[0].Recommendation
+		t := teamByID[a.TeamID]
+		var leader *user.UserInfo
+		if p := ds.Profiles[t.LeaderID]; p != nil {
+			leader = p.UserInfo
+		}
+		label := 0.0
+		if ex.Accepted {
+			label = 1
+		}
+		samples = append(samples, &rerank.Sample{
+			Features: rerank.Features(rerank.FeatureInput{
+				Cosine:       rec.SemanticScore,
+				SkillOverlap: rec.SkillScore,
+				TeamAge:      a.CreatedTime.Sub(t.CreatedTime),
+				Members:      ex.Members,
+				TeamSizeMax:  ds.TeamSizeMax[ex.ContestID],
+				User:         profile.UserInfo,
+				Leader:       leader,
+			}),
+			Label: label,
+		})
+	}
+	return samples, skipped, nil
+}
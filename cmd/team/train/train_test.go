@@ -0,0 +1,90 @@
+package train
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/team/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/team/rerank"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+)
+
+// TestBuildExamples 测试按申请状态标注申请结果，并按此前被接受的申请与受邀加入的队员估计队伍人数
+func TestBuildExamples(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	teams := []*db.TeamInfo{{TeamID: 10, ContestID: 100}}
+	// 用户 3 被接受后已离队，用户 6 受邀加入
+	members := []*db.TeamMember{{UserID: 1, TeamID: 10, ContestID: 100}, {UserID: 6, TeamID: 10, ContestID: 100}}
+	applications := []*db.TeamApplication{
+		{UserID: 3, TeamID: 10, Status: db.ApplicationStatusAccepted, CreatedTime: now.Add(2 * time.Hour)},
+		{UserID: 1, TeamID: 10, Status: db.ApplicationStatusAccepted, CreatedTime: now},
+		{UserID: 2, TeamID: 10, Status: db.ApplicationStatusRejected, CreatedTime: now.Add(time.Hour)},
+		// 队伍已删除
+		{UserID: 4, TeamID: 11, Status: db.ApplicationStatusAccepted, CreatedTime: now},
+	}
+
+	got := BuildExamples(applications, members, teams)
+	if len(got) != 3 {
+		t.Fatalf("BuildExamples() = %d examples, want 3", len(got))
+	}
+	want := []struct {
+		userID   int32
+		accepted bool
+		members  int32
+	}{{1, true, 2}, {2, false, 3}, {3, true, 3}}
+	for i, w := range want {
+		ex := got[i]
+		if ex.Application.UserID != w.userID || ex.Accepted != w.accepted || ex.Members != w.members || ex.ContestID != 100 {
+			t.Errorf("BuildExamples()[%d] = {user %d, accepted %v, members %d}, want %+v",
+				i, ex.Application.UserID, ex.Accepted, ex.Members, w)
+		}
+	}
+}
+
+// TestRun 测试在内存数据上计算特征并训练，缺少档案的申请被跳过
+func TestRun(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ds := &Dataset{
+		Teams: []*db.TeamInfo{
+			{TeamID: 10, ContestID: 100, LeaderID: 5, CreatedTime: now.Add(-48 * time.Hour), Goal: "后端开发", Description: "使用 Go 开发后端服务"},
+		},
+		Skills: []*db.TeamSkills{{TeamID: 10, Skill: "Go", Category: "编程语言", Job: "后端"}},
+		Profiles: map[int32]*user.UserProfileInfo{
+			1: {UserInfo: &user.UserInfo{UserId: 1, HasProfile: true, College: "计算机学院", EnrollmentYear: 2021}, Introduction: "熟悉 Go 后端开发",
+				UserSkills: []*user.UserSkill{{Skill: "Go", Category: "编程语言", Proficiency: "精通"}}},
+			2: {UserInfo: &user.UserInfo{UserId: 2, HasProfile: true, College: "外国语学院"}, Introduction: "擅长英语写作"},
+			5: {UserInfo: &user.UserInfo{UserId: 5, HasProfile: true, College: "计算机学院", EnrollmentYear: 2022}},
+		},
+		TeamSizeMax: map[int32]int32{100: 4},
+	}
+	ds.Examples = []*Example{
+		{Application: &db.TeamApplication{UserID: 1, TeamID: 10, CreatedTime: now.Add(-24 * time.Hour)}, ContestID: 100, Accepted: true, Members: 1},
+		{Application: &db.TeamApplication{UserID: 2, TeamID: 10, CreatedTime: now}, ContestID: 100, Members: 2},
+		{Application: &db.TeamApplication{UserID: 3, TeamID: 10, CreatedTime: now}, ContestID: 100, Members: 2},
+	}
+
+	samples, skipped, err := BuildSamples(context.Background(), ds, embedder.NewLocal(256))
+	if err != nil {
+		t.Fatalf("BuildSamples() error = %v", err)
+	}
+	if len(samples) != 2 || skipped != 1 {
+		t.Fatalf("BuildSamples() = %d samples, %d skipped, want 2 and 1", len(samples), skipped)
+	}
+	// team_age_days, fill_ratio, same_college, enrollment_gap
+	if f := samples[0].Features; f[2] != 1 || f[3] != 0.25 || f[4] != 1 || f[5] != 1 || samples[0].Label != 1 {
+		t.Errorf("BuildSamples()[0] = %+v", samples[0])
+	}
+	if samples[0].Features[0] <= samples[1].Features[0] {
+		t.Errorf("BuildSamples() cosine of matching applicant %v <= %v", samples[0].Features[0], samples[1].Features[0])
+	}
+
+	report, err := Run(context.Background(), &Options{Holdout: 0, Train: rerank.DefaultTrainOptions()}, ds, embedder.NewLocal(256))
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if report.Model.Embedding == "" || report.Train.Samples != 2 || report.Train.AUC != 1 {
+		t.Errorf("Run() report = %+v", report)
+	}
+}
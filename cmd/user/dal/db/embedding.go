@@ -0,0 +1,57 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/user/dal/redis"
+)
+
+var rdEmbedding redis.Embedding
+
+// UserEmbedding 用户档案的 embedding，与 user_profile_info 一一对应
+type UserEmbedding struct {
+	UserID               int32     `gorm:"primary_key;column:user_id"`
+	Embedding            string    `gorm:"column:embedding;type:json"` // 存储为 JSON 字符串
+	Model                string    `gorm:"column:model"`
+	EmbeddingUpdatedTime time.Time `gorm:"column:embedding_updated_time"`
+}
+
+func (UserEmbedding) TableName() string {
+	return "user_embedding"
+}
+
+// QueryUserEmbeddingByUserId 获取用户的 embedding，优先读取缓存
+func QueryUserEmbeddingByUserId(userId int32) (*UserEmbedding, error) {
+	if v, ok := rdEmbedding.GetUserEmbedding(int64(userId)); ok {
+		e := &UserEmbedding{}
+		if err := json.Unmarshal([]byte(v), e); err == nil {
+			return e, nil
+		}
+	}
+	e := &UserEmbedding{}
+	if err := DB.Where("user_id = ?", userId).First(e).Error; err != nil {
+		return nil, err
+	}
+	// 异步回填 cache
+	go func(e *UserEmbedding) {
+		if v, err := json.Marshal(e); err == nil {
+			rdEmbedding.SetUserEmbedding(int64(e.UserID), string(v))
+		}
+	}(e)
+	return e, nil
+}
+
+// SaveUserEmbedding 新增或覆盖用户的 embedding，并同步更新缓存
+func SaveUserEmbedding(e *UserEmbedding) error {
+	if err := DB.Save(e).Error; err != nil {
+		return err
+	}
+	v, err := json.Marshal(e)
+	if err != nil {
+		rdEmbedding.DelUserEmbedding(int64(e.UserID))
+		return nil
+	}
+	rdEmbedding.SetUserEmbedding(int64(e.UserID), string(v))
+	return nil
+}
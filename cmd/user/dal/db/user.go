@@ -215,3 +215,39 @@ func AddOrUpdateHonors(userId int32, honors []string) error {
 func UpdateHasProfile(userId int32, hasProfile bool) error {
 	return DB.Model(&UserProfileInfo{}).Where("user_id = ?", userId).Update("hasProfile", hasProfile).Error
 }
+
+// QueryUserProfilesByUserIds 批量获取用户档案，不存在的用户不包含在结果中
+func QueryUserProfilesByUserIds(userIds []int32) ([]*UserProfileInfo, error) {
+	var profiles []*UserProfileInfo
+	if len(userIds) == 0 {
+		return profiles, nil
+	}
+	if err := DB.Where("user_id IN ?", userIds).Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// QueryUserSkillsByUserIds 批量获取用户的技能，同一用户的技能按添加顺序排列
+func QueryUserSkillsByUserIds(userIds []int32) ([]*UserSkills, error) {
+	var skills []*UserSkills
+	if len(userIds) == 0 {
+		return skills, nil
+	}
+	if err := DB.Where("user_id IN ?", userIds).Order("user_skill_id").Find(&skills).Error; err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+// QueryHonorsByUserIds 批量获取用户的荣誉，同一用户的荣誉按添加顺序排列
+func QueryHonorsByUserIds(userIds []int32) ([]*Honors, error) {
+	var honors []*Honors
+	if len(userIds) == 0 {
+		return honors, nil
+	}
+	if err := DB.Where("user_id IN ?", userIds).Order("honor_id").Find(&honors).Error; err != nil {
+		return nil, err
+	}
+	return honors, nil
+}
@@ -1,7 +1,11 @@
 package dal
 
-import "github.com/Yra-A/Fusion_Go/cmd/user/dal/db"
+import (
+	"github.com/Yra-A/Fusion_Go/cmd/user/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/user/dal/redis"
+)
 
 func Init() {
 	db.Init()
+	redis.Init()
 }
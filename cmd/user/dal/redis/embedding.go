@@ -0,0 +1,31 @@
+package redis
+
+import "strconv"
+
+const (
+	userIdPrefix    = "user_id:"
+	embeddingSuffix = "_embedding"
+)
+
+type (
+	Embedding struct{}
+)
+
+func getEmbeddingKeyStr(user_id int64) string {
+	return userIdPrefix + strconv.FormatInt(user_id, 10) + embeddingSuffix
+}
+
+// SetUserEmbedding 缓存用户 embedding 到 user_id:xxx_embedding
+func (e Embedding) SetUserEmbedding(user_id int64, value string) {
+	set(rdb, getEmbeddingKeyStr(user_id), value)
+}
+
+// GetUserEmbedding 获取 user_id:xxx_embedding 中缓存的用户 embedding
+func (e Embedding) GetUserEmbedding(user_id int64) (string, bool) {
+	return get(rdb, getEmbeddingKeyStr(user_id))
+}
+
+// DelUserEmbedding 删除 user_id:xxx_embedding
+func (e Embedding) DelUserEmbedding(user_id int64) {
+	del(rdb, getEmbeddingKeyStr(user_id))
+}
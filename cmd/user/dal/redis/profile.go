@@ -0,0 +1,44 @@
+package redis
+
+import "strconv"
+
+const profileSuffix = "_profile"
+
+type (
+	Profile struct{}
+)
+
+func getProfileKeyStr(user_id int64) string {
+	return userIdPrefix + strconv.FormatInt(user_id, 10) + profileSuffix
+}
+
+// SetUserProfile 缓存组装好的用户档案（档案、技能与荣誉）到 user_id:xxx_profile
+func (p Profile) SetUserProfile(user_id int64, value string) {
+	set(rdb, getProfileKeyStr(user_id), value)
+}
+
+// GetUserProfile 获取 user_id:xxx_profile 中缓存的用户档案
+func (p Profile) GetUserProfile(user_id int64) (string, bool) {
+	return get(rdb, getProfileKeyStr(user_id))
+}
+
+// MGetUserProfiles 批量获取缓存的用户档案，按 user_id 索引，未命中的用户不包含在结果中
+func (p Profile) MGetUserProfiles(user_ids []int64) map[int64]string {
+	keys := make([]string, len(user_ids))
+	for i, id := range user_ids {
+		keys[i] = getProfileKeyStr(id)
+	}
+	values := mget(rdb, keys)
+	result := make(map[int64]string, len(values))
+	for i, id := range user_ids {
+		if v, ok := values[keys[i]]; ok {
+			result[id] = v
+		}
+	}
+	return result
+}
+
+// DelUserProfile 删除 user_id:xxx_profile
+func (p Profile) DelUserProfile(user_id int64) {
+	del(rdb, getProfileKeyStr(user_id))
+}
@@ -0,0 +1,50 @@
+package redis
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// getRandomTTL 设置随机的过期时间，防止缓存雪崩
+func getRandomTTL() time.Duration {
+	return time.Duration(60+rand.Intn(20)) * time.Minute
+}
+
+// set 写入字符串 k，并设置随机过期时间
+func set(c *redis.Client, k string, v string) {
+	c.Set(k, v, getRandomTTL())
+}
+
+// get 读取字符串 k，不存在或出错时返回 false
+func get(c *redis.Client, k string) (string, bool) {
+	v, err := c.Get(k).Result()
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
+
+// del 删除 k
+func del(c *redis.Client, k string) {
+	c.Del(k)
+}
+
+// mget 批量读取字符串 keys，按 key 索引，不存在的 key 不包含在结果中，出错时返回空结果
+func mget(c *redis.Client, keys []string) map[string]string {
+	result := make(map[string]string, len(keys))
+	if len(keys) == 0 {
+		return result
+	}
+	values, err := c.MGet(keys...).Result()
+	if err != nil {
+		return result
+	}
+	for i, v := range values {
+		if s, ok := v.(string); ok {
+			result[keys[i]] = s
+		}
+	}
+	return result
+}
@@ -111,6 +111,22 @@ func (s *UserServiceImpl) UserProfileInfo(ctx context.Context, req *user.UserPro
 
 }
 
+// UserProfileInfoBatch implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UserProfileInfoBatch(ctx context.Context, req *user.UserProfileInfoBatchRequest) (resp *user.UserProfileInfoBatchResponse, err error) {
+	klog.CtxDebugf(ctx, "UserProfileInfoBatch called: %d users", len(req.GetUserIds()))
+	resp = new(user.UserProfileInfoBatchResponse)
+	profiles, err := service.NewQueryUserProfileService(ctx).QueryUserProfileBatch(req.UserIds)
+	if err != nil {
+		resp.StatusCode = errno.Fail.ErrCode
+		resp.StatusMsg = errno.Fail.ErrMsg
+		return resp, err
+	}
+	resp.StatusCode = errno.SuccessCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.UserProfileInfos = profiles
+	return resp, nil
+}
+
 // UserProfileUpload implements the UserServiceImpl interface.
 func (s *UserServiceImpl) UserProfileUpload(ctx context.Context, req *user.UserProfileUploadRequest) (resp *user.UserProfileUploadResponse, err error) {
 	klog.CtxDebugf(ctx, "UserProfileUpload called: %d", req.GetUserId())
@@ -125,3 +141,21 @@ func (s *UserServiceImpl) UserProfileUpload(ctx context.Context, req *user.UserP
 	resp.StatusMsg = errno.Success.ErrMsg
 	return
 }
+
+// UserEmbedding implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UserEmbedding(ctx context.Context, req *user.UserEmbeddingRequest) (resp *user.UserEmbeddingResponse, err error) {
+	klog.CtxDebugf(ctx, "UserEmbedding called: %d", req.GetUserId())
+	resp = new(user.UserEmbeddingResponse)
+	embedding, e, err := service.NewUserEmbeddingService(ctx).QueryUserEmbedding(req.UserId)
+	if err != nil {
+		resp.StatusCode = errno.FailCode
+		resp.StatusMsg = errno.Fail.ErrMsg
+		return resp, nil
+	}
+	resp.StatusCode = errno.SuccessCode
+	resp.StatusMsg = errno.Success.ErrMsg
+	resp.Embedding = embedding
+	resp.Model = e.Model
+	resp.EmbeddingUpdatedTime = e.EmbeddingUpdatedTime.Unix()
+	return resp, nil
+}
@@ -22,6 +22,7 @@ import (
     "github.com/Yra-A/Fusion_Go/cmd/user/dal"
     user "github.com/Yra-A/Fusion_Go/kitex_gen/user/userservice"
     "github.com/Yra-A/Fusion_Go/pkg/constants"
+    "github.com/Yra-A/Fusion_Go/pkg/embedder"
     "github.com/Yra-A/Fusion_Go/pkg/middleware"
     "github.com/cloudwego/kitex/pkg/klog"
     "github.com/cloudwego/kitex/pkg/limit"
@@ -35,6 +36,7 @@ func Init() {
     klog.SetLogger(kitexlogrus.NewLogger())
     klog.SetLevel(klog.LevelDebug)
     dal.Init()
+    embedder.Init()
 }
 
 func main() {
@@ -2,12 +2,34 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/Yra-A/Fusion_Go/cmd/user/dal/db"
+	"github.com/Yra-A/Fusion_Go/cmd/user/dal/redis"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"golang.org/x/sync/singleflight"
 )
 
+// profileCache 用户档案缓存，测试时替换为内存实现
+type profileCache interface {
+	GetUserProfile(user_id int64) (string, bool)
+	MGetUserProfiles(user_ids []int64) map[int64]string
+	SetUserProfile(user_id int64, value string)
+	DelUserProfile(user_id int64)
+}
+
+var rdProfile profileCache = redis.Profile{}
+
+// profileInvalidateDelay 第二次删除档案缓存的延迟，需要大于一次档案加载的耗时，
+// 保证在更新之前开始、更新之后才回填的旧档案也会被删除
+var profileInvalidateDelay = time.Second
+
+// profileGroup 合并同一用户并发的缓存未命中，避免缓存失效时大量请求同时查询数据库
+var profileGroup singleflight.Group
+
 type QueryUserProfileService struct {
 	ctx context.Context
 }
@@ -16,7 +38,58 @@ func NewQueryUserProfileService(ctx context.Context) *QueryUserProfileService {
 	return &QueryUserProfileService{ctx: ctx}
 }
 
+// QueryUserProfile 获取用户档案，优先读取缓存，未命中时从数据库组装并回填缓存
 func (s *QueryUserProfileService) QueryUserProfile(user_id int32) (*user.UserProfileInfo, error) {
+	if u, ok := cachedUserProfile(user_id); ok {
+		return u, nil
+	}
+	v, err, _ := profileGroup.Do(strconv.Itoa(int(user_id)), func() (interface{}, error) {
+		u, err := s.loadUserProfile(user_id)
+		if err != nil {
+			return nil, err
+		}
+		cacheUserProfile(u)
+		return u, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*user.UserProfileInfo), nil
+}
+
+// cachedUserProfile 读取缓存的用户档案
+func cachedUserProfile(user_id int32) (*user.UserProfileInfo, bool) {
+	v, ok := rdProfile.GetUserProfile(int64(user_id))
+	if !ok {
+		return nil, false
+	}
+	u := &user.UserProfileInfo{}
+	if err := json.Unmarshal([]byte(v), u); err != nil || u.UserInfo == nil {
+		return nil, false
+	}
+	return u, true
+}
+
+// cacheUserProfile 回填用户档案缓存
+func cacheUserProfile(u *user.UserProfileInfo) {
+	if v, err := json.Marshal(u); err == nil {
+		rdProfile.SetUserProfile(int64(u.UserInfo.UserId), string(v))
+	}
+}
+
+// InvalidateUserProfile 删除用户档案缓存，用户信息或档案更新后调用
+// 更新前已开始的加载可能在删除之后回填旧档案，因此延迟 profileInvalidateDelay 后再删除一次；
+// 本进程中尚未完成的加载不再被之后的请求复用
+func InvalidateUserProfile(user_id int32) {
+	profileGroup.Forget(strconv.Itoa(int(user_id)))
+	rdProfile.DelUserProfile(int64(user_id))
+	time.AfterFunc(profileInvalidateDelay, func() {
+		rdProfile.DelUserProfile(int64(user_id))
+	})
+}
+
+// loadUserProfile 从数据库并发读取档案、技能、荣誉与基本信息并组装
+func (s *QueryUserProfileService) loadUserProfile(user_id int32) (*user.UserProfileInfo, error) {
 	u := &user.UserProfileInfo{}
 	tasks := []TaskFunc{
 		func() error { return s.FetchUserProfileInfo(user_id, u) },
@@ -111,3 +184,112 @@ func (s *QueryUserProfileService) FetchUserInfo(user_id int32, u *user.UserProfi
 	}
 	return nil
 }
+
+// QueryUserProfileBatch 批量获取用户档案，按 user_id 索引，不存在的用户不包含在结果中
+// 先批量读取缓存，未命中的用户再从数据库批量组装并回填缓存
+func (s *QueryUserProfileService) QueryUserProfileBatch(user_ids []int32) (map[int32]*user.UserProfileInfo, error) {
+	ids := make([]int64, len(user_ids))
+	for i, id := range user_ids {
+		ids[i] = int64(id)
+	}
+	result := make(map[int32]*user.UserProfileInfo, len(user_ids))
+	cached := rdProfile.MGetUserProfiles(ids)
+	var missed []int32
+	for _, id := range user_ids {
+		u := &user.UserProfileInfo{}
+		if v, ok := cached[int64(id)]; ok && json.Unmarshal([]byte(v), u) == nil && u.UserInfo != nil {
+			result[id] = u
+			continue
+		}
+		missed = append(missed, id)
+	}
+	if len(missed) == 0 {
+		return result, nil
+	}
+
+	loaded, err := s.loadUserProfileBatch(missed)
+	if err != nil {
+		return nil, err
+	}
+	for id, u := range loaded {
+		result[id] = u
+		cacheUserProfile(u)
+	}
+	return result, nil
+}
+
+// loadUserProfileBatch 从数据库批量组装用户档案，档案、技能与荣誉各用一次 IN 查询获取，不随用户数量增加查询次数
+func (s *QueryUserProfileService) loadUserProfileBatch(user_ids []int32) (map[int32]*user.UserProfileInfo, error) {
+	var (
+		profiles []*db.UserProfileInfo
+		skills   []*db.UserSkills
+		honors   []*db.Honors
+	)
+	tasks := []TaskFunc{
+		func() (err error) { profiles, err = db.QueryUserProfilesByUserIds(user_ids); return },
+		func() (err error) { skills, err = db.QueryUserSkillsByUserIds(user_ids); return },
+		func() (err error) { honors, err = db.QueryHonorsByUserIds(user_ids); return },
+	}
+
+	errChan := make(chan error, len(tasks))
+	defer close(errChan)
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(t TaskFunc) {
+			defer wg.Done()
+			if err := t(); err != nil {
+				errChan <- err
+			}
+		}(task)
+	}
+	wg.Wait()
+	select {
+	case err := <-errChan:
+		return nil, err
+	default:
+	}
+	return buildUserProfiles(profiles, skills, honors), nil
+}
+
+// buildUserProfiles 将批量查询到的档案、技能与荣誉按 user_id 组装为用户档案，没有档案记录的技能与荣誉被忽略
+func buildUserProfiles(profiles []*db.UserProfileInfo, skills []*db.UserSkills, honors []*db.Honors) map[int32]*user.UserProfileInfo {
+	result := make(map[int32]*user.UserProfileInfo, len(profiles))
+	for _, p := range profiles {
+		result[p.UserID] = &user.UserProfileInfo{
+			Introduction: p.Introduction,
+			QqNumber:     p.QQNumber,
+			WechatNumber: p.WeChatNumber,
+			UserSkills:   []*user.UserSkill{},
+			Honors:       []string{},
+			UserInfo: &user.UserInfo{
+				UserId:         p.UserID,
+				Gender:         p.Gender,
+				EnrollmentYear: p.EnrollmentYear,
+				MobilePhone:    p.MobilePhone,
+				College:        p.College,
+				Nickname:       p.Nickname,
+				Realname:       p.Realname,
+				HasProfile:     p.HasProfile,
+				AvatarUrl:      p.AvatarURL,
+			},
+		}
+	}
+	for _, skill := range skills {
+		if u, ok := result[skill.UserID]; ok {
+			u.UserSkills = append(u.UserSkills, &user.UserSkill{
+				UserSkillId: skill.UserSkillID,
+				UserId:      skill.UserID,
+				Skill:       skill.Skill,
+				Category:    skill.Category,
+				Proficiency: skill.Proficiency,
+			})
+		}
+	}
+	for _, honor := range honors {
+		if u, ok := result[honor.UserID]; ok {
+			u.Honors = append(u.Honors, honor.Honor)
+		}
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/user/dal/db"
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// TestBuildUserProfiles 测试批量查询结果按 user_id 组装，没有档案记录的技能与荣誉被忽略
+func TestBuildUserProfiles(t *testing.T) {
+	profiles := []*db.UserProfileInfo{
+		{UserID: 1, Nickname: "a", College: "计算机学院", Introduction: "后端"},
+		{UserID: 2, Nickname: "b"},
+	}
+	skills := []*db.UserSkills{
+		{UserSkillID: 10, UserID: 1, Skill: "Go", Proficiency: "熟练"},
+		{UserSkillID: 11, UserID: 3, Skill: "Java"},
+		{UserSkillID: 12, UserID: 1, Skill: "MySQL"},
+	}
+	honors := []*db.Honors{{HonorID: 1, UserID: 2, Honor: "一等奖"}, {HonorID: 2, UserID: 3, Honor: "二等奖"}}
+
+	got := buildUserProfiles(profiles, skills, honors)
+	if len(got) != 2 {
+		t.Fatalf("len = %d, want 2", len(got))
+	}
+	u := got[1]
+	if u.UserInfo.UserId != 1 || u.UserInfo.College != "计算机学院" || u.Introduction != "后端" {
+		t.Errorf("user 1 = %+v", u)
+	}
+	if len(u.UserSkills) != 2 || u.UserSkills[0].Skill != "Go" || u.UserSkills[1].Skill != "MySQL" {
+		t.Errorf("user 1 skills = %v", u.UserSkills)
+	}
+	if len(u.Honors) != 0 {
+		t.Errorf("user 1 honors = %v", u.Honors)
+	}
+	if len(got[2].UserSkills) != 0 || len(got[2].Honors) != 1 || got[2].Honors[0] != "一等奖" {
+		t.Errorf("user 2 = %+v", got[2])
+	}
+}
+
+// memoryProfileCache 用于测试的内存档案缓存
+type memoryProfileCache struct {
+	mu    sync.Mutex
+	items map[int64]string
+}
+
+func (c *memoryProfileCache) GetUserProfile(user_id int64) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.items[user_id]
+	return v, ok
+}
+
+func (c *memoryProfileCache) MGetUserProfiles(user_ids []int64) map[int64]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make(map[int64]string)
+	for _, id := range user_ids {
+		if v, ok := c.items[id]; ok {
+			result[id] = v
+		}
+	}
+	return result
+}
+
+func (c *memoryProfileCache) SetUserProfile(user_id int64, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[user_id] = value
+}
+
+func (c *memoryProfileCache) DelUserProfile(user_id int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, user_id)
+}
+
+// useMemoryProfileCache 在测试期间使用内存档案缓存
+func useMemoryProfileCache(t *testing.T) *memoryProfileCache {
+	c := &memoryProfileCache{items: make(map[int64]string)}
+	saved := rdProfile
+	rdProfile = c
+	t.Cleanup(func() { rdProfile = saved })
+	return c
+}
+
+// TestProfileCacheHit 测试缓存命中时单个与批量查询都直接返回缓存的档案，不访问数据库
+func TestProfileCacheHit(t *testing.T) {
+	useMemoryProfileCache(t)
+	for _, id := range []int32{1, 2} {
+		cacheUserProfile(&user.UserProfileInfo{Introduction: "cached", UserInfo: &user.UserInfo{UserId: id}})
+	}
+
+	s := NewQueryUserProfileService(context.Background())
+	u, err := s.QueryUserProfile(1)
+	if err != nil || u.Introduction != "cached" {
+		t.Fatalf("QueryUserProfile() = %+v, %v", u, err)
+	}
+	got, err := s.QueryUserProfileBatch([]int32{1, 2})
+	if err != nil || len(got) != 2 || got[2].UserInfo.UserId != 2 {
+		t.Fatalf("QueryUserProfileBatch() = %+v, %v", got, err)
+	}
+}
+
+// TestInvalidateUserProfile 测试失效后缓存被删除，删除之后回填的旧档案在延迟后再次被删除
+func TestInvalidateUserProfile(t *testing.T) {
+	c := useMemoryProfileCache(t)
+	saved := profileInvalidateDelay
+	profileInvalidateDelay = 10 * time.Millisecond
+	t.Cleanup(func() { profileInvalidateDelay = saved })
+
+	stale := &user.UserProfileInfo{Introduction: "stale", UserInfo: &user.UserInfo{UserId: 1}}
+	cacheUserProfile(stale)
+	InvalidateUserProfile(1)
+	if _, ok := cachedUserProfile(1); ok {
+		t.Fatal("profile still cached after InvalidateUserProfile()")
+	}
+
+	// 更新前开始的加载在删除之后回填旧档案
+	v, _ := json.Marshal(stale)
+	c.SetUserProfile(1, string(v))
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, ok := cachedUserProfile(1); !ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("stale profile written back after invalidation was not deleted")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"github.com/Yra-A/Fusion_Go/cmd/user/dal/db"
 	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 type UploadUserService struct {
@@ -14,7 +18,58 @@ func NewUploadUserService(ctx context.Context) *UploadUserService {
 	return &UploadUserService{ctx: ctx}
 }
 
+// UploadUserInfo 写入用户基本信息，昵称、学院等参与 embedding 的字段变化时异步刷新用户 embedding
 func (s *UploadUserService) UploadUserInfo(u *user.UserInfo) error {
+	return s.saveAndRefresh(u.UserId, func() error { return saveUserInfo(u) })
+}
+
+// UploadUserProfileInfo 写入用户基本信息与档案，档案文本变化时异步刷新用户 embedding
+func (s *UploadUserService) UploadUserProfileInfo(u *user.UserProfileInfo) error {
+	return s.saveAndRefresh(u.UserInfo.UserId, func() error { return saveUserProfileInfo(u) })
+}
+
+// saveAndRefresh 执行 save 后删除档案缓存，并比较写入前后参与 embedding 的档案文本，发生变化时异步刷新用户 embedding
+func (s *UploadUserService) saveAndRefresh(user_id int32, save func() error) error {
+	before, err := s.profileText(user_id)
+	if err != nil {
+		return err
+	}
+	err = save()
+	// 先删除档案缓存，再刷新 embedding，避免刷新时读到旧档案；中途失败时已写入的部分同样需要使缓存失效
+	InvalidateUserProfile(user_id)
+	if err != nil {
+		return err
+	}
+	after, err := s.profileText(user_id)
+	if err != nil {
+		// 信息已写入成功，仅跳过本次刷新
+		klog.Errorf("读取用户 %d 的档案失败: %v", user_id, err)
+		return nil
+	}
+	if after != before {
+		go func() {
+			if _, _, err := NewUserEmbeddingService(context.Background()).RefreshUserEmbedding(user_id); err != nil {
+				klog.Errorf("刷新用户 %d 的 embedding 失败: %v", user_id, err)
+			}
+		}()
+	}
+	return nil
+}
+
+// profileText 从数据库组装用户档案并生成参与 embedding 的文本，用户不存在时返回空字符串
+func (s *UploadUserService) profileText(user_id int32) (string, error) {
+	profile, err := NewQueryUserProfileService(s.ctx).loadUserProfile(user_id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return embedder.UserProfileText(profile), nil
+}
+
+// saveUserInfo 写入用户基本信息
+func saveUserInfo(u *user.UserInfo) error {
 	dbu := &db.UserProfileInfo{
 		UserID:         u.UserId,
 		Gender:         u.Gender,
@@ -26,14 +81,12 @@ func (s *UploadUserService) UploadUserInfo(u *user.UserInfo) error {
 		HasProfile:     u.HasProfile,
 		AvatarURL:      u.AvatarUrl,
 	}
-	if err := db.AddOrUpdateUserProfileInfo(dbu); err != nil {
-		return err
-	}
-	return nil
+	return db.AddOrUpdateUserProfileInfo(dbu)
 }
 
-func (s *UploadUserService) UploadUserProfileInfo(u *user.UserProfileInfo) error {
-	if err := s.UploadUserInfo(u.UserInfo); err != nil {
+// saveUserProfileInfo 依次写入基本信息、档案、技能与荣誉
+func saveUserProfileInfo(u *user.UserProfileInfo) error {
+	if err := saveUserInfo(u.UserInfo); err != nil {
 		return err
 	}
 	dbu := &db.UserProfileInfo{
@@ -70,5 +123,9 @@ func (s *UploadUserService) UpdateHasProfile(userId int32) error {
 	profile.HasProfile = hasProfile
 
 	// 更新 UserProfile 信息
-	return db.UpdateHasProfile(userId, hasProfile)
+	if err := db.UpdateHasProfile(userId, hasProfile); err != nil {
+		return err
+	}
+	InvalidateUserProfile(userId)
+	return nil
 }
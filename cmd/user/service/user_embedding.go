@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Yra-A/Fusion_Go/cmd/user/dal/db"
+	"github.com/Yra-A/Fusion_Go/pkg/embedder"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type UserEmbeddingService struct {
+	ctx context.Context
+}
+
+func NewUserEmbeddingService(ctx context.Context) *UserEmbeddingService {
+	return &UserEmbeddingService{ctx: ctx}
+}
+
+// QueryUserEmbedding 获取用户 embedding，缓存与数据库中都不存在或模型已变更时重新生成
+func (s *UserEmbeddingService) QueryUserEmbedding(user_id int32) ([]float64, *db.UserEmbedding, error) {
+	e, err := db.QueryUserEmbeddingByUserId(user_id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, err
+	}
+	if e != nil && e.Model == embedder.Default().Model() {
+		var vec []float64
+		if err := json.Unmarshal([]byte(e.Embedding), &vec); err == nil && len(vec) > 0 {
+			return vec, e, nil
+		}
+	}
+	return s.RefreshUserEmbedding(user_id)
+}
+
+// RefreshUserEmbedding 根据当前的用户档案重新生成并保存 embedding
+func (s *UserEmbeddingService) RefreshUserEmbedding(user_id int32) ([]float64, *db.UserEmbedding, error) {
+	profile, err := NewQueryUserProfileService(s.ctx).QueryUserProfile(user_id)
+	if err != nil {
+		return nil, nil, err
+	}
+	e := embedder.Default()
+	vec, err := e.Embed(s.ctx, embedder.UserProfileText(profile))
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := json.Marshal(vec)
+	if err != nil {
+		return nil, nil, err
+	}
+	record := &db.UserEmbedding{
+		UserID:               user_id,
+		Embedding:            string(data),
+		Model:                e.Model(),
+		EmbeddingUpdatedTime: time.Now(),
+	}
+	if err := db.SaveUserEmbedding(record); err != nil {
+		return nil, nil, err
+	}
+	return vec, record, nil
+}
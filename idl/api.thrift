@@ -171,6 +171,20 @@ struct ContestListResponse {
     4: list<ContestBriefInfo> contest_list,
 }
 
+struct ContestRecommendRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id (api.query="user_id")
+    3: i32 limit (api.query="limit")
+    4: i32 offset (api.query="offset")
+}
+
+struct ContestRecommendResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 total,
+    4: list<ContestBriefInfo> contest_list, // 按推荐分数降序排列
+}
+
 struct ContestInfoRequest {
     1: i32 contest_id (api.path="contest_id")
     2: i32 user_id (api.query="user_id")
@@ -217,6 +231,18 @@ struct MemberInfo {
     7: list<string> honors,
 }
 
+// 推荐系统对队伍的打分及推荐理由
+struct TeamRecommendation {
+    1: double score,                // 最终推荐分数
+    2: double semantic_score,       // 与岗位描述的语义相似度
+    3: double skill_score,          // 与岗位技能要求的匹配度
+    4: string job,                  // 与用户最匹配的岗位
+    5: list<string> matched_skills, // 用户满足的技能要求
+    6: double time_boost,           // 按队伍创建时间计算的衰减系数
+    7: double popularity_score,     // 队伍在赛事中的热度分数，按热度排序或混合排序时返回
+    8: string reason,               // 推荐理由，按热度推荐时为“本赛事热门队伍”
+}
+
 struct TeamBriefInfo {
     1: i32 team_id,
     2: string title,
@@ -225,6 +251,8 @@ struct TeamBriefInfo {
     5: i64 created_time,
     6: MemberInfo leader_info,
     7: i32 contest_id,
+    8: optional TeamRecommendation recommendation, // 仅在请求推荐理由时返回
+    9: bool closed, // 队伍已停止招募
 }
 
 struct TeamInfo {
@@ -241,6 +269,9 @@ struct TeamApplication {
     4: i32 application_type,
     5: MemberInfo member_info,
     6: i32 application_id,
+    7: string status, // pending 待处理、accepted 已接受、rejected 已拒绝、withdrawn 已撤回、expired 已过期
+    8: i64 decided_time, // 申请被处理、撤回或过期的时间，待处理时为 0
+    9: i32 decided_by, // 处理申请的用户：接受或拒绝时为队长，撤回时为申请人，过期时为 0
 }
 
 struct TeamSkill {
@@ -251,6 +282,20 @@ struct TeamSkill {
     5: string job,  // 什么岗位需要该skill
 }
 
+// 队长向指定用户发出的入队邀请
+struct TeamInvitation {
+    1: i32 invitation_id,
+    2: i32 team_id,
+    3: string team_title,
+    4: i32 contest_id,
+    5: MemberInfo inviter_info, // 发出邀请的队长
+    6: i32 invitee_id,
+    7: string message,
+    8: i64 created_time,
+    9: string status, // pending 待处理、accepted 已接受、declined 已拒绝、cancelled 已取消（队伍解散、队长取消、原队长不再担任队长或被邀请人已加入该赛事其他队伍）、expired 已过期
+    10: i64 decided_time, // 邀请被处理、取消或过期的时间，待处理时为 0
+}
+
 struct TeamCreateRequest {
     1: string authorization (api.header="Authorization")
     2: i32 user_id
@@ -274,6 +319,8 @@ struct TeamListRequest {
     3: i32 limit (api.query="limit")
     4: i32 offset (api.query="offset")
     5: i32 user_id (api.query="user_id")
+    6: bool with_recommendation (api.query="with_recommendation") // 为 true 时在每个队伍中返回推荐分数及理由
+    7: string cursor (api.query="cursor") // 上一页响应中的 next_cursor，不为空时忽略 offset
 }
 
 struct TeamListResponse {
@@ -281,6 +328,9 @@ struct TeamListResponse {
     2: string status_msg,
     3: i32 total,
     4: list<TeamBriefInfo> team_list,
+    5: string experiment, // 推荐排序所在的实验分组，格式为 实验名/分组名，未参与实验时为空
+    6: string strategy, // 推荐策略: personalized 个性化、blended 与热度混合、popularity 按热度，未使用推荐排序时为空
+    7: string next_cursor, // 下一页的游标，已是最后一页时为空
 }
 
 struct TeamInfoRequest {
@@ -309,6 +359,63 @@ struct TeamApplicationSubmitResponse {
     2: string status_msg,
 }
 
+// 用户提交的入队申请
+struct MyApplication {
+    1: TeamApplication application,
+    2: string team_title,
+    3: i32 contest_id,
+    4: string contest_title,
+}
+
+struct MyApplicationsRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id (api.query="user_id")
+    3: string status (api.query="status") // 申请状态，为空时返回所有状态的申请
+    4: i32 limit (api.query="limit")
+    5: i32 offset (api.query="offset")
+}
+
+struct MyApplicationsResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 total,
+    4: list<MyApplication> application_list,
+}
+
+// 用户担任队长或加入的队伍
+struct MyTeam {
+    1: TeamBriefInfo team_brief_info,
+    2: string contest_title,
+    3: string role, // leader 队长、member 队员
+}
+
+struct MyTeamListRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id (api.query="user_id")
+    3: string role (api.query="role") // leader 只返回担任队长的队伍，member 只返回作为队员加入的队伍，为空时返回全部
+    4: i32 limit (api.query="limit")
+    5: i32 offset (api.query="offset")
+}
+
+struct MyTeamListResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 total,
+    4: list<MyTeam> team_list,
+}
+
+// 撤回入队申请，只有申请人可以撤回自己待处理的申请
+struct TeamApplicationWithdrawRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 application_id,
+}
+
+struct TeamApplicationWithdrawResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
 struct TeamManageListRequest {
     1: string authorization (api.header="Authorization")
     2: i32 user_id (api.query="user_id")
@@ -321,6 +428,142 @@ struct TeamManageListResponse {
     3: list<TeamApplication> application_list,
 }
 
+// 队员退出队伍，队长需要先转让队长身份或解散队伍
+struct TeamLeaveRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 team_id,
+}
+
+struct TeamLeaveResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
+// 队长将队员移出队伍
+struct TeamMemberRemoveRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 team_id,
+    4: i32 member_id,
+}
+
+struct TeamMemberRemoveResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
+// 队长将队长身份转让给队伍中的其他成员
+struct TeamLeaderTransferRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 team_id,
+    4: i32 member_id, // 新队长，必须是队伍中的成员
+}
+
+struct TeamLeaderTransferResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
+// 队长停止或恢复队伍的招募，停止招募的队伍不再出现在队伍列表中，也不再接受入队申请
+struct TeamCloseRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 team_id,
+    4: bool closed, // true 停止招募，false 恢复招募
+}
+
+struct TeamCloseResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
+// 队长解散队伍，队伍的所有成员关系被删除，待处理的申请被拒绝
+struct TeamDisbandRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 team_id,
+}
+
+struct TeamDisbandResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
+// 队长邀请用户加入队伍
+struct TeamInvitationSendRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 team_id,
+    4: i32 invitee_id,
+    5: string message,
+}
+
+struct TeamInvitationSendResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 invitation_id,
+}
+
+// 被邀请人接受或拒绝邀请
+struct TeamInvitationRespondRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 invitation_id,
+    4: i32 action_type, // 1 接受，其他值拒绝
+}
+
+struct TeamInvitationRespondResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
+// 被邀请人收到的邀请
+struct TeamInvitationListRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id (api.query="user_id")
+    3: string status (api.query="status") // 邀请状态，为空时返回所有状态的邀请
+    4: i32 limit (api.query="limit")
+    5: i32 offset (api.query="offset")
+}
+
+struct TeamInvitationListResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 total,
+    4: list<TeamInvitation> invitation_list,
+}
+
+// 队长查看队伍发出的邀请
+struct TeamSentInvitationListRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id (api.query="user_id")
+    3: i32 team_id (api.query="team_id")
+    4: string status (api.query="status") // 邀请状态，为空时返回所有状态的邀请
+    5: i32 limit (api.query="limit")
+    6: i32 offset (api.query="offset")
+}
+
+struct TeamSentInvitationListResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 total,
+    4: list<TeamInvitation> invitation_list,
+}
+
+// 队长取消待处理的邀请
+struct TeamInvitationCancelRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id,
+    3: i32 invitation_id,
+}
+
+struct TeamInvitationCancelResponse {
+    1: i32 status_code,
+    2: string status_msg,
+}
+
 struct TeamManageActionRequest {
     1: string authorization (api.header="Authorization")
     2: i32 user_id (api.path="user_id")
@@ -335,6 +578,30 @@ struct TeamManageActionResponse {
 
 /* =========================== favorite =========================== */
 
+// 推荐给队长的候选队员
+struct TeamCandidate {
+    1: MemberInfo member_info,
+    2: double score,            // 与岗位的匹配度
+}
+
+struct JobCandidates {
+    1: string job,
+    2: list<TeamCandidate> candidates, // 按匹配度降序排列
+}
+
+struct TeamCandidatesRequest {
+    1: string authorization (api.header="Authorization")
+    2: i32 user_id (api.query="user_id")
+    3: i32 team_id (api.query="team_id")
+    4: i32 limit (api.query="limit")   // 每个岗位返回的候选人数
+}
+
+struct TeamCandidatesResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: list<JobCandidates> job_candidates,
+}
+
 struct ContestFavoriteActionRequest {
     1: string authorization (api.header="Authorization")
     2: i32 user_id
@@ -423,6 +690,8 @@ service ApiService {
     /* contest */
     // 获取赛事资讯列表
     ContestListResponse ContestList(1: ContestListRequest req) (api.get="/fusion/contest/list")
+    // 获取个性化推荐的赛事资讯列表
+    ContestRecommendResponse ContestRecommend(1: ContestRecommendRequest req) (api.get="/fusion/contest/recommend")
     // 获取赛事资讯详情
     ContestInfoResponse ContestInfo(1: ContestInfoRequest req) (api.get="/fusion/contest/info/:contest_id")
     // 创建赛事资讯
@@ -443,10 +712,38 @@ service ApiService {
     TeamInfoResponse TeamInfo(1: TeamInfoRequest req) (api.get="/fusion/contest/:contest_id/team/info/:team_id")
     // 提交队伍申请
     TeamApplicationSubmitResponse TeamApplicationSubmit(1: TeamApplicationSubmitRequest req) (api.post="/fusion/team/application/submit")
+    // 撤回队伍申请
+    TeamApplicationWithdrawResponse TeamApplicationWithdraw(1: TeamApplicationWithdrawRequest req) (api.post="/fusion/team/application/withdraw")
+    // 获取用户提交的队伍申请
+    MyApplicationsResponse MyApplications(1: MyApplicationsRequest req) (api.get="/fusion/team/my/applications")
+    // 获取用户担任队长或加入的队伍
+    MyTeamListResponse MyTeamList(1: MyTeamListRequest req) (api.get="/fusion/team/my/list")
     // 获取队伍申请列表
     TeamManageListResponse TeamManageList(1: TeamManageListRequest req) (api.get="/fusion/team/manage/list")
     // 队伍申请操作
     TeamManageActionResponse TeamManageAction(1: TeamManageActionRequest req) (api.post="/fusion/team/manage/action")
+    // 退出队伍
+    TeamLeaveResponse TeamLeave(1: TeamLeaveRequest req) (api.post="/fusion/team/leave")
+    // 将队员移出队伍
+    TeamMemberRemoveResponse TeamMemberRemove(1: TeamMemberRemoveRequest req) (api.post="/fusion/team/manage/remove")
+    // 转让队长
+    TeamLeaderTransferResponse TeamLeaderTransfer(1: TeamLeaderTransferRequest req) (api.post="/fusion/team/manage/transfer")
+    // 停止或恢复队伍招募
+    TeamCloseResponse TeamClose(1: TeamCloseRequest req) (api.post="/fusion/team/manage/close")
+    // 解散队伍
+    TeamDisbandResponse TeamDisband(1: TeamDisbandRequest req) (api.post="/fusion/team/manage/disband")
+    // 邀请用户加入队伍
+    TeamInvitationSendResponse TeamInvitationSend(1: TeamInvitationSendRequest req) (api.post="/fusion/team/invitation/send")
+    // 接受或拒绝入队邀请
+    TeamInvitationRespondResponse TeamInvitationRespond(1: TeamInvitationRespondRequest req) (api.post="/fusion/team/invitation/respond")
+    // 获取用户收到的入队邀请
+    TeamInvitationListResponse TeamInvitationList(1: TeamInvitationListRequest req) (api.get="/fusion/team/invitation/list")
+    // 获取队伍发出的入队邀请
+    TeamSentInvitationListResponse TeamSentInvitationList(1: TeamSentInvitationListRequest req) (api.get="/fusion/team/invitation/sent")
+    // 取消待处理的入队邀请
+    TeamInvitationCancelResponse TeamInvitationCancel(1: TeamInvitationCancelRequest req) (api.post="/fusion/team/invitation/cancel")
+    // 为队长推荐候选队员
+    TeamCandidatesResponse TeamCandidates(1: TeamCandidatesRequest req) (api.get="/fusion/team/manage/candidates")
 
     /* favorite */
     // 赛事收藏操作
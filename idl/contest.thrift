@@ -60,6 +60,19 @@ struct ContestListResponse {
     4: list<ContestBriefInfo> contest_list,
 }
 
+struct ContestRecommendRequest {
+    1: i32 user_id
+    2: i32 limit
+    3: i32 offset
+}
+
+struct ContestRecommendResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: i32 total,
+    4: list<ContestBriefInfo> contest_list, // 按推荐分数降序排列
+}
+
 struct ContestInfoRequest {
     1: i32 contest_id
     2: i32 user_id
@@ -81,6 +94,16 @@ struct ContestCreateResponse {
     3: i32 contest_id,
 }
 
+struct ContestBatchInfoRequest {
+    1: list<i32> contest_ids
+}
+
+struct ContestBatchInfoResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: list<ContestBriefInfo> contest_list, // 按 contest_ids 的顺序排列，不存在的赛事不包含在结果中
+}
+
 
 //The following interface is specifically designed for the 'favorite' module to retrieve favorite contest list
 struct GetContestsByFavoritesRequest {
@@ -95,10 +118,14 @@ struct GetContestsByFavoritesResponse {
 service ContestService {
     // 获取赛事资讯列表
     ContestListResponse ContestList(1: ContestListRequest req)
+    // 获取个性化推荐的赛事资讯列表
+    ContestRecommendResponse ContestRecommend(1: ContestRecommendRequest req)
     // 获取赛事资讯详情
     ContestInfoResponse ContestInfo(1: ContestInfoRequest req)
     // 创建赛事资讯
     ContestCreateResponse ContestCreate(1: ContestCreateRequest req)
+    // 批量获取赛事简要信息
+    ContestBatchInfoResponse ContestBatchInfo(1: ContestBatchInfoRequest req)
 
     //The following interface is specifically designed for the 'favorite' module to retrieve contest information
     GetContestsByFavoritesResponse GetContestsByFavorites(1: GetContestsByFavoritesRequest req)
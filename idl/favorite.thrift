@@ -48,6 +48,17 @@ struct QueryFavoriteStatusByUserIdResponse {
     1: bool is_favorite
 }
 
+//The following interface is specifically designed for the 'team' module to find candidates for a team
+struct QueryContestFavoriteUsersRequest {
+    1: i32 contest_id
+}
+
+struct QueryContestFavoriteUsersResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: list<i32> user_ids
+}
+
 service FavoriteService {
     // 赛事收藏操作
     ContestFavoriteActionResponse ContestFavoriteAction(1: ContestFavoriteActionRequest req)
@@ -55,4 +66,6 @@ service FavoriteService {
     ContestFavoriteListResponse ContestFavoriteList(1: ContestFavoriteListRequest req)
     // 获取用户对某个赛事的收藏状态
     QueryFavoriteStatusByUserIdResponse QueryFavoriteStatusByUserId(1: QueryFavoriteStatusByUserIdRequest req)
+    // 获取收藏了某个赛事的用户
+    QueryContestFavoriteUsersResponse QueryContestFavoriteUsers(1: QueryContestFavoriteUsersRequest req)
 }
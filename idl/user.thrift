@@ -86,6 +86,17 @@ struct UserProfileInfoResponse {
     3: UserProfileInfo user_profile_info,
 }
 
+// 批量获取用户档案信息
+struct UserProfileInfoBatchRequest {
+    1: list<i32> user_ids
+}
+
+struct UserProfileInfoBatchResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: map<i32, UserProfileInfo> user_profile_infos, // 按 user_id 索引，不存在的用户不包含在内
+}
+
 // 上传用户档案信息
 
 struct UserProfileUploadRequest {
@@ -98,6 +109,19 @@ struct UserProfileUploadResponse {
     2: string status_msg,
 }
 
+// 获取用户档案 embedding
+struct UserEmbeddingRequest {
+    1: i32 user_id
+}
+
+struct UserEmbeddingResponse {
+    1: i32 status_code,
+    2: string status_msg,
+    3: list<double> embedding,
+    4: string model,
+    5: i64 embedding_updated_time,
+}
+
 service UserService {
     // 用户注册操作
     UserRegisterResponse UserRegister(1: UserRegisterRequest req)
@@ -109,6 +133,10 @@ service UserService {
     UserInfoUploadResponse UserInfoUpload(1: UserInfoUploadRequest req)
     // 获取用户档案信息
     UserProfileInfoResponse UserProfileInfo(1: UserProfileInfoRequest req)
+    // 批量获取用户档案信息
+    UserProfileInfoBatchResponse UserProfileInfoBatch(1: UserProfileInfoBatchRequest req)
     // 上传用户档案信息
     UserProfileUploadResponse UserProfileUpload(1: UserProfileUploadRequest req)
+    // 获取用户档案 embedding
+    UserEmbeddingResponse UserEmbedding(1: UserEmbeddingRequest req)
 }
\ No newline at end of file
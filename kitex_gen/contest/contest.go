@@ -3160,39 +3160,48 @@ func (p *ContestListResponse) Field4DeepEqual(src []*ContestBriefInfo) bool {
 	return true
 }
 
-type ContestInfoRequest struct {
-	ContestId int32 `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
-	UserId    int32 `thrift:"user_id,2" frugal:"2,default,i32" json:"user_id"`
+type ContestRecommendRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	Limit  int32 `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+	Offset int32 `thrift:"offset,3" frugal:"3,default,i32" json:"offset"`
 }
 
-func NewContestInfoRequest() *ContestInfoRequest {
-	return &ContestInfoRequest{}
+func NewContestRecommendRequest() *ContestRecommendRequest {
+	return &ContestRecommendRequest{}
 }
 
-func (p *ContestInfoRequest) InitDefault() {
-	*p = ContestInfoRequest{}
+func (p *ContestRecommendRequest) InitDefault() {
+	*p = ContestRecommendRequest{}
 }
 
-func (p *ContestInfoRequest) GetContestId() (v int32) {
-	return p.ContestId
+func (p *ContestRecommendRequest) GetUserId() (v int32) {
+	return p.UserId
 }
 
-func (p *ContestInfoRequest) GetUserId() (v int32) {
-	return p.UserId
+func (p *ContestRecommendRequest) GetLimit() (v int32) {
+	return p.Limit
 }
-func (p *ContestInfoRequest) SetContestId(val int32) {
-	p.ContestId = val
+
+func (p *ContestRecommendRequest) GetOffset() (v int32) {
+	return p.Offset
 }
-func (p *ContestInfoRequest) SetUserId(val int32) {
+func (p *ContestRecommendRequest) SetUserId(val int32) {
 	p.UserId = val
 }
+func (p *ContestRecommendRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *ContestRecommendRequest) SetOffset(val int32) {
+	p.Offset = val
+}
 
-var fieldIDToName_ContestInfoRequest = map[int16]string{
-	1: "contest_id",
-	2: "user_id",
+var fieldIDToName_ContestRecommendRequest = map[int16]string{
+	1: "user_id",
+	2: "limit",
+	3: "offset",
 }
 
-func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3231,6 +3240,16 @@ func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3251,7 +3270,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestRecommendRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3261,27 +3280,36 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContestRecommendRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ContestId = v
+		p.UserId = v
 	}
 	return nil
 }
 
-func (p *ContestInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestRecommendRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.UserId = v
+		p.Limit = v
 	}
 	return nil
 }
 
-func (p *ContestInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *ContestRecommendRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ContestRecommendRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3293,6 +3321,10 @@ func (p *ContestInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3312,11 +3344,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
+func (p *ContestRecommendRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestId); err != nil {
+	if err := oprot.WriteI32(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3329,11 +3361,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+func (p *ContestRecommendRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserId); err != nil {
+	if err := oprot.WriteI32(p.Limit); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3346,94 +3378,121 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestInfoRequest) String() string {
+func (p *ContestRecommendRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestRecommendRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestInfoRequest(%+v)", *p)
+	return fmt.Sprintf("ContestRecommendRequest(%+v)", *p)
 }
 
-func (p *ContestInfoRequest) DeepEqual(ano *ContestInfoRequest) bool {
+func (p *ContestRecommendRequest) DeepEqual(ano *ContestRecommendRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ContestId) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.UserId) {
+	if !p.Field2DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Offset) {
 		return false
 	}
 	return true
 }
 
-func (p *ContestInfoRequest) Field1DeepEqual(src int32) bool {
+func (p *ContestRecommendRequest) Field1DeepEqual(src int32) bool {
 
-	if p.ContestId != src {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
-func (p *ContestInfoRequest) Field2DeepEqual(src int32) bool {
+func (p *ContestRecommendRequest) Field2DeepEqual(src int32) bool {
 
-	if p.UserId != src {
+	if p.Limit != src {
 		return false
 	}
 	return true
 }
+func (p *ContestRecommendRequest) Field3DeepEqual(src int32) bool {
 
-type ContestInfoResponse struct {
-	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string   `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
-	Contest    *Contest `thrift:"contest,3" frugal:"3,default,Contest" json:"contest"`
+	if p.Offset != src {
+		return false
+	}
+	return true
 }
 
-func NewContestInfoResponse() *ContestInfoResponse {
-	return &ContestInfoResponse{}
+type ContestRecommendResponse struct {
+	StatusCode  int32               `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg   string              `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Total       int32               `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	ContestList []*ContestBriefInfo `thrift:"contest_list,4" frugal:"4,default,list<ContestBriefInfo>" json:"contest_list"`
 }
 
-func (p *ContestInfoResponse) InitDefault() {
-	*p = ContestInfoResponse{}
+func NewContestRecommendResponse() *ContestRecommendResponse {
+	return &ContestRecommendResponse{}
 }
 
-func (p *ContestInfoResponse) GetStatusCode() (v int32) {
+func (p *ContestRecommendResponse) InitDefault() {
+	*p = ContestRecommendResponse{}
+}
+
+func (p *ContestRecommendResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ContestInfoResponse) GetStatusMsg() (v string) {
+func (p *ContestRecommendResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-var ContestInfoResponse_Contest_DEFAULT *Contest
+func (p *ContestRecommendResponse) GetTotal() (v int32) {
+	return p.Total
+}
 
-func (p *ContestInfoResponse) GetContest() (v *Contest) {
-	if !p.IsSetContest() {
-		return ContestInfoResponse_Contest_DEFAULT
-	}
-	return p.Contest
+func (p *ContestRecommendResponse) GetContestList() (v []*ContestBriefInfo) {
+	return p.ContestList
 }
-func (p *ContestInfoResponse) SetStatusCode(val int32) {
+func (p *ContestRecommendResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *ContestInfoResponse) SetStatusMsg(val string) {
+func (p *ContestRecommendResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
-func (p *ContestInfoResponse) SetContest(val *Contest) {
-	p.Contest = val
+func (p *ContestRecommendResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *ContestRecommendResponse) SetContestList(val []*ContestBriefInfo) {
+	p.ContestList = val
 }
 
-var fieldIDToName_ContestInfoResponse = map[int16]string{
+var fieldIDToName_ContestRecommendResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "contest",
-}
-
-func (p *ContestInfoResponse) IsSetContest() bool {
-	return p.Contest != nil
+	3: "total",
+	4: "contest_list",
 }
 
-func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3473,7 +3532,7 @@ func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3482,6 +3541,16 @@ func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3502,7 +3571,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestRecommendResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3512,7 +3581,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContestRecommendResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -3521,7 +3590,7 @@ func (p *ContestInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestRecommendResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3530,17 +3599,38 @@ func (p *ContestInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestInfoResponse) ReadField3(iprot thrift.TProtocol) error {
-	p.Contest = NewContest()
-	if err := p.Contest.Read(iprot); err != nil {
+func (p *ContestRecommendResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.Total = v
 	}
 	return nil
 }
 
-func (p *ContestInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ContestList = make([]*ContestBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewContestBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ContestList = append(p.ContestList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestRecommendResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ContestRecommendResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3556,6 +3646,10 @@ func (p *ContestInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3575,7 +3669,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3592,7 +3686,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ContestRecommendResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3609,11 +3703,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 3); err != nil {
+func (p *ContestRecommendResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Contest.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.Total); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3626,14 +3720,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestInfoResponse) String() string {
+func (p *ContestRecommendResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
+		return err
+	}
+	for _, v := range p.ContestList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ContestRecommendResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestInfoResponse(%+v)", *p)
+	return fmt.Sprintf("ContestRecommendResponse(%+v)", *p)
 }
 
-func (p *ContestInfoResponse) DeepEqual(ano *ContestInfoResponse) bool {
+func (p *ContestRecommendResponse) DeepEqual(ano *ContestRecommendResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3645,67 +3764,83 @@ func (p *ContestInfoResponse) DeepEqual(ano *ContestInfoResponse) bool {
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Contest) {
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ContestList) {
 		return false
 	}
 	return true
 }
 
-func (p *ContestInfoResponse) Field1DeepEqual(src int32) bool {
+func (p *ContestRecommendResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *ContestInfoResponse) Field2DeepEqual(src string) bool {
+func (p *ContestRecommendResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ContestInfoResponse) Field3DeepEqual(src *Contest) bool {
+func (p *ContestRecommendResponse) Field3DeepEqual(src int32) bool {
 
-	if !p.Contest.DeepEqual(src) {
+	if p.Total != src {
 		return false
 	}
 	return true
 }
+func (p *ContestRecommendResponse) Field4DeepEqual(src []*ContestBriefInfo) bool {
 
-type ContestCreateRequest struct {
-	Contest *Contest `thrift:"contest,1" frugal:"1,default,Contest" json:"contest"`
+	if len(p.ContestList) != len(src) {
+		return false
+	}
+	for i, v := range p.ContestList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
 
-func NewContestCreateRequest() *ContestCreateRequest {
-	return &ContestCreateRequest{}
+type ContestInfoRequest struct {
+	ContestId int32 `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
+	UserId    int32 `thrift:"user_id,2" frugal:"2,default,i32" json:"user_id"`
 }
 
-func (p *ContestCreateRequest) InitDefault() {
-	*p = ContestCreateRequest{}
+func NewContestInfoRequest() *ContestInfoRequest {
+	return &ContestInfoRequest{}
 }
 
-var ContestCreateRequest_Contest_DEFAULT *Contest
+func (p *ContestInfoRequest) InitDefault() {
+	*p = ContestInfoRequest{}
+}
 
-func (p *ContestCreateRequest) GetContest() (v *Contest) {
-	if !p.IsSetContest() {
-		return ContestCreateRequest_Contest_DEFAULT
-	}
-	return p.Contest
-}
-func (p *ContestCreateRequest) SetContest(val *Contest) {
-	p.Contest = val
+func (p *ContestInfoRequest) GetContestId() (v int32) {
+	return p.ContestId
 }
 
-var fieldIDToName_ContestCreateRequest = map[int16]string{
-	1: "contest",
+func (p *ContestInfoRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+func (p *ContestInfoRequest) SetContestId(val int32) {
+	p.ContestId = val
+}
+func (p *ContestInfoRequest) SetUserId(val int32) {
+	p.UserId = val
 }
 
-func (p *ContestCreateRequest) IsSetContest() bool {
-	return p.Contest != nil
+var fieldIDToName_ContestInfoRequest = map[int16]string{
+	1: "contest_id",
+	2: "user_id",
 }
 
-func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3725,7 +3860,7 @@ func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3734,6 +3869,16 @@ func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3754,7 +3899,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3764,17 +3909,27 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestCreateRequest) ReadField1(iprot thrift.TProtocol) error {
-	p.Contest = NewContest()
-	if err := p.Contest.Read(iprot); err != nil {
+func (p *ContestInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *ContestCreateRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *ContestInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ContestInfoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3782,6 +3937,10 @@ func (p *ContestCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3801,11 +3960,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 1); err != nil {
+func (p *ContestInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Contest.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.ContestId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3818,75 +3977,111 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestCreateRequest) String() string {
+func (p *ContestInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestInfoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestCreateRequest(%+v)", *p)
+	return fmt.Sprintf("ContestInfoRequest(%+v)", *p)
 }
 
-func (p *ContestCreateRequest) DeepEqual(ano *ContestCreateRequest) bool {
+func (p *ContestInfoRequest) DeepEqual(ano *ContestInfoRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Contest) {
+	if !p.Field1DeepEqual(ano.ContestId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
 		return false
 	}
 	return true
 }
 
-func (p *ContestCreateRequest) Field1DeepEqual(src *Contest) bool {
+func (p *ContestInfoRequest) Field1DeepEqual(src int32) bool {
 
-	if !p.Contest.DeepEqual(src) {
+	if p.ContestId != src {
 		return false
 	}
 	return true
 }
+func (p *ContestInfoRequest) Field2DeepEqual(src int32) bool {
 
-type ContestCreateResponse struct {
-	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
-	ContestId  int32  `thrift:"contest_id,3" frugal:"3,default,i32" json:"contest_id"`
+	if p.UserId != src {
+		return false
+	}
+	return true
 }
 
-func NewContestCreateResponse() *ContestCreateResponse {
-	return &ContestCreateResponse{}
+type ContestInfoResponse struct {
+	StatusCode int32    `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string   `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Contest    *Contest `thrift:"contest,3" frugal:"3,default,Contest" json:"contest"`
 }
 
-func (p *ContestCreateResponse) InitDefault() {
-	*p = ContestCreateResponse{}
+func NewContestInfoResponse() *ContestInfoResponse {
+	return &ContestInfoResponse{}
 }
 
-func (p *ContestCreateResponse) GetStatusCode() (v int32) {
+func (p *ContestInfoResponse) InitDefault() {
+	*p = ContestInfoResponse{}
+}
+
+func (p *ContestInfoResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *ContestCreateResponse) GetStatusMsg() (v string) {
+func (p *ContestInfoResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-func (p *ContestCreateResponse) GetContestId() (v int32) {
-	return p.ContestId
+var ContestInfoResponse_Contest_DEFAULT *Contest
+
+func (p *ContestInfoResponse) GetContest() (v *Contest) {
+	if !p.IsSetContest() {
+		return ContestInfoResponse_Contest_DEFAULT
+	}
+	return p.Contest
 }
-func (p *ContestCreateResponse) SetStatusCode(val int32) {
+func (p *ContestInfoResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *ContestCreateResponse) SetStatusMsg(val string) {
+func (p *ContestInfoResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
-func (p *ContestCreateResponse) SetContestId(val int32) {
-	p.ContestId = val
+func (p *ContestInfoResponse) SetContest(val *Contest) {
+	p.Contest = val
 }
 
-var fieldIDToName_ContestCreateResponse = map[int16]string{
+var fieldIDToName_ContestInfoResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "contest_id",
+	3: "contest",
 }
 
-func (p *ContestCreateResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) IsSetContest() bool {
+	return p.Contest != nil
+}
+
+func (p *ContestInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3926,7 +4121,7 @@ func (p *ContestCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3955,7 +4150,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestInfoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3965,7 +4160,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ContestInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -3974,7 +4169,7 @@ func (p *ContestCreateResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ContestInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3983,18 +4178,17 @@ func (p *ContestCreateResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ContestCreateResponse) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *ContestInfoResponse) ReadField3(iprot thrift.TProtocol) error {
+	p.Contest = NewContest()
+	if err := p.Contest.Read(iprot); err != nil {
 		return err
-	} else {
-		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *ContestCreateResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreateResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ContestInfoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4029,7 +4223,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4046,7 +4240,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ContestInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4063,11 +4257,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
+func (p *ContestInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestId); err != nil {
+	if err := p.Contest.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4080,14 +4274,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ContestCreateResponse) String() string {
+func (p *ContestInfoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestCreateResponse(%+v)", *p)
+	return fmt.Sprintf("ContestInfoResponse(%+v)", *p)
 }
 
-func (p *ContestCreateResponse) DeepEqual(ano *ContestCreateResponse) bool {
+func (p *ContestInfoResponse) DeepEqual(ano *ContestInfoResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4099,58 +4293,67 @@ func (p *ContestCreateResponse) DeepEqual(ano *ContestCreateResponse) bool {
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ContestId) {
+	if !p.Field3DeepEqual(ano.Contest) {
 		return false
 	}
 	return true
 }
 
-func (p *ContestCreateResponse) Field1DeepEqual(src int32) bool {
+func (p *ContestInfoResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *ContestCreateResponse) Field2DeepEqual(src string) bool {
+func (p *ContestInfoResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ContestCreateResponse) Field3DeepEqual(src int32) bool {
+func (p *ContestInfoResponse) Field3DeepEqual(src *Contest) bool {
 
-	if p.ContestId != src {
+	if !p.Contest.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type GetContestsByFavoritesRequest struct {
-	ContestIds []int32 `thrift:"contest_ids,1" frugal:"1,default,list<i32>" json:"contest_ids"`
+type ContestCreateRequest struct {
+	Contest *Contest `thrift:"contest,1" frugal:"1,default,Contest" json:"contest"`
 }
 
-func NewGetContestsByFavoritesRequest() *GetContestsByFavoritesRequest {
-	return &GetContestsByFavoritesRequest{}
+func NewContestCreateRequest() *ContestCreateRequest {
+	return &ContestCreateRequest{}
 }
 
-func (p *GetContestsByFavoritesRequest) InitDefault() {
-	*p = GetContestsByFavoritesRequest{}
+func (p *ContestCreateRequest) InitDefault() {
+	*p = ContestCreateRequest{}
 }
 
-func (p *GetContestsByFavoritesRequest) GetContestIds() (v []int32) {
-	return p.ContestIds
+var ContestCreateRequest_Contest_DEFAULT *Contest
+
+func (p *ContestCreateRequest) GetContest() (v *Contest) {
+	if !p.IsSetContest() {
+		return ContestCreateRequest_Contest_DEFAULT
+	}
+	return p.Contest
 }
-func (p *GetContestsByFavoritesRequest) SetContestIds(val []int32) {
-	p.ContestIds = val
+func (p *ContestCreateRequest) SetContest(val *Contest) {
+	p.Contest = val
 }
 
-var fieldIDToName_GetContestsByFavoritesRequest = map[int16]string{
-	1: "contest_ids",
+var fieldIDToName_ContestCreateRequest = map[int16]string{
+	1: "contest",
 }
 
-func (p *GetContestsByFavoritesRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestCreateRequest) IsSetContest() bool {
+	return p.Contest != nil
+}
+
+func (p *ContestCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4170,7 +4373,7 @@ func (p *GetContestsByFavoritesRequest) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4199,7 +4402,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetContestsByFavoritesRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4209,31 +4412,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetContestsByFavoritesRequest) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ContestCreateRequest) ReadField1(iprot thrift.TProtocol) error {
+	p.Contest = NewContest()
+	if err := p.Contest.Read(iprot); err != nil {
 		return err
 	}
-	p.ContestIds = make([]int32, 0, size)
-	for i := 0; i < size; i++ {
-		var _elem int32
-		if v, err := iprot.ReadI32(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+	return nil
+}
 
-		p.ContestIds = append(p.ContestIds, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (p *GetContestsByFavoritesRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetContestsByFavoritesRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ContestCreateRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4260,19 +4449,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetContestsByFavoritesRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_ids", thrift.LIST, 1); err != nil {
+func (p *ContestCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.I32, len(p.ContestIds)); err != nil {
-		return err
-	}
-	for _, v := range p.ContestIds {
-		if err := oprot.WriteI32(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := p.Contest.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4285,63 +4466,75 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetContestsByFavoritesRequest) String() string {
+func (p *ContestCreateRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetContestsByFavoritesRequest(%+v)", *p)
+	return fmt.Sprintf("ContestCreateRequest(%+v)", *p)
 }
 
-func (p *GetContestsByFavoritesRequest) DeepEqual(ano *GetContestsByFavoritesRequest) bool {
+func (p *ContestCreateRequest) DeepEqual(ano *ContestCreateRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ContestIds) {
+	if !p.Field1DeepEqual(ano.Contest) {
 		return false
 	}
 	return true
 }
 
-func (p *GetContestsByFavoritesRequest) Field1DeepEqual(src []int32) bool {
+func (p *ContestCreateRequest) Field1DeepEqual(src *Contest) bool {
 
-	if len(p.ContestIds) != len(src) {
+	if !p.Contest.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.ContestIds {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
 	return true
 }
 
-type GetContestsByFavoritesResponse struct {
-	ContestList []*ContestBriefInfo `thrift:"contest_list,1" frugal:"1,default,list<ContestBriefInfo>" json:"contest_list"`
+type ContestCreateResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	ContestId  int32  `thrift:"contest_id,3" frugal:"3,default,i32" json:"contest_id"`
 }
 
-func NewGetContestsByFavoritesResponse() *GetContestsByFavoritesResponse {
-	return &GetContestsByFavoritesResponse{}
+func NewContestCreateResponse() *ContestCreateResponse {
+	return &ContestCreateResponse{}
 }
 
-func (p *GetContestsByFavoritesResponse) InitDefault() {
-	*p = GetContestsByFavoritesResponse{}
+func (p *ContestCreateResponse) InitDefault() {
+	*p = ContestCreateResponse{}
 }
 
-func (p *GetContestsByFavoritesResponse) GetContestList() (v []*ContestBriefInfo) {
-	return p.ContestList
+func (p *ContestCreateResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
-func (p *GetContestsByFavoritesResponse) SetContestList(val []*ContestBriefInfo) {
-	p.ContestList = val
+
+func (p *ContestCreateResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-var fieldIDToName_GetContestsByFavoritesResponse = map[int16]string{
-	1: "contest_list",
+func (p *ContestCreateResponse) GetContestId() (v int32) {
+	return p.ContestId
+}
+func (p *ContestCreateResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *ContestCreateResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *ContestCreateResponse) SetContestId(val int32) {
+	p.ContestId = val
 }
 
-func (p *GetContestsByFavoritesResponse) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ContestCreateResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "contest_id",
+}
+
+func (p *ContestCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4361,7 +4554,7 @@ func (p *GetContestsByFavoritesResponse) Read(iprot thrift.TProtocol) (err error
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4370,6 +4563,26 @@ func (p *GetContestsByFavoritesResponse) Read(iprot thrift.TProtocol) (err error
 					goto SkipFieldError
 				}
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4390,7 +4603,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetContestsByFavoritesResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestCreateResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4400,29 +4613,36 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetContestsByFavoritesResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ContestCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.StatusCode = v
 	}
-	p.ContestList = make([]*ContestBriefInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewContestBriefInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	return nil
+}
 
-		p.ContestList = append(p.ContestList, _elem)
+func (p *ContestCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	return nil
+}
+
+func (p *ContestCreateResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *GetContestsByFavoritesResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestCreateResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetContestsByFavoritesResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ContestCreateResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4430,6 +4650,14 @@ func (p *GetContestsByFavoritesResponse) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -4449,19 +4677,28 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetContestsByFavoritesResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 1); err != nil {
+func (p *ContestCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
 		return err
 	}
-	for _, v := range p.ContestList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4469,386 +4706,2107 @@ func (p *GetContestsByFavoritesResponse) writeField1(oprot thrift.TProtocol) (er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetContestsByFavoritesResponse) String() string {
+func (p *ContestCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestCreateResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetContestsByFavoritesResponse(%+v)", *p)
+	return fmt.Sprintf("ContestCreateResponse(%+v)", *p)
 }
 
-func (p *GetContestsByFavoritesResponse) DeepEqual(ano *GetContestsByFavoritesResponse) bool {
+func (p *ContestCreateResponse) DeepEqual(ano *ContestCreateResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ContestList) {
+	if !p.Field1DeepEqual(ano.StatusCode) {
 		return false
 	}
-	return true
-}
-
-func (p *GetContestsByFavoritesResponse) Field1DeepEqual(src []*ContestBriefInfo) bool {
-
-	if len(p.ContestList) != len(src) {
+	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	for i, v := range p.ContestList {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if !p.Field3DeepEqual(ano.ContestId) {
+		return false
 	}
 	return true
 }
 
-type ContestService interface {
-	ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error)
-
-	ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error)
-
-	ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error)
+func (p *ContestCreateResponse) Field1DeepEqual(src int32) bool {
 
-	GetContestsByFavorites(ctx context.Context, req *GetContestsByFavoritesRequest) (r *GetContestsByFavoritesResponse, err error)
+	if p.StatusCode != src {
+		return false
+	}
+	return true
 }
+func (p *ContestCreateResponse) Field2DeepEqual(src string) bool {
 
-type ContestServiceClient struct {
-	c thrift.TClient
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ContestCreateResponse) Field3DeepEqual(src int32) bool {
 
-func NewContestServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ContestServiceClient {
-	return &ContestServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	if p.ContestId != src {
+		return false
 	}
+	return true
 }
 
-func NewContestServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ContestServiceClient {
-	return &ContestServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+type ContestBatchInfoRequest struct {
+	ContestIds []int32 `thrift:"contest_ids,1" frugal:"1,default,list<i32>" json:"contest_ids"`
 }
 
-func NewContestServiceClient(c thrift.TClient) *ContestServiceClient {
-	return &ContestServiceClient{
-		c: c,
-	}
+func NewContestBatchInfoRequest() *ContestBatchInfoRequest {
+	return &ContestBatchInfoRequest{}
 }
 
-func (p *ContestServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *ContestBatchInfoRequest) InitDefault() {
+	*p = ContestBatchInfoRequest{}
 }
 
-func (p *ContestServiceClient) ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error) {
-	var _args ContestServiceContestListArgs
-	_args.Req = req
-	var _result ContestServiceContestListResult
-	if err = p.Client_().Call(ctx, "ContestList", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ContestServiceClient) ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error) {
-	var _args ContestServiceContestInfoArgs
-	_args.Req = req
-	var _result ContestServiceContestInfoResult
-	if err = p.Client_().Call(ctx, "ContestInfo", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ContestServiceClient) ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error) {
-	var _args ContestServiceContestCreateArgs
-	_args.Req = req
-	var _result ContestServiceContestCreateResult
-	if err = p.Client_().Call(ctx, "ContestCreate", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *ContestBatchInfoRequest) GetContestIds() (v []int32) {
+	return p.ContestIds
 }
-func (p *ContestServiceClient) GetContestsByFavorites(ctx context.Context, req *GetContestsByFavoritesRequest) (r *GetContestsByFavoritesResponse, err error) {
-	var _args ContestServiceGetContestsByFavoritesArgs
-	_args.Req = req
-	var _result ContestServiceGetContestsByFavoritesResult
-	if err = p.Client_().Call(ctx, "GetContestsByFavorites", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *ContestBatchInfoRequest) SetContestIds(val []int32) {
+	p.ContestIds = val
 }
 
-type ContestServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      ContestService
+var fieldIDToName_ContestBatchInfoRequest = map[int16]string{
+	1: "contest_ids",
 }
 
-func (p *ContestServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+func (p *ContestBatchInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *ContestServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *ContestServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-func NewContestServiceProcessor(handler ContestService) *ContestServiceProcessor {
-	self := &ContestServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("ContestList", &contestServiceProcessorContestList{handler: handler})
-	self.AddToProcessorMap("ContestInfo", &contestServiceProcessorContestInfo{handler: handler})
-	self.AddToProcessorMap("ContestCreate", &contestServiceProcessorContestCreate{handler: handler})
-	self.AddToProcessorMap("GetContestsByFavorites", &contestServiceProcessorGetContestsByFavorites{handler: handler})
-	return self
-}
-func (p *ContestServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type contestServiceProcessorContestList struct {
-	handler ContestService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestBatchInfoRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *contestServiceProcessorContestList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ContestServiceContestListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ContestBatchInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
+	p.ContestIds = make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ContestServiceContestListResult{}
-	var retval *ContestListResponse
-	if retval, err2 = p.handler.ContestList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestList: "+err2.Error())
-		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+		p.ContestIds = append(p.ContestIds, _elem)
 	}
-	if err2 = oprot.WriteMessageBegin("ContestList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *ContestBatchInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestBatchInfoRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type contestServiceProcessorContestInfo struct {
-	handler ContestService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *contestServiceProcessorContestInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ContestServiceContestInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ContestServiceContestInfoResult{}
-	var retval *ContestInfoResponse
-	if retval, err2 = p.handler.ContestInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestInfo: "+err2.Error())
-		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ContestInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *ContestBatchInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_ids", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteListBegin(thrift.I32, len(p.ContestIds)); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	for _, v := range p.ContestIds {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type contestServiceProcessorContestCreate struct {
-	handler ContestService
+func (p *ContestBatchInfoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestBatchInfoRequest(%+v)", *p)
+}
+
+func (p *ContestBatchInfoRequest) DeepEqual(ano *ContestBatchInfoRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ContestIds) {
+		return false
+	}
+	return true
+}
+
+func (p *ContestBatchInfoRequest) Field1DeepEqual(src []int32) bool {
+
+	if len(p.ContestIds) != len(src) {
+		return false
+	}
+	for i, v := range p.ContestIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type ContestBatchInfoResponse struct {
+	StatusCode  int32               `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg   string              `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	ContestList []*ContestBriefInfo `thrift:"contest_list,3" frugal:"3,default,list<ContestBriefInfo>" json:"contest_list"`
+}
+
+func NewContestBatchInfoResponse() *ContestBatchInfoResponse {
+	return &ContestBatchInfoResponse{}
+}
+
+func (p *ContestBatchInfoResponse) InitDefault() {
+	*p = ContestBatchInfoResponse{}
+}
+
+func (p *ContestBatchInfoResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *ContestBatchInfoResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *ContestBatchInfoResponse) GetContestList() (v []*ContestBriefInfo) {
+	return p.ContestList
+}
+func (p *ContestBatchInfoResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *ContestBatchInfoResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *ContestBatchInfoResponse) SetContestList(val []*ContestBriefInfo) {
+	p.ContestList = val
+}
+
+var fieldIDToName_ContestBatchInfoResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "contest_list",
+}
+
+func (p *ContestBatchInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestBatchInfoResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestBatchInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *ContestBatchInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *ContestBatchInfoResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ContestList = make([]*ContestBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewContestBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ContestList = append(p.ContestList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestBatchInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestBatchInfoResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestBatchInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestBatchInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ContestBatchInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
+		return err
+	}
+	for _, v := range p.ContestList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ContestBatchInfoResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestBatchInfoResponse(%+v)", *p)
+}
+
+func (p *ContestBatchInfoResponse) DeepEqual(ano *ContestBatchInfoResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ContestList) {
+		return false
+	}
+	return true
+}
+
+func (p *ContestBatchInfoResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *ContestBatchInfoResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ContestBatchInfoResponse) Field3DeepEqual(src []*ContestBriefInfo) bool {
+
+	if len(p.ContestList) != len(src) {
+		return false
+	}
+	for i, v := range p.ContestList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type GetContestsByFavoritesRequest struct {
+	ContestIds []int32 `thrift:"contest_ids,1" frugal:"1,default,list<i32>" json:"contest_ids"`
+}
+
+func NewGetContestsByFavoritesRequest() *GetContestsByFavoritesRequest {
+	return &GetContestsByFavoritesRequest{}
+}
+
+func (p *GetContestsByFavoritesRequest) InitDefault() {
+	*p = GetContestsByFavoritesRequest{}
+}
+
+func (p *GetContestsByFavoritesRequest) GetContestIds() (v []int32) {
+	return p.ContestIds
+}
+func (p *GetContestsByFavoritesRequest) SetContestIds(val []int32) {
+	p.ContestIds = val
+}
+
+var fieldIDToName_GetContestsByFavoritesRequest = map[int16]string{
+	1: "contest_ids",
+}
+
+func (p *GetContestsByFavoritesRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetContestsByFavoritesRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetContestsByFavoritesRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ContestIds = make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.ContestIds = append(p.ContestIds, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *GetContestsByFavoritesRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetContestsByFavoritesRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetContestsByFavoritesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_ids", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.ContestIds)); err != nil {
+		return err
+	}
+	for _, v := range p.ContestIds {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetContestsByFavoritesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetContestsByFavoritesRequest(%+v)", *p)
+}
+
+func (p *GetContestsByFavoritesRequest) DeepEqual(ano *GetContestsByFavoritesRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ContestIds) {
+		return false
+	}
+	return true
+}
+
+func (p *GetContestsByFavoritesRequest) Field1DeepEqual(src []int32) bool {
+
+	if len(p.ContestIds) != len(src) {
+		return false
+	}
+	for i, v := range p.ContestIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type GetContestsByFavoritesResponse struct {
+	ContestList []*ContestBriefInfo `thrift:"contest_list,1" frugal:"1,default,list<ContestBriefInfo>" json:"contest_list"`
+}
+
+func NewGetContestsByFavoritesResponse() *GetContestsByFavoritesResponse {
+	return &GetContestsByFavoritesResponse{}
+}
+
+func (p *GetContestsByFavoritesResponse) InitDefault() {
+	*p = GetContestsByFavoritesResponse{}
+}
+
+func (p *GetContestsByFavoritesResponse) GetContestList() (v []*ContestBriefInfo) {
+	return p.ContestList
+}
+func (p *GetContestsByFavoritesResponse) SetContestList(val []*ContestBriefInfo) {
+	p.ContestList = val
+}
+
+var fieldIDToName_GetContestsByFavoritesResponse = map[int16]string{
+	1: "contest_list",
+}
+
+func (p *GetContestsByFavoritesResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetContestsByFavoritesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetContestsByFavoritesResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ContestList = make([]*ContestBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewContestBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ContestList = append(p.ContestList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *GetContestsByFavoritesResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetContestsByFavoritesResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetContestsByFavoritesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_list", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ContestList)); err != nil {
+		return err
+	}
+	for _, v := range p.ContestList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetContestsByFavoritesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetContestsByFavoritesResponse(%+v)", *p)
+}
+
+func (p *GetContestsByFavoritesResponse) DeepEqual(ano *GetContestsByFavoritesResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ContestList) {
+		return false
+	}
+	return true
+}
+
+func (p *GetContestsByFavoritesResponse) Field1DeepEqual(src []*ContestBriefInfo) bool {
+
+	if len(p.ContestList) != len(src) {
+		return false
+	}
+	for i, v := range p.ContestList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ContestService interface {
+	ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error)
+
+	ContestRecommend(ctx context.Context, req *ContestRecommendRequest) (r *ContestRecommendResponse, err error)
+
+	ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error)
+
+	ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error)
+
+	ContestBatchInfo(ctx context.Context, req *ContestBatchInfoRequest) (r *ContestBatchInfoResponse, err error)
+
+	GetContestsByFavorites(ctx context.Context, req *GetContestsByFavoritesRequest) (r *GetContestsByFavoritesResponse, err error)
+}
+
+type ContestServiceClient struct {
+	c thrift.TClient
+}
+
+func NewContestServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ContestServiceClient {
+	return &ContestServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewContestServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ContestServiceClient {
+	return &ContestServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewContestServiceClient(c thrift.TClient) *ContestServiceClient {
+	return &ContestServiceClient{
+		c: c,
+	}
+}
+
+func (p *ContestServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ContestServiceClient) ContestList(ctx context.Context, req *ContestListRequest) (r *ContestListResponse, err error) {
+	var _args ContestServiceContestListArgs
+	_args.Req = req
+	var _result ContestServiceContestListResult
+	if err = p.Client_().Call(ctx, "ContestList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContestServiceClient) ContestRecommend(ctx context.Context, req *ContestRecommendRequest) (r *ContestRecommendResponse, err error) {
+	var _args ContestServiceContestRecommendArgs
+	_args.Req = req
+	var _result ContestServiceContestRecommendResult
+	if err = p.Client_().Call(ctx, "ContestRecommend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContestServiceClient) ContestInfo(ctx context.Context, req *ContestInfoRequest) (r *ContestInfoResponse, err error) {
+	var _args ContestServiceContestInfoArgs
+	_args.Req = req
+	var _result ContestServiceContestInfoResult
+	if err = p.Client_().Call(ctx, "ContestInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContestServiceClient) ContestCreate(ctx context.Context, req *ContestCreateRequest) (r *ContestCreateResponse, err error) {
+	var _args ContestServiceContestCreateArgs
+	_args.Req = req
+	var _result ContestServiceContestCreateResult
+	if err = p.Client_().Call(ctx, "ContestCreate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContestServiceClient) ContestBatchInfo(ctx context.Context, req *ContestBatchInfoRequest) (r *ContestBatchInfoResponse, err error) {
+	var _args ContestServiceContestBatchInfoArgs
+	_args.Req = req
+	var _result ContestServiceContestBatchInfoResult
+	if err = p.Client_().Call(ctx, "ContestBatchInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContestServiceClient) GetContestsByFavorites(ctx context.Context, req *GetContestsByFavoritesRequest) (r *GetContestsByFavoritesResponse, err error) {
+	var _args ContestServiceGetContestsByFavoritesArgs
+	_args.Req = req
+	var _result ContestServiceGetContestsByFavoritesResult
+	if err = p.Client_().Call(ctx, "GetContestsByFavorites", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ContestServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ContestService
+}
+
+func (p *ContestServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ContestServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ContestServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewContestServiceProcessor(handler ContestService) *ContestServiceProcessor {
+	self := &ContestServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ContestList", &contestServiceProcessorContestList{handler: handler})
+	self.AddToProcessorMap("ContestRecommend", &contestServiceProcessorContestRecommend{handler: handler})
+	self.AddToProcessorMap("ContestInfo", &contestServiceProcessorContestInfo{handler: handler})
+	self.AddToProcessorMap("ContestCreate", &contestServiceProcessorContestCreate{handler: handler})
+	self.AddToProcessorMap("ContestBatchInfo", &contestServiceProcessorContestBatchInfo{handler: handler})
+	self.AddToProcessorMap("GetContestsByFavorites", &contestServiceProcessorGetContestsByFavorites{handler: handler})
+	return self
+}
+func (p *ContestServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type contestServiceProcessorContestList struct {
+	handler ContestService
+}
+
+func (p *contestServiceProcessorContestList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContestServiceContestListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ContestServiceContestListResult{}
+	var retval *ContestListResponse
+	if retval, err2 = p.handler.ContestList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestList: "+err2.Error())
+		oprot.WriteMessageBegin("ContestList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type contestServiceProcessorContestRecommend struct {
+	handler ContestService
+}
+
+func (p *contestServiceProcessorContestRecommend) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContestServiceContestRecommendArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestRecommend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ContestServiceContestRecommendResult{}
+	var retval *ContestRecommendResponse
+	if retval, err2 = p.handler.ContestRecommend(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestRecommend: "+err2.Error())
+		oprot.WriteMessageBegin("ContestRecommend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestRecommend", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type contestServiceProcessorContestInfo struct {
+	handler ContestService
+}
+
+func (p *contestServiceProcessorContestInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContestServiceContestInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ContestServiceContestInfoResult{}
+	var retval *ContestInfoResponse
+	if retval, err2 = p.handler.ContestInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestInfo: "+err2.Error())
+		oprot.WriteMessageBegin("ContestInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type contestServiceProcessorContestCreate struct {
+	handler ContestService
+}
+
+func (p *contestServiceProcessorContestCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContestServiceContestCreateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ContestServiceContestCreateResult{}
+	var retval *ContestCreateResponse
+	if retval, err2 = p.handler.ContestCreate(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestCreate: "+err2.Error())
+		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestCreate", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type contestServiceProcessorContestBatchInfo struct {
+	handler ContestService
+}
+
+func (p *contestServiceProcessorContestBatchInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContestServiceContestBatchInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestBatchInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ContestServiceContestBatchInfoResult{}
+	var retval *ContestBatchInfoResponse
+	if retval, err2 = p.handler.ContestBatchInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestBatchInfo: "+err2.Error())
+		oprot.WriteMessageBegin("ContestBatchInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestBatchInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type contestServiceProcessorGetContestsByFavorites struct {
+	handler ContestService
+}
+
+func (p *contestServiceProcessorGetContestsByFavorites) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContestServiceGetContestsByFavoritesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetContestsByFavorites", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ContestServiceGetContestsByFavoritesResult{}
+	var retval *GetContestsByFavoritesResponse
+	if retval, err2 = p.handler.GetContestsByFavorites(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetContestsByFavorites: "+err2.Error())
+		oprot.WriteMessageBegin("GetContestsByFavorites", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetContestsByFavorites", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ContestServiceContestListArgs struct {
+	Req *ContestListRequest `thrift:"req,1" frugal:"1,default,ContestListRequest" json:"req"`
+}
+
+func NewContestServiceContestListArgs() *ContestServiceContestListArgs {
+	return &ContestServiceContestListArgs{}
+}
+
+func (p *ContestServiceContestListArgs) InitDefault() {
+	*p = ContestServiceContestListArgs{}
+}
+
+var ContestServiceContestListArgs_Req_DEFAULT *ContestListRequest
+
+func (p *ContestServiceContestListArgs) GetReq() (v *ContestListRequest) {
+	if !p.IsSetReq() {
+		return ContestServiceContestListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ContestServiceContestListArgs) SetReq(val *ContestListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_ContestServiceContestListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ContestServiceContestListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ContestServiceContestListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestListRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestServiceContestListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ContestServiceContestListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestServiceContestListArgs(%+v)", *p)
+}
+
+func (p *ContestServiceContestListArgs) DeepEqual(ano *ContestServiceContestListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ContestServiceContestListArgs) Field1DeepEqual(src *ContestListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ContestServiceContestListResult struct {
+	Success *ContestListResponse `thrift:"success,0,optional" frugal:"0,optional,ContestListResponse" json:"success,omitempty"`
+}
+
+func NewContestServiceContestListResult() *ContestServiceContestListResult {
+	return &ContestServiceContestListResult{}
+}
+
+func (p *ContestServiceContestListResult) InitDefault() {
+	*p = ContestServiceContestListResult{}
+}
+
+var ContestServiceContestListResult_Success_DEFAULT *ContestListResponse
+
+func (p *ContestServiceContestListResult) GetSuccess() (v *ContestListResponse) {
+	if !p.IsSetSuccess() {
+		return ContestServiceContestListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ContestServiceContestListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestListResponse)
+}
+
+var fieldIDToName_ContestServiceContestListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ContestServiceContestListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ContestServiceContestListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestListResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestServiceContestListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ContestServiceContestListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestServiceContestListResult(%+v)", *p)
+}
+
+func (p *ContestServiceContestListResult) DeepEqual(ano *ContestServiceContestListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ContestServiceContestListResult) Field0DeepEqual(src *ContestListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ContestServiceContestRecommendArgs struct {
+	Req *ContestRecommendRequest `thrift:"req,1" frugal:"1,default,ContestRecommendRequest" json:"req"`
+}
+
+func NewContestServiceContestRecommendArgs() *ContestServiceContestRecommendArgs {
+	return &ContestServiceContestRecommendArgs{}
+}
+
+func (p *ContestServiceContestRecommendArgs) InitDefault() {
+	*p = ContestServiceContestRecommendArgs{}
+}
+
+var ContestServiceContestRecommendArgs_Req_DEFAULT *ContestRecommendRequest
+
+func (p *ContestServiceContestRecommendArgs) GetReq() (v *ContestRecommendRequest) {
+	if !p.IsSetReq() {
+		return ContestServiceContestRecommendArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ContestServiceContestRecommendArgs) SetReq(val *ContestRecommendRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_ContestServiceContestRecommendArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ContestServiceContestRecommendArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ContestServiceContestRecommendArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestRecommendArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *contestServiceProcessorContestCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ContestServiceContestCreateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ContestServiceContestRecommendArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestRecommendRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ContestServiceContestCreateResult{}
-	var retval *ContestCreateResponse
-	if retval, err2 = p.handler.ContestCreate(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestCreate: "+err2.Error())
-		oprot.WriteMessageBegin("ContestCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ContestServiceContestRecommendArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestRecommend_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ContestCreate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestRecommendArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type contestServiceProcessorGetContestsByFavorites struct {
-	handler ContestService
+func (p *ContestServiceContestRecommendArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContestServiceContestRecommendArgs(%+v)", *p)
 }
 
-func (p *contestServiceProcessorGetContestsByFavorites) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ContestServiceGetContestsByFavoritesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetContestsByFavorites", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ContestServiceContestRecommendArgs) DeepEqual(ano *ContestServiceContestRecommendArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
+	return true
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ContestServiceGetContestsByFavoritesResult{}
-	var retval *GetContestsByFavoritesResponse
-	if retval, err2 = p.handler.GetContestsByFavorites(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetContestsByFavorites: "+err2.Error())
-		oprot.WriteMessageBegin("GetContestsByFavorites", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ContestServiceContestRecommendArgs) Field1DeepEqual(src *ContestRecommendRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("GetContestsByFavorites", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return true
+}
+
+type ContestServiceContestRecommendResult struct {
+	Success *ContestRecommendResponse `thrift:"success,0,optional" frugal:"0,optional,ContestRecommendResponse" json:"success,omitempty"`
+}
+
+func NewContestServiceContestRecommendResult() *ContestServiceContestRecommendResult {
+	return &ContestServiceContestRecommendResult{}
+}
+
+func (p *ContestServiceContestRecommendResult) InitDefault() {
+	*p = ContestServiceContestRecommendResult{}
+}
+
+var ContestServiceContestRecommendResult_Success_DEFAULT *ContestRecommendResponse
+
+func (p *ContestServiceContestRecommendResult) GetSuccess() (v *ContestRecommendResponse) {
+	if !p.IsSetSuccess() {
+		return ContestServiceContestRecommendResult_Success_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+func (p *ContestServiceContestRecommendResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestRecommendResponse)
+}
+
+var fieldIDToName_ContestServiceContestRecommendResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ContestServiceContestRecommendResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ContestServiceContestRecommendResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestRecommendResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestRecommendResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestRecommendResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *ContestServiceContestRecommendResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestRecommend_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ContestServiceContestRecommendResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ContestServiceContestRecommendResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("ContestServiceContestRecommendResult(%+v)", *p)
+}
+
+func (p *ContestServiceContestRecommendResult) DeepEqual(ano *ContestServiceContestRecommendResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type ContestServiceContestListArgs struct {
-	Req *ContestListRequest `thrift:"req,1" frugal:"1,default,ContestListRequest" json:"req"`
+func (p *ContestServiceContestRecommendResult) Field0DeepEqual(src *ContestRecommendResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewContestServiceContestListArgs() *ContestServiceContestListArgs {
-	return &ContestServiceContestListArgs{}
+type ContestServiceContestInfoArgs struct {
+	Req *ContestInfoRequest `thrift:"req,1" frugal:"1,default,ContestInfoRequest" json:"req"`
 }
 
-func (p *ContestServiceContestListArgs) InitDefault() {
-	*p = ContestServiceContestListArgs{}
+func NewContestServiceContestInfoArgs() *ContestServiceContestInfoArgs {
+	return &ContestServiceContestInfoArgs{}
 }
 
-var ContestServiceContestListArgs_Req_DEFAULT *ContestListRequest
+func (p *ContestServiceContestInfoArgs) InitDefault() {
+	*p = ContestServiceContestInfoArgs{}
+}
 
-func (p *ContestServiceContestListArgs) GetReq() (v *ContestListRequest) {
+var ContestServiceContestInfoArgs_Req_DEFAULT *ContestInfoRequest
+
+func (p *ContestServiceContestInfoArgs) GetReq() (v *ContestInfoRequest) {
 	if !p.IsSetReq() {
-		return ContestServiceContestListArgs_Req_DEFAULT
+		return ContestServiceContestInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ContestServiceContestListArgs) SetReq(val *ContestListRequest) {
+func (p *ContestServiceContestInfoArgs) SetReq(val *ContestInfoRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ContestServiceContestListArgs = map[int16]string{
+var fieldIDToName_ContestServiceContestInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ContestServiceContestListArgs) IsSetReq() bool {
+func (p *ContestServiceContestInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ContestServiceContestListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4897,7 +6855,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4907,17 +6865,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestListRequest()
+func (p *ContestServiceContestInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestInfoRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ContestServiceContestListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ContestInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4944,7 +6902,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4961,14 +6919,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestServiceContestListArgs) String() string {
+func (p *ContestServiceContestInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestServiceContestListArgs(%+v)", *p)
+	return fmt.Sprintf("ContestServiceContestInfoArgs(%+v)", *p)
 }
 
-func (p *ContestServiceContestListArgs) DeepEqual(ano *ContestServiceContestListArgs) bool {
+func (p *ContestServiceContestInfoArgs) DeepEqual(ano *ContestServiceContestInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4980,7 +6938,7 @@ func (p *ContestServiceContestListArgs) DeepEqual(ano *ContestServiceContestList
 	return true
 }
 
-func (p *ContestServiceContestListArgs) Field1DeepEqual(src *ContestListRequest) bool {
+func (p *ContestServiceContestInfoArgs) Field1DeepEqual(src *ContestInfoRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -4988,39 +6946,39 @@ func (p *ContestServiceContestListArgs) Field1DeepEqual(src *ContestListRequest)
 	return true
 }
 
-type ContestServiceContestListResult struct {
-	Success *ContestListResponse `thrift:"success,0,optional" frugal:"0,optional,ContestListResponse" json:"success,omitempty"`
+type ContestServiceContestInfoResult struct {
+	Success *ContestInfoResponse `thrift:"success,0,optional" frugal:"0,optional,ContestInfoResponse" json:"success,omitempty"`
 }
 
-func NewContestServiceContestListResult() *ContestServiceContestListResult {
-	return &ContestServiceContestListResult{}
+func NewContestServiceContestInfoResult() *ContestServiceContestInfoResult {
+	return &ContestServiceContestInfoResult{}
 }
 
-func (p *ContestServiceContestListResult) InitDefault() {
-	*p = ContestServiceContestListResult{}
+func (p *ContestServiceContestInfoResult) InitDefault() {
+	*p = ContestServiceContestInfoResult{}
 }
 
-var ContestServiceContestListResult_Success_DEFAULT *ContestListResponse
+var ContestServiceContestInfoResult_Success_DEFAULT *ContestInfoResponse
 
-func (p *ContestServiceContestListResult) GetSuccess() (v *ContestListResponse) {
+func (p *ContestServiceContestInfoResult) GetSuccess() (v *ContestInfoResponse) {
 	if !p.IsSetSuccess() {
-		return ContestServiceContestListResult_Success_DEFAULT
+		return ContestServiceContestInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ContestServiceContestListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ContestListResponse)
+func (p *ContestServiceContestInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestInfoResponse)
 }
 
-var fieldIDToName_ContestServiceContestListResult = map[int16]string{
+var fieldIDToName_ContestServiceContestInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ContestServiceContestListResult) IsSetSuccess() bool {
+func (p *ContestServiceContestInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ContestServiceContestListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5069,7 +7027,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5079,17 +7037,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestListResponse()
+func (p *ContestServiceContestInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestInfoResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ContestServiceContestListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ContestInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5116,7 +7074,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5135,14 +7093,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ContestServiceContestListResult) String() string {
+func (p *ContestServiceContestInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestServiceContestListResult(%+v)", *p)
+	return fmt.Sprintf("ContestServiceContestInfoResult(%+v)", *p)
 }
 
-func (p *ContestServiceContestListResult) DeepEqual(ano *ContestServiceContestListResult) bool {
+func (p *ContestServiceContestInfoResult) DeepEqual(ano *ContestServiceContestInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5154,7 +7112,7 @@ func (p *ContestServiceContestListResult) DeepEqual(ano *ContestServiceContestLi
 	return true
 }
 
-func (p *ContestServiceContestListResult) Field0DeepEqual(src *ContestListResponse) bool {
+func (p *ContestServiceContestInfoResult) Field0DeepEqual(src *ContestInfoResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -5162,39 +7120,39 @@ func (p *ContestServiceContestListResult) Field0DeepEqual(src *ContestListRespon
 	return true
 }
 
-type ContestServiceContestInfoArgs struct {
-	Req *ContestInfoRequest `thrift:"req,1" frugal:"1,default,ContestInfoRequest" json:"req"`
+type ContestServiceContestCreateArgs struct {
+	Req *ContestCreateRequest `thrift:"req,1" frugal:"1,default,ContestCreateRequest" json:"req"`
 }
 
-func NewContestServiceContestInfoArgs() *ContestServiceContestInfoArgs {
-	return &ContestServiceContestInfoArgs{}
+func NewContestServiceContestCreateArgs() *ContestServiceContestCreateArgs {
+	return &ContestServiceContestCreateArgs{}
 }
 
-func (p *ContestServiceContestInfoArgs) InitDefault() {
-	*p = ContestServiceContestInfoArgs{}
+func (p *ContestServiceContestCreateArgs) InitDefault() {
+	*p = ContestServiceContestCreateArgs{}
 }
 
-var ContestServiceContestInfoArgs_Req_DEFAULT *ContestInfoRequest
+var ContestServiceContestCreateArgs_Req_DEFAULT *ContestCreateRequest
 
-func (p *ContestServiceContestInfoArgs) GetReq() (v *ContestInfoRequest) {
+func (p *ContestServiceContestCreateArgs) GetReq() (v *ContestCreateRequest) {
 	if !p.IsSetReq() {
-		return ContestServiceContestInfoArgs_Req_DEFAULT
+		return ContestServiceContestCreateArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ContestServiceContestInfoArgs) SetReq(val *ContestInfoRequest) {
+func (p *ContestServiceContestCreateArgs) SetReq(val *ContestCreateRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ContestServiceContestInfoArgs = map[int16]string{
+var fieldIDToName_ContestServiceContestCreateArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ContestServiceContestInfoArgs) IsSetReq() bool {
+func (p *ContestServiceContestCreateArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ContestServiceContestInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestCreateArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5243,7 +7201,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestCreateArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5253,17 +7211,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestInfoRequest()
+func (p *ContestServiceContestCreateArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestCreateRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ContestServiceContestInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestCreateArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("ContestCreate_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5290,7 +7248,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5307,14 +7265,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestServiceContestInfoArgs) String() string {
+func (p *ContestServiceContestCreateArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestServiceContestInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ContestServiceContestCreateArgs(%+v)", *p)
 }
 
-func (p *ContestServiceContestInfoArgs) DeepEqual(ano *ContestServiceContestInfoArgs) bool {
+func (p *ContestServiceContestCreateArgs) DeepEqual(ano *ContestServiceContestCreateArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5326,7 +7284,7 @@ func (p *ContestServiceContestInfoArgs) DeepEqual(ano *ContestServiceContestInfo
 	return true
 }
 
-func (p *ContestServiceContestInfoArgs) Field1DeepEqual(src *ContestInfoRequest) bool {
+func (p *ContestServiceContestCreateArgs) Field1DeepEqual(src *ContestCreateRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5334,39 +7292,39 @@ func (p *ContestServiceContestInfoArgs) Field1DeepEqual(src *ContestInfoRequest)
 	return true
 }
 
-type ContestServiceContestInfoResult struct {
-	Success *ContestInfoResponse `thrift:"success,0,optional" frugal:"0,optional,ContestInfoResponse" json:"success,omitempty"`
+type ContestServiceContestCreateResult struct {
+	Success *ContestCreateResponse `thrift:"success,0,optional" frugal:"0,optional,ContestCreateResponse" json:"success,omitempty"`
 }
 
-func NewContestServiceContestInfoResult() *ContestServiceContestInfoResult {
-	return &ContestServiceContestInfoResult{}
+func NewContestServiceContestCreateResult() *ContestServiceContestCreateResult {
+	return &ContestServiceContestCreateResult{}
 }
 
-func (p *ContestServiceContestInfoResult) InitDefault() {
-	*p = ContestServiceContestInfoResult{}
+func (p *ContestServiceContestCreateResult) InitDefault() {
+	*p = ContestServiceContestCreateResult{}
 }
 
-var ContestServiceContestInfoResult_Success_DEFAULT *ContestInfoResponse
+var ContestServiceContestCreateResult_Success_DEFAULT *ContestCreateResponse
 
-func (p *ContestServiceContestInfoResult) GetSuccess() (v *ContestInfoResponse) {
+func (p *ContestServiceContestCreateResult) GetSuccess() (v *ContestCreateResponse) {
 	if !p.IsSetSuccess() {
-		return ContestServiceContestInfoResult_Success_DEFAULT
+		return ContestServiceContestCreateResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ContestServiceContestInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ContestInfoResponse)
+func (p *ContestServiceContestCreateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestCreateResponse)
 }
 
-var fieldIDToName_ContestServiceContestInfoResult = map[int16]string{
+var fieldIDToName_ContestServiceContestCreateResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ContestServiceContestInfoResult) IsSetSuccess() bool {
+func (p *ContestServiceContestCreateResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ContestServiceContestInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestCreateResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5415,7 +7373,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestCreateResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5425,17 +7383,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestInfoResponse()
+func (p *ContestServiceContestCreateResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestCreateResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ContestServiceContestInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestCreateResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("ContestCreate_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5462,7 +7420,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5481,14 +7439,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ContestServiceContestInfoResult) String() string {
+func (p *ContestServiceContestCreateResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestServiceContestInfoResult(%+v)", *p)
+	return fmt.Sprintf("ContestServiceContestCreateResult(%+v)", *p)
 }
 
-func (p *ContestServiceContestInfoResult) DeepEqual(ano *ContestServiceContestInfoResult) bool {
+func (p *ContestServiceContestCreateResult) DeepEqual(ano *ContestServiceContestCreateResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5500,7 +7458,7 @@ func (p *ContestServiceContestInfoResult) DeepEqual(ano *ContestServiceContestIn
 	return true
 }
 
-func (p *ContestServiceContestInfoResult) Field0DeepEqual(src *ContestInfoResponse) bool {
+func (p *ContestServiceContestCreateResult) Field0DeepEqual(src *ContestCreateResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -5508,39 +7466,39 @@ func (p *ContestServiceContestInfoResult) Field0DeepEqual(src *ContestInfoRespon
 	return true
 }
 
-type ContestServiceContestCreateArgs struct {
-	Req *ContestCreateRequest `thrift:"req,1" frugal:"1,default,ContestCreateRequest" json:"req"`
+type ContestServiceContestBatchInfoArgs struct {
+	Req *ContestBatchInfoRequest `thrift:"req,1" frugal:"1,default,ContestBatchInfoRequest" json:"req"`
 }
 
-func NewContestServiceContestCreateArgs() *ContestServiceContestCreateArgs {
-	return &ContestServiceContestCreateArgs{}
+func NewContestServiceContestBatchInfoArgs() *ContestServiceContestBatchInfoArgs {
+	return &ContestServiceContestBatchInfoArgs{}
 }
 
-func (p *ContestServiceContestCreateArgs) InitDefault() {
-	*p = ContestServiceContestCreateArgs{}
+func (p *ContestServiceContestBatchInfoArgs) InitDefault() {
+	*p = ContestServiceContestBatchInfoArgs{}
 }
 
-var ContestServiceContestCreateArgs_Req_DEFAULT *ContestCreateRequest
+var ContestServiceContestBatchInfoArgs_Req_DEFAULT *ContestBatchInfoRequest
 
-func (p *ContestServiceContestCreateArgs) GetReq() (v *ContestCreateRequest) {
+func (p *ContestServiceContestBatchInfoArgs) GetReq() (v *ContestBatchInfoRequest) {
 	if !p.IsSetReq() {
-		return ContestServiceContestCreateArgs_Req_DEFAULT
+		return ContestServiceContestBatchInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ContestServiceContestCreateArgs) SetReq(val *ContestCreateRequest) {
+func (p *ContestServiceContestBatchInfoArgs) SetReq(val *ContestBatchInfoRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ContestServiceContestCreateArgs = map[int16]string{
+var fieldIDToName_ContestServiceContestBatchInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ContestServiceContestCreateArgs) IsSetReq() bool {
+func (p *ContestServiceContestBatchInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ContestServiceContestCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestBatchInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5589,7 +7547,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestCreateArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestBatchInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5599,17 +7557,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestCreateArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestCreateRequest()
+func (p *ContestServiceContestBatchInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestBatchInfoRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ContestServiceContestCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestBatchInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreate_args"); err != nil {
+	if err = oprot.WriteStructBegin("ContestBatchInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5636,7 +7594,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestBatchInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5653,14 +7611,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ContestServiceContestCreateArgs) String() string {
+func (p *ContestServiceContestBatchInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestServiceContestCreateArgs(%+v)", *p)
+	return fmt.Sprintf("ContestServiceContestBatchInfoArgs(%+v)", *p)
 }
 
-func (p *ContestServiceContestCreateArgs) DeepEqual(ano *ContestServiceContestCreateArgs) bool {
+func (p *ContestServiceContestBatchInfoArgs) DeepEqual(ano *ContestServiceContestBatchInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5672,7 +7630,7 @@ func (p *ContestServiceContestCreateArgs) DeepEqual(ano *ContestServiceContestCr
 	return true
 }
 
-func (p *ContestServiceContestCreateArgs) Field1DeepEqual(src *ContestCreateRequest) bool {
+func (p *ContestServiceContestBatchInfoArgs) Field1DeepEqual(src *ContestBatchInfoRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5680,39 +7638,39 @@ func (p *ContestServiceContestCreateArgs) Field1DeepEqual(src *ContestCreateRequ
 	return true
 }
 
-type ContestServiceContestCreateResult struct {
-	Success *ContestCreateResponse `thrift:"success,0,optional" frugal:"0,optional,ContestCreateResponse" json:"success,omitempty"`
+type ContestServiceContestBatchInfoResult struct {
+	Success *ContestBatchInfoResponse `thrift:"success,0,optional" frugal:"0,optional,ContestBatchInfoResponse" json:"success,omitempty"`
 }
 
-func NewContestServiceContestCreateResult() *ContestServiceContestCreateResult {
-	return &ContestServiceContestCreateResult{}
+func NewContestServiceContestBatchInfoResult() *ContestServiceContestBatchInfoResult {
+	return &ContestServiceContestBatchInfoResult{}
 }
 
-func (p *ContestServiceContestCreateResult) InitDefault() {
-	*p = ContestServiceContestCreateResult{}
+func (p *ContestServiceContestBatchInfoResult) InitDefault() {
+	*p = ContestServiceContestBatchInfoResult{}
 }
 
-var ContestServiceContestCreateResult_Success_DEFAULT *ContestCreateResponse
+var ContestServiceContestBatchInfoResult_Success_DEFAULT *ContestBatchInfoResponse
 
-func (p *ContestServiceContestCreateResult) GetSuccess() (v *ContestCreateResponse) {
+func (p *ContestServiceContestBatchInfoResult) GetSuccess() (v *ContestBatchInfoResponse) {
 	if !p.IsSetSuccess() {
-		return ContestServiceContestCreateResult_Success_DEFAULT
+		return ContestServiceContestBatchInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ContestServiceContestCreateResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ContestCreateResponse)
+func (p *ContestServiceContestBatchInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestBatchInfoResponse)
 }
 
-var fieldIDToName_ContestServiceContestCreateResult = map[int16]string{
+var fieldIDToName_ContestServiceContestBatchInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ContestServiceContestCreateResult) IsSetSuccess() bool {
+func (p *ContestServiceContestBatchInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ContestServiceContestCreateResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestBatchInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5761,7 +7719,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestCreateResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContestServiceContestBatchInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5771,17 +7729,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestCreateResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestCreateResponse()
+func (p *ContestServiceContestBatchInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestBatchInfoResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *ContestServiceContestCreateResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestBatchInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestCreate_result"); err != nil {
+	if err = oprot.WriteStructBegin("ContestBatchInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5808,7 +7766,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ContestServiceContestCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContestServiceContestBatchInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5827,14 +7785,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ContestServiceContestCreateResult) String() string {
+func (p *ContestServiceContestBatchInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ContestServiceContestCreateResult(%+v)", *p)
+	return fmt.Sprintf("ContestServiceContestBatchInfoResult(%+v)", *p)
 }
 
-func (p *ContestServiceContestCreateResult) DeepEqual(ano *ContestServiceContestCreateResult) bool {
+func (p *ContestServiceContestBatchInfoResult) DeepEqual(ano *ContestServiceContestBatchInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5846,7 +7804,7 @@ func (p *ContestServiceContestCreateResult) DeepEqual(ano *ContestServiceContest
 	return true
 }
 
-func (p *ContestServiceContestCreateResult) Field0DeepEqual(src *ContestCreateResponse) bool {
+func (p *ContestServiceContestBatchInfoResult) Field0DeepEqual(src *ContestBatchInfoResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
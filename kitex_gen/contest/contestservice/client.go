@@ -12,8 +12,10 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	ContestList(ctx context.Context, req *contest.ContestListRequest, callOptions ...callopt.Option) (r *contest.ContestListResponse, err error)
+	ContestRecommend(ctx context.Context, req *contest.ContestRecommendRequest, callOptions ...callopt.Option) (r *contest.ContestRecommendResponse, err error)
 	ContestInfo(ctx context.Context, req *contest.ContestInfoRequest, callOptions ...callopt.Option) (r *contest.ContestInfoResponse, err error)
 	ContestCreate(ctx context.Context, req *contest.ContestCreateRequest, callOptions ...callopt.Option) (r *contest.ContestCreateResponse, err error)
+	ContestBatchInfo(ctx context.Context, req *contest.ContestBatchInfoRequest, callOptions ...callopt.Option) (r *contest.ContestBatchInfoResponse, err error)
 	GetContestsByFavorites(ctx context.Context, req *contest.GetContestsByFavoritesRequest, callOptions ...callopt.Option) (r *contest.GetContestsByFavoritesResponse, err error)
 }
 
@@ -51,6 +53,11 @@ func (p *kContestServiceClient) ContestList(ctx context.Context, req *contest.Co
 	return p.kClient.ContestList(ctx, req)
 }
 
+func (p *kContestServiceClient) ContestRecommend(ctx context.Context, req *contest.ContestRecommendRequest, callOptions ...callopt.Option) (r *contest.ContestRecommendResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ContestRecommend(ctx, req)
+}
+
 func (p *kContestServiceClient) ContestInfo(ctx context.Context, req *contest.ContestInfoRequest, callOptions ...callopt.Option) (r *contest.ContestInfoResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ContestInfo(ctx, req)
@@ -61,6 +68,11 @@ func (p *kContestServiceClient) ContestCreate(ctx context.Context, req *contest.
 	return p.kClient.ContestCreate(ctx, req)
 }
 
+func (p *kContestServiceClient) ContestBatchInfo(ctx context.Context, req *contest.ContestBatchInfoRequest, callOptions ...callopt.Option) (r *contest.ContestBatchInfoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ContestBatchInfo(ctx, req)
+}
+
 func (p *kContestServiceClient) GetContestsByFavorites(ctx context.Context, req *contest.GetContestsByFavoritesRequest, callOptions ...callopt.Option) (r *contest.GetContestsByFavoritesResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetContestsByFavorites(ctx, req)
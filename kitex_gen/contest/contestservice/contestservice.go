@@ -20,8 +20,10 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	handlerType := (*contest.ContestService)(nil)
 	methods := map[string]kitex.MethodInfo{
 		"ContestList":            kitex.NewMethodInfo(contestListHandler, newContestServiceContestListArgs, newContestServiceContestListResult, false),
+		"ContestRecommend":       kitex.NewMethodInfo(contestRecommendHandler, newContestServiceContestRecommendArgs, newContestServiceContestRecommendResult, false),
 		"ContestInfo":            kitex.NewMethodInfo(contestInfoHandler, newContestServiceContestInfoArgs, newContestServiceContestInfoResult, false),
 		"ContestCreate":          kitex.NewMethodInfo(contestCreateHandler, newContestServiceContestCreateArgs, newContestServiceContestCreateResult, false),
+		"ContestBatchInfo":       kitex.NewMethodInfo(contestBatchInfoHandler, newContestServiceContestBatchInfoArgs, newContestServiceContestBatchInfoResult, false),
 		"GetContestsByFavorites": kitex.NewMethodInfo(getContestsByFavoritesHandler, newContestServiceGetContestsByFavoritesArgs, newContestServiceGetContestsByFavoritesResult, false),
 	}
 	extra := map[string]interface{}{
@@ -57,6 +59,24 @@ func newContestServiceContestListResult() interface{} {
 	return contest.NewContestServiceContestListResult()
 }
 
+func contestRecommendHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*contest.ContestServiceContestRecommendArgs)
+	realResult := result.(*contest.ContestServiceContestRecommendResult)
+	success, err := handler.(contest.ContestService).ContestRecommend(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newContestServiceContestRecommendArgs() interface{} {
+	return contest.NewContestServiceContestRecommendArgs()
+}
+
+func newContestServiceContestRecommendResult() interface{} {
+	return contest.NewContestServiceContestRecommendResult()
+}
+
 func contestInfoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*contest.ContestServiceContestInfoArgs)
 	realResult := result.(*contest.ContestServiceContestInfoResult)
@@ -93,6 +113,24 @@ func newContestServiceContestCreateResult() interface{} {
 	return contest.NewContestServiceContestCreateResult()
 }
 
+func contestBatchInfoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*contest.ContestServiceContestBatchInfoArgs)
+	realResult := result.(*contest.ContestServiceContestBatchInfoResult)
+	success, err := handler.(contest.ContestService).ContestBatchInfo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newContestServiceContestBatchInfoArgs() interface{} {
+	return contest.NewContestServiceContestBatchInfoArgs()
+}
+
+func newContestServiceContestBatchInfoResult() interface{} {
+	return contest.NewContestServiceContestBatchInfoResult()
+}
+
 func getContestsByFavoritesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*contest.ContestServiceGetContestsByFavoritesArgs)
 	realResult := result.(*contest.ContestServiceGetContestsByFavoritesResult)
@@ -131,6 +169,16 @@ func (p *kClient) ContestList(ctx context.Context, req *contest.ContestListReque
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ContestRecommend(ctx context.Context, req *contest.ContestRecommendRequest) (r *contest.ContestRecommendResponse, err error) {
+	var _args contest.ContestServiceContestRecommendArgs
+	_args.Req = req
+	var _result contest.ContestServiceContestRecommendResult
+	if err = p.c.Call(ctx, "ContestRecommend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ContestInfo(ctx context.Context, req *contest.ContestInfoRequest) (r *contest.ContestInfoResponse, err error) {
 	var _args contest.ContestServiceContestInfoArgs
 	_args.Req = req
@@ -151,6 +199,16 @@ func (p *kClient) ContestCreate(ctx context.Context, req *contest.ContestCreateR
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ContestBatchInfo(ctx context.Context, req *contest.ContestBatchInfoRequest) (r *contest.ContestBatchInfoResponse, err error) {
+	var _args contest.ContestServiceContestBatchInfoArgs
+	_args.Req = req
+	var _result contest.ContestServiceContestBatchInfoResult
+	if err = p.c.Call(ctx, "ContestBatchInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetContestsByFavorites(ctx context.Context, req *contest.GetContestsByFavoritesRequest) (r *contest.GetContestsByFavoritesResponse, err error) {
 	var _args contest.ContestServiceGetContestsByFavoritesArgs
 	_args.Req = req
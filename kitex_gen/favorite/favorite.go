@@ -2180,288 +2180,1167 @@ func (p *QueryFavoriteStatusByUserIdResponse) Field1DeepEqual(src bool) bool {
 	return true
 }
 
-type FavoriteService interface {
-	ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error)
+type QueryContestFavoriteUsersRequest struct {
+	ContestId int32 `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
+}
 
-	ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error)
+func NewQueryContestFavoriteUsersRequest() *QueryContestFavoriteUsersRequest {
+	return &QueryContestFavoriteUsersRequest{}
+}
 
-	QueryFavoriteStatusByUserId(ctx context.Context, req *QueryFavoriteStatusByUserIdRequest) (r *QueryFavoriteStatusByUserIdResponse, err error)
+func (p *QueryContestFavoriteUsersRequest) InitDefault() {
+	*p = QueryContestFavoriteUsersRequest{}
 }
 
-type FavoriteServiceClient struct {
-	c thrift.TClient
+func (p *QueryContestFavoriteUsersRequest) GetContestId() (v int32) {
+	return p.ContestId
+}
+func (p *QueryContestFavoriteUsersRequest) SetContestId(val int32) {
+	p.ContestId = val
 }
 
-func NewFavoriteServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *FavoriteServiceClient {
-	return &FavoriteServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+var fieldIDToName_QueryContestFavoriteUsersRequest = map[int16]string{
+	1: "contest_id",
+}
+
+func (p *QueryContestFavoriteUsersRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryContestFavoriteUsersRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func NewFavoriteServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *FavoriteServiceClient {
-	return &FavoriteServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+func (p *QueryContestFavoriteUsersRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestId = v
 	}
+	return nil
 }
 
-func NewFavoriteServiceClient(c thrift.TClient) *FavoriteServiceClient {
-	return &FavoriteServiceClient{
-		c: c,
+func (p *QueryContestFavoriteUsersRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryContestFavoriteUsersRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *QueryContestFavoriteUsersRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FavoriteServiceClient) ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error) {
-	var _args FavoriteServiceContestFavoriteActionArgs
-	_args.Req = req
-	var _result FavoriteServiceContestFavoriteActionResult
-	if err = p.Client_().Call(ctx, "ContestFavoriteAction", &_args, &_result); err != nil {
-		return
+func (p *QueryContestFavoriteUsersRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("QueryContestFavoriteUsersRequest(%+v)", *p)
 }
-func (p *FavoriteServiceClient) ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error) {
-	var _args FavoriteServiceContestFavoriteListArgs
-	_args.Req = req
-	var _result FavoriteServiceContestFavoriteListResult
-	if err = p.Client_().Call(ctx, "ContestFavoriteList", &_args, &_result); err != nil {
-		return
+
+func (p *QueryContestFavoriteUsersRequest) DeepEqual(ano *QueryContestFavoriteUsersRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	if !p.Field1DeepEqual(ano.ContestId) {
+		return false
+	}
+	return true
 }
-func (p *FavoriteServiceClient) QueryFavoriteStatusByUserId(ctx context.Context, req *QueryFavoriteStatusByUserIdRequest) (r *QueryFavoriteStatusByUserIdResponse, err error) {
-	var _args FavoriteServiceQueryFavoriteStatusByUserIdArgs
-	_args.Req = req
-	var _result FavoriteServiceQueryFavoriteStatusByUserIdResult
-	if err = p.Client_().Call(ctx, "QueryFavoriteStatusByUserId", &_args, &_result); err != nil {
-		return
+
+func (p *QueryContestFavoriteUsersRequest) Field1DeepEqual(src int32) bool {
+
+	if p.ContestId != src {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
 
-type FavoriteServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      FavoriteService
+type QueryContestFavoriteUsersResponse struct {
+	StatusCode int32   `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string  `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	UserIds    []int32 `thrift:"user_ids,3" frugal:"3,default,list<i32>" json:"user_ids"`
 }
 
-func (p *FavoriteServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewQueryContestFavoriteUsersResponse() *QueryContestFavoriteUsersResponse {
+	return &QueryContestFavoriteUsersResponse{}
 }
 
-func (p *FavoriteServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *QueryContestFavoriteUsersResponse) InitDefault() {
+	*p = QueryContestFavoriteUsersResponse{}
 }
 
-func (p *FavoriteServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *QueryContestFavoriteUsersResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func NewFavoriteServiceProcessor(handler FavoriteService) *FavoriteServiceProcessor {
-	self := &FavoriteServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("ContestFavoriteAction", &favoriteServiceProcessorContestFavoriteAction{handler: handler})
-	self.AddToProcessorMap("ContestFavoriteList", &favoriteServiceProcessorContestFavoriteList{handler: handler})
-	self.AddToProcessorMap("QueryFavoriteStatusByUserId", &favoriteServiceProcessorQueryFavoriteStatusByUserId{handler: handler})
-	return self
+func (p *QueryContestFavoriteUsersResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
-func (p *FavoriteServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+
+func (p *QueryContestFavoriteUsersResponse) GetUserIds() (v []int32) {
+	return p.UserIds
+}
+func (p *QueryContestFavoriteUsersResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *QueryContestFavoriteUsersResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *QueryContestFavoriteUsersResponse) SetUserIds(val []int32) {
+	p.UserIds = val
 }
 
-type favoriteServiceProcessorContestFavoriteAction struct {
-	handler FavoriteService
+var fieldIDToName_QueryContestFavoriteUsersResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "user_ids",
 }
 
-func (p *favoriteServiceProcessorContestFavoriteAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := FavoriteServiceContestFavoriteActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *QueryContestFavoriteUsersResponse) Read(iprot thrift.TProtocol) (err error) {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := FavoriteServiceContestFavoriteActionResult{}
-	var retval *ContestFavoriteActionResponse
-	if retval, err2 = p.handler.ContestFavoriteAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteAction: "+err2.Error())
-		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ContestFavoriteAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
-}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-type favoriteServiceProcessorContestFavoriteList struct {
-	handler FavoriteService
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-func (p *favoriteServiceProcessorContestFavoriteList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := FavoriteServiceContestFavoriteListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := FavoriteServiceContestFavoriteListResult{}
-	var retval *ContestFavoriteListResponse
-	if retval, err2 = p.handler.ContestFavoriteList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteList: "+err2.Error())
-		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryContestFavoriteUsersResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryContestFavoriteUsersResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		p.StatusCode = v
 	}
-	if err2 = oprot.WriteMessageBegin("ContestFavoriteList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *QueryContestFavoriteUsersResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *QueryContestFavoriteUsersResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	p.UserIds = make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.UserIds = append(p.UserIds, _elem)
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	return nil
+}
+
+func (p *QueryContestFavoriteUsersResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryContestFavoriteUsersResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	return true, err
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type favoriteServiceProcessorQueryFavoriteStatusByUserId struct {
-	handler FavoriteService
+func (p *QueryContestFavoriteUsersResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *favoriteServiceProcessorQueryFavoriteStatusByUserId) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := FavoriteServiceQueryFavoriteStatusByUserIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryFavoriteStatusByUserId", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *QueryContestFavoriteUsersResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryContestFavoriteUsersResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.UserIds)); err != nil {
+		return err
+	}
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := FavoriteServiceQueryFavoriteStatusByUserIdResult{}
-	var retval *QueryFavoriteStatusByUserIdResponse
-	if retval, err2 = p.handler.QueryFavoriteStatusByUserId(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryFavoriteStatusByUserId: "+err2.Error())
-		oprot.WriteMessageBegin("QueryFavoriteStatusByUserId", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *QueryContestFavoriteUsersResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageBegin("QueryFavoriteStatusByUserId", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return fmt.Sprintf("QueryContestFavoriteUsersResponse(%+v)", *p)
+}
+
+func (p *QueryContestFavoriteUsersResponse) DeepEqual(ano *QueryContestFavoriteUsersResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.UserIds) {
+		return false
+	}
+	return true
+}
+
+func (p *QueryContestFavoriteUsersResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *QueryContestFavoriteUsersResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *QueryContestFavoriteUsersResponse) Field3DeepEqual(src []int32) bool {
+
+	if len(p.UserIds) != len(src) {
+		return false
+	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+type FavoriteService interface {
+	ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error)
+
+	ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error)
+
+	QueryFavoriteStatusByUserId(ctx context.Context, req *QueryFavoriteStatusByUserIdRequest) (r *QueryFavoriteStatusByUserIdResponse, err error)
+
+	QueryContestFavoriteUsers(ctx context.Context, req *QueryContestFavoriteUsersRequest) (r *QueryContestFavoriteUsersResponse, err error)
+}
+
+type FavoriteServiceClient struct {
+	c thrift.TClient
+}
+
+func NewFavoriteServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *FavoriteServiceClient {
+	return &FavoriteServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewFavoriteServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *FavoriteServiceClient {
+	return &FavoriteServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewFavoriteServiceClient(c thrift.TClient) *FavoriteServiceClient {
+	return &FavoriteServiceClient{
+		c: c,
+	}
+}
+
+func (p *FavoriteServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *FavoriteServiceClient) ContestFavoriteAction(ctx context.Context, req *ContestFavoriteActionRequest) (r *ContestFavoriteActionResponse, err error) {
+	var _args FavoriteServiceContestFavoriteActionArgs
+	_args.Req = req
+	var _result FavoriteServiceContestFavoriteActionResult
+	if err = p.Client_().Call(ctx, "ContestFavoriteAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *FavoriteServiceClient) ContestFavoriteList(ctx context.Context, req *ContestFavoriteListRequest) (r *ContestFavoriteListResponse, err error) {
+	var _args FavoriteServiceContestFavoriteListArgs
+	_args.Req = req
+	var _result FavoriteServiceContestFavoriteListResult
+	if err = p.Client_().Call(ctx, "ContestFavoriteList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *FavoriteServiceClient) QueryFavoriteStatusByUserId(ctx context.Context, req *QueryFavoriteStatusByUserIdRequest) (r *QueryFavoriteStatusByUserIdResponse, err error) {
+	var _args FavoriteServiceQueryFavoriteStatusByUserIdArgs
+	_args.Req = req
+	var _result FavoriteServiceQueryFavoriteStatusByUserIdResult
+	if err = p.Client_().Call(ctx, "QueryFavoriteStatusByUserId", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *FavoriteServiceClient) QueryContestFavoriteUsers(ctx context.Context, req *QueryContestFavoriteUsersRequest) (r *QueryContestFavoriteUsersResponse, err error) {
+	var _args FavoriteServiceQueryContestFavoriteUsersArgs
+	_args.Req = req
+	var _result FavoriteServiceQueryContestFavoriteUsersResult
+	if err = p.Client_().Call(ctx, "QueryContestFavoriteUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type FavoriteServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      FavoriteService
+}
+
+func (p *FavoriteServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *FavoriteServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *FavoriteServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewFavoriteServiceProcessor(handler FavoriteService) *FavoriteServiceProcessor {
+	self := &FavoriteServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ContestFavoriteAction", &favoriteServiceProcessorContestFavoriteAction{handler: handler})
+	self.AddToProcessorMap("ContestFavoriteList", &favoriteServiceProcessorContestFavoriteList{handler: handler})
+	self.AddToProcessorMap("QueryFavoriteStatusByUserId", &favoriteServiceProcessorQueryFavoriteStatusByUserId{handler: handler})
+	self.AddToProcessorMap("QueryContestFavoriteUsers", &favoriteServiceProcessorQueryContestFavoriteUsers{handler: handler})
+	return self
+}
+func (p *FavoriteServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type favoriteServiceProcessorContestFavoriteAction struct {
+	handler FavoriteService
+}
+
+func (p *favoriteServiceProcessorContestFavoriteAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceContestFavoriteActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceContestFavoriteActionResult{}
+	var retval *ContestFavoriteActionResponse
+	if retval, err2 = p.handler.ContestFavoriteAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteAction: "+err2.Error())
+		oprot.WriteMessageBegin("ContestFavoriteAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestFavoriteAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type favoriteServiceProcessorContestFavoriteList struct {
+	handler FavoriteService
+}
+
+func (p *favoriteServiceProcessorContestFavoriteList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceContestFavoriteListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceContestFavoriteListResult{}
+	var retval *ContestFavoriteListResponse
+	if retval, err2 = p.handler.ContestFavoriteList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ContestFavoriteList: "+err2.Error())
+		oprot.WriteMessageBegin("ContestFavoriteList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ContestFavoriteList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type favoriteServiceProcessorQueryFavoriteStatusByUserId struct {
+	handler FavoriteService
+}
+
+func (p *favoriteServiceProcessorQueryFavoriteStatusByUserId) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceQueryFavoriteStatusByUserIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryFavoriteStatusByUserId", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceQueryFavoriteStatusByUserIdResult{}
+	var retval *QueryFavoriteStatusByUserIdResponse
+	if retval, err2 = p.handler.QueryFavoriteStatusByUserId(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryFavoriteStatusByUserId: "+err2.Error())
+		oprot.WriteMessageBegin("QueryFavoriteStatusByUserId", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryFavoriteStatusByUserId", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type favoriteServiceProcessorQueryContestFavoriteUsers struct {
+	handler FavoriteService
+}
+
+func (p *favoriteServiceProcessorQueryContestFavoriteUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := FavoriteServiceQueryContestFavoriteUsersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryContestFavoriteUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := FavoriteServiceQueryContestFavoriteUsersResult{}
+	var retval *QueryContestFavoriteUsersResponse
+	if retval, err2 = p.handler.QueryContestFavoriteUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryContestFavoriteUsers: "+err2.Error())
+		oprot.WriteMessageBegin("QueryContestFavoriteUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryContestFavoriteUsers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type FavoriteServiceContestFavoriteActionArgs struct {
+	Req *ContestFavoriteActionRequest `thrift:"req,1" frugal:"1,default,ContestFavoriteActionRequest" json:"req"`
+}
+
+func NewFavoriteServiceContestFavoriteActionArgs() *FavoriteServiceContestFavoriteActionArgs {
+	return &FavoriteServiceContestFavoriteActionArgs{}
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) InitDefault() {
+	*p = FavoriteServiceContestFavoriteActionArgs{}
+}
+
+var FavoriteServiceContestFavoriteActionArgs_Req_DEFAULT *ContestFavoriteActionRequest
+
+func (p *FavoriteServiceContestFavoriteActionArgs) GetReq() (v *ContestFavoriteActionRequest) {
+	if !p.IsSetReq() {
+		return FavoriteServiceContestFavoriteActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FavoriteServiceContestFavoriteActionArgs) SetReq(val *ContestFavoriteActionRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FavoriteServiceContestFavoriteActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteActionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestFavoriteActionRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestFavoriteAction_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceContestFavoriteActionArgs(%+v)", *p)
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) DeepEqual(ano *FavoriteServiceContestFavoriteActionArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FavoriteServiceContestFavoriteActionArgs) Field1DeepEqual(src *ContestFavoriteActionRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FavoriteServiceContestFavoriteActionResult struct {
+	Success *ContestFavoriteActionResponse `thrift:"success,0,optional" frugal:"0,optional,ContestFavoriteActionResponse" json:"success,omitempty"`
+}
+
+func NewFavoriteServiceContestFavoriteActionResult() *FavoriteServiceContestFavoriteActionResult {
+	return &FavoriteServiceContestFavoriteActionResult{}
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) InitDefault() {
+	*p = FavoriteServiceContestFavoriteActionResult{}
+}
+
+var FavoriteServiceContestFavoriteActionResult_Success_DEFAULT *ContestFavoriteActionResponse
+
+func (p *FavoriteServiceContestFavoriteActionResult) GetSuccess() (v *ContestFavoriteActionResponse) {
+	if !p.IsSetSuccess() {
+		return FavoriteServiceContestFavoriteActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FavoriteServiceContestFavoriteActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestFavoriteActionResponse)
+}
+
+var fieldIDToName_FavoriteServiceContestFavoriteActionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteActionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestFavoriteActionResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ContestFavoriteAction_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FavoriteServiceContestFavoriteActionResult(%+v)", *p)
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) DeepEqual(ano *FavoriteServiceContestFavoriteActionResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *FavoriteServiceContestFavoriteActionResult) Field0DeepEqual(src *ContestFavoriteActionResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type FavoriteServiceContestFavoriteActionArgs struct {
-	Req *ContestFavoriteActionRequest `thrift:"req,1" frugal:"1,default,ContestFavoriteActionRequest" json:"req"`
+type FavoriteServiceContestFavoriteListArgs struct {
+	Req *ContestFavoriteListRequest `thrift:"req,1" frugal:"1,default,ContestFavoriteListRequest" json:"req"`
 }
 
-func NewFavoriteServiceContestFavoriteActionArgs() *FavoriteServiceContestFavoriteActionArgs {
-	return &FavoriteServiceContestFavoriteActionArgs{}
+func NewFavoriteServiceContestFavoriteListArgs() *FavoriteServiceContestFavoriteListArgs {
+	return &FavoriteServiceContestFavoriteListArgs{}
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) InitDefault() {
-	*p = FavoriteServiceContestFavoriteActionArgs{}
+func (p *FavoriteServiceContestFavoriteListArgs) InitDefault() {
+	*p = FavoriteServiceContestFavoriteListArgs{}
 }
 
-var FavoriteServiceContestFavoriteActionArgs_Req_DEFAULT *ContestFavoriteActionRequest
+var FavoriteServiceContestFavoriteListArgs_Req_DEFAULT *ContestFavoriteListRequest
 
-func (p *FavoriteServiceContestFavoriteActionArgs) GetReq() (v *ContestFavoriteActionRequest) {
+func (p *FavoriteServiceContestFavoriteListArgs) GetReq() (v *ContestFavoriteListRequest) {
 	if !p.IsSetReq() {
-		return FavoriteServiceContestFavoriteActionArgs_Req_DEFAULT
+		return FavoriteServiceContestFavoriteListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FavoriteServiceContestFavoriteActionArgs) SetReq(val *ContestFavoriteActionRequest) {
+func (p *FavoriteServiceContestFavoriteListArgs) SetReq(val *ContestFavoriteListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FavoriteServiceContestFavoriteActionArgs = map[int16]string{
+var fieldIDToName_FavoriteServiceContestFavoriteListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) IsSetReq() bool {
+func (p *FavoriteServiceContestFavoriteListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceContestFavoriteListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2510,7 +3389,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteActionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2520,17 +3399,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestFavoriteActionRequest()
+func (p *FavoriteServiceContestFavoriteListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewContestFavoriteListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceContestFavoriteListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteAction_args"); err != nil {
+	if err = oprot.WriteStructBegin("ContestFavoriteList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2557,7 +3436,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceContestFavoriteListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2574,14 +3453,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) String() string {
+func (p *FavoriteServiceContestFavoriteListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FavoriteServiceContestFavoriteActionArgs(%+v)", *p)
+	return fmt.Sprintf("FavoriteServiceContestFavoriteListArgs(%+v)", *p)
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) DeepEqual(ano *FavoriteServiceContestFavoriteActionArgs) bool {
+func (p *FavoriteServiceContestFavoriteListArgs) DeepEqual(ano *FavoriteServiceContestFavoriteListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2593,7 +3472,7 @@ func (p *FavoriteServiceContestFavoriteActionArgs) DeepEqual(ano *FavoriteServic
 	return true
 }
 
-func (p *FavoriteServiceContestFavoriteActionArgs) Field1DeepEqual(src *ContestFavoriteActionRequest) bool {
+func (p *FavoriteServiceContestFavoriteListArgs) Field1DeepEqual(src *ContestFavoriteListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2601,39 +3480,39 @@ func (p *FavoriteServiceContestFavoriteActionArgs) Field1DeepEqual(src *ContestF
 	return true
 }
 
-type FavoriteServiceContestFavoriteActionResult struct {
-	Success *ContestFavoriteActionResponse `thrift:"success,0,optional" frugal:"0,optional,ContestFavoriteActionResponse" json:"success,omitempty"`
+type FavoriteServiceContestFavoriteListResult struct {
+	Success *ContestFavoriteListResponse `thrift:"success,0,optional" frugal:"0,optional,ContestFavoriteListResponse" json:"success,omitempty"`
 }
 
-func NewFavoriteServiceContestFavoriteActionResult() *FavoriteServiceContestFavoriteActionResult {
-	return &FavoriteServiceContestFavoriteActionResult{}
+func NewFavoriteServiceContestFavoriteListResult() *FavoriteServiceContestFavoriteListResult {
+	return &FavoriteServiceContestFavoriteListResult{}
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) InitDefault() {
-	*p = FavoriteServiceContestFavoriteActionResult{}
+func (p *FavoriteServiceContestFavoriteListResult) InitDefault() {
+	*p = FavoriteServiceContestFavoriteListResult{}
 }
 
-var FavoriteServiceContestFavoriteActionResult_Success_DEFAULT *ContestFavoriteActionResponse
+var FavoriteServiceContestFavoriteListResult_Success_DEFAULT *ContestFavoriteListResponse
 
-func (p *FavoriteServiceContestFavoriteActionResult) GetSuccess() (v *ContestFavoriteActionResponse) {
+func (p *FavoriteServiceContestFavoriteListResult) GetSuccess() (v *ContestFavoriteListResponse) {
 	if !p.IsSetSuccess() {
-		return FavoriteServiceContestFavoriteActionResult_Success_DEFAULT
+		return FavoriteServiceContestFavoriteListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FavoriteServiceContestFavoriteActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ContestFavoriteActionResponse)
+func (p *FavoriteServiceContestFavoriteListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ContestFavoriteListResponse)
 }
 
-var fieldIDToName_FavoriteServiceContestFavoriteActionResult = map[int16]string{
+var fieldIDToName_FavoriteServiceContestFavoriteListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) IsSetSuccess() bool {
+func (p *FavoriteServiceContestFavoriteListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceContestFavoriteListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2682,7 +3561,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteActionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2692,17 +3571,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestFavoriteActionResponse()
+func (p *FavoriteServiceContestFavoriteListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewContestFavoriteListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceContestFavoriteListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteAction_result"); err != nil {
+	if err = oprot.WriteStructBegin("ContestFavoriteList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2729,7 +3608,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceContestFavoriteListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2748,14 +3627,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) String() string {
+func (p *FavoriteServiceContestFavoriteListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FavoriteServiceContestFavoriteActionResult(%+v)", *p)
+	return fmt.Sprintf("FavoriteServiceContestFavoriteListResult(%+v)", *p)
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) DeepEqual(ano *FavoriteServiceContestFavoriteActionResult) bool {
+func (p *FavoriteServiceContestFavoriteListResult) DeepEqual(ano *FavoriteServiceContestFavoriteListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2767,7 +3646,7 @@ func (p *FavoriteServiceContestFavoriteActionResult) DeepEqual(ano *FavoriteServ
 	return true
 }
 
-func (p *FavoriteServiceContestFavoriteActionResult) Field0DeepEqual(src *ContestFavoriteActionResponse) bool {
+func (p *FavoriteServiceContestFavoriteListResult) Field0DeepEqual(src *ContestFavoriteListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -2775,39 +3654,39 @@ func (p *FavoriteServiceContestFavoriteActionResult) Field0DeepEqual(src *Contes
 	return true
 }
 
-type FavoriteServiceContestFavoriteListArgs struct {
-	Req *ContestFavoriteListRequest `thrift:"req,1" frugal:"1,default,ContestFavoriteListRequest" json:"req"`
+type FavoriteServiceQueryFavoriteStatusByUserIdArgs struct {
+	Req *QueryFavoriteStatusByUserIdRequest `thrift:"req,1" frugal:"1,default,QueryFavoriteStatusByUserIdRequest" json:"req"`
 }
 
-func NewFavoriteServiceContestFavoriteListArgs() *FavoriteServiceContestFavoriteListArgs {
-	return &FavoriteServiceContestFavoriteListArgs{}
+func NewFavoriteServiceQueryFavoriteStatusByUserIdArgs() *FavoriteServiceQueryFavoriteStatusByUserIdArgs {
+	return &FavoriteServiceQueryFavoriteStatusByUserIdArgs{}
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) InitDefault() {
-	*p = FavoriteServiceContestFavoriteListArgs{}
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) InitDefault() {
+	*p = FavoriteServiceQueryFavoriteStatusByUserIdArgs{}
 }
 
-var FavoriteServiceContestFavoriteListArgs_Req_DEFAULT *ContestFavoriteListRequest
+var FavoriteServiceQueryFavoriteStatusByUserIdArgs_Req_DEFAULT *QueryFavoriteStatusByUserIdRequest
 
-func (p *FavoriteServiceContestFavoriteListArgs) GetReq() (v *ContestFavoriteListRequest) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) GetReq() (v *QueryFavoriteStatusByUserIdRequest) {
 	if !p.IsSetReq() {
-		return FavoriteServiceContestFavoriteListArgs_Req_DEFAULT
+		return FavoriteServiceQueryFavoriteStatusByUserIdArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FavoriteServiceContestFavoriteListArgs) SetReq(val *ContestFavoriteListRequest) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) SetReq(val *QueryFavoriteStatusByUserIdRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FavoriteServiceContestFavoriteListArgs = map[int16]string{
+var fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) IsSetReq() bool {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2856,7 +3735,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2866,17 +3745,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewContestFavoriteListRequest()
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewQueryFavoriteStatusByUserIdRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteList_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryFavoriteStatusByUserId_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2903,7 +3782,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2920,14 +3799,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) String() string {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FavoriteServiceContestFavoriteListArgs(%+v)", *p)
+	return fmt.Sprintf("FavoriteServiceQueryFavoriteStatusByUserIdArgs(%+v)", *p)
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) DeepEqual(ano *FavoriteServiceContestFavoriteListArgs) bool {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) DeepEqual(ano *FavoriteServiceQueryFavoriteStatusByUserIdArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2939,7 +3818,7 @@ func (p *FavoriteServiceContestFavoriteListArgs) DeepEqual(ano *FavoriteServiceC
 	return true
 }
 
-func (p *FavoriteServiceContestFavoriteListArgs) Field1DeepEqual(src *ContestFavoriteListRequest) bool {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Field1DeepEqual(src *QueryFavoriteStatusByUserIdRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2947,39 +3826,39 @@ func (p *FavoriteServiceContestFavoriteListArgs) Field1DeepEqual(src *ContestFav
 	return true
 }
 
-type FavoriteServiceContestFavoriteListResult struct {
-	Success *ContestFavoriteListResponse `thrift:"success,0,optional" frugal:"0,optional,ContestFavoriteListResponse" json:"success,omitempty"`
+type FavoriteServiceQueryFavoriteStatusByUserIdResult struct {
+	Success *QueryFavoriteStatusByUserIdResponse `thrift:"success,0,optional" frugal:"0,optional,QueryFavoriteStatusByUserIdResponse" json:"success,omitempty"`
 }
 
-func NewFavoriteServiceContestFavoriteListResult() *FavoriteServiceContestFavoriteListResult {
-	return &FavoriteServiceContestFavoriteListResult{}
+func NewFavoriteServiceQueryFavoriteStatusByUserIdResult() *FavoriteServiceQueryFavoriteStatusByUserIdResult {
+	return &FavoriteServiceQueryFavoriteStatusByUserIdResult{}
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) InitDefault() {
-	*p = FavoriteServiceContestFavoriteListResult{}
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) InitDefault() {
+	*p = FavoriteServiceQueryFavoriteStatusByUserIdResult{}
 }
 
-var FavoriteServiceContestFavoriteListResult_Success_DEFAULT *ContestFavoriteListResponse
+var FavoriteServiceQueryFavoriteStatusByUserIdResult_Success_DEFAULT *QueryFavoriteStatusByUserIdResponse
 
-func (p *FavoriteServiceContestFavoriteListResult) GetSuccess() (v *ContestFavoriteListResponse) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) GetSuccess() (v *QueryFavoriteStatusByUserIdResponse) {
 	if !p.IsSetSuccess() {
-		return FavoriteServiceContestFavoriteListResult_Success_DEFAULT
+		return FavoriteServiceQueryFavoriteStatusByUserIdResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FavoriteServiceContestFavoriteListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ContestFavoriteListResponse)
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryFavoriteStatusByUserIdResponse)
 }
 
-var fieldIDToName_FavoriteServiceContestFavoriteListResult = map[int16]string{
+var fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) IsSetSuccess() bool {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3028,7 +3907,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceContestFavoriteListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3038,17 +3917,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewContestFavoriteListResponse()
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewQueryFavoriteStatusByUserIdResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ContestFavoriteList_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryFavoriteStatusByUserId_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3075,7 +3954,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3094,14 +3973,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) String() string {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FavoriteServiceContestFavoriteListResult(%+v)", *p)
+	return fmt.Sprintf("FavoriteServiceQueryFavoriteStatusByUserIdResult(%+v)", *p)
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) DeepEqual(ano *FavoriteServiceContestFavoriteListResult) bool {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) DeepEqual(ano *FavoriteServiceQueryFavoriteStatusByUserIdResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3113,7 +3992,7 @@ func (p *FavoriteServiceContestFavoriteListResult) DeepEqual(ano *FavoriteServic
 	return true
 }
 
-func (p *FavoriteServiceContestFavoriteListResult) Field0DeepEqual(src *ContestFavoriteListResponse) bool {
+func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) Field0DeepEqual(src *QueryFavoriteStatusByUserIdResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3121,39 +4000,39 @@ func (p *FavoriteServiceContestFavoriteListResult) Field0DeepEqual(src *ContestF
 	return true
 }
 
-type FavoriteServiceQueryFavoriteStatusByUserIdArgs struct {
-	Req *QueryFavoriteStatusByUserIdRequest `thrift:"req,1" frugal:"1,default,QueryFavoriteStatusByUserIdRequest" json:"req"`
+type FavoriteServiceQueryContestFavoriteUsersArgs struct {
+	Req *QueryContestFavoriteUsersRequest `thrift:"req,1" frugal:"1,default,QueryContestFavoriteUsersRequest" json:"req"`
 }
 
-func NewFavoriteServiceQueryFavoriteStatusByUserIdArgs() *FavoriteServiceQueryFavoriteStatusByUserIdArgs {
-	return &FavoriteServiceQueryFavoriteStatusByUserIdArgs{}
+func NewFavoriteServiceQueryContestFavoriteUsersArgs() *FavoriteServiceQueryContestFavoriteUsersArgs {
+	return &FavoriteServiceQueryContestFavoriteUsersArgs{}
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) InitDefault() {
-	*p = FavoriteServiceQueryFavoriteStatusByUserIdArgs{}
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) InitDefault() {
+	*p = FavoriteServiceQueryContestFavoriteUsersArgs{}
 }
 
-var FavoriteServiceQueryFavoriteStatusByUserIdArgs_Req_DEFAULT *QueryFavoriteStatusByUserIdRequest
+var FavoriteServiceQueryContestFavoriteUsersArgs_Req_DEFAULT *QueryContestFavoriteUsersRequest
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) GetReq() (v *QueryFavoriteStatusByUserIdRequest) {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) GetReq() (v *QueryContestFavoriteUsersRequest) {
 	if !p.IsSetReq() {
-		return FavoriteServiceQueryFavoriteStatusByUserIdArgs_Req_DEFAULT
+		return FavoriteServiceQueryContestFavoriteUsersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) SetReq(val *QueryFavoriteStatusByUserIdRequest) {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) SetReq(val *QueryContestFavoriteUsersRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdArgs = map[int16]string{
+var fieldIDToName_FavoriteServiceQueryContestFavoriteUsersArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) IsSetReq() bool {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3202,7 +4081,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceQueryContestFavoriteUsersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3212,17 +4091,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewQueryFavoriteStatusByUserIdRequest()
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewQueryContestFavoriteUsersRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryFavoriteStatusByUserId_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryContestFavoriteUsers_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3249,7 +4128,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3266,14 +4145,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) String() string {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FavoriteServiceQueryFavoriteStatusByUserIdArgs(%+v)", *p)
+	return fmt.Sprintf("FavoriteServiceQueryContestFavoriteUsersArgs(%+v)", *p)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) DeepEqual(ano *FavoriteServiceQueryFavoriteStatusByUserIdArgs) bool {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) DeepEqual(ano *FavoriteServiceQueryContestFavoriteUsersArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3285,7 +4164,7 @@ func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) DeepEqual(ano *Favorite
 	return true
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Field1DeepEqual(src *QueryFavoriteStatusByUserIdRequest) bool {
+func (p *FavoriteServiceQueryContestFavoriteUsersArgs) Field1DeepEqual(src *QueryContestFavoriteUsersRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3293,39 +4172,39 @@ func (p *FavoriteServiceQueryFavoriteStatusByUserIdArgs) Field1DeepEqual(src *Qu
 	return true
 }
 
-type FavoriteServiceQueryFavoriteStatusByUserIdResult struct {
-	Success *QueryFavoriteStatusByUserIdResponse `thrift:"success,0,optional" frugal:"0,optional,QueryFavoriteStatusByUserIdResponse" json:"success,omitempty"`
+type FavoriteServiceQueryContestFavoriteUsersResult struct {
+	Success *QueryContestFavoriteUsersResponse `thrift:"success,0,optional" frugal:"0,optional,QueryContestFavoriteUsersResponse" json:"success,omitempty"`
 }
 
-func NewFavoriteServiceQueryFavoriteStatusByUserIdResult() *FavoriteServiceQueryFavoriteStatusByUserIdResult {
-	return &FavoriteServiceQueryFavoriteStatusByUserIdResult{}
+func NewFavoriteServiceQueryContestFavoriteUsersResult() *FavoriteServiceQueryContestFavoriteUsersResult {
+	return &FavoriteServiceQueryContestFavoriteUsersResult{}
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) InitDefault() {
-	*p = FavoriteServiceQueryFavoriteStatusByUserIdResult{}
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) InitDefault() {
+	*p = FavoriteServiceQueryContestFavoriteUsersResult{}
 }
 
-var FavoriteServiceQueryFavoriteStatusByUserIdResult_Success_DEFAULT *QueryFavoriteStatusByUserIdResponse
+var FavoriteServiceQueryContestFavoriteUsersResult_Success_DEFAULT *QueryContestFavoriteUsersResponse
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) GetSuccess() (v *QueryFavoriteStatusByUserIdResponse) {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) GetSuccess() (v *QueryContestFavoriteUsersResponse) {
 	if !p.IsSetSuccess() {
-		return FavoriteServiceQueryFavoriteStatusByUserIdResult_Success_DEFAULT
+		return FavoriteServiceQueryContestFavoriteUsersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) SetSuccess(x interface{}) {
-	p.Success = x.(*QueryFavoriteStatusByUserIdResponse)
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryContestFavoriteUsersResponse)
 }
 
-var fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdResult = map[int16]string{
+var fieldIDToName_FavoriteServiceQueryContestFavoriteUsersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) IsSetSuccess() bool {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3374,7 +4253,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceQueryFavoriteStatusByUserIdResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FavoriteServiceQueryContestFavoriteUsersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3384,17 +4263,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewQueryFavoriteStatusByUserIdResponse()
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewQueryContestFavoriteUsersResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryFavoriteStatusByUserId_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryContestFavoriteUsers_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3421,7 +4300,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3440,14 +4319,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) String() string {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FavoriteServiceQueryFavoriteStatusByUserIdResult(%+v)", *p)
+	return fmt.Sprintf("FavoriteServiceQueryContestFavoriteUsersResult(%+v)", *p)
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) DeepEqual(ano *FavoriteServiceQueryFavoriteStatusByUserIdResult) bool {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) DeepEqual(ano *FavoriteServiceQueryContestFavoriteUsersResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3459,7 +4338,7 @@ func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) DeepEqual(ano *Favori
 	return true
 }
 
-func (p *FavoriteServiceQueryFavoriteStatusByUserIdResult) Field0DeepEqual(src *QueryFavoriteStatusByUserIdResponse) bool {
+func (p *FavoriteServiceQueryContestFavoriteUsersResult) Field0DeepEqual(src *QueryContestFavoriteUsersResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -14,6 +14,7 @@ type Client interface {
 	ContestFavoriteAction(ctx context.Context, req *favorite.ContestFavoriteActionRequest, callOptions ...callopt.Option) (r *favorite.ContestFavoriteActionResponse, err error)
 	ContestFavoriteList(ctx context.Context, req *favorite.ContestFavoriteListRequest, callOptions ...callopt.Option) (r *favorite.ContestFavoriteListResponse, err error)
 	QueryFavoriteStatusByUserId(ctx context.Context, req *favorite.QueryFavoriteStatusByUserIdRequest, callOptions ...callopt.Option) (r *favorite.QueryFavoriteStatusByUserIdResponse, err error)
+	QueryContestFavoriteUsers(ctx context.Context, req *favorite.QueryContestFavoriteUsersRequest, callOptions ...callopt.Option) (r *favorite.QueryContestFavoriteUsersResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +60,8 @@ func (p *kFavoriteServiceClient) QueryFavoriteStatusByUserId(ctx context.Context
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.QueryFavoriteStatusByUserId(ctx, req)
 }
+
+func (p *kFavoriteServiceClient) QueryContestFavoriteUsers(ctx context.Context, req *favorite.QueryContestFavoriteUsersRequest, callOptions ...callopt.Option) (r *favorite.QueryContestFavoriteUsersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryContestFavoriteUsers(ctx, req)
+}
@@ -22,6 +22,7 @@ func NewServiceInfo() *kitex.ServiceInfo {
 		"ContestFavoriteAction":       kitex.NewMethodInfo(contestFavoriteActionHandler, newFavoriteServiceContestFavoriteActionArgs, newFavoriteServiceContestFavoriteActionResult, false),
 		"ContestFavoriteList":         kitex.NewMethodInfo(contestFavoriteListHandler, newFavoriteServiceContestFavoriteListArgs, newFavoriteServiceContestFavoriteListResult, false),
 		"QueryFavoriteStatusByUserId": kitex.NewMethodInfo(queryFavoriteStatusByUserIdHandler, newFavoriteServiceQueryFavoriteStatusByUserIdArgs, newFavoriteServiceQueryFavoriteStatusByUserIdResult, false),
+		"QueryContestFavoriteUsers":   kitex.NewMethodInfo(queryContestFavoriteUsersHandler, newFavoriteServiceQueryContestFavoriteUsersArgs, newFavoriteServiceQueryContestFavoriteUsersResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "favorite",
@@ -92,6 +93,24 @@ func newFavoriteServiceQueryFavoriteStatusByUserIdResult() interface{} {
 	return favorite.NewFavoriteServiceQueryFavoriteStatusByUserIdResult()
 }
 
+func queryContestFavoriteUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*favorite.FavoriteServiceQueryContestFavoriteUsersArgs)
+	realResult := result.(*favorite.FavoriteServiceQueryContestFavoriteUsersResult)
+	success, err := handler.(favorite.FavoriteService).QueryContestFavoriteUsers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFavoriteServiceQueryContestFavoriteUsersArgs() interface{} {
+	return favorite.NewFavoriteServiceQueryContestFavoriteUsersArgs()
+}
+
+func newFavoriteServiceQueryContestFavoriteUsersResult() interface{} {
+	return favorite.NewFavoriteServiceQueryContestFavoriteUsersResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -131,3 +150,13 @@ func (p *kClient) QueryFavoriteStatusByUserId(ctx context.Context, req *favorite
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) QueryContestFavoriteUsers(ctx context.Context, req *favorite.QueryContestFavoriteUsersRequest) (r *favorite.QueryContestFavoriteUsersResponse, err error) {
+	var _args favorite.FavoriteServiceQueryContestFavoriteUsersArgs
+	_args.Req = req
+	var _result favorite.FavoriteServiceQueryContestFavoriteUsersResult
+	if err = p.c.Call(ctx, "QueryContestFavoriteUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -554,93 +554,93 @@ func (p *MemberInfo) Field7DeepEqual(src []string) bool {
 	return true
 }
 
-type TeamBriefInfo struct {
-	TeamId       int32       `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
-	Title        string      `thrift:"title,2" frugal:"2,default,string" json:"title"`
-	Goal         string      `thrift:"goal,3" frugal:"3,default,string" json:"goal"`
-	CurPeopleNum int32       `thrift:"cur_people_num,4" frugal:"4,default,i32" json:"cur_people_num"`
-	CreatedTime  int64       `thrift:"created_time,5" frugal:"5,default,i64" json:"created_time"`
-	LeaderInfo   *MemberInfo `thrift:"leader_info,6" frugal:"6,default,MemberInfo" json:"leader_info"`
-	ContestId    int32       `thrift:"contest_id,7" frugal:"7,default,i32" json:"contest_id"`
+type TeamRecommendation struct {
+	Score           float64  `thrift:"score,1" frugal:"1,default,double" json:"score"`
+	SemanticScore   float64  `thrift:"semantic_score,2" frugal:"2,default,double" json:"semantic_score"`
+	SkillScore      float64  `thrift:"skill_score,3" frugal:"3,default,double" json:"skill_score"`
+	Job             string   `thrift:"job,4" frugal:"4,default,string" json:"job"`
+	MatchedSkills   []string `thrift:"matched_skills,5" frugal:"5,default,list<string>" json:"matched_skills"`
+	TimeBoost       float64  `thrift:"time_boost,6" frugal:"6,default,double" json:"time_boost"`
+	PopularityScore float64  `thrift:"popularity_score,7" frugal:"7,default,double" json:"popularity_score"`
+	Reason          string   `thrift:"reason,8" frugal:"8,default,string" json:"reason"`
 }
 
-func NewTeamBriefInfo() *TeamBriefInfo {
-	return &TeamBriefInfo{}
+func NewTeamRecommendation() *TeamRecommendation {
+	return &TeamRecommendation{}
 }
 
-func (p *TeamBriefInfo) InitDefault() {
-	*p = TeamBriefInfo{}
+func (p *TeamRecommendation) InitDefault() {
+	*p = TeamRecommendation{}
 }
 
-func (p *TeamBriefInfo) GetTeamId() (v int32) {
-	return p.TeamId
+func (p *TeamRecommendation) GetScore() (v float64) {
+	return p.Score
 }
 
-func (p *TeamBriefInfo) GetTitle() (v string) {
-	return p.Title
+func (p *TeamRecommendation) GetSemanticScore() (v float64) {
+	return p.SemanticScore
 }
 
-func (p *TeamBriefInfo) GetGoal() (v string) {
-	return p.Goal
+func (p *TeamRecommendation) GetSkillScore() (v float64) {
+	return p.SkillScore
 }
 
-func (p *TeamBriefInfo) GetCurPeopleNum() (v int32) {
-	return p.CurPeopleNum
+func (p *TeamRecommendation) GetJob() (v string) {
+	return p.Job
 }
 
-func (p *TeamBriefInfo) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func (p *TeamRecommendation) GetMatchedSkills() (v []string) {
+	return p.MatchedSkills
 }
 
-var TeamBriefInfo_LeaderInfo_DEFAULT *MemberInfo
+func (p *TeamRecommendation) GetTimeBoost() (v float64) {
+	return p.TimeBoost
+}
 
-func (p *TeamBriefInfo) GetLeaderInfo() (v *MemberInfo) {
-	if !p.IsSetLeaderInfo() {
-		return TeamBriefInfo_LeaderInfo_DEFAULT
-	}
-	return p.LeaderInfo
+func (p *TeamRecommendation) GetPopularityScore() (v float64) {
+	return p.PopularityScore
 }
 
-func (p *TeamBriefInfo) GetContestId() (v int32) {
-	return p.ContestId
+func (p *TeamRecommendation) GetReason() (v string) {
+	return p.Reason
 }
-func (p *TeamBriefInfo) SetTeamId(val int32) {
-	p.TeamId = val
+func (p *TeamRecommendation) SetScore(val float64) {
+	p.Score = val
 }
-func (p *TeamBriefInfo) SetTitle(val string) {
-	p.Title = val
+func (p *TeamRecommendation) SetSemanticScore(val float64) {
+	p.SemanticScore = val
 }
-func (p *TeamBriefInfo) SetGoal(val string) {
-	p.Goal = val
+func (p *TeamRecommendation) SetSkillScore(val float64) {
+	p.SkillScore = val
 }
-func (p *TeamBriefInfo) SetCurPeopleNum(val int32) {
-	p.CurPeopleNum = val
+func (p *TeamRecommendation) SetJob(val string) {
+	p.Job = val
 }
-func (p *TeamBriefInfo) SetCreatedTime(val int64) {
-	p.CreatedTime = val
+func (p *TeamRecommendation) SetMatchedSkills(val []string) {
+	p.MatchedSkills = val
 }
-func (p *TeamBriefInfo) SetLeaderInfo(val *MemberInfo) {
-	p.LeaderInfo = val
+func (p *TeamRecommendation) SetTimeBoost(val float64) {
+	p.TimeBoost = val
 }
-func (p *TeamBriefInfo) SetContestId(val int32) {
-	p.ContestId = val
+func (p *TeamRecommendation) SetPopularityScore(val float64) {
+	p.PopularityScore = val
 }
-
-var fieldIDToName_TeamBriefInfo = map[int16]string{
-	1: "team_id",
-	2: "title",
-	3: "goal",
-	4: "cur_people_num",
-	5: "created_time",
-	6: "leader_info",
-	7: "contest_id",
+func (p *TeamRecommendation) SetReason(val string) {
+	p.Reason = val
 }
 
-func (p *TeamBriefInfo) IsSetLeaderInfo() bool {
-	return p.LeaderInfo != nil
+var fieldIDToName_TeamRecommendation = map[int16]string{
+	1: "score",
+	2: "semantic_score",
+	3: "skill_score",
+	4: "job",
+	5: "matched_skills",
+	6: "time_boost",
+	7: "popularity_score",
+	8: "reason",
 }
 
-func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamRecommendation) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -660,7 +660,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -670,7 +670,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -680,7 +680,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -690,7 +690,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -700,7 +700,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -710,7 +710,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -720,7 +720,7 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 7:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -729,6 +729,16 @@ func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -749,7 +759,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamBriefInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamRecommendation[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -759,71 +769,94 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamRecommendation) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
+		p.Score = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamRecommendation) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.Title = v
+		p.SemanticScore = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamRecommendation) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.Goal = v
+		p.SkillScore = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamRecommendation) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.CurPeopleNum = v
+		p.Job = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *TeamRecommendation) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.MatchedSkills = make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.MatchedSkills = append(p.MatchedSkills, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamRecommendation) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		p.CreatedTime = v
+		p.TimeBoost = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField6(iprot thrift.TProtocol) error {
-	p.LeaderInfo = NewMemberInfo()
-	if err := p.LeaderInfo.Read(iprot); err != nil {
+func (p *TeamRecommendation) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		p.PopularityScore = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) ReadField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamRecommendation) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ContestId = v
+		p.Reason = v
 	}
 	return nil
 }
 
-func (p *TeamBriefInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamRecommendation) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamBriefInfo"); err != nil {
+	if err = oprot.WriteStructBegin("TeamRecommendation"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -855,6 +888,10 @@ func (p *TeamBriefInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -874,11 +911,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
+func (p *TeamRecommendation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteDouble(p.Score); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -891,11 +928,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+func (p *TeamRecommendation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("semantic_score", thrift.DOUBLE, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteDouble(p.SemanticScore); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -908,11 +945,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 3); err != nil {
+func (p *TeamRecommendation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skill_score", thrift.DOUBLE, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Goal); err != nil {
+	if err := oprot.WriteDouble(p.SkillScore); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -925,11 +962,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("cur_people_num", thrift.I32, 4); err != nil {
+func (p *TeamRecommendation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.CurPeopleNum); err != nil {
+	if err := oprot.WriteString(p.Job); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -942,11 +979,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 5); err != nil {
+func (p *TeamRecommendation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("matched_skills", thrift.LIST, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.MatchedSkills)); err != nil {
+		return err
+	}
+	for _, v := range p.MatchedSkills {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -959,11 +1004,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("leader_info", thrift.STRUCT, 6); err != nil {
+func (p *TeamRecommendation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("time_boost", thrift.DOUBLE, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.LeaderInfo.Write(oprot); err != nil {
+	if err := oprot.WriteDouble(p.TimeBoost); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -976,11 +1021,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 7); err != nil {
+func (p *TeamRecommendation) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("popularity_score", thrift.DOUBLE, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestId); err != nil {
+	if err := oprot.WriteDouble(p.PopularityScore); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -993,155 +1038,242 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TeamBriefInfo) String() string {
+func (p *TeamRecommendation) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamRecommendation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamBriefInfo(%+v)", *p)
+	return fmt.Sprintf("TeamRecommendation(%+v)", *p)
 }
 
-func (p *TeamBriefInfo) DeepEqual(ano *TeamBriefInfo) bool {
+func (p *TeamRecommendation) DeepEqual(ano *TeamRecommendation) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TeamId) {
+	if !p.Field1DeepEqual(ano.Score) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Title) {
+	if !p.Field2DeepEqual(ano.SemanticScore) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Goal) {
+	if !p.Field3DeepEqual(ano.SkillScore) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.CurPeopleNum) {
+	if !p.Field4DeepEqual(ano.Job) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.CreatedTime) {
+	if !p.Field5DeepEqual(ano.MatchedSkills) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.LeaderInfo) {
+	if !p.Field6DeepEqual(ano.TimeBoost) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.ContestId) {
+	if !p.Field7DeepEqual(ano.PopularityScore) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Reason) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamBriefInfo) Field1DeepEqual(src int32) bool {
+func (p *TeamRecommendation) Field1DeepEqual(src float64) bool {
 
-	if p.TeamId != src {
+	if p.Score != src {
 		return false
 	}
 	return true
 }
-func (p *TeamBriefInfo) Field2DeepEqual(src string) bool {
+func (p *TeamRecommendation) Field2DeepEqual(src float64) bool {
 
-	if strings.Compare(p.Title, src) != 0 {
+	if p.SemanticScore != src {
 		return false
 	}
 	return true
 }
-func (p *TeamBriefInfo) Field3DeepEqual(src string) bool {
+func (p *TeamRecommendation) Field3DeepEqual(src float64) bool {
 
-	if strings.Compare(p.Goal, src) != 0 {
+	if p.SkillScore != src {
 		return false
 	}
 	return true
 }
-func (p *TeamBriefInfo) Field4DeepEqual(src int32) bool {
+func (p *TeamRecommendation) Field4DeepEqual(src string) bool {
 
-	if p.CurPeopleNum != src {
+	if strings.Compare(p.Job, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamBriefInfo) Field5DeepEqual(src int64) bool {
+func (p *TeamRecommendation) Field5DeepEqual(src []string) bool {
 
-	if p.CreatedTime != src {
+	if len(p.MatchedSkills) != len(src) {
 		return false
 	}
+	for i, v := range p.MatchedSkills {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
 	return true
 }
-func (p *TeamBriefInfo) Field6DeepEqual(src *MemberInfo) bool {
+func (p *TeamRecommendation) Field6DeepEqual(src float64) bool {
 
-	if !p.LeaderInfo.DeepEqual(src) {
+	if p.TimeBoost != src {
 		return false
 	}
 	return true
 }
-func (p *TeamBriefInfo) Field7DeepEqual(src int32) bool {
+func (p *TeamRecommendation) Field7DeepEqual(src float64) bool {
 
-	if p.ContestId != src {
+	if p.PopularityScore != src {
 		return false
 	}
 	return true
 }
+func (p *TeamRecommendation) Field8DeepEqual(src string) bool {
 
-type TeamSkill struct {
-	TeamSkillId int32  `thrift:"team_skill_id,1" frugal:"1,default,i32" json:"team_skill_id"`
-	TeamId      int32  `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
-	Skill       string `thrift:"skill,3" frugal:"3,default,string" json:"skill"`
-	Category    string `thrift:"category,4" frugal:"4,default,string" json:"category"`
-	Job         string `thrift:"job,5" frugal:"5,default,string" json:"job"`
+	if strings.Compare(p.Reason, src) != 0 {
+		return false
+	}
+	return true
 }
 
-func NewTeamSkill() *TeamSkill {
-	return &TeamSkill{}
+type TeamBriefInfo struct {
+	TeamId         int32               `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
+	Title          string              `thrift:"title,2" frugal:"2,default,string" json:"title"`
+	Goal           string              `thrift:"goal,3" frugal:"3,default,string" json:"goal"`
+	CurPeopleNum   int32               `thrift:"cur_people_num,4" frugal:"4,default,i32" json:"cur_people_num"`
+	CreatedTime    int64               `thrift:"created_time,5" frugal:"5,default,i64" json:"created_time"`
+	LeaderInfo     *MemberInfo         `thrift:"leader_info,6" frugal:"6,default,MemberInfo" json:"leader_info"`
+	ContestId      int32               `thrift:"contest_id,7" frugal:"7,default,i32" json:"contest_id"`
+	Recommendation *TeamRecommendation `thrift:"recommendation,8,optional" frugal:"8,optional,TeamRecommendation" json:"recommendation,omitempty"`
+	Closed         bool                `thrift:"closed,9" frugal:"9,default,bool" json:"closed"`
 }
 
-func (p *TeamSkill) InitDefault() {
-	*p = TeamSkill{}
+func NewTeamBriefInfo() *TeamBriefInfo {
+	return &TeamBriefInfo{}
 }
 
-func (p *TeamSkill) GetTeamSkillId() (v int32) {
-	return p.TeamSkillId
+func (p *TeamBriefInfo) InitDefault() {
+	*p = TeamBriefInfo{}
 }
 
-func (p *TeamSkill) GetTeamId() (v int32) {
+func (p *TeamBriefInfo) GetTeamId() (v int32) {
 	return p.TeamId
 }
 
-func (p *TeamSkill) GetSkill() (v string) {
-	return p.Skill
+func (p *TeamBriefInfo) GetTitle() (v string) {
+	return p.Title
 }
 
-func (p *TeamSkill) GetCategory() (v string) {
-	return p.Category
+func (p *TeamBriefInfo) GetGoal() (v string) {
+	return p.Goal
 }
 
-func (p *TeamSkill) GetJob() (v string) {
-	return p.Job
-}
-func (p *TeamSkill) SetTeamSkillId(val int32) {
-	p.TeamSkillId = val
-}
-func (p *TeamSkill) SetTeamId(val int32) {
-	p.TeamId = val
-}
-func (p *TeamSkill) SetSkill(val string) {
-	p.Skill = val
-}
-func (p *TeamSkill) SetCategory(val string) {
-	p.Category = val
-}
-func (p *TeamSkill) SetJob(val string) {
-	p.Job = val
+func (p *TeamBriefInfo) GetCurPeopleNum() (v int32) {
+	return p.CurPeopleNum
 }
 
-var fieldIDToName_TeamSkill = map[int16]string{
-	1: "team_skill_id",
-	2: "team_id",
-	3: "skill",
-	4: "category",
-	5: "job",
+func (p *TeamBriefInfo) GetCreatedTime() (v int64) {
+	return p.CreatedTime
 }
 
-func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
+var TeamBriefInfo_LeaderInfo_DEFAULT *MemberInfo
 
-	var fieldTypeId thrift.TType
+func (p *TeamBriefInfo) GetLeaderInfo() (v *MemberInfo) {
+	if !p.IsSetLeaderInfo() {
+		return TeamBriefInfo_LeaderInfo_DEFAULT
+	}
+	return p.LeaderInfo
+}
+
+func (p *TeamBriefInfo) GetContestId() (v int32) {
+	return p.ContestId
+}
+
+var TeamBriefInfo_Recommendation_DEFAULT *TeamRecommendation
+
+func (p *TeamBriefInfo) GetRecommendation() (v *TeamRecommendation) {
+	if !p.IsSetRecommendation() {
+		return TeamBriefInfo_Recommendation_DEFAULT
+	}
+	return p.Recommendation
+}
+
+func (p *TeamBriefInfo) GetClosed() (v bool) {
+	return p.Closed
+}
+func (p *TeamBriefInfo) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamBriefInfo) SetTitle(val string) {
+	p.Title = val
+}
+func (p *TeamBriefInfo) SetGoal(val string) {
+	p.Goal = val
+}
+func (p *TeamBriefInfo) SetCurPeopleNum(val int32) {
+	p.CurPeopleNum = val
+}
+func (p *TeamBriefInfo) SetCreatedTime(val int64) {
+	p.CreatedTime = val
+}
+func (p *TeamBriefInfo) SetLeaderInfo(val *MemberInfo) {
+	p.LeaderInfo = val
+}
+func (p *TeamBriefInfo) SetContestId(val int32) {
+	p.ContestId = val
+}
+func (p *TeamBriefInfo) SetRecommendation(val *TeamRecommendation) {
+	p.Recommendation = val
+}
+func (p *TeamBriefInfo) SetClosed(val bool) {
+	p.Closed = val
+}
+
+var fieldIDToName_TeamBriefInfo = map[int16]string{
+	1: "team_id",
+	2: "title",
+	3: "goal",
+	4: "cur_people_num",
+	5: "created_time",
+	6: "leader_info",
+	7: "contest_id",
+	8: "recommendation",
+	9: "closed",
+}
+
+func (p *TeamBriefInfo) IsSetLeaderInfo() bool {
+	return p.LeaderInfo != nil
+}
+
+func (p *TeamBriefInfo) IsSetRecommendation() bool {
+	return p.Recommendation != nil
+}
+
+func (p *TeamBriefInfo) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
 	var fieldId int16
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
@@ -1169,7 +1301,7 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1189,7 +1321,7 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1199,7 +1331,7 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1208,6 +1340,46 @@ func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1228,7 +1400,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSkill[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamBriefInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1238,54 +1410,88 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamSkill) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamBriefInfo) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamSkillId = v
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamBriefInfo) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
+		p.Title = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamBriefInfo) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Skill = v
+		p.Goal = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamBriefInfo) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Category = v
+		p.CurPeopleNum = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamBriefInfo) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.Job = v
+		p.CreatedTime = v
 	}
 	return nil
 }
 
-func (p *TeamSkill) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamBriefInfo) ReadField6(iprot thrift.TProtocol) error {
+	p.LeaderInfo = NewMemberInfo()
+	if err := p.LeaderInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ContestId = v
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) ReadField8(iprot thrift.TProtocol) error {
+	p.Recommendation = NewTeamRecommendation()
+	if err := p.Recommendation.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Closed = v
+	}
+	return nil
+}
+
+func (p *TeamBriefInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamSkill"); err != nil {
+	if err = oprot.WriteStructBegin("TeamBriefInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1309,6 +1515,22 @@ func (p *TeamSkill) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -1328,11 +1550,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_skill_id", thrift.I32, 1); err != nil {
+func (p *TeamBriefInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamSkillId); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1345,11 +1567,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+func (p *TeamBriefInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1362,11 +1584,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("skill", thrift.STRING, 3); err != nil {
+func (p *TeamBriefInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Skill); err != nil {
+	if err := oprot.WriteString(p.Goal); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1379,11 +1601,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
+func (p *TeamBriefInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cur_people_num", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Category); err != nil {
+	if err := oprot.WriteI32(p.CurPeopleNum); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1396,11 +1618,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamSkill) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("job", thrift.STRING, 5); err != nil {
+func (p *TeamBriefInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Job); err != nil {
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1413,151 +1635,243 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamSkill) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("TeamSkill(%+v)", *p)
-}
-
-func (p *TeamSkill) DeepEqual(ano *TeamSkill) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+func (p *TeamBriefInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("leader_info", thrift.STRUCT, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field1DeepEqual(ano.TeamSkillId) {
-		return false
+	if err := p.LeaderInfo.Write(oprot); err != nil {
+		return err
 	}
-	if !p.Field2DeepEqual(ano.TeamId) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if !p.Field3DeepEqual(ano.Skill) {
-		return false
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamBriefInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field4DeepEqual(ano.Category) {
-		return false
+	if err := oprot.WriteI32(p.ContestId); err != nil {
+		return err
 	}
-	if !p.Field5DeepEqual(ano.Job) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TeamSkill) Field1DeepEqual(src int32) bool {
-
-	if p.TeamSkillId != src {
-		return false
-	}
+func (p *TeamBriefInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecommendation() {
+		if err = oprot.WriteFieldBegin("recommendation", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Recommendation.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamBriefInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("closed", thrift.BOOL, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Closed); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *TeamBriefInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamBriefInfo(%+v)", *p)
+}
+
+func (p *TeamBriefInfo) DeepEqual(ano *TeamBriefInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Goal) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CurPeopleNum) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CreatedTime) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.LeaderInfo) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ContestId) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Recommendation) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Closed) {
+		return false
+	}
 	return true
 }
-func (p *TeamSkill) Field2DeepEqual(src int32) bool {
+
+func (p *TeamBriefInfo) Field1DeepEqual(src int32) bool {
 
 	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamSkill) Field3DeepEqual(src string) bool {
+func (p *TeamBriefInfo) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.Skill, src) != 0 {
+	if strings.Compare(p.Title, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamSkill) Field4DeepEqual(src string) bool {
+func (p *TeamBriefInfo) Field3DeepEqual(src string) bool {
 
-	if strings.Compare(p.Category, src) != 0 {
+	if strings.Compare(p.Goal, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamSkill) Field5DeepEqual(src string) bool {
+func (p *TeamBriefInfo) Field4DeepEqual(src int32) bool {
 
-	if strings.Compare(p.Job, src) != 0 {
+	if p.CurPeopleNum != src {
 		return false
 	}
 	return true
 }
+func (p *TeamBriefInfo) Field5DeepEqual(src int64) bool {
 
-type TeamInfo struct {
-	TeamBriefInfo      *TeamBriefInfo `thrift:"team_brief_info,1" frugal:"1,default,TeamBriefInfo" json:"team_brief_info"`
-	Description        string         `thrift:"description,2" frugal:"2,default,string" json:"description"`
-	TeamSkills         []*TeamSkill   `thrift:"team_skills,3" frugal:"3,default,list<TeamSkill>" json:"team_skills"`
-	Members            []*MemberInfo  `thrift:"members,4" frugal:"4,default,list<MemberInfo>" json:"members"`
-	Embedding          string         `thrift:"embedding,5" frugal:"5,default,string" json:"embedding"`
-	EmbeddingUpdatedAt int64          `thrift:"embedding_updated_at,6" frugal:"6,default,i64" json:"embedding_updated_at"`
+	if p.CreatedTime != src {
+		return false
+	}
+	return true
 }
+func (p *TeamBriefInfo) Field6DeepEqual(src *MemberInfo) bool {
 
-func NewTeamInfo() *TeamInfo {
-	return &TeamInfo{}
+	if !p.LeaderInfo.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *TeamBriefInfo) Field7DeepEqual(src int32) bool {
 
-func (p *TeamInfo) InitDefault() {
-	*p = TeamInfo{}
+	if p.ContestId != src {
+		return false
+	}
+	return true
 }
+func (p *TeamBriefInfo) Field8DeepEqual(src *TeamRecommendation) bool {
 
-var TeamInfo_TeamBriefInfo_DEFAULT *TeamBriefInfo
+	if !p.Recommendation.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *TeamBriefInfo) Field9DeepEqual(src bool) bool {
 
-func (p *TeamInfo) GetTeamBriefInfo() (v *TeamBriefInfo) {
-	if !p.IsSetTeamBriefInfo() {
-		return TeamInfo_TeamBriefInfo_DEFAULT
+	if p.Closed != src {
+		return false
 	}
-	return p.TeamBriefInfo
+	return true
 }
 
-func (p *TeamInfo) GetDescription() (v string) {
-	return p.Description
+type TeamSkill struct {
+	TeamSkillId int32  `thrift:"team_skill_id,1" frugal:"1,default,i32" json:"team_skill_id"`
+	TeamId      int32  `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	Skill       string `thrift:"skill,3" frugal:"3,default,string" json:"skill"`
+	Category    string `thrift:"category,4" frugal:"4,default,string" json:"category"`
+	Job         string `thrift:"job,5" frugal:"5,default,string" json:"job"`
 }
 
-func (p *TeamInfo) GetTeamSkills() (v []*TeamSkill) {
-	return p.TeamSkills
+func NewTeamSkill() *TeamSkill {
+	return &TeamSkill{}
 }
 
-func (p *TeamInfo) GetMembers() (v []*MemberInfo) {
-	return p.Members
+func (p *TeamSkill) InitDefault() {
+	*p = TeamSkill{}
 }
 
-func (p *TeamInfo) GetEmbedding() (v string) {
-	return p.Embedding
+func (p *TeamSkill) GetTeamSkillId() (v int32) {
+	return p.TeamSkillId
 }
 
-func (p *TeamInfo) GetEmbeddingUpdatedAt() (v int64) {
-	return p.EmbeddingUpdatedAt
+func (p *TeamSkill) GetTeamId() (v int32) {
+	return p.TeamId
 }
-func (p *TeamInfo) SetTeamBriefInfo(val *TeamBriefInfo) {
-	p.TeamBriefInfo = val
+
+func (p *TeamSkill) GetSkill() (v string) {
+	return p.Skill
 }
-func (p *TeamInfo) SetDescription(val string) {
-	p.Description = val
+
+func (p *TeamSkill) GetCategory() (v string) {
+	return p.Category
 }
-func (p *TeamInfo) SetTeamSkills(val []*TeamSkill) {
-	p.TeamSkills = val
+
+func (p *TeamSkill) GetJob() (v string) {
+	return p.Job
 }
-func (p *TeamInfo) SetMembers(val []*MemberInfo) {
-	p.Members = val
+func (p *TeamSkill) SetTeamSkillId(val int32) {
+	p.TeamSkillId = val
 }
-func (p *TeamInfo) SetEmbedding(val string) {
-	p.Embedding = val
+func (p *TeamSkill) SetTeamId(val int32) {
+	p.TeamId = val
 }
-func (p *TeamInfo) SetEmbeddingUpdatedAt(val int64) {
-	p.EmbeddingUpdatedAt = val
+func (p *TeamSkill) SetSkill(val string) {
+	p.Skill = val
 }
-
-var fieldIDToName_TeamInfo = map[int16]string{
-	1: "team_brief_info",
-	2: "description",
-	3: "team_skills",
-	4: "members",
-	5: "embedding",
-	6: "embedding_updated_at",
+func (p *TeamSkill) SetCategory(val string) {
+	p.Category = val
+}
+func (p *TeamSkill) SetJob(val string) {
+	p.Job = val
 }
 
-func (p *TeamInfo) IsSetTeamBriefInfo() bool {
-	return p.TeamBriefInfo != nil
+var fieldIDToName_TeamSkill = map[int16]string{
+	1: "team_skill_id",
+	2: "team_id",
+	3: "skill",
+	4: "category",
+	5: "job",
 }
 
-func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamSkill) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1577,7 +1891,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1587,7 +1901,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1597,7 +1911,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1607,7 +1921,7 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1626,16 +1940,6 @@ func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1656,7 +1960,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSkill[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1666,84 +1970,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamInfo) ReadField1(iprot thrift.TProtocol) error {
-	p.TeamBriefInfo = NewTeamBriefInfo()
-	if err := p.TeamBriefInfo.Read(iprot); err != nil {
+func (p *TeamSkill) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.TeamSkillId = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamSkill) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Description = v
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *TeamSkill) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.Skill = v
 	}
-	p.TeamSkills = make([]*TeamSkill, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamSkill()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	return nil
+}
 
-		p.TeamSkills = append(p.TeamSkills, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *TeamSkill) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.Category = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *TeamSkill) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
-	}
-	p.Members = make([]*MemberInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewMemberInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		p.Members = append(p.Members, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (p *TeamInfo) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Embedding = v
+	} else {
+		p.Job = v
 	}
 	return nil
 }
 
-func (p *TeamInfo) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		p.EmbeddingUpdatedAt = v
-	}
-	return nil
-}
-
-func (p *TeamInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamSkill) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfo"); err != nil {
+	if err = oprot.WriteStructBegin("TeamSkill"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1767,10 +2041,6 @@ func (p *TeamInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -1790,11 +2060,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_brief_info", thrift.STRUCT, 1); err != nil {
+func (p *TeamSkill) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_skill_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.TeamBriefInfo.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.TeamSkillId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1807,11 +2077,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
+func (p *TeamSkill) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1824,19 +2094,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 3); err != nil {
+func (p *TeamSkill) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("skill", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
-		return err
-	}
-	for _, v := range p.TeamSkills {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.Skill); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1849,19 +2111,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("members", thrift.LIST, 4); err != nil {
+func (p *TeamSkill) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
-		return err
-	}
-	for _, v := range p.Members {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.Category); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1874,11 +2128,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("embedding", thrift.STRING, 5); err != nil {
+func (p *TeamSkill) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRING, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Embedding); err != nil {
+	if err := oprot.WriteString(p.Job); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1891,190 +2145,151 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("embedding_updated_at", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EmbeddingUpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *TeamInfo) String() string {
+func (p *TeamSkill) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamInfo(%+v)", *p)
+	return fmt.Sprintf("TeamSkill(%+v)", *p)
 }
 
-func (p *TeamInfo) DeepEqual(ano *TeamInfo) bool {
+func (p *TeamSkill) DeepEqual(ano *TeamSkill) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TeamBriefInfo) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Description) {
+	if !p.Field1DeepEqual(ano.TeamSkillId) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.TeamSkills) {
+	if !p.Field2DeepEqual(ano.TeamId) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Members) {
+	if !p.Field3DeepEqual(ano.Skill) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Embedding) {
+	if !p.Field4DeepEqual(ano.Category) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.EmbeddingUpdatedAt) {
+	if !p.Field5DeepEqual(ano.Job) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamInfo) Field1DeepEqual(src *TeamBriefInfo) bool {
+func (p *TeamSkill) Field1DeepEqual(src int32) bool {
 
-	if !p.TeamBriefInfo.DeepEqual(src) {
+	if p.TeamSkillId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamInfo) Field2DeepEqual(src string) bool {
+func (p *TeamSkill) Field2DeepEqual(src int32) bool {
 
-	if strings.Compare(p.Description, src) != 0 {
+	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamInfo) Field3DeepEqual(src []*TeamSkill) bool {
+func (p *TeamSkill) Field3DeepEqual(src string) bool {
 
-	if len(p.TeamSkills) != len(src) {
+	if strings.Compare(p.Skill, src) != 0 {
 		return false
 	}
-	for i, v := range p.TeamSkills {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *TeamInfo) Field4DeepEqual(src []*MemberInfo) bool {
+func (p *TeamSkill) Field4DeepEqual(src string) bool {
 
-	if len(p.Members) != len(src) {
+	if strings.Compare(p.Category, src) != 0 {
 		return false
 	}
-	for i, v := range p.Members {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *TeamInfo) Field5DeepEqual(src string) bool {
+func (p *TeamSkill) Field5DeepEqual(src string) bool {
 
-	if strings.Compare(p.Embedding, src) != 0 {
+	if strings.Compare(p.Job, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamInfo) Field6DeepEqual(src int64) bool {
 
-	if p.EmbeddingUpdatedAt != src {
-		return false
-	}
-	return true
+type TeamInfo struct {
+	TeamBriefInfo      *TeamBriefInfo `thrift:"team_brief_info,1" frugal:"1,default,TeamBriefInfo" json:"team_brief_info"`
+	Description        string         `thrift:"description,2" frugal:"2,default,string" json:"description"`
+	TeamSkills         []*TeamSkill   `thrift:"team_skills,3" frugal:"3,default,list<TeamSkill>" json:"team_skills"`
+	Members            []*MemberInfo  `thrift:"members,4" frugal:"4,default,list<MemberInfo>" json:"members"`
+	Embedding          string         `thrift:"embedding,5" frugal:"5,default,string" json:"embedding"`
+	EmbeddingUpdatedAt int64          `thrift:"embedding_updated_at,6" frugal:"6,default,i64" json:"embedding_updated_at"`
 }
 
-type TeamApplication struct {
-	TeamId          int32       `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
-	Reason          string      `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
-	CreatedTime     int64       `thrift:"created_time,3" frugal:"3,default,i64" json:"created_time"`
-	ApplicationType int32       `thrift:"application_type,4" frugal:"4,default,i32" json:"application_type"`
-	MemberInfo      *MemberInfo `thrift:"member_info,5" frugal:"5,default,MemberInfo" json:"member_info"`
-	ApplicationId   int32       `thrift:"application_id,6" frugal:"6,default,i32" json:"application_id"`
+func NewTeamInfo() *TeamInfo {
+	return &TeamInfo{}
 }
 
-func NewTeamApplication() *TeamApplication {
-	return &TeamApplication{}
+func (p *TeamInfo) InitDefault() {
+	*p = TeamInfo{}
 }
 
-func (p *TeamApplication) InitDefault() {
-	*p = TeamApplication{}
-}
+var TeamInfo_TeamBriefInfo_DEFAULT *TeamBriefInfo
 
-func (p *TeamApplication) GetTeamId() (v int32) {
-	return p.TeamId
+func (p *TeamInfo) GetTeamBriefInfo() (v *TeamBriefInfo) {
+	if !p.IsSetTeamBriefInfo() {
+		return TeamInfo_TeamBriefInfo_DEFAULT
+	}
+	return p.TeamBriefInfo
 }
 
-func (p *TeamApplication) GetReason() (v string) {
-	return p.Reason
+func (p *TeamInfo) GetDescription() (v string) {
+	return p.Description
 }
 
-func (p *TeamApplication) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func (p *TeamInfo) GetTeamSkills() (v []*TeamSkill) {
+	return p.TeamSkills
 }
 
-func (p *TeamApplication) GetApplicationType() (v int32) {
-	return p.ApplicationType
+func (p *TeamInfo) GetMembers() (v []*MemberInfo) {
+	return p.Members
 }
 
-var TeamApplication_MemberInfo_DEFAULT *MemberInfo
-
-func (p *TeamApplication) GetMemberInfo() (v *MemberInfo) {
-	if !p.IsSetMemberInfo() {
-		return TeamApplication_MemberInfo_DEFAULT
-	}
-	return p.MemberInfo
+func (p *TeamInfo) GetEmbedding() (v string) {
+	return p.Embedding
 }
 
-func (p *TeamApplication) GetApplicationId() (v int32) {
-	return p.ApplicationId
+func (p *TeamInfo) GetEmbeddingUpdatedAt() (v int64) {
+	return p.EmbeddingUpdatedAt
 }
-func (p *TeamApplication) SetTeamId(val int32) {
-	p.TeamId = val
+func (p *TeamInfo) SetTeamBriefInfo(val *TeamBriefInfo) {
+	p.TeamBriefInfo = val
 }
-func (p *TeamApplication) SetReason(val string) {
-	p.Reason = val
+func (p *TeamInfo) SetDescription(val string) {
+	p.Description = val
 }
-func (p *TeamApplication) SetCreatedTime(val int64) {
-	p.CreatedTime = val
+func (p *TeamInfo) SetTeamSkills(val []*TeamSkill) {
+	p.TeamSkills = val
 }
-func (p *TeamApplication) SetApplicationType(val int32) {
-	p.ApplicationType = val
+func (p *TeamInfo) SetMembers(val []*MemberInfo) {
+	p.Members = val
 }
-func (p *TeamApplication) SetMemberInfo(val *MemberInfo) {
-	p.MemberInfo = val
+func (p *TeamInfo) SetEmbedding(val string) {
+	p.Embedding = val
 }
-func (p *TeamApplication) SetApplicationId(val int32) {
-	p.ApplicationId = val
+func (p *TeamInfo) SetEmbeddingUpdatedAt(val int64) {
+	p.EmbeddingUpdatedAt = val
 }
 
-var fieldIDToName_TeamApplication = map[int16]string{
-	1: "team_id",
-	2: "reason",
-	3: "created_time",
-	4: "application_type",
-	5: "member_info",
-	6: "application_id",
+var fieldIDToName_TeamInfo = map[int16]string{
+	1: "team_brief_info",
+	2: "description",
+	3: "team_skills",
+	4: "members",
+	5: "embedding",
+	6: "embedding_updated_at",
 }
 
-func (p *TeamApplication) IsSetMemberInfo() bool {
-	return p.MemberInfo != nil
+func (p *TeamInfo) IsSetTeamBriefInfo() bool {
+	return p.TeamBriefInfo != nil
 }
 
-func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInfo) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2094,7 +2309,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2114,7 +2329,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2124,7 +2339,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2134,7 +2349,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2144,7 +2359,7 @@ func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2173,7 +2388,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplication[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2183,62 +2398,84 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamApplication) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamInfo) ReadField1(iprot thrift.TProtocol) error {
+	p.TeamBriefInfo = NewTeamBriefInfo()
+	if err := p.TeamBriefInfo.Read(iprot); err != nil {
 		return err
-	} else {
-		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamInfo) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Reason = v
+		p.Description = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *TeamInfo) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamSkills = make([]*TeamSkill, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamSkill()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamSkills = append(p.TeamSkills, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		p.CreatedTime = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamInfo) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Members = make([]*MemberInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewMemberInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Members = append(p.Members, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		p.ApplicationType = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField5(iprot thrift.TProtocol) error {
-	p.MemberInfo = NewMemberInfo()
-	if err := p.MemberInfo.Read(iprot); err != nil {
+func (p *TeamInfo) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		p.Embedding = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamInfo) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.ApplicationId = v
+		p.EmbeddingUpdatedAt = v
 	}
 	return nil
 }
 
-func (p *TeamApplication) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplication"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2285,11 +2522,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
+func (p *TeamInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_brief_info", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := p.TeamBriefInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2302,11 +2539,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+func (p *TeamInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Reason); err != nil {
+	if err := oprot.WriteString(p.Description); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2319,11 +2556,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 3); err != nil {
+func (p *TeamInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamSkills {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2336,11 +2581,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 4); err != nil {
+func (p *TeamInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("members", thrift.LIST, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationType); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
+		return err
+	}
+	for _, v := range p.Members {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2353,11 +2606,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 5); err != nil {
+func (p *TeamInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embedding", thrift.STRING, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.MemberInfo.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.Embedding); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2370,11 +2623,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamApplication) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 6); err != nil {
+func (p *TeamInfo) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embedding_updated_at", thrift.I64, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationId); err != nil {
+	if err := oprot.WriteI64(p.EmbeddingUpdatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2387,161 +2640,200 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamApplication) String() string {
+func (p *TeamInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamApplication(%+v)", *p)
+	return fmt.Sprintf("TeamInfo(%+v)", *p)
 }
 
-func (p *TeamApplication) DeepEqual(ano *TeamApplication) bool {
+func (p *TeamInfo) DeepEqual(ano *TeamInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TeamId) {
+	if !p.Field1DeepEqual(ano.TeamBriefInfo) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Reason) {
+	if !p.Field2DeepEqual(ano.Description) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.CreatedTime) {
+	if !p.Field3DeepEqual(ano.TeamSkills) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.ApplicationType) {
+	if !p.Field4DeepEqual(ano.Members) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.MemberInfo) {
+	if !p.Field5DeepEqual(ano.Embedding) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.ApplicationId) {
+	if !p.Field6DeepEqual(ano.EmbeddingUpdatedAt) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamApplication) Field1DeepEqual(src int32) bool {
+func (p *TeamInfo) Field1DeepEqual(src *TeamBriefInfo) bool {
 
-	if p.TeamId != src {
+	if !p.TeamBriefInfo.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *TeamApplication) Field2DeepEqual(src string) bool {
+func (p *TeamInfo) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.Reason, src) != 0 {
+	if strings.Compare(p.Description, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamApplication) Field3DeepEqual(src int64) bool {
+func (p *TeamInfo) Field3DeepEqual(src []*TeamSkill) bool {
 
-	if p.CreatedTime != src {
+	if len(p.TeamSkills) != len(src) {
 		return false
 	}
+	for i, v := range p.TeamSkills {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *TeamApplication) Field4DeepEqual(src int32) bool {
+func (p *TeamInfo) Field4DeepEqual(src []*MemberInfo) bool {
 
-	if p.ApplicationType != src {
+	if len(p.Members) != len(src) {
 		return false
 	}
+	for i, v := range p.Members {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *TeamApplication) Field5DeepEqual(src *MemberInfo) bool {
+func (p *TeamInfo) Field5DeepEqual(src string) bool {
 
-	if !p.MemberInfo.DeepEqual(src) {
+	if strings.Compare(p.Embedding, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamApplication) Field6DeepEqual(src int32) bool {
+func (p *TeamInfo) Field6DeepEqual(src int64) bool {
 
-	if p.ApplicationId != src {
+	if p.EmbeddingUpdatedAt != src {
 		return false
 	}
 	return true
 }
 
-type TeamCreateRequest struct {
-	UserId      int32        `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
-	TeamId      int32        `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
-	Title       string       `thrift:"title,3" frugal:"3,default,string" json:"title"`
-	Goal        string       `thrift:"goal,4" frugal:"4,default,string" json:"goal"`
-	Description string       `thrift:"description,5" frugal:"5,default,string" json:"description"`
-	TeamSkills  []*TeamSkill `thrift:"team_skills,6" frugal:"6,default,list<TeamSkill>" json:"team_skills"`
-	ContestId   int32        `thrift:"contest_id,7" frugal:"7,default,i32" json:"contest_id"`
+type TeamApplication struct {
+	TeamId          int32       `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
+	Reason          string      `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
+	CreatedTime     int64       `thrift:"created_time,3" frugal:"3,default,i64" json:"created_time"`
+	ApplicationType int32       `thrift:"application_type,4" frugal:"4,default,i32" json:"application_type"`
+	MemberInfo      *MemberInfo `thrift:"member_info,5" frugal:"5,default,MemberInfo" json:"member_info"`
+	ApplicationId   int32       `thrift:"application_id,6" frugal:"6,default,i32" json:"application_id"`
+	Status          string      `thrift:"status,7" frugal:"7,default,string" json:"status"`
+	DecidedTime     int64       `thrift:"decided_time,8" frugal:"8,default,i64" json:"decided_time"`
+	DecidedBy       int32       `thrift:"decided_by,9" frugal:"9,default,i32" json:"decided_by"`
 }
 
-func NewTeamCreateRequest() *TeamCreateRequest {
-	return &TeamCreateRequest{}
+func NewTeamApplication() *TeamApplication {
+	return &TeamApplication{}
 }
 
-func (p *TeamCreateRequest) InitDefault() {
-	*p = TeamCreateRequest{}
+func (p *TeamApplication) InitDefault() {
+	*p = TeamApplication{}
 }
 
-func (p *TeamCreateRequest) GetUserId() (v int32) {
-	return p.UserId
+func (p *TeamApplication) GetTeamId() (v int32) {
+	return p.TeamId
 }
 
-func (p *TeamCreateRequest) GetTeamId() (v int32) {
-	return p.TeamId
+func (p *TeamApplication) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *TeamCreateRequest) GetTitle() (v string) {
-	return p.Title
+func (p *TeamApplication) GetCreatedTime() (v int64) {
+	return p.CreatedTime
 }
 
-func (p *TeamCreateRequest) GetGoal() (v string) {
-	return p.Goal
+func (p *TeamApplication) GetApplicationType() (v int32) {
+	return p.ApplicationType
 }
 
-func (p *TeamCreateRequest) GetDescription() (v string) {
-	return p.Description
+var TeamApplication_MemberInfo_DEFAULT *MemberInfo
+
+func (p *TeamApplication) GetMemberInfo() (v *MemberInfo) {
+	if !p.IsSetMemberInfo() {
+		return TeamApplication_MemberInfo_DEFAULT
+	}
+	return p.MemberInfo
 }
 
-func (p *TeamCreateRequest) GetTeamSkills() (v []*TeamSkill) {
-	return p.TeamSkills
+func (p *TeamApplication) GetApplicationId() (v int32) {
+	return p.ApplicationId
 }
 
-func (p *TeamCreateRequest) GetContestId() (v int32) {
-	return p.ContestId
+func (p *TeamApplication) GetStatus() (v string) {
+	return p.Status
 }
-func (p *TeamCreateRequest) SetUserId(val int32) {
-	p.UserId = val
+
+func (p *TeamApplication) GetDecidedTime() (v int64) {
+	return p.DecidedTime
 }
-func (p *TeamCreateRequest) SetTeamId(val int32) {
-	p.TeamId = val
+
+func (p *TeamApplication) GetDecidedBy() (v int32) {
+	return p.DecidedBy
 }
-func (p *TeamCreateRequest) SetTitle(val string) {
-	p.Title = val
+func (p *TeamApplication) SetTeamId(val int32) {
+	p.TeamId = val
 }
-func (p *TeamCreateRequest) SetGoal(val string) {
-	p.Goal = val
+func (p *TeamApplication) SetReason(val string) {
+	p.Reason = val
 }
-func (p *TeamCreateRequest) SetDescription(val string) {
-	p.Description = val
+func (p *TeamApplication) SetCreatedTime(val int64) {
+	p.CreatedTime = val
 }
-func (p *TeamCreateRequest) SetTeamSkills(val []*TeamSkill) {
-	p.TeamSkills = val
+func (p *TeamApplication) SetApplicationType(val int32) {
+	p.ApplicationType = val
 }
-func (p *TeamCreateRequest) SetContestId(val int32) {
-	p.ContestId = val
+func (p *TeamApplication) SetMemberInfo(val *MemberInfo) {
+	p.MemberInfo = val
+}
+func (p *TeamApplication) SetApplicationId(val int32) {
+	p.ApplicationId = val
+}
+func (p *TeamApplication) SetStatus(val string) {
+	p.Status = val
+}
+func (p *TeamApplication) SetDecidedTime(val int64) {
+	p.DecidedTime = val
+}
+func (p *TeamApplication) SetDecidedBy(val int32) {
+	p.DecidedBy = val
 }
 
-var fieldIDToName_TeamCreateRequest = map[int16]string{
-	1: "user_id",
-	2: "team_id",
-	3: "title",
-	4: "goal",
-	5: "description",
-	6: "team_skills",
-	7: "contest_id",
+var fieldIDToName_TeamApplication = map[int16]string{
+	1: "team_id",
+	2: "reason",
+	3: "created_time",
+	4: "application_type",
+	5: "member_info",
+	6: "application_id",
+	7: "status",
+	8: "decided_time",
+	9: "decided_by",
 }
 
-func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplication) IsSetMemberInfo() bool {
+	return p.MemberInfo != nil
+}
+
+func (p *TeamApplication) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2571,7 +2863,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2581,7 +2873,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2591,7 +2883,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2601,7 +2893,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2611,7 +2903,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2621,7 +2913,7 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 7:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2630,6 +2922,26 @@ func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2650,7 +2962,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplication[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2660,83 +2972,89 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplication) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.UserId = v
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamApplication) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
+		p.Reason = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamApplication) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		p.Title = v
+		p.CreatedTime = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamApplication) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Goal = v
+		p.ApplicationType = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TeamApplication) ReadField5(iprot thrift.TProtocol) error {
+	p.MemberInfo = NewMemberInfo()
+	if err := p.MemberInfo.Read(iprot); err != nil {
 		return err
-	} else {
-		p.Description = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField6(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *TeamApplication) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.ApplicationId = v
 	}
-	p.TeamSkills = make([]*TeamSkill, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamSkill()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	return nil
+}
 
-		p.TeamSkills = append(p.TeamSkills, _elem)
+func (p *TeamApplication) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	return nil
+}
+
+func (p *TeamApplication) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		p.DecidedTime = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) ReadField7(iprot thrift.TProtocol) error {
+func (p *TeamApplication) ReadField9(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ContestId = v
+		p.DecidedBy = v
 	}
 	return nil
 }
 
-func (p *TeamCreateRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplication) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplication"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2768,6 +3086,14 @@ func (p *TeamCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -2787,11 +3113,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+func (p *TeamApplication) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserId); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2804,11 +3130,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+func (p *TeamApplication) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteString(p.Reason); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2821,11 +3147,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
+func (p *TeamApplication) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2838,11 +3164,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 4); err != nil {
+func (p *TeamApplication) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Goal); err != nil {
+	if err := oprot.WriteI32(p.ApplicationType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2855,11 +3181,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+func (p *TeamApplication) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Description); err != nil {
+	if err := p.MemberInfo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2872,19 +3198,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 6); err != nil {
+func (p *TeamApplication) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
-		return err
-	}
-	for _, v := range p.TeamSkills {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteI32(p.ApplicationId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2897,11 +3215,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 7); err != nil {
+func (p *TeamApplication) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestId); err != nil {
+	if err := oprot.WriteString(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2914,141 +3232,261 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *TeamCreateRequest) String() string {
+func (p *TeamApplication) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decided_time", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DecidedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamApplication) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decided_by", thrift.I32, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.DecidedBy); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *TeamApplication) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamCreateRequest(%+v)", *p)
+	return fmt.Sprintf("TeamApplication(%+v)", *p)
 }
 
-func (p *TeamCreateRequest) DeepEqual(ano *TeamCreateRequest) bool {
+func (p *TeamApplication) DeepEqual(ano *TeamApplication) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.TeamId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.TeamId) {
+	if !p.Field2DeepEqual(ano.Reason) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Title) {
+	if !p.Field3DeepEqual(ano.CreatedTime) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Goal) {
+	if !p.Field4DeepEqual(ano.ApplicationType) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Description) {
+	if !p.Field5DeepEqual(ano.MemberInfo) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.TeamSkills) {
+	if !p.Field6DeepEqual(ano.ApplicationId) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.ContestId) {
+	if !p.Field7DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.DecidedTime) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.DecidedBy) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamCreateRequest) Field1DeepEqual(src int32) bool {
+func (p *TeamApplication) Field1DeepEqual(src int32) bool {
 
-	if p.UserId != src {
+	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateRequest) Field2DeepEqual(src int32) bool {
+func (p *TeamApplication) Field2DeepEqual(src string) bool {
 
-	if p.TeamId != src {
+	if strings.Compare(p.Reason, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateRequest) Field3DeepEqual(src string) bool {
+func (p *TeamApplication) Field3DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Title, src) != 0 {
+	if p.CreatedTime != src {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateRequest) Field4DeepEqual(src string) bool {
+func (p *TeamApplication) Field4DeepEqual(src int32) bool {
 
-	if strings.Compare(p.Goal, src) != 0 {
+	if p.ApplicationType != src {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateRequest) Field5DeepEqual(src string) bool {
+func (p *TeamApplication) Field5DeepEqual(src *MemberInfo) bool {
 
-	if strings.Compare(p.Description, src) != 0 {
+	if !p.MemberInfo.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateRequest) Field6DeepEqual(src []*TeamSkill) bool {
+func (p *TeamApplication) Field6DeepEqual(src int32) bool {
 
-	if len(p.TeamSkills) != len(src) {
+	if p.ApplicationId != src {
 		return false
 	}
-	for i, v := range p.TeamSkills {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	return true
+}
+func (p *TeamApplication) Field7DeepEqual(src string) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *TeamCreateRequest) Field7DeepEqual(src int32) bool {
+func (p *TeamApplication) Field8DeepEqual(src int64) bool {
 
-	if p.ContestId != src {
+	if p.DecidedTime != src {
 		return false
 	}
 	return true
 }
+func (p *TeamApplication) Field9DeepEqual(src int32) bool {
 
-type TeamCreateResponse struct {
-	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
-	TeamId     int32  `thrift:"team_id,3" frugal:"3,default,i32" json:"team_id"`
+	if p.DecidedBy != src {
+		return false
+	}
+	return true
 }
 
-func NewTeamCreateResponse() *TeamCreateResponse {
-	return &TeamCreateResponse{}
+type TeamInvitation struct {
+	InvitationId int32       `thrift:"invitation_id,1" frugal:"1,default,i32" json:"invitation_id"`
+	TeamId       int32       `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	TeamTitle    string      `thrift:"team_title,3" frugal:"3,default,string" json:"team_title"`
+	ContestId    int32       `thrift:"contest_id,4" frugal:"4,default,i32" json:"contest_id"`
+	InviterInfo  *MemberInfo `thrift:"inviter_info,5" frugal:"5,default,MemberInfo" json:"inviter_info"`
+	InviteeId    int32       `thrift:"invitee_id,6" frugal:"6,default,i32" json:"invitee_id"`
+	Message      string      `thrift:"message,7" frugal:"7,default,string" json:"message"`
+	CreatedTime  int64       `thrift:"created_time,8" frugal:"8,default,i64" json:"created_time"`
+	Status       string      `thrift:"status,9" frugal:"9,default,string" json:"status"`
+	DecidedTime  int64       `thrift:"decided_time,10" frugal:"10,default,i64" json:"decided_time"`
 }
 
-func (p *TeamCreateResponse) InitDefault() {
-	*p = TeamCreateResponse{}
+func NewTeamInvitation() *TeamInvitation {
+	return &TeamInvitation{}
 }
 
-func (p *TeamCreateResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *TeamInvitation) InitDefault() {
+	*p = TeamInvitation{}
 }
 
-func (p *TeamCreateResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *TeamInvitation) GetInvitationId() (v int32) {
+	return p.InvitationId
 }
 
-func (p *TeamCreateResponse) GetTeamId() (v int32) {
+func (p *TeamInvitation) GetTeamId() (v int32) {
 	return p.TeamId
 }
-func (p *TeamCreateResponse) SetStatusCode(val int32) {
-	p.StatusCode = val
+
+func (p *TeamInvitation) GetTeamTitle() (v string) {
+	return p.TeamTitle
 }
-func (p *TeamCreateResponse) SetStatusMsg(val string) {
-	p.StatusMsg = val
+
+func (p *TeamInvitation) GetContestId() (v int32) {
+	return p.ContestId
 }
-func (p *TeamCreateResponse) SetTeamId(val int32) {
+
+var TeamInvitation_InviterInfo_DEFAULT *MemberInfo
+
+func (p *TeamInvitation) GetInviterInfo() (v *MemberInfo) {
+	if !p.IsSetInviterInfo() {
+		return TeamInvitation_InviterInfo_DEFAULT
+	}
+	return p.InviterInfo
+}
+
+func (p *TeamInvitation) GetInviteeId() (v int32) {
+	return p.InviteeId
+}
+
+func (p *TeamInvitation) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *TeamInvitation) GetCreatedTime() (v int64) {
+	return p.CreatedTime
+}
+
+func (p *TeamInvitation) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamInvitation) GetDecidedTime() (v int64) {
+	return p.DecidedTime
+}
+func (p *TeamInvitation) SetInvitationId(val int32) {
+	p.InvitationId = val
+}
+func (p *TeamInvitation) SetTeamId(val int32) {
 	p.TeamId = val
 }
+func (p *TeamInvitation) SetTeamTitle(val string) {
+	p.TeamTitle = val
+}
+func (p *TeamInvitation) SetContestId(val int32) {
+	p.ContestId = val
+}
+func (p *TeamInvitation) SetInviterInfo(val *MemberInfo) {
+	p.InviterInfo = val
+}
+func (p *TeamInvitation) SetInviteeId(val int32) {
+	p.InviteeId = val
+}
+func (p *TeamInvitation) SetMessage(val string) {
+	p.Message = val
+}
+func (p *TeamInvitation) SetCreatedTime(val int64) {
+	p.CreatedTime = val
+}
+func (p *TeamInvitation) SetStatus(val string) {
+	p.Status = val
+}
+func (p *TeamInvitation) SetDecidedTime(val int64) {
+	p.DecidedTime = val
+}
 
-var fieldIDToName_TeamCreateResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
-	3: "team_id",
+var fieldIDToName_TeamInvitation = map[int16]string{
+	1:  "invitation_id",
+	2:  "team_id",
+	3:  "team_title",
+	4:  "contest_id",
+	5:  "inviter_info",
+	6:  "invitee_id",
+	7:  "message",
+	8:  "created_time",
+	9:  "status",
+	10: "decided_time",
 }
 
-func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInvitation) IsSetInviterInfo() bool {
+	return p.InviterInfo != nil
+}
+
+func (p *TeamInvitation) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3078,7 +3516,7 @@ func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3088,7 +3526,7 @@ func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3097,6 +3535,76 @@ func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3117,7 +3625,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitation[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3127,36 +3635,98 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamInvitation) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.StatusCode = v
+		p.InvitationId = v
 	}
 	return nil
 }
 
-func (p *TeamCreateResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamInvitation) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.StatusMsg = v
+		p.TeamTitle = v
 	}
 	return nil
 }
 
-func (p *TeamCreateResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamInvitation) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
+		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *TeamCreateResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInvitation) ReadField5(iprot thrift.TProtocol) error {
+	p.InviterInfo = NewMemberInfo()
+	if err := p.InviterInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InviteeId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CreatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) ReadField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.DecidedTime = v
+	}
+	return nil
+}
+
+func (p *TeamInvitation) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreateResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitation"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3172,6 +3742,34 @@ func (p *TeamCreateResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3191,11 +3789,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+func (p *TeamInvitation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
+	if err := oprot.WriteI32(p.InvitationId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3208,11 +3806,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+func (p *TeamInvitation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StatusMsg); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3225,11 +3823,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
+func (p *TeamInvitation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_title", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteString(p.TeamTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3242,104 +3840,320 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TeamInvitation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("TeamCreateResponse(%+v)", *p)
+	if err := oprot.WriteI32(p.ContestId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) DeepEqual(ano *TeamCreateResponse) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.StatusCode) {
-		return false
+func (p *TeamInvitation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("inviter_info", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	if !p.Field2DeepEqual(ano.StatusMsg) {
-		return false
+	if err := p.InviterInfo.Write(oprot); err != nil {
+		return err
 	}
-	if !p.Field3DeepEqual(ano.TeamId) {
-		return false
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TeamCreateResponse) Field1DeepEqual(src int32) bool {
-
-	if p.StatusCode != src {
+func (p *TeamInvitation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitee_id", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InviteeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *TeamInvitation) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("decided_time", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DecidedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *TeamInvitation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitation(%+v)", *p)
+}
+
+func (p *TeamInvitation) DeepEqual(ano *TeamInvitation) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.InvitationId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TeamTitle) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ContestId) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.InviterInfo) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.InviteeId) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CreatedTime) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.DecidedTime) {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateResponse) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.StatusMsg, src) != 0 {
+func (p *TeamInvitation) Field1DeepEqual(src int32) bool {
+
+	if p.InvitationId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamCreateResponse) Field3DeepEqual(src int32) bool {
+func (p *TeamInvitation) Field2DeepEqual(src int32) bool {
 
 	if p.TeamId != src {
 		return false
 	}
 	return true
 }
+func (p *TeamInvitation) Field3DeepEqual(src string) bool {
 
-type TeamListRequest struct {
-	ContestId int32 `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
-	Limit     int32 `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
-	Offset    int32 `thrift:"offset,3" frugal:"3,default,i32" json:"offset"`
-	UserId    int32 `thrift:"user_id,4" frugal:"4,default,i32" json:"user_id"`
+	if strings.Compare(p.TeamTitle, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TeamInvitation) Field4DeepEqual(src int32) bool {
 
-func NewTeamListRequest() *TeamListRequest {
-	return &TeamListRequest{}
+	if p.ContestId != src {
+		return false
+	}
+	return true
 }
+func (p *TeamInvitation) Field5DeepEqual(src *MemberInfo) bool {
 
-func (p *TeamListRequest) InitDefault() {
-	*p = TeamListRequest{}
+	if !p.InviterInfo.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *TeamInvitation) Field6DeepEqual(src int32) bool {
 
-func (p *TeamListRequest) GetContestId() (v int32) {
-	return p.ContestId
+	if p.InviteeId != src {
+		return false
+	}
+	return true
 }
+func (p *TeamInvitation) Field7DeepEqual(src string) bool {
 
-func (p *TeamListRequest) GetLimit() (v int32) {
-	return p.Limit
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TeamInvitation) Field8DeepEqual(src int64) bool {
 
-func (p *TeamListRequest) GetOffset() (v int32) {
-	return p.Offset
+	if p.CreatedTime != src {
+		return false
+	}
+	return true
 }
+func (p *TeamInvitation) Field9DeepEqual(src string) bool {
 
-func (p *TeamListRequest) GetUserId() (v int32) {
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitation) Field10DeepEqual(src int64) bool {
+
+	if p.DecidedTime != src {
+		return false
+	}
+	return true
+}
+
+type TeamCreateRequest struct {
+	UserId      int32        `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId      int32        `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	Title       string       `thrift:"title,3" frugal:"3,default,string" json:"title"`
+	Goal        string       `thrift:"goal,4" frugal:"4,default,string" json:"goal"`
+	Description string       `thrift:"description,5" frugal:"5,default,string" json:"description"`
+	TeamSkills  []*TeamSkill `thrift:"team_skills,6" frugal:"6,default,list<TeamSkill>" json:"team_skills"`
+	ContestId   int32        `thrift:"contest_id,7" frugal:"7,default,i32" json:"contest_id"`
+}
+
+func NewTeamCreateRequest() *TeamCreateRequest {
+	return &TeamCreateRequest{}
+}
+
+func (p *TeamCreateRequest) InitDefault() {
+	*p = TeamCreateRequest{}
+}
+
+func (p *TeamCreateRequest) GetUserId() (v int32) {
 	return p.UserId
 }
-func (p *TeamListRequest) SetContestId(val int32) {
-	p.ContestId = val
+
+func (p *TeamCreateRequest) GetTeamId() (v int32) {
+	return p.TeamId
 }
-func (p *TeamListRequest) SetLimit(val int32) {
-	p.Limit = val
+
+func (p *TeamCreateRequest) GetTitle() (v string) {
+	return p.Title
 }
-func (p *TeamListRequest) SetOffset(val int32) {
-	p.Offset = val
+
+func (p *TeamCreateRequest) GetGoal() (v string) {
+	return p.Goal
 }
-func (p *TeamListRequest) SetUserId(val int32) {
+
+func (p *TeamCreateRequest) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *TeamCreateRequest) GetTeamSkills() (v []*TeamSkill) {
+	return p.TeamSkills
+}
+
+func (p *TeamCreateRequest) GetContestId() (v int32) {
+	return p.ContestId
+}
+func (p *TeamCreateRequest) SetUserId(val int32) {
 	p.UserId = val
 }
+func (p *TeamCreateRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamCreateRequest) SetTitle(val string) {
+	p.Title = val
+}
+func (p *TeamCreateRequest) SetGoal(val string) {
+	p.Goal = val
+}
+func (p *TeamCreateRequest) SetDescription(val string) {
+	p.Description = val
+}
+func (p *TeamCreateRequest) SetTeamSkills(val []*TeamSkill) {
+	p.TeamSkills = val
+}
+func (p *TeamCreateRequest) SetContestId(val int32) {
+	p.ContestId = val
+}
 
-var fieldIDToName_TeamListRequest = map[int16]string{
-	1: "contest_id",
-	2: "limit",
-	3: "offset",
-	4: "user_id",
+var fieldIDToName_TeamCreateRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "title",
+	4: "goal",
+	5: "description",
+	6: "team_skills",
+	7: "contest_id",
 }
 
-func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamCreateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3379,7 +4193,7 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3389,7 +4203,7 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3398,6 +4212,36 @@ func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3418,7 +4262,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3428,45 +4272,83 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamListRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamCreateRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ContestId = v
+		p.UserId = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamCreateRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Limit = v
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *TeamCreateRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.Offset = v
+		p.Title = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *TeamCreateRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Goal = v
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamSkills = make([]*TeamSkill, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamSkill()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamSkills = append(p.TeamSkills, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamCreateRequest) ReadField7(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.UserId = v
+		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *TeamListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCreateRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3486,6 +4368,18 @@ func (p *TeamListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3505,11 +4399,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
+func (p *TeamCreateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ContestId); err != nil {
+	if err := oprot.WriteI32(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3522,11 +4416,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
+func (p *TeamCreateRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Limit); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3539,11 +4433,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("offset", thrift.I32, 3); err != nil {
+func (p *TeamCreateRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Offset); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3556,11 +4450,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamListRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 4); err != nil {
+func (p *TeamCreateRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("goal", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserId); err != nil {
+	if err := oprot.WriteString(p.Goal); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3573,114 +4467,200 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *TeamListRequest) String() string {
+func (p *TeamCreateRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Description); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamCreateRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_skills", thrift.LIST, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamSkills)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamSkills {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamCreateRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ContestId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamCreateRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamListRequest(%+v)", *p)
+	return fmt.Sprintf("TeamCreateRequest(%+v)", *p)
 }
 
-func (p *TeamListRequest) DeepEqual(ano *TeamListRequest) bool {
+func (p *TeamCreateRequest) DeepEqual(ano *TeamCreateRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ContestId) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Limit) {
+	if !p.Field2DeepEqual(ano.TeamId) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Offset) {
+	if !p.Field3DeepEqual(ano.Title) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.UserId) {
+	if !p.Field4DeepEqual(ano.Goal) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.TeamSkills) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ContestId) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamListRequest) Field1DeepEqual(src int32) bool {
+func (p *TeamCreateRequest) Field1DeepEqual(src int32) bool {
 
-	if p.ContestId != src {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamListRequest) Field2DeepEqual(src int32) bool {
+func (p *TeamCreateRequest) Field2DeepEqual(src int32) bool {
 
-	if p.Limit != src {
+	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamListRequest) Field3DeepEqual(src int32) bool {
+func (p *TeamCreateRequest) Field3DeepEqual(src string) bool {
 
-	if p.Offset != src {
+	if strings.Compare(p.Title, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamListRequest) Field4DeepEqual(src int32) bool {
+func (p *TeamCreateRequest) Field4DeepEqual(src string) bool {
 
-	if p.UserId != src {
+	if strings.Compare(p.Goal, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *TeamCreateRequest) Field5DeepEqual(src string) bool {
 
-type TeamListResponse struct {
-	StatusCode int32            `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string           `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
-	Total      int32            `thrift:"total,3" frugal:"3,default,i32" json:"total"`
-	TeamList   []*TeamBriefInfo `thrift:"team_list,4" frugal:"4,default,list<TeamBriefInfo>" json:"team_list"`
+	if strings.Compare(p.Description, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TeamCreateRequest) Field6DeepEqual(src []*TeamSkill) bool {
 
-func NewTeamListResponse() *TeamListResponse {
-	return &TeamListResponse{}
+	if len(p.TeamSkills) != len(src) {
+		return false
+	}
+	for i, v := range p.TeamSkills {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *TeamCreateRequest) Field7DeepEqual(src int32) bool {
 
-func (p *TeamListResponse) InitDefault() {
-	*p = TeamListResponse{}
+	if p.ContestId != src {
+		return false
+	}
+	return true
 }
 
-func (p *TeamListResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+type TeamCreateResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	TeamId     int32  `thrift:"team_id,3" frugal:"3,default,i32" json:"team_id"`
 }
 
-func (p *TeamListResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func NewTeamCreateResponse() *TeamCreateResponse {
+	return &TeamCreateResponse{}
 }
 
-func (p *TeamListResponse) GetTotal() (v int32) {
-	return p.Total
+func (p *TeamCreateResponse) InitDefault() {
+	*p = TeamCreateResponse{}
 }
 
-func (p *TeamListResponse) GetTeamList() (v []*TeamBriefInfo) {
-	return p.TeamList
+func (p *TeamCreateResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
-func (p *TeamListResponse) SetStatusCode(val int32) {
+
+func (p *TeamCreateResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamCreateResponse) GetTeamId() (v int32) {
+	return p.TeamId
+}
+func (p *TeamCreateResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *TeamListResponse) SetStatusMsg(val string) {
+func (p *TeamCreateResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
-func (p *TeamListResponse) SetTotal(val int32) {
-	p.Total = val
-}
-func (p *TeamListResponse) SetTeamList(val []*TeamBriefInfo) {
-	p.TeamList = val
+func (p *TeamCreateResponse) SetTeamId(val int32) {
+	p.TeamId = val
 }
 
-var fieldIDToName_TeamListResponse = map[int16]string{
+var fieldIDToName_TeamCreateResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "total",
-	4: "team_list",
+	3: "team_id",
 }
 
-func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3729,16 +4709,6 @@ func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3759,7 +4729,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCreateResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3769,7 +4739,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamCreateResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -3778,7 +4748,7 @@ func (p *TeamListResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamCreateResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -3787,38 +4757,18 @@ func (p *TeamListResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamListResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *TeamCreateResponse) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.Total = v
-	}
-	return nil
-}
-
-func (p *TeamListResponse) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	p.TeamList = make([]*TeamBriefInfo, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamBriefInfo()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		p.TeamList = append(p.TeamList, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCreateResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3834,10 +4784,6 @@ func (p *TeamListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3857,7 +4803,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3874,7 +4820,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamCreateResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3891,11 +4837,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+func (p *TeamCreateResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Total); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3908,39 +4854,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_list", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamList)); err != nil {
-		return err
-	}
-	for _, v := range p.TeamList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *TeamListResponse) String() string {
+func (p *TeamCreateResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamListResponse(%+v)", *p)
+	return fmt.Sprintf("TeamCreateResponse(%+v)", *p)
 }
 
-func (p *TeamListResponse) DeepEqual(ano *TeamListResponse) bool {
+func (p *TeamCreateResponse) DeepEqual(ano *TeamCreateResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3952,83 +4873,103 @@ func (p *TeamListResponse) DeepEqual(ano *TeamListResponse) bool {
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Total) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.TeamList) {
+	if !p.Field3DeepEqual(ano.TeamId) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamListResponse) Field1DeepEqual(src int32) bool {
+func (p *TeamCreateResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *TeamListResponse) Field2DeepEqual(src string) bool {
+func (p *TeamCreateResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamListResponse) Field3DeepEqual(src int32) bool {
+func (p *TeamCreateResponse) Field3DeepEqual(src int32) bool {
 
-	if p.Total != src {
+	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamListResponse) Field4DeepEqual(src []*TeamBriefInfo) bool {
 
-	if len(p.TeamList) != len(src) {
-		return false
-	}
-	for i, v := range p.TeamList {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+type TeamListRequest struct {
+	ContestId          int32  `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
+	Limit              int32  `thrift:"limit,2" frugal:"2,default,i32" json:"limit"`
+	Offset             int32  `thrift:"offset,3" frugal:"3,default,i32" json:"offset"`
+	UserId             int32  `thrift:"user_id,4" frugal:"4,default,i32" json:"user_id"`
+	WithRecommendation bool   `thrift:"with_recommendation,5" frugal:"5,default,bool" json:"with_recommendation"`
+	Cursor             string `thrift:"cursor,6" frugal:"6,default,string" json:"cursor"`
 }
 
-type TeamInfoRequest struct {
-	ContestId int32 `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
-	TeamId    int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+func NewTeamListRequest() *TeamListRequest {
+	return &TeamListRequest{}
 }
 
-func NewTeamInfoRequest() *TeamInfoRequest {
-	return &TeamInfoRequest{}
+func (p *TeamListRequest) InitDefault() {
+	*p = TeamListRequest{}
 }
 
-func (p *TeamInfoRequest) InitDefault() {
-	*p = TeamInfoRequest{}
+func (p *TeamListRequest) GetContestId() (v int32) {
+	return p.ContestId
 }
 
-func (p *TeamInfoRequest) GetContestId() (v int32) {
-	return p.ContestId
+func (p *TeamListRequest) GetLimit() (v int32) {
+	return p.Limit
 }
 
-func (p *TeamInfoRequest) GetTeamId() (v int32) {
-	return p.TeamId
+func (p *TeamListRequest) GetOffset() (v int32) {
+	return p.Offset
 }
-func (p *TeamInfoRequest) SetContestId(val int32) {
+
+func (p *TeamListRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamListRequest) GetWithRecommendation() (v bool) {
+	return p.WithRecommendation
+}
+
+func (p *TeamListRequest) GetCursor() (v string) {
+	return p.Cursor
+}
+func (p *TeamListRequest) SetContestId(val int32) {
 	p.ContestId = val
 }
-func (p *TeamInfoRequest) SetTeamId(val int32) {
-	p.TeamId = val
+func (p *TeamListRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *TeamListRequest) SetOffset(val int32) {
+	p.Offset = val
+}
+func (p *TeamListRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamListRequest) SetWithRecommendation(val bool) {
+	p.WithRecommendation = val
+}
+func (p *TeamListRequest) SetCursor(val string) {
+	p.Cursor = val
 }
 
-var fieldIDToName_TeamInfoRequest = map[int16]string{
+var fieldIDToName_TeamListRequest = map[int16]string{
 	1: "contest_id",
-	2: "team_id",
+	2: "limit",
+	3: "offset",
+	4: "user_id",
+	5: "with_recommendation",
+	6: "cursor",
 }
 
-func (p *TeamInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4067,6 +5008,46 @@ func (p *TeamInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4087,7 +5068,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4097,7 +5078,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamListRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -4106,36 +5087,88 @@ func (p *TeamInfoRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamListRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
+		p.Limit = v
 	}
 	return nil
 }
 
-func (p *TeamInfoRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfoRequest"); err != nil {
-		goto WriteStructBeginError
+func (p *TeamListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
+	return nil
+}
 
+func (p *TeamListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	return nil
+}
+
+func (p *TeamListRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.WithRecommendation = v
+	}
+	return nil
+}
+
+func (p *TeamListRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Cursor = v
+	}
+	return nil
+}
+
+func (p *TeamListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
 WriteStructBeginError:
@@ -4148,7 +5181,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamListRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4165,11 +5198,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+func (p *TeamListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteI32(p.Limit); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4182,14 +5215,82 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamInfoRequest) String() string {
+func (p *TeamListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("with_recommendation", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.WithRecommendation); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamListRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Cursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamInfoRequest(%+v)", *p)
+	return fmt.Sprintf("TeamListRequest(%+v)", *p)
 }
 
-func (p *TeamInfoRequest) DeepEqual(ano *TeamInfoRequest) bool {
+func (p *TeamListRequest) DeepEqual(ano *TeamListRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4198,78 +5299,145 @@ func (p *TeamInfoRequest) DeepEqual(ano *TeamInfoRequest) bool {
 	if !p.Field1DeepEqual(ano.ContestId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.TeamId) {
+	if !p.Field2DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Offset) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.WithRecommendation) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Cursor) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamInfoRequest) Field1DeepEqual(src int32) bool {
+func (p *TeamListRequest) Field1DeepEqual(src int32) bool {
 
 	if p.ContestId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamInfoRequest) Field2DeepEqual(src int32) bool {
+func (p *TeamListRequest) Field2DeepEqual(src int32) bool {
 
-	if p.TeamId != src {
+	if p.Limit != src {
 		return false
 	}
 	return true
 }
+func (p *TeamListRequest) Field3DeepEqual(src int32) bool {
 
-type TeamInfoResponse struct {
-	StatusCode int32     `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string    `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
-	TeamInfo   *TeamInfo `thrift:"team_info,3" frugal:"3,default,TeamInfo" json:"team_info"`
+	if p.Offset != src {
+		return false
+	}
+	return true
 }
+func (p *TeamListRequest) Field4DeepEqual(src int32) bool {
 
-func NewTeamInfoResponse() *TeamInfoResponse {
-	return &TeamInfoResponse{}
+	if p.UserId != src {
+		return false
+	}
+	return true
 }
+func (p *TeamListRequest) Field5DeepEqual(src bool) bool {
 
-func (p *TeamInfoResponse) InitDefault() {
-	*p = TeamInfoResponse{}
+	if p.WithRecommendation != src {
+		return false
+	}
+	return true
 }
+func (p *TeamListRequest) Field6DeepEqual(src string) bool {
 
-func (p *TeamInfoResponse) GetStatusCode() (v int32) {
+	if strings.Compare(p.Cursor, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamListResponse struct {
+	StatusCode int32            `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string           `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Total      int32            `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	TeamList   []*TeamBriefInfo `thrift:"team_list,4" frugal:"4,default,list<TeamBriefInfo>" json:"team_list"`
+	Experiment string           `thrift:"experiment,5" frugal:"5,default,string" json:"experiment"`
+	Strategy   string           `thrift:"strategy,6" frugal:"6,default,string" json:"strategy"`
+	NextCursor string           `thrift:"next_cursor,7" frugal:"7,default,string" json:"next_cursor"`
+}
+
+func NewTeamListResponse() *TeamListResponse {
+	return &TeamListResponse{}
+}
+
+func (p *TeamListResponse) InitDefault() {
+	*p = TeamListResponse{}
+}
+
+func (p *TeamListResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *TeamInfoResponse) GetStatusMsg() (v string) {
+func (p *TeamListResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
 
-var TeamInfoResponse_TeamInfo_DEFAULT *TeamInfo
+func (p *TeamListResponse) GetTotal() (v int32) {
+	return p.Total
+}
 
-func (p *TeamInfoResponse) GetTeamInfo() (v *TeamInfo) {
-	if !p.IsSetTeamInfo() {
-		return TeamInfoResponse_TeamInfo_DEFAULT
-	}
-	return p.TeamInfo
+func (p *TeamListResponse) GetTeamList() (v []*TeamBriefInfo) {
+	return p.TeamList
 }
-func (p *TeamInfoResponse) SetStatusCode(val int32) {
+
+func (p *TeamListResponse) GetExperiment() (v string) {
+	return p.Experiment
+}
+
+func (p *TeamListResponse) GetStrategy() (v string) {
+	return p.Strategy
+}
+
+func (p *TeamListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+func (p *TeamListResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *TeamInfoResponse) SetStatusMsg(val string) {
+func (p *TeamListResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
-func (p *TeamInfoResponse) SetTeamInfo(val *TeamInfo) {
-	p.TeamInfo = val
+func (p *TeamListResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *TeamListResponse) SetTeamList(val []*TeamBriefInfo) {
+	p.TeamList = val
+}
+func (p *TeamListResponse) SetExperiment(val string) {
+	p.Experiment = val
+}
+func (p *TeamListResponse) SetStrategy(val string) {
+	p.Strategy = val
+}
+func (p *TeamListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
 }
 
-var fieldIDToName_TeamInfoResponse = map[int16]string{
+var fieldIDToName_TeamListResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "team_info",
-}
-
-func (p *TeamInfoResponse) IsSetTeamInfo() bool {
-	return p.TeamInfo != nil
+	3: "total",
+	4: "team_list",
+	5: "experiment",
+	6: "strategy",
+	7: "next_cursor",
 }
 
-func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4309,7 +5477,7 @@ func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4318,18 +5486,58 @@ func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
 
 	return nil
@@ -4338,7 +5546,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4348,7 +5556,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamListResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -4357,7 +5565,7 @@ func (p *TeamInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamListResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -4366,17 +5574,65 @@ func (p *TeamInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamInfoResponse) ReadField3(iprot thrift.TProtocol) error {
-	p.TeamInfo = NewTeamInfo()
-	if err := p.TeamInfo.Read(iprot); err != nil {
+func (p *TeamListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		p.Total = v
 	}
 	return nil
 }
 
-func (p *TeamInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamList = make([]*TeamBriefInfo, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamBriefInfo()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamList = append(p.TeamList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Experiment = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Strategy = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.NextCursor = v
+	}
+	return nil
+}
+
+func (p *TeamListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4392,6 +5648,22 @@ func (p *TeamInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -4411,7 +5683,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4428,7 +5700,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamListResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4445,11 +5717,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_info", thrift.STRUCT, 3); err != nil {
+func (p *TeamListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.TeamInfo.Write(oprot); err != nil {
+	if err := oprot.WriteI32(p.Total); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4462,14 +5734,90 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamInfoResponse) String() string {
+func (p *TeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamList)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("experiment", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Experiment); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("strategy", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Strategy); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamListResponse) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamInfoResponse(%+v)", *p)
+	return fmt.Sprintf("TeamListResponse(%+v)", *p)
 }
 
-func (p *TeamInfoResponse) DeepEqual(ano *TeamInfoResponse) bool {
+func (p *TeamListResponse) DeepEqual(ano *TeamListResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4481,103 +5829,113 @@ func (p *TeamInfoResponse) DeepEqual(ano *TeamInfoResponse) bool {
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.TeamInfo) {
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TeamList) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Experiment) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Strategy) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.NextCursor) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamInfoResponse) Field1DeepEqual(src int32) bool {
+func (p *TeamListResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *TeamInfoResponse) Field2DeepEqual(src string) bool {
+func (p *TeamListResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamInfoResponse) Field3DeepEqual(src *TeamInfo) bool {
+func (p *TeamListResponse) Field3DeepEqual(src int32) bool {
 
-	if !p.TeamInfo.DeepEqual(src) {
+	if p.Total != src {
 		return false
 	}
 	return true
 }
+func (p *TeamListResponse) Field4DeepEqual(src []*TeamBriefInfo) bool {
 
-type TeamApplicationSubmitRequest struct {
-	TeamId          int32       `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
-	Reason          string      `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
-	CreatedTime     int64       `thrift:"created_time,3" frugal:"3,default,i64" json:"created_time"`
-	ApplicationType int32       `thrift:"application_type,4" frugal:"4,default,i32" json:"application_type"`
-	MemberInfo      *MemberInfo `thrift:"member_info,5" frugal:"5,default,MemberInfo" json:"member_info"`
+	if len(p.TeamList) != len(src) {
+		return false
+	}
+	for i, v := range p.TeamList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *TeamListResponse) Field5DeepEqual(src string) bool {
 
-func NewTeamApplicationSubmitRequest() *TeamApplicationSubmitRequest {
-	return &TeamApplicationSubmitRequest{}
+	if strings.Compare(p.Experiment, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TeamListResponse) Field6DeepEqual(src string) bool {
 
-func (p *TeamApplicationSubmitRequest) InitDefault() {
-	*p = TeamApplicationSubmitRequest{}
+	if strings.Compare(p.Strategy, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TeamListResponse) Field7DeepEqual(src string) bool {
 
-func (p *TeamApplicationSubmitRequest) GetTeamId() (v int32) {
-	return p.TeamId
+	if strings.Compare(p.NextCursor, src) != 0 {
+		return false
+	}
+	return true
 }
 
-func (p *TeamApplicationSubmitRequest) GetReason() (v string) {
-	return p.Reason
+type TeamInfoRequest struct {
+	ContestId int32 `thrift:"contest_id,1" frugal:"1,default,i32" json:"contest_id"`
+	TeamId    int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
 }
 
-func (p *TeamApplicationSubmitRequest) GetCreatedTime() (v int64) {
-	return p.CreatedTime
+func NewTeamInfoRequest() *TeamInfoRequest {
+	return &TeamInfoRequest{}
 }
 
-func (p *TeamApplicationSubmitRequest) GetApplicationType() (v int32) {
-	return p.ApplicationType
+func (p *TeamInfoRequest) InitDefault() {
+	*p = TeamInfoRequest{}
 }
 
-var TeamApplicationSubmitRequest_MemberInfo_DEFAULT *MemberInfo
-
-func (p *TeamApplicationSubmitRequest) GetMemberInfo() (v *MemberInfo) {
-	if !p.IsSetMemberInfo() {
-		return TeamApplicationSubmitRequest_MemberInfo_DEFAULT
-	}
-	return p.MemberInfo
-}
-func (p *TeamApplicationSubmitRequest) SetTeamId(val int32) {
-	p.TeamId = val
-}
-func (p *TeamApplicationSubmitRequest) SetReason(val string) {
-	p.Reason = val
-}
-func (p *TeamApplicationSubmitRequest) SetCreatedTime(val int64) {
-	p.CreatedTime = val
+func (p *TeamInfoRequest) GetContestId() (v int32) {
+	return p.ContestId
 }
-func (p *TeamApplicationSubmitRequest) SetApplicationType(val int32) {
-	p.ApplicationType = val
+
+func (p *TeamInfoRequest) GetTeamId() (v int32) {
+	return p.TeamId
 }
-func (p *TeamApplicationSubmitRequest) SetMemberInfo(val *MemberInfo) {
-	p.MemberInfo = val
+func (p *TeamInfoRequest) SetContestId(val int32) {
+	p.ContestId = val
 }
-
-var fieldIDToName_TeamApplicationSubmitRequest = map[int16]string{
-	1: "team_id",
-	2: "reason",
-	3: "created_time",
-	4: "application_type",
-	5: "member_info",
+func (p *TeamInfoRequest) SetTeamId(val int32) {
+	p.TeamId = val
 }
 
-func (p *TeamApplicationSubmitRequest) IsSetMemberInfo() bool {
-	return p.MemberInfo != nil
+var fieldIDToName_TeamInfoRequest = map[int16]string{
+	1: "contest_id",
+	2: "team_id",
 }
 
-func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4607,38 +5965,8 @@ func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error)
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 4:
 			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else {
@@ -4666,7 +5994,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4676,53 +6004,27 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamInfoRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
-	}
-	return nil
-}
-
-func (p *TeamApplicationSubmitRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		p.Reason = v
-	}
-	return nil
-}
-
-func (p *TeamApplicationSubmitRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		p.CreatedTime = v
+		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *TeamInfoRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ApplicationType = v
-	}
-	return nil
-}
-
-func (p *TeamApplicationSubmitRequest) ReadField5(iprot thrift.TProtocol) error {
-	p.MemberInfo = NewMemberInfo()
-	if err := p.MemberInfo.Read(iprot); err != nil {
-		return err
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamApplicationSubmitRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmitRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInfoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4734,18 +6036,6 @@ func (p *TeamApplicationSubmitRequest) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -4765,11 +6055,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
+func (p *TeamInfoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteI32(p.ContestId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4782,11 +6072,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+func (p *TeamInfoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Reason); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4799,157 +6089,94 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *TeamApplicationSubmitRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.ApplicationType); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *TeamApplicationSubmitRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.MemberInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *TeamApplicationSubmitRequest) String() string {
+func (p *TeamInfoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamApplicationSubmitRequest(%+v)", *p)
+	return fmt.Sprintf("TeamInfoRequest(%+v)", *p)
 }
 
-func (p *TeamApplicationSubmitRequest) DeepEqual(ano *TeamApplicationSubmitRequest) bool {
+func (p *TeamInfoRequest) DeepEqual(ano *TeamInfoRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TeamId) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Reason) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.CreatedTime) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.ApplicationType) {
+	if !p.Field1DeepEqual(ano.ContestId) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.MemberInfo) {
+	if !p.Field2DeepEqual(ano.TeamId) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamApplicationSubmitRequest) Field1DeepEqual(src int32) bool {
+func (p *TeamInfoRequest) Field1DeepEqual(src int32) bool {
 
-	if p.TeamId != src {
+	if p.ContestId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamApplicationSubmitRequest) Field2DeepEqual(src string) bool {
+func (p *TeamInfoRequest) Field2DeepEqual(src int32) bool {
 
-	if strings.Compare(p.Reason, src) != 0 {
+	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamApplicationSubmitRequest) Field3DeepEqual(src int64) bool {
 
-	if p.CreatedTime != src {
-		return false
-	}
-	return true
+type TeamInfoResponse struct {
+	StatusCode int32     `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string    `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	TeamInfo   *TeamInfo `thrift:"team_info,3" frugal:"3,default,TeamInfo" json:"team_info"`
 }
-func (p *TeamApplicationSubmitRequest) Field4DeepEqual(src int32) bool {
 
-	if p.ApplicationType != src {
-		return false
-	}
-	return true
+func NewTeamInfoResponse() *TeamInfoResponse {
+	return &TeamInfoResponse{}
 }
-func (p *TeamApplicationSubmitRequest) Field5DeepEqual(src *MemberInfo) bool {
 
-	if !p.MemberInfo.DeepEqual(src) {
-		return false
-	}
-	return true
+func (p *TeamInfoResponse) InitDefault() {
+	*p = TeamInfoResponse{}
 }
 
-type TeamApplicationSubmitResponse struct {
-	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+func (p *TeamInfoResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func NewTeamApplicationSubmitResponse() *TeamApplicationSubmitResponse {
-	return &TeamApplicationSubmitResponse{}
+func (p *TeamInfoResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-func (p *TeamApplicationSubmitResponse) InitDefault() {
-	*p = TeamApplicationSubmitResponse{}
-}
-
-func (p *TeamApplicationSubmitResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
-}
+var TeamInfoResponse_TeamInfo_DEFAULT *TeamInfo
 
-func (p *TeamApplicationSubmitResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *TeamInfoResponse) GetTeamInfo() (v *TeamInfo) {
+	if !p.IsSetTeamInfo() {
+		return TeamInfoResponse_TeamInfo_DEFAULT
+	}
+	return p.TeamInfo
 }
-func (p *TeamApplicationSubmitResponse) SetStatusCode(val int32) {
+func (p *TeamInfoResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *TeamApplicationSubmitResponse) SetStatusMsg(val string) {
+func (p *TeamInfoResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
+func (p *TeamInfoResponse) SetTeamInfo(val *TeamInfo) {
+	p.TeamInfo = val
+}
 
-var fieldIDToName_TeamApplicationSubmitResponse = map[int16]string{
+var fieldIDToName_TeamInfoResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
+	3: "team_info",
 }
 
-func (p *TeamApplicationSubmitResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) IsSetTeamInfo() bool {
+	return p.TeamInfo != nil
+}
+
+func (p *TeamInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4988,6 +6215,16 @@ func (p *TeamApplicationSubmitResponse) Read(iprot thrift.TProtocol) (err error)
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5008,7 +6245,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInfoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5018,7 +6255,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -5027,7 +6264,7 @@ func (p *TeamApplicationSubmitResponse) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamApplicationSubmitResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -5036,9 +6273,17 @@ func (p *TeamApplicationSubmitResponse) ReadField2(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TeamApplicationSubmitResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) ReadField3(iprot thrift.TProtocol) error {
+	p.TeamInfo = NewTeamInfo()
+	if err := p.TeamInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmitResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInfoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5050,6 +6295,10 @@ func (p *TeamApplicationSubmitResponse) Write(oprot thrift.TProtocol) (err error
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -5069,7 +6318,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5086,7 +6335,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5103,14 +6352,31 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamApplicationSubmitResponse) String() string {
+func (p *TeamInfoResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_info", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.TeamInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInfoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamApplicationSubmitResponse(%+v)", *p)
+	return fmt.Sprintf("TeamInfoResponse(%+v)", *p)
 }
 
-func (p *TeamApplicationSubmitResponse) DeepEqual(ano *TeamApplicationSubmitResponse) bool {
+func (p *TeamInfoResponse) DeepEqual(ano *TeamInfoResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5122,57 +6388,103 @@ func (p *TeamApplicationSubmitResponse) DeepEqual(ano *TeamApplicationSubmitResp
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.TeamInfo) {
+		return false
+	}
 	return true
 }
 
-func (p *TeamApplicationSubmitResponse) Field1DeepEqual(src int32) bool {
+func (p *TeamInfoResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *TeamApplicationSubmitResponse) Field2DeepEqual(src string) bool {
+func (p *TeamInfoResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *TeamInfoResponse) Field3DeepEqual(src *TeamInfo) bool {
 
-type TeamManageListRequest struct {
-	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
-	TeamId int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	if !p.TeamInfo.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewTeamManageListRequest() *TeamManageListRequest {
-	return &TeamManageListRequest{}
+type TeamApplicationSubmitRequest struct {
+	TeamId          int32       `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
+	Reason          string      `thrift:"reason,2" frugal:"2,default,string" json:"reason"`
+	CreatedTime     int64       `thrift:"created_time,3" frugal:"3,default,i64" json:"created_time"`
+	ApplicationType int32       `thrift:"application_type,4" frugal:"4,default,i32" json:"application_type"`
+	MemberInfo      *MemberInfo `thrift:"member_info,5" frugal:"5,default,MemberInfo" json:"member_info"`
 }
 
-func (p *TeamManageListRequest) InitDefault() {
-	*p = TeamManageListRequest{}
+func NewTeamApplicationSubmitRequest() *TeamApplicationSubmitRequest {
+	return &TeamApplicationSubmitRequest{}
 }
 
-func (p *TeamManageListRequest) GetUserId() (v int32) {
-	return p.UserId
+func (p *TeamApplicationSubmitRequest) InitDefault() {
+	*p = TeamApplicationSubmitRequest{}
 }
 
-func (p *TeamManageListRequest) GetTeamId() (v int32) {
+func (p *TeamApplicationSubmitRequest) GetTeamId() (v int32) {
 	return p.TeamId
 }
-func (p *TeamManageListRequest) SetUserId(val int32) {
-	p.UserId = val
+
+func (p *TeamApplicationSubmitRequest) GetReason() (v string) {
+	return p.Reason
 }
-func (p *TeamManageListRequest) SetTeamId(val int32) {
+
+func (p *TeamApplicationSubmitRequest) GetCreatedTime() (v int64) {
+	return p.CreatedTime
+}
+
+func (p *TeamApplicationSubmitRequest) GetApplicationType() (v int32) {
+	return p.ApplicationType
+}
+
+var TeamApplicationSubmitRequest_MemberInfo_DEFAULT *MemberInfo
+
+func (p *TeamApplicationSubmitRequest) GetMemberInfo() (v *MemberInfo) {
+	if !p.IsSetMemberInfo() {
+		return TeamApplicationSubmitRequest_MemberInfo_DEFAULT
+	}
+	return p.MemberInfo
+}
+func (p *TeamApplicationSubmitRequest) SetTeamId(val int32) {
 	p.TeamId = val
 }
+func (p *TeamApplicationSubmitRequest) SetReason(val string) {
+	p.Reason = val
+}
+func (p *TeamApplicationSubmitRequest) SetCreatedTime(val int64) {
+	p.CreatedTime = val
+}
+func (p *TeamApplicationSubmitRequest) SetApplicationType(val int32) {
+	p.ApplicationType = val
+}
+func (p *TeamApplicationSubmitRequest) SetMemberInfo(val *MemberInfo) {
+	p.MemberInfo = val
+}
 
-var fieldIDToName_TeamManageListRequest = map[int16]string{
-	1: "user_id",
-	2: "team_id",
+var fieldIDToName_TeamApplicationSubmitRequest = map[int16]string{
+	1: "team_id",
+	2: "reason",
+	3: "created_time",
+	4: "application_type",
+	5: "member_info",
 }
 
-func (p *TeamManageListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitRequest) IsSetMemberInfo() bool {
+	return p.MemberInfo != nil
+}
+
+func (p *TeamApplicationSubmitRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5202,7 +6514,7 @@ func (p *TeamManageListRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5211,6 +6523,36 @@ func (p *TeamManageListRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5231,7 +6573,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5241,27 +6583,53 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.UserId = v
+		p.TeamId = v
 	}
 	return nil
 }
 
-func (p *TeamManageListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Reason = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationSubmitRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.CreatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationSubmitRequest) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.TeamId = v
+		p.ApplicationType = v
 	}
 	return nil
 }
 
-func (p *TeamManageListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitRequest) ReadField5(iprot thrift.TProtocol) error {
+	p.MemberInfo = NewMemberInfo()
+	if err := p.MemberInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamApplicationSubmitRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationSubmitRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5273,16 +6641,28 @@ func (p *TeamManageListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 WriteFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
@@ -5292,11 +6672,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+func (p *TeamApplicationSubmitRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserId); err != nil {
+	if err := oprot.WriteI32(p.TeamId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5309,11 +6689,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+func (p *TeamApplicationSubmitRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.TeamId); err != nil {
+	if err := oprot.WriteString(p.Reason); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5326,85 +6706,157 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageListRequest) String() string {
+func (p *TeamApplicationSubmitRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_time", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamApplicationSubmitRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_type", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ApplicationType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamApplicationSubmitRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.MemberInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamApplicationSubmitRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageListRequest(%+v)", *p)
+	return fmt.Sprintf("TeamApplicationSubmitRequest(%+v)", *p)
 }
 
-func (p *TeamManageListRequest) DeepEqual(ano *TeamManageListRequest) bool {
+func (p *TeamApplicationSubmitRequest) DeepEqual(ano *TeamApplicationSubmitRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.TeamId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.TeamId) {
+	if !p.Field2DeepEqual(ano.Reason) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CreatedTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ApplicationType) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MemberInfo) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamManageListRequest) Field1DeepEqual(src int32) bool {
+func (p *TeamApplicationSubmitRequest) Field1DeepEqual(src int32) bool {
 
-	if p.UserId != src {
+	if p.TeamId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamManageListRequest) Field2DeepEqual(src int32) bool {
+func (p *TeamApplicationSubmitRequest) Field2DeepEqual(src string) bool {
 
-	if p.TeamId != src {
+	if strings.Compare(p.Reason, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *TeamApplicationSubmitRequest) Field3DeepEqual(src int64) bool {
 
-type TeamManageListResponse struct {
-	StatusCode      int32              `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg       string             `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
-	ApplicationList []*TeamApplication `thrift:"application_list,3" frugal:"3,default,list<TeamApplication>" json:"application_list"`
+	if p.CreatedTime != src {
+		return false
+	}
+	return true
 }
+func (p *TeamApplicationSubmitRequest) Field4DeepEqual(src int32) bool {
 
-func NewTeamManageListResponse() *TeamManageListResponse {
-	return &TeamManageListResponse{}
+	if p.ApplicationType != src {
+		return false
+	}
+	return true
 }
+func (p *TeamApplicationSubmitRequest) Field5DeepEqual(src *MemberInfo) bool {
 
-func (p *TeamManageListResponse) InitDefault() {
-	*p = TeamManageListResponse{}
+	if !p.MemberInfo.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *TeamManageListResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+type TeamApplicationSubmitResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
 }
 
-func (p *TeamManageListResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func NewTeamApplicationSubmitResponse() *TeamApplicationSubmitResponse {
+	return &TeamApplicationSubmitResponse{}
 }
 
-func (p *TeamManageListResponse) GetApplicationList() (v []*TeamApplication) {
-	return p.ApplicationList
+func (p *TeamApplicationSubmitResponse) InitDefault() {
+	*p = TeamApplicationSubmitResponse{}
 }
-func (p *TeamManageListResponse) SetStatusCode(val int32) {
+
+func (p *TeamApplicationSubmitResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamApplicationSubmitResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamApplicationSubmitResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *TeamManageListResponse) SetStatusMsg(val string) {
+func (p *TeamApplicationSubmitResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
-func (p *TeamManageListResponse) SetApplicationList(val []*TeamApplication) {
-	p.ApplicationList = val
-}
 
-var fieldIDToName_TeamManageListResponse = map[int16]string{
+var fieldIDToName_TeamApplicationSubmitResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
-	3: "application_list",
 }
 
-func (p *TeamManageListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5443,16 +6895,6 @@ func (p *TeamManageListResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5473,7 +6915,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationSubmitResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5483,7 +6925,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -5492,7 +6934,7 @@ func (p *TeamManageListResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageListResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *TeamApplicationSubmitResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -5501,29 +6943,9 @@ func (p *TeamManageListResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TeamManageListResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	p.ApplicationList = make([]*TeamApplication, 0, size)
-	for i := 0; i < size; i++ {
-		_elem := NewTeamApplication()
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		p.ApplicationList = append(p.ApplicationList, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (p *TeamManageListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TeamApplicationSubmitResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5535,10 +6957,6 @@ func (p *TeamManageListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -5558,7 +6976,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5575,7 +6993,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *TeamApplicationSubmitResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5592,39 +7010,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageListResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_list", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ApplicationList)); err != nil {
-		return err
-	}
-	for _, v := range p.ApplicationList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *TeamManageListResponse) String() string {
+func (p *TeamApplicationSubmitResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageListResponse(%+v)", *p)
+	return fmt.Sprintf("TeamApplicationSubmitResponse(%+v)", *p)
 }
 
-func (p *TeamManageListResponse) DeepEqual(ano *TeamManageListResponse) bool {
+func (p *TeamApplicationSubmitResponse) DeepEqual(ano *TeamApplicationSubmitResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5636,82 +7029,84 @@ func (p *TeamManageListResponse) DeepEqual(ano *TeamManageListResponse) bool {
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ApplicationList) {
-		return false
-	}
 	return true
 }
 
-func (p *TeamManageListResponse) Field1DeepEqual(src int32) bool {
+func (p *TeamApplicationSubmitResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *TeamManageListResponse) Field2DeepEqual(src string) bool {
+func (p *TeamApplicationSubmitResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamManageListResponse) Field3DeepEqual(src []*TeamApplication) bool {
 
-	if len(p.ApplicationList) != len(src) {
-		return false
-	}
-	for i, v := range p.ApplicationList {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+type MyApplication struct {
+	Application  *TeamApplication `thrift:"application,1" frugal:"1,default,TeamApplication" json:"application"`
+	TeamTitle    string           `thrift:"team_title,2" frugal:"2,default,string" json:"team_title"`
+	ContestId    int32            `thrift:"contest_id,3" frugal:"3,default,i32" json:"contest_id"`
+	ContestTitle string           `thrift:"contest_title,4" frugal:"4,default,string" json:"contest_title"`
 }
 
-type TeamManageActionRequest struct {
-	UserId        int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
-	ApplicationId int32 `thrift:"application_id,2" frugal:"2,default,i32" json:"application_id"`
-	ActionType    int32 `thrift:"action_type,3" frugal:"3,default,i32" json:"action_type"`
+func NewMyApplication() *MyApplication {
+	return &MyApplication{}
 }
 
-func NewTeamManageActionRequest() *TeamManageActionRequest {
-	return &TeamManageActionRequest{}
+func (p *MyApplication) InitDefault() {
+	*p = MyApplication{}
 }
 
-func (p *TeamManageActionRequest) InitDefault() {
-	*p = TeamManageActionRequest{}
+var MyApplication_Application_DEFAULT *TeamApplication
+
+func (p *MyApplication) GetApplication() (v *TeamApplication) {
+	if !p.IsSetApplication() {
+		return MyApplication_Application_DEFAULT
+	}
+	return p.Application
 }
 
-func (p *TeamManageActionRequest) GetUserId() (v int32) {
-	return p.UserId
+func (p *MyApplication) GetTeamTitle() (v string) {
+	return p.TeamTitle
 }
 
-func (p *TeamManageActionRequest) GetApplicationId() (v int32) {
-	return p.ApplicationId
+func (p *MyApplication) GetContestId() (v int32) {
+	return p.ContestId
 }
 
-func (p *TeamManageActionRequest) GetActionType() (v int32) {
-	return p.ActionType
+func (p *MyApplication) GetContestTitle() (v string) {
+	return p.ContestTitle
 }
-func (p *TeamManageActionRequest) SetUserId(val int32) {
-	p.UserId = val
+func (p *MyApplication) SetApplication(val *TeamApplication) {
+	p.Application = val
 }
-func (p *TeamManageActionRequest) SetApplicationId(val int32) {
-	p.ApplicationId = val
+func (p *MyApplication) SetTeamTitle(val string) {
+	p.TeamTitle = val
 }
-func (p *TeamManageActionRequest) SetActionType(val int32) {
-	p.ActionType = val
+func (p *MyApplication) SetContestId(val int32) {
+	p.ContestId = val
+}
+func (p *MyApplication) SetContestTitle(val string) {
+	p.ContestTitle = val
 }
 
-var fieldIDToName_TeamManageActionRequest = map[int16]string{
-	1: "user_id",
-	2: "application_id",
-	3: "action_type",
+var fieldIDToName_MyApplication = map[int16]string{
+	1: "application",
+	2: "team_title",
+	3: "contest_id",
+	4: "contest_title",
 }
 
-func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *MyApplication) IsSetApplication() bool {
+	return p.Application != nil
+}
+
+func (p *MyApplication) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5731,7 +7126,7 @@ func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5741,7 +7136,7 @@ func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5760,6 +7155,16 @@ func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5780,7 +7185,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyApplication[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5790,36 +7195,44 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyApplication) ReadField1(iprot thrift.TProtocol) error {
+	p.Application = NewTeamApplication()
+	if err := p.Application.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MyApplication) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.UserId = v
+		p.TeamTitle = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *MyApplication) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.ApplicationId = v
+		p.ContestId = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *MyApplication) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.ActionType = v
+		p.ContestTitle = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyApplication) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageActionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplication"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5835,6 +7248,10 @@ func (p *TeamManageActionRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -5854,11 +7271,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+func (p *MyApplication) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserId); err != nil {
+	if err := p.Application.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5871,11 +7288,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 2); err != nil {
+func (p *MyApplication) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_title", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ApplicationId); err != nil {
+	if err := oprot.WriteString(p.TeamTitle); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5888,11 +7305,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 3); err != nil {
+func (p *MyApplication) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_id", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.ActionType); err != nil {
+	if err := oprot.WriteI32(p.ContestId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5905,86 +7322,131 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TeamManageActionRequest) String() string {
+func (p *MyApplication) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_title", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ContestTitle); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MyApplication) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageActionRequest(%+v)", *p)
+	return fmt.Sprintf("MyApplication(%+v)", *p)
 }
 
-func (p *TeamManageActionRequest) DeepEqual(ano *TeamManageActionRequest) bool {
+func (p *MyApplication) DeepEqual(ano *MyApplication) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
+	if !p.Field1DeepEqual(ano.Application) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.ApplicationId) {
+	if !p.Field2DeepEqual(ano.TeamTitle) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ActionType) {
+	if !p.Field3DeepEqual(ano.ContestId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ContestTitle) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamManageActionRequest) Field1DeepEqual(src int32) bool {
+func (p *MyApplication) Field1DeepEqual(src *TeamApplication) bool {
 
-	if p.UserId != src {
+	if !p.Application.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *TeamManageActionRequest) Field2DeepEqual(src int32) bool {
+func (p *MyApplication) Field2DeepEqual(src string) bool {
 
-	if p.ApplicationId != src {
+	if strings.Compare(p.TeamTitle, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TeamManageActionRequest) Field3DeepEqual(src int32) bool {
+func (p *MyApplication) Field3DeepEqual(src int32) bool {
 
-	if p.ActionType != src {
+	if p.ContestId != src {
 		return false
 	}
 	return true
 }
+func (p *MyApplication) Field4DeepEqual(src string) bool {
 
-type TeamManageActionResponse struct {
-	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	if strings.Compare(p.ContestTitle, src) != 0 {
+		return false
+	}
+	return true
 }
 
-func NewTeamManageActionResponse() *TeamManageActionResponse {
-	return &TeamManageActionResponse{}
+type MyApplicationsRequest struct {
+	UserId int32  `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	Status string `thrift:"status,2" frugal:"2,default,string" json:"status"`
+	Limit  int32  `thrift:"limit,3" frugal:"3,default,i32" json:"limit"`
+	Offset int32  `thrift:"offset,4" frugal:"4,default,i32" json:"offset"`
 }
 
-func (p *TeamManageActionResponse) InitDefault() {
-	*p = TeamManageActionResponse{}
+func NewMyApplicationsRequest() *MyApplicationsRequest {
+	return &MyApplicationsRequest{}
 }
 
-func (p *TeamManageActionResponse) GetStatusCode() (v int32) {
-	return p.StatusCode
+func (p *MyApplicationsRequest) InitDefault() {
+	*p = MyApplicationsRequest{}
 }
 
-func (p *TeamManageActionResponse) GetStatusMsg() (v string) {
-	return p.StatusMsg
+func (p *MyApplicationsRequest) GetUserId() (v int32) {
+	return p.UserId
 }
-func (p *TeamManageActionResponse) SetStatusCode(val int32) {
-	p.StatusCode = val
+
+func (p *MyApplicationsRequest) GetStatus() (v string) {
+	return p.Status
 }
-func (p *TeamManageActionResponse) SetStatusMsg(val string) {
-	p.StatusMsg = val
+
+func (p *MyApplicationsRequest) GetLimit() (v int32) {
+	return p.Limit
 }
 
-var fieldIDToName_TeamManageActionResponse = map[int16]string{
-	1: "status_code",
-	2: "status_msg",
+func (p *MyApplicationsRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+func (p *MyApplicationsRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *MyApplicationsRequest) SetStatus(val string) {
+	p.Status = val
+}
+func (p *MyApplicationsRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *MyApplicationsRequest) SetOffset(val int32) {
+	p.Offset = val
 }
 
-func (p *TeamManageActionResponse) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_MyApplicationsRequest = map[int16]string{
+	1: "user_id",
+	2: "status",
+	3: "limit",
+	4: "offset",
+}
+
+func (p *MyApplicationsRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6023,6 +7485,26 @@ func (p *TeamManageActionResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6043,7 +7525,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyApplicationsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6053,27 +7535,45 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *MyApplicationsRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		p.StatusCode = v
+		p.UserId = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *MyApplicationsRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		p.StatusMsg = v
+		p.Status = v
 	}
 	return nil
 }
 
-func (p *TeamManageActionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *MyApplicationsRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *MyApplicationsRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *MyApplicationsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageActionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("MyApplicationsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6085,6 +7585,14 @@ func (p *TeamManageActionResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -6104,11 +7612,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+func (p *MyApplicationsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.StatusCode); err != nil {
+	if err := oprot.WriteI32(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6121,11 +7629,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+func (p *MyApplicationsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StatusMsg); err != nil {
+	if err := oprot.WriteString(p.Status); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6138,505 +7646,16874 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TeamManageActionResponse) String() string {
+func (p *MyApplicationsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MyApplicationsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MyApplicationsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamManageActionResponse(%+v)", *p)
+	return fmt.Sprintf("MyApplicationsRequest(%+v)", *p)
 }
 
-func (p *TeamManageActionResponse) DeepEqual(ano *TeamManageActionResponse) bool {
+func (p *MyApplicationsRequest) DeepEqual(ano *MyApplicationsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.StatusCode) {
+	if !p.Field1DeepEqual(ano.UserId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.StatusMsg) {
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Offset) {
 		return false
 	}
 	return true
 }
 
-func (p *TeamManageActionResponse) Field1DeepEqual(src int32) bool {
+func (p *MyApplicationsRequest) Field1DeepEqual(src int32) bool {
 
-	if p.StatusCode != src {
+	if p.UserId != src {
 		return false
 	}
 	return true
 }
-func (p *TeamManageActionResponse) Field2DeepEqual(src string) bool {
+func (p *MyApplicationsRequest) Field2DeepEqual(src string) bool {
 
-	if strings.Compare(p.StatusMsg, src) != 0 {
+	if strings.Compare(p.Status, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *MyApplicationsRequest) Field3DeepEqual(src int32) bool {
 
-type TeamService interface {
-	TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error)
-
-	TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error)
-
-	TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error)
-
-	TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error)
-
-	TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error)
+	if p.Limit != src {
+		return false
+	}
+	return true
+}
+func (p *MyApplicationsRequest) Field4DeepEqual(src int32) bool {
 
-	TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error)
+	if p.Offset != src {
+		return false
+	}
+	return true
 }
 
-type TeamServiceClient struct {
-	c thrift.TClient
+type MyApplicationsResponse struct {
+	StatusCode      int32            `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg       string           `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Total           int32            `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	ApplicationList []*MyApplication `thrift:"application_list,4" frugal:"4,default,list<MyApplication>" json:"application_list"`
 }
 
-func NewTeamServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TeamServiceClient {
-	return &TeamServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func NewMyApplicationsResponse() *MyApplicationsResponse {
+	return &MyApplicationsResponse{}
 }
 
-func NewTeamServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TeamServiceClient {
-	return &TeamServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *MyApplicationsResponse) InitDefault() {
+	*p = MyApplicationsResponse{}
 }
 
-func NewTeamServiceClient(c thrift.TClient) *TeamServiceClient {
-	return &TeamServiceClient{
-		c: c,
-	}
+func (p *MyApplicationsResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
 }
 
-func (p *TeamServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *MyApplicationsResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
 }
 
-func (p *TeamServiceClient) TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error) {
-	var _args TeamServiceTeamCreateArgs
-	_args.Req = req
-	var _result TeamServiceTeamCreateResult
-	if err = p.Client_().Call(ctx, "TeamCreate", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *MyApplicationsResponse) GetTotal() (v int32) {
+	return p.Total
 }
-func (p *TeamServiceClient) TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error) {
-	var _args TeamServiceTeamListArgs
-	_args.Req = req
-	var _result TeamServiceTeamListResult
-	if err = p.Client_().Call(ctx, "TeamList", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *MyApplicationsResponse) GetApplicationList() (v []*MyApplication) {
+	return p.ApplicationList
 }
-func (p *TeamServiceClient) TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error) {
-	var _args TeamServiceTeamInfoArgs
-	_args.Req = req
-	var _result TeamServiceTeamInfoResult
-	if err = p.Client_().Call(ctx, "TeamInfo", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *MyApplicationsResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
 }
-func (p *TeamServiceClient) TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error) {
-	var _args TeamServiceTeamApplicationSubmitArgs
-	_args.Req = req
-	var _result TeamServiceTeamApplicationSubmitResult
-	if err = p.Client_().Call(ctx, "TeamApplicationSubmit", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *MyApplicationsResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
 }
-func (p *TeamServiceClient) TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error) {
-	var _args TeamServiceTeamManageListArgs
-	_args.Req = req
-	var _result TeamServiceTeamManageListResult
-	if err = p.Client_().Call(ctx, "TeamManageList", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *MyApplicationsResponse) SetTotal(val int32) {
+	p.Total = val
 }
-func (p *TeamServiceClient) TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error) {
-	var _args TeamServiceTeamManageActionArgs
-	_args.Req = req
-	var _result TeamServiceTeamManageActionResult
-	if err = p.Client_().Call(ctx, "TeamManageAction", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *MyApplicationsResponse) SetApplicationList(val []*MyApplication) {
+	p.ApplicationList = val
 }
 
-type TeamServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      TeamService
+var fieldIDToName_MyApplicationsResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "application_list",
 }
 
-func (p *TeamServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+func (p *MyApplicationsResponse) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *TeamServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *TeamServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-func NewTeamServiceProcessor(handler TeamService) *TeamServiceProcessor {
-	self := &TeamServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("TeamCreate", &teamServiceProcessorTeamCreate{handler: handler})
-	self.AddToProcessorMap("TeamList", &teamServiceProcessorTeamList{handler: handler})
-	self.AddToProcessorMap("TeamInfo", &teamServiceProcessorTeamInfo{handler: handler})
-	self.AddToProcessorMap("TeamApplicationSubmit", &teamServiceProcessorTeamApplicationSubmit{handler: handler})
-	self.AddToProcessorMap("TeamManageList", &teamServiceProcessorTeamManageList{handler: handler})
-	self.AddToProcessorMap("TeamManageAction", &teamServiceProcessorTeamManageAction{handler: handler})
-	return self
-}
-func (p *TeamServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyApplicationsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type teamServiceProcessorTeamCreate struct {
-	handler TeamService
+func (p *MyApplicationsResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
 }
 
-func (p *teamServiceProcessorTeamCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceTeamCreateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *MyApplicationsResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceTeamCreateResult{}
-	var retval *TeamCreateResponse
-	if retval, err2 = p.handler.TeamCreate(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamCreate: "+err2.Error())
-		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+func (p *MyApplicationsResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		p.Total = v
 	}
-	if err2 = oprot.WriteMessageBegin("TeamCreate", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *MyApplicationsResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.ApplicationList = make([]*MyApplication, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewMyApplication()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ApplicationList = append(p.ApplicationList, _elem)
 	}
-	if err != nil {
-		return
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	return true, err
+	return nil
 }
 
-type teamServiceProcessorTeamList struct {
-	handler TeamService
+func (p *MyApplicationsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyApplicationsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *teamServiceProcessorTeamList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceTeamListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *MyApplicationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceTeamListResult{}
-	var retval *TeamListResponse
-	if retval, err2 = p.handler.TeamList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamList: "+err2.Error())
-		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *MyApplicationsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("TeamList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MyApplicationsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-type teamServiceProcessorTeamInfo struct {
-	handler TeamService
+func (p *MyApplicationsResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ApplicationList)); err != nil {
+		return err
+	}
+	for _, v := range p.ApplicationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *teamServiceProcessorTeamInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceTeamInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *MyApplicationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("MyApplicationsResponse(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceTeamInfoResult{}
-	var retval *TeamInfoResponse
-	if retval, err2 = p.handler.TeamInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInfo: "+err2.Error())
-		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *MyApplicationsResponse) DeepEqual(ano *MyApplicationsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("TeamInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field4DeepEqual(ano.ApplicationList) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *MyApplicationsResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
 	}
-	return true, err
+	return true
 }
+func (p *MyApplicationsResponse) Field2DeepEqual(src string) bool {
 
-type teamServiceProcessorTeamApplicationSubmit struct {
-	handler TeamService
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *MyApplicationsResponse) Field3DeepEqual(src int32) bool {
 
-func (p *teamServiceProcessorTeamApplicationSubmit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceTeamApplicationSubmitArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if p.Total != src {
+		return false
 	}
+	return true
+}
+func (p *MyApplicationsResponse) Field4DeepEqual(src []*MyApplication) bool {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceTeamApplicationSubmitResult{}
-	var retval *TeamApplicationSubmitResponse
-	if retval, err2 = p.handler.TeamApplicationSubmit(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamApplicationSubmit: "+err2.Error())
-		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if len(p.ApplicationList) != len(src) {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	for i, v := range p.ApplicationList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type MyTeam struct {
+	TeamBriefInfo *TeamBriefInfo `thrift:"team_brief_info,1" frugal:"1,default,TeamBriefInfo" json:"team_brief_info"`
+	ContestTitle  string         `thrift:"contest_title,2" frugal:"2,default,string" json:"contest_title"`
+	Role          string         `thrift:"role,3" frugal:"3,default,string" json:"role"`
+}
+
+func NewMyTeam() *MyTeam {
+	return &MyTeam{}
+}
+
+func (p *MyTeam) InitDefault() {
+	*p = MyTeam{}
+}
+
+var MyTeam_TeamBriefInfo_DEFAULT *TeamBriefInfo
+
+func (p *MyTeam) GetTeamBriefInfo() (v *TeamBriefInfo) {
+	if !p.IsSetTeamBriefInfo() {
+		return MyTeam_TeamBriefInfo_DEFAULT
+	}
+	return p.TeamBriefInfo
+}
+
+func (p *MyTeam) GetContestTitle() (v string) {
+	return p.ContestTitle
+}
+
+func (p *MyTeam) GetRole() (v string) {
+	return p.Role
+}
+func (p *MyTeam) SetTeamBriefInfo(val *TeamBriefInfo) {
+	p.TeamBriefInfo = val
+}
+func (p *MyTeam) SetContestTitle(val string) {
+	p.ContestTitle = val
+}
+func (p *MyTeam) SetRole(val string) {
+	p.Role = val
+}
+
+var fieldIDToName_MyTeam = map[int16]string{
+	1: "team_brief_info",
+	2: "contest_title",
+	3: "role",
+}
+
+func (p *MyTeam) IsSetTeamBriefInfo() bool {
+	return p.TeamBriefInfo != nil
+}
+
+func (p *MyTeam) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyTeam[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MyTeam) ReadField1(iprot thrift.TProtocol) error {
+	p.TeamBriefInfo = NewTeamBriefInfo()
+	if err := p.TeamBriefInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MyTeam) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.ContestTitle = v
+	}
+	return nil
+}
+
+func (p *MyTeam) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Role = v
+	}
+	return nil
+}
+
+func (p *MyTeam) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyTeam"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MyTeam) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_brief_info", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.TeamBriefInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MyTeam) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("contest_title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ContestTitle); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MyTeam) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Role); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MyTeam) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MyTeam(%+v)", *p)
+}
+
+func (p *MyTeam) DeepEqual(ano *MyTeam) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TeamBriefInfo) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ContestTitle) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Role) {
+		return false
+	}
+	return true
+}
+
+func (p *MyTeam) Field1DeepEqual(src *TeamBriefInfo) bool {
+
+	if !p.TeamBriefInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *MyTeam) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.ContestTitle, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MyTeam) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Role, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type MyTeamListRequest struct {
+	UserId int32  `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	Role   string `thrift:"role,2" frugal:"2,default,string" json:"role"`
+	Limit  int32  `thrift:"limit,3" frugal:"3,default,i32" json:"limit"`
+	Offset int32  `thrift:"offset,4" frugal:"4,default,i32" json:"offset"`
+}
+
+func NewMyTeamListRequest() *MyTeamListRequest {
+	return &MyTeamListRequest{}
+}
+
+func (p *MyTeamListRequest) InitDefault() {
+	*p = MyTeamListRequest{}
+}
+
+func (p *MyTeamListRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *MyTeamListRequest) GetRole() (v string) {
+	return p.Role
+}
+
+func (p *MyTeamListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *MyTeamListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+func (p *MyTeamListRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *MyTeamListRequest) SetRole(val string) {
+	p.Role = val
+}
+func (p *MyTeamListRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *MyTeamListRequest) SetOffset(val int32) {
+	p.Offset = val
+}
+
+var fieldIDToName_MyTeamListRequest = map[int16]string{
+	1: "user_id",
+	2: "role",
+	3: "limit",
+	4: "offset",
+}
+
+func (p *MyTeamListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyTeamListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MyTeamListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *MyTeamListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Role = v
+	}
+	return nil
+}
+
+func (p *MyTeamListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *MyTeamListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *MyTeamListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyTeamListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MyTeamListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MyTeamListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Role); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MyTeamListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MyTeamListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MyTeamListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MyTeamListRequest(%+v)", *p)
+}
+
+func (p *MyTeamListRequest) DeepEqual(ano *MyTeamListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Role) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Offset) {
+		return false
+	}
+	return true
+}
+
+func (p *MyTeamListRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *MyTeamListRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Role, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MyTeamListRequest) Field3DeepEqual(src int32) bool {
+
+	if p.Limit != src {
+		return false
+	}
+	return true
+}
+func (p *MyTeamListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Offset != src {
+		return false
+	}
+	return true
+}
+
+type MyTeamListResponse struct {
+	StatusCode int32     `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string    `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Total      int32     `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	TeamList   []*MyTeam `thrift:"team_list,4" frugal:"4,default,list<MyTeam>" json:"team_list"`
+}
+
+func NewMyTeamListResponse() *MyTeamListResponse {
+	return &MyTeamListResponse{}
+}
+
+func (p *MyTeamListResponse) InitDefault() {
+	*p = MyTeamListResponse{}
+}
+
+func (p *MyTeamListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *MyTeamListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *MyTeamListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *MyTeamListResponse) GetTeamList() (v []*MyTeam) {
+	return p.TeamList
+}
+func (p *MyTeamListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *MyTeamListResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *MyTeamListResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *MyTeamListResponse) SetTeamList(val []*MyTeam) {
+	p.TeamList = val
+}
+
+var fieldIDToName_MyTeamListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "team_list",
+}
+
+func (p *MyTeamListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MyTeamListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *MyTeamListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *MyTeamListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *MyTeamListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.TeamList = make([]*MyTeam, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewMyTeam()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.TeamList = append(p.TeamList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *MyTeamListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyTeamListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TeamList)); err != nil {
+		return err
+	}
+	for _, v := range p.TeamList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MyTeamListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MyTeamListResponse(%+v)", *p)
+}
+
+func (p *MyTeamListResponse) DeepEqual(ano *MyTeamListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TeamList) {
+		return false
+	}
+	return true
+}
+
+func (p *MyTeamListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *MyTeamListResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MyTeamListResponse) Field3DeepEqual(src int32) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+func (p *MyTeamListResponse) Field4DeepEqual(src []*MyTeam) bool {
+
+	if len(p.TeamList) != len(src) {
+		return false
+	}
+	for i, v := range p.TeamList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TeamApplicationWithdrawRequest struct {
+	UserId        int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	ApplicationId int32 `thrift:"application_id,2" frugal:"2,default,i32" json:"application_id"`
+}
+
+func NewTeamApplicationWithdrawRequest() *TeamApplicationWithdrawRequest {
+	return &TeamApplicationWithdrawRequest{}
+}
+
+func (p *TeamApplicationWithdrawRequest) InitDefault() {
+	*p = TeamApplicationWithdrawRequest{}
+}
+
+func (p *TeamApplicationWithdrawRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamApplicationWithdrawRequest) GetApplicationId() (v int32) {
+	return p.ApplicationId
+}
+func (p *TeamApplicationWithdrawRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamApplicationWithdrawRequest) SetApplicationId(val int32) {
+	p.ApplicationId = val
+}
+
+var fieldIDToName_TeamApplicationWithdrawRequest = map[int16]string{
+	1: "user_id",
+	2: "application_id",
+}
+
+func (p *TeamApplicationWithdrawRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationWithdrawRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationWithdrawRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ApplicationId = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationWithdrawRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationWithdrawRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ApplicationId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamApplicationWithdrawRequest(%+v)", *p)
+}
+
+func (p *TeamApplicationWithdrawRequest) DeepEqual(ano *TeamApplicationWithdrawRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ApplicationId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamApplicationWithdrawRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamApplicationWithdrawRequest) Field2DeepEqual(src int32) bool {
+
+	if p.ApplicationId != src {
+		return false
+	}
+	return true
+}
+
+type TeamApplicationWithdrawResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamApplicationWithdrawResponse() *TeamApplicationWithdrawResponse {
+	return &TeamApplicationWithdrawResponse{}
+}
+
+func (p *TeamApplicationWithdrawResponse) InitDefault() {
+	*p = TeamApplicationWithdrawResponse{}
+}
+
+func (p *TeamApplicationWithdrawResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamApplicationWithdrawResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamApplicationWithdrawResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamApplicationWithdrawResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamApplicationWithdrawResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamApplicationWithdrawResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamApplicationWithdrawResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationWithdrawResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamApplicationWithdrawResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationWithdrawResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamApplicationWithdrawResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamApplicationWithdrawResponse(%+v)", *p)
+}
+
+func (p *TeamApplicationWithdrawResponse) DeepEqual(ano *TeamApplicationWithdrawResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamApplicationWithdrawResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamApplicationWithdrawResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamManageListRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+}
+
+func NewTeamManageListRequest() *TeamManageListRequest {
+	return &TeamManageListRequest{}
+}
+
+func (p *TeamManageListRequest) InitDefault() {
+	*p = TeamManageListRequest{}
+}
+
+func (p *TeamManageListRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamManageListRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+func (p *TeamManageListRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamManageListRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+
+var fieldIDToName_TeamManageListRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+}
+
+func (p *TeamManageListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamManageListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamManageListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamManageListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamManageListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamManageListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamManageListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamManageListRequest(%+v)", *p)
+}
+
+func (p *TeamManageListRequest) DeepEqual(ano *TeamManageListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamManageListRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamManageListRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+
+type TeamManageListResponse struct {
+	StatusCode      int32              `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg       string             `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	ApplicationList []*TeamApplication `thrift:"application_list,3" frugal:"3,default,list<TeamApplication>" json:"application_list"`
+}
+
+func NewTeamManageListResponse() *TeamManageListResponse {
+	return &TeamManageListResponse{}
+}
+
+func (p *TeamManageListResponse) InitDefault() {
+	*p = TeamManageListResponse{}
+}
+
+func (p *TeamManageListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamManageListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamManageListResponse) GetApplicationList() (v []*TeamApplication) {
+	return p.ApplicationList
+}
+func (p *TeamManageListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamManageListResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *TeamManageListResponse) SetApplicationList(val []*TeamApplication) {
+	p.ApplicationList = val
+}
+
+var fieldIDToName_TeamManageListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "application_list",
+}
+
+func (p *TeamManageListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamManageListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamManageListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamManageListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.ApplicationList = make([]*TeamApplication, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamApplication()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.ApplicationList = append(p.ApplicationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamManageListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamManageListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamManageListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamManageListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ApplicationList)); err != nil {
+		return err
+	}
+	for _, v := range p.ApplicationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamManageListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamManageListResponse(%+v)", *p)
+}
+
+func (p *TeamManageListResponse) DeepEqual(ano *TeamManageListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ApplicationList) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamManageListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamManageListResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamManageListResponse) Field3DeepEqual(src []*TeamApplication) bool {
+
+	if len(p.ApplicationList) != len(src) {
+		return false
+	}
+	for i, v := range p.ApplicationList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TeamLeaveRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+}
+
+func NewTeamLeaveRequest() *TeamLeaveRequest {
+	return &TeamLeaveRequest{}
+}
+
+func (p *TeamLeaveRequest) InitDefault() {
+	*p = TeamLeaveRequest{}
+}
+
+func (p *TeamLeaveRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamLeaveRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+func (p *TeamLeaveRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamLeaveRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+
+var fieldIDToName_TeamLeaveRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+}
+
+func (p *TeamLeaveRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaveRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamLeaveRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamLeaveRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamLeaveRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaveRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamLeaveRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaveRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaveRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamLeaveRequest(%+v)", *p)
+}
+
+func (p *TeamLeaveRequest) DeepEqual(ano *TeamLeaveRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamLeaveRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamLeaveRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+
+type TeamLeaveResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamLeaveResponse() *TeamLeaveResponse {
+	return &TeamLeaveResponse{}
+}
+
+func (p *TeamLeaveResponse) InitDefault() {
+	*p = TeamLeaveResponse{}
+}
+
+func (p *TeamLeaveResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamLeaveResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamLeaveResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamLeaveResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamLeaveResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamLeaveResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaveResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamLeaveResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamLeaveResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamLeaveResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaveResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamLeaveResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaveResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaveResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamLeaveResponse(%+v)", *p)
+}
+
+func (p *TeamLeaveResponse) DeepEqual(ano *TeamLeaveResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamLeaveResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamLeaveResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamMemberRemoveRequest struct {
+	UserId   int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId   int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	MemberId int32 `thrift:"member_id,3" frugal:"3,default,i32" json:"member_id"`
+}
+
+func NewTeamMemberRemoveRequest() *TeamMemberRemoveRequest {
+	return &TeamMemberRemoveRequest{}
+}
+
+func (p *TeamMemberRemoveRequest) InitDefault() {
+	*p = TeamMemberRemoveRequest{}
+}
+
+func (p *TeamMemberRemoveRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamMemberRemoveRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+
+func (p *TeamMemberRemoveRequest) GetMemberId() (v int32) {
+	return p.MemberId
+}
+func (p *TeamMemberRemoveRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamMemberRemoveRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamMemberRemoveRequest) SetMemberId(val int32) {
+	p.MemberId = val
+}
+
+var fieldIDToName_TeamMemberRemoveRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "member_id",
+}
+
+func (p *TeamMemberRemoveRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamMemberRemoveRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.MemberId = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamMemberRemoveRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MemberId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamMemberRemoveRequest(%+v)", *p)
+}
+
+func (p *TeamMemberRemoveRequest) DeepEqual(ano *TeamMemberRemoveRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.MemberId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamMemberRemoveRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamMemberRemoveRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamMemberRemoveRequest) Field3DeepEqual(src int32) bool {
+
+	if p.MemberId != src {
+		return false
+	}
+	return true
+}
+
+type TeamMemberRemoveResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamMemberRemoveResponse() *TeamMemberRemoveResponse {
+	return &TeamMemberRemoveResponse{}
+}
+
+func (p *TeamMemberRemoveResponse) InitDefault() {
+	*p = TeamMemberRemoveResponse{}
+}
+
+func (p *TeamMemberRemoveResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamMemberRemoveResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamMemberRemoveResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamMemberRemoveResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamMemberRemoveResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamMemberRemoveResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamMemberRemoveResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamMemberRemoveResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamMemberRemoveResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamMemberRemoveResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamMemberRemoveResponse(%+v)", *p)
+}
+
+func (p *TeamMemberRemoveResponse) DeepEqual(ano *TeamMemberRemoveResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamMemberRemoveResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamMemberRemoveResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamLeaderTransferRequest struct {
+	UserId   int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId   int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	MemberId int32 `thrift:"member_id,3" frugal:"3,default,i32" json:"member_id"`
+}
+
+func NewTeamLeaderTransferRequest() *TeamLeaderTransferRequest {
+	return &TeamLeaderTransferRequest{}
+}
+
+func (p *TeamLeaderTransferRequest) InitDefault() {
+	*p = TeamLeaderTransferRequest{}
+}
+
+func (p *TeamLeaderTransferRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamLeaderTransferRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+
+func (p *TeamLeaderTransferRequest) GetMemberId() (v int32) {
+	return p.MemberId
+}
+func (p *TeamLeaderTransferRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamLeaderTransferRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamLeaderTransferRequest) SetMemberId(val int32) {
+	p.MemberId = val
+}
+
+var fieldIDToName_TeamLeaderTransferRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "member_id",
+}
+
+func (p *TeamLeaderTransferRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaderTransferRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.MemberId = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaderTransferRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MemberId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamLeaderTransferRequest(%+v)", *p)
+}
+
+func (p *TeamLeaderTransferRequest) DeepEqual(ano *TeamLeaderTransferRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.MemberId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamLeaderTransferRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamLeaderTransferRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamLeaderTransferRequest) Field3DeepEqual(src int32) bool {
+
+	if p.MemberId != src {
+		return false
+	}
+	return true
+}
+
+type TeamLeaderTransferResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamLeaderTransferResponse() *TeamLeaderTransferResponse {
+	return &TeamLeaderTransferResponse{}
+}
+
+func (p *TeamLeaderTransferResponse) InitDefault() {
+	*p = TeamLeaderTransferResponse{}
+}
+
+func (p *TeamLeaderTransferResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamLeaderTransferResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamLeaderTransferResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamLeaderTransferResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamLeaderTransferResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamLeaderTransferResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamLeaderTransferResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamLeaderTransferResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaderTransferResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamLeaderTransferResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamLeaderTransferResponse(%+v)", *p)
+}
+
+func (p *TeamLeaderTransferResponse) DeepEqual(ano *TeamLeaderTransferResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamLeaderTransferResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamLeaderTransferResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamCloseRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	Closed bool  `thrift:"closed,3" frugal:"3,default,bool" json:"closed"`
+}
+
+func NewTeamCloseRequest() *TeamCloseRequest {
+	return &TeamCloseRequest{}
+}
+
+func (p *TeamCloseRequest) InitDefault() {
+	*p = TeamCloseRequest{}
+}
+
+func (p *TeamCloseRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamCloseRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+
+func (p *TeamCloseRequest) GetClosed() (v bool) {
+	return p.Closed
+}
+func (p *TeamCloseRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamCloseRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamCloseRequest) SetClosed(val bool) {
+	p.Closed = val
+}
+
+var fieldIDToName_TeamCloseRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "closed",
+}
+
+func (p *TeamCloseRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCloseRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		p.Closed = v
+	}
+	return nil
+}
+
+func (p *TeamCloseRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCloseRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("closed", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Closed); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamCloseRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCloseRequest(%+v)", *p)
+}
+
+func (p *TeamCloseRequest) DeepEqual(ano *TeamCloseRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Closed) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamCloseRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamCloseRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamCloseRequest) Field3DeepEqual(src bool) bool {
+
+	if p.Closed != src {
+		return false
+	}
+	return true
+}
+
+type TeamCloseResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamCloseResponse() *TeamCloseResponse {
+	return &TeamCloseResponse{}
+}
+
+func (p *TeamCloseResponse) InitDefault() {
+	*p = TeamCloseResponse{}
+}
+
+func (p *TeamCloseResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamCloseResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamCloseResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamCloseResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamCloseResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamCloseResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCloseResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamCloseResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamCloseResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCloseResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCloseResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCloseResponse(%+v)", *p)
+}
+
+func (p *TeamCloseResponse) DeepEqual(ano *TeamCloseResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamCloseResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamCloseResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamDisbandRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+}
+
+func NewTeamDisbandRequest() *TeamDisbandRequest {
+	return &TeamDisbandRequest{}
+}
+
+func (p *TeamDisbandRequest) InitDefault() {
+	*p = TeamDisbandRequest{}
+}
+
+func (p *TeamDisbandRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamDisbandRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+func (p *TeamDisbandRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamDisbandRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+
+var fieldIDToName_TeamDisbandRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+}
+
+func (p *TeamDisbandRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamDisbandRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamDisbandRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamDisbandRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamDisbandRequest(%+v)", *p)
+}
+
+func (p *TeamDisbandRequest) DeepEqual(ano *TeamDisbandRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamDisbandRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamDisbandRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+
+type TeamDisbandResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamDisbandResponse() *TeamDisbandResponse {
+	return &TeamDisbandResponse{}
+}
+
+func (p *TeamDisbandResponse) InitDefault() {
+	*p = TeamDisbandResponse{}
+}
+
+func (p *TeamDisbandResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamDisbandResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamDisbandResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamDisbandResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamDisbandResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamDisbandResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamDisbandResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamDisbandResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamDisbandResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamDisbandResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamDisbandResponse(%+v)", *p)
+}
+
+func (p *TeamDisbandResponse) DeepEqual(ano *TeamDisbandResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamDisbandResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamDisbandResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationSendRequest struct {
+	UserId    int32  `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId    int32  `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	InviteeId int32  `thrift:"invitee_id,3" frugal:"3,default,i32" json:"invitee_id"`
+	Message   string `thrift:"message,4" frugal:"4,default,string" json:"message"`
+}
+
+func NewTeamInvitationSendRequest() *TeamInvitationSendRequest {
+	return &TeamInvitationSendRequest{}
+}
+
+func (p *TeamInvitationSendRequest) InitDefault() {
+	*p = TeamInvitationSendRequest{}
+}
+
+func (p *TeamInvitationSendRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamInvitationSendRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+
+func (p *TeamInvitationSendRequest) GetInviteeId() (v int32) {
+	return p.InviteeId
+}
+
+func (p *TeamInvitationSendRequest) GetMessage() (v string) {
+	return p.Message
+}
+func (p *TeamInvitationSendRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamInvitationSendRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamInvitationSendRequest) SetInviteeId(val int32) {
+	p.InviteeId = val
+}
+func (p *TeamInvitationSendRequest) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_TeamInvitationSendRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "invitee_id",
+	4: "message",
+}
+
+func (p *TeamInvitationSendRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationSendRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InviteeId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Message = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationSendRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitee_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InviteeId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationSendRequest(%+v)", *p)
+}
+
+func (p *TeamInvitationSendRequest) DeepEqual(ano *TeamInvitationSendRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.InviteeId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationSendRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationSendRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationSendRequest) Field3DeepEqual(src int32) bool {
+
+	if p.InviteeId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationSendRequest) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationSendResponse struct {
+	StatusCode   int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg    string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	InvitationId int32  `thrift:"invitation_id,3" frugal:"3,default,i32" json:"invitation_id"`
+}
+
+func NewTeamInvitationSendResponse() *TeamInvitationSendResponse {
+	return &TeamInvitationSendResponse{}
+}
+
+func (p *TeamInvitationSendResponse) InitDefault() {
+	*p = TeamInvitationSendResponse{}
+}
+
+func (p *TeamInvitationSendResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationSendResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamInvitationSendResponse) GetInvitationId() (v int32) {
+	return p.InvitationId
+}
+func (p *TeamInvitationSendResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamInvitationSendResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *TeamInvitationSendResponse) SetInvitationId(val int32) {
+	p.InvitationId = val
+}
+
+var fieldIDToName_TeamInvitationSendResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "invitation_id",
+}
+
+func (p *TeamInvitationSendResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationSendResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InvitationId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationSendResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationSendResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InvitationId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationSendResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationSendResponse(%+v)", *p)
+}
+
+func (p *TeamInvitationSendResponse) DeepEqual(ano *TeamInvitationSendResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.InvitationId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationSendResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationSendResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationSendResponse) Field3DeepEqual(src int32) bool {
+
+	if p.InvitationId != src {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationRespondRequest struct {
+	UserId       int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	InvitationId int32 `thrift:"invitation_id,2" frugal:"2,default,i32" json:"invitation_id"`
+	ActionType   int32 `thrift:"action_type,3" frugal:"3,default,i32" json:"action_type"`
+}
+
+func NewTeamInvitationRespondRequest() *TeamInvitationRespondRequest {
+	return &TeamInvitationRespondRequest{}
+}
+
+func (p *TeamInvitationRespondRequest) InitDefault() {
+	*p = TeamInvitationRespondRequest{}
+}
+
+func (p *TeamInvitationRespondRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamInvitationRespondRequest) GetInvitationId() (v int32) {
+	return p.InvitationId
+}
+
+func (p *TeamInvitationRespondRequest) GetActionType() (v int32) {
+	return p.ActionType
+}
+func (p *TeamInvitationRespondRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamInvitationRespondRequest) SetInvitationId(val int32) {
+	p.InvitationId = val
+}
+func (p *TeamInvitationRespondRequest) SetActionType(val int32) {
+	p.ActionType = val
+}
+
+var fieldIDToName_TeamInvitationRespondRequest = map[int16]string{
+	1: "user_id",
+	2: "invitation_id",
+	3: "action_type",
+}
+
+func (p *TeamInvitationRespondRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationRespondRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InvitationId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ActionType = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationRespondRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InvitationId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationRespondRequest(%+v)", *p)
+}
+
+func (p *TeamInvitationRespondRequest) DeepEqual(ano *TeamInvitationRespondRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.InvitationId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ActionType) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationRespondRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationRespondRequest) Field2DeepEqual(src int32) bool {
+
+	if p.InvitationId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationRespondRequest) Field3DeepEqual(src int32) bool {
+
+	if p.ActionType != src {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationRespondResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamInvitationRespondResponse() *TeamInvitationRespondResponse {
+	return &TeamInvitationRespondResponse{}
+}
+
+func (p *TeamInvitationRespondResponse) InitDefault() {
+	*p = TeamInvitationRespondResponse{}
+}
+
+func (p *TeamInvitationRespondResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationRespondResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamInvitationRespondResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamInvitationRespondResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamInvitationRespondResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamInvitationRespondResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationRespondResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationRespondResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationRespondResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationRespondResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationRespondResponse(%+v)", *p)
+}
+
+func (p *TeamInvitationRespondResponse) DeepEqual(ano *TeamInvitationRespondResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationRespondResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationRespondResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationListRequest struct {
+	UserId int32  `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	Status string `thrift:"status,2" frugal:"2,default,string" json:"status"`
+	Limit  int32  `thrift:"limit,3" frugal:"3,default,i32" json:"limit"`
+	Offset int32  `thrift:"offset,4" frugal:"4,default,i32" json:"offset"`
+}
+
+func NewTeamInvitationListRequest() *TeamInvitationListRequest {
+	return &TeamInvitationListRequest{}
+}
+
+func (p *TeamInvitationListRequest) InitDefault() {
+	*p = TeamInvitationListRequest{}
+}
+
+func (p *TeamInvitationListRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamInvitationListRequest) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamInvitationListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *TeamInvitationListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+func (p *TeamInvitationListRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamInvitationListRequest) SetStatus(val string) {
+	p.Status = val
+}
+func (p *TeamInvitationListRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *TeamInvitationListRequest) SetOffset(val int32) {
+	p.Offset = val
+}
+
+var fieldIDToName_TeamInvitationListRequest = map[int16]string{
+	1: "user_id",
+	2: "status",
+	3: "limit",
+	4: "offset",
+}
+
+func (p *TeamInvitationListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationListRequest(%+v)", *p)
+}
+
+func (p *TeamInvitationListRequest) DeepEqual(ano *TeamInvitationListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Offset) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationListRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationListRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationListRequest) Field3DeepEqual(src int32) bool {
+
+	if p.Limit != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Offset != src {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationListResponse struct {
+	StatusCode     int32             `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg      string            `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Total          int32             `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	InvitationList []*TeamInvitation `thrift:"invitation_list,4" frugal:"4,default,list<TeamInvitation>" json:"invitation_list"`
+}
+
+func NewTeamInvitationListResponse() *TeamInvitationListResponse {
+	return &TeamInvitationListResponse{}
+}
+
+func (p *TeamInvitationListResponse) InitDefault() {
+	*p = TeamInvitationListResponse{}
+}
+
+func (p *TeamInvitationListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamInvitationListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *TeamInvitationListResponse) GetInvitationList() (v []*TeamInvitation) {
+	return p.InvitationList
+}
+func (p *TeamInvitationListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamInvitationListResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *TeamInvitationListResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *TeamInvitationListResponse) SetInvitationList(val []*TeamInvitation) {
+	p.InvitationList = val
+}
+
+var fieldIDToName_TeamInvitationListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "invitation_list",
+}
+
+func (p *TeamInvitationListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.InvitationList = make([]*TeamInvitation, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamInvitation()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.InvitationList = append(p.InvitationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamInvitationListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InvitationList)); err != nil {
+		return err
+	}
+	for _, v := range p.InvitationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamInvitationListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationListResponse(%+v)", *p)
+}
+
+func (p *TeamInvitationListResponse) DeepEqual(ano *TeamInvitationListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.InvitationList) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationListResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationListResponse) Field3DeepEqual(src int32) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationListResponse) Field4DeepEqual(src []*TeamInvitation) bool {
+
+	if len(p.InvitationList) != len(src) {
+		return false
+	}
+	for i, v := range p.InvitationList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TeamSentInvitationListRequest struct {
+	UserId int32  `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId int32  `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	Status string `thrift:"status,3" frugal:"3,default,string" json:"status"`
+	Limit  int32  `thrift:"limit,4" frugal:"4,default,i32" json:"limit"`
+	Offset int32  `thrift:"offset,5" frugal:"5,default,i32" json:"offset"`
+}
+
+func NewTeamSentInvitationListRequest() *TeamSentInvitationListRequest {
+	return &TeamSentInvitationListRequest{}
+}
+
+func (p *TeamSentInvitationListRequest) InitDefault() {
+	*p = TeamSentInvitationListRequest{}
+}
+
+func (p *TeamSentInvitationListRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamSentInvitationListRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+
+func (p *TeamSentInvitationListRequest) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamSentInvitationListRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+
+func (p *TeamSentInvitationListRequest) GetOffset() (v int32) {
+	return p.Offset
+}
+func (p *TeamSentInvitationListRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamSentInvitationListRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamSentInvitationListRequest) SetStatus(val string) {
+	p.Status = val
+}
+func (p *TeamSentInvitationListRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *TeamSentInvitationListRequest) SetOffset(val int32) {
+	p.Offset = val
+}
+
+var fieldIDToName_TeamSentInvitationListRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "status",
+	4: "limit",
+	5: "offset",
+}
+
+func (p *TeamSentInvitationListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSentInvitationListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamSentInvitationListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("offset", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Offset); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamSentInvitationListRequest(%+v)", *p)
+}
+
+func (p *TeamSentInvitationListRequest) DeepEqual(ano *TeamSentInvitationListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Offset) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamSentInvitationListRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListRequest) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListRequest) Field4DeepEqual(src int32) bool {
+
+	if p.Limit != src {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListRequest) Field5DeepEqual(src int32) bool {
+
+	if p.Offset != src {
+		return false
+	}
+	return true
+}
+
+type TeamSentInvitationListResponse struct {
+	StatusCode     int32             `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg      string            `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Total          int32             `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	InvitationList []*TeamInvitation `thrift:"invitation_list,4" frugal:"4,default,list<TeamInvitation>" json:"invitation_list"`
+}
+
+func NewTeamSentInvitationListResponse() *TeamSentInvitationListResponse {
+	return &TeamSentInvitationListResponse{}
+}
+
+func (p *TeamSentInvitationListResponse) InitDefault() {
+	*p = TeamSentInvitationListResponse{}
+}
+
+func (p *TeamSentInvitationListResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamSentInvitationListResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamSentInvitationListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *TeamSentInvitationListResponse) GetInvitationList() (v []*TeamInvitation) {
+	return p.InvitationList
+}
+func (p *TeamSentInvitationListResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamSentInvitationListResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *TeamSentInvitationListResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *TeamSentInvitationListResponse) SetInvitationList(val []*TeamInvitation) {
+	p.InvitationList = val
+}
+
+var fieldIDToName_TeamSentInvitationListResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "total",
+	4: "invitation_list",
+}
+
+func (p *TeamSentInvitationListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamSentInvitationListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Total = v
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.InvitationList = make([]*TeamInvitation, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamInvitation()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.InvitationList = append(p.InvitationList, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamSentInvitationListResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamSentInvitationListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_list", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InvitationList)); err != nil {
+		return err
+	}
+	for _, v := range p.InvitationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamSentInvitationListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamSentInvitationListResponse(%+v)", *p)
+}
+
+func (p *TeamSentInvitationListResponse) DeepEqual(ano *TeamSentInvitationListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.InvitationList) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamSentInvitationListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListResponse) Field3DeepEqual(src int32) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+func (p *TeamSentInvitationListResponse) Field4DeepEqual(src []*TeamInvitation) bool {
+
+	if len(p.InvitationList) != len(src) {
+		return false
+	}
+	for i, v := range p.InvitationList {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TeamInvitationCancelRequest struct {
+	UserId       int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	InvitationId int32 `thrift:"invitation_id,2" frugal:"2,default,i32" json:"invitation_id"`
+}
+
+func NewTeamInvitationCancelRequest() *TeamInvitationCancelRequest {
+	return &TeamInvitationCancelRequest{}
+}
+
+func (p *TeamInvitationCancelRequest) InitDefault() {
+	*p = TeamInvitationCancelRequest{}
+}
+
+func (p *TeamInvitationCancelRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamInvitationCancelRequest) GetInvitationId() (v int32) {
+	return p.InvitationId
+}
+func (p *TeamInvitationCancelRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamInvitationCancelRequest) SetInvitationId(val int32) {
+	p.InvitationId = val
+}
+
+var fieldIDToName_TeamInvitationCancelRequest = map[int16]string{
+	1: "user_id",
+	2: "invitation_id",
+}
+
+func (p *TeamInvitationCancelRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationCancelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.InvitationId = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationCancelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("invitation_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.InvitationId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationCancelRequest(%+v)", *p)
+}
+
+func (p *TeamInvitationCancelRequest) DeepEqual(ano *TeamInvitationCancelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.InvitationId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationCancelRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationCancelRequest) Field2DeepEqual(src int32) bool {
+
+	if p.InvitationId != src {
+		return false
+	}
+	return true
+}
+
+type TeamInvitationCancelResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamInvitationCancelResponse() *TeamInvitationCancelResponse {
+	return &TeamInvitationCancelResponse{}
+}
+
+func (p *TeamInvitationCancelResponse) InitDefault() {
+	*p = TeamInvitationCancelResponse{}
+}
+
+func (p *TeamInvitationCancelResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamInvitationCancelResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamInvitationCancelResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamInvitationCancelResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamInvitationCancelResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamInvitationCancelResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamInvitationCancelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamInvitationCancelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationCancelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamInvitationCancelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamInvitationCancelResponse(%+v)", *p)
+}
+
+func (p *TeamInvitationCancelResponse) DeepEqual(ano *TeamInvitationCancelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamInvitationCancelResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamInvitationCancelResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamManageActionRequest struct {
+	UserId        int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	ApplicationId int32 `thrift:"application_id,2" frugal:"2,default,i32" json:"application_id"`
+	ActionType    int32 `thrift:"action_type,3" frugal:"3,default,i32" json:"action_type"`
+}
+
+func NewTeamManageActionRequest() *TeamManageActionRequest {
+	return &TeamManageActionRequest{}
+}
+
+func (p *TeamManageActionRequest) InitDefault() {
+	*p = TeamManageActionRequest{}
+}
+
+func (p *TeamManageActionRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamManageActionRequest) GetApplicationId() (v int32) {
+	return p.ApplicationId
+}
+
+func (p *TeamManageActionRequest) GetActionType() (v int32) {
+	return p.ActionType
+}
+func (p *TeamManageActionRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamManageActionRequest) SetApplicationId(val int32) {
+	p.ApplicationId = val
+}
+func (p *TeamManageActionRequest) SetActionType(val int32) {
+	p.ActionType = val
+}
+
+var fieldIDToName_TeamManageActionRequest = map[int16]string{
+	1: "user_id",
+	2: "application_id",
+	3: "action_type",
+}
+
+func (p *TeamManageActionRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ApplicationId = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.ActionType = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageActionRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("application_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ApplicationId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_type", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ActionType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamManageActionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamManageActionRequest(%+v)", *p)
+}
+
+func (p *TeamManageActionRequest) DeepEqual(ano *TeamManageActionRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ApplicationId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ActionType) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamManageActionRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamManageActionRequest) Field2DeepEqual(src int32) bool {
+
+	if p.ApplicationId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamManageActionRequest) Field3DeepEqual(src int32) bool {
+
+	if p.ActionType != src {
+		return false
+	}
+	return true
+}
+
+type TeamManageActionResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewTeamManageActionResponse() *TeamManageActionResponse {
+	return &TeamManageActionResponse{}
+}
+
+func (p *TeamManageActionResponse) InitDefault() {
+	*p = TeamManageActionResponse{}
+}
+
+func (p *TeamManageActionResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamManageActionResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *TeamManageActionResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamManageActionResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_TeamManageActionResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *TeamManageActionResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamManageActionResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamManageActionResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageActionResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamManageActionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamManageActionResponse(%+v)", *p)
+}
+
+func (p *TeamManageActionResponse) DeepEqual(ano *TeamManageActionResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamManageActionResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamManageActionResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type TeamEmbeddingJobStatusRequest struct {
+	TeamId int32 `thrift:"team_id,1" frugal:"1,default,i32" json:"team_id"`
+}
+
+func NewTeamEmbeddingJobStatusRequest() *TeamEmbeddingJobStatusRequest {
+	return &TeamEmbeddingJobStatusRequest{}
+}
+
+func (p *TeamEmbeddingJobStatusRequest) InitDefault() {
+	*p = TeamEmbeddingJobStatusRequest{}
+}
+
+func (p *TeamEmbeddingJobStatusRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+func (p *TeamEmbeddingJobStatusRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+
+var fieldIDToName_TeamEmbeddingJobStatusRequest = map[int16]string{
+	1: "team_id",
+}
+
+func (p *TeamEmbeddingJobStatusRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamEmbeddingJobStatusRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamEmbeddingJobStatusRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamEmbeddingJobStatusRequest(%+v)", *p)
+}
+
+func (p *TeamEmbeddingJobStatusRequest) DeepEqual(ano *TeamEmbeddingJobStatusRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TeamId) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamEmbeddingJobStatusRequest) Field1DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+
+type TeamEmbeddingJobStatusResponse struct {
+	StatusCode           int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg            string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	JobId                int64  `thrift:"job_id,3" frugal:"3,default,i64" json:"job_id"`
+	Status               string `thrift:"status,4" frugal:"4,default,string" json:"status"`
+	Attempts             int32  `thrift:"attempts,5" frugal:"5,default,i32" json:"attempts"`
+	MaxAttempts          int32  `thrift:"max_attempts,6" frugal:"6,default,i32" json:"max_attempts"`
+	LastError            string `thrift:"last_error,7" frugal:"7,default,string" json:"last_error"`
+	NextRunTime          int64  `thrift:"next_run_time,8" frugal:"8,default,i64" json:"next_run_time"`
+	UpdatedTime          int64  `thrift:"updated_time,9" frugal:"9,default,i64" json:"updated_time"`
+	EmbeddingUpdatedTime int64  `thrift:"embedding_updated_time,10" frugal:"10,default,i64" json:"embedding_updated_time"`
+}
+
+func NewTeamEmbeddingJobStatusResponse() *TeamEmbeddingJobStatusResponse {
+	return &TeamEmbeddingJobStatusResponse{}
+}
+
+func (p *TeamEmbeddingJobStatusResponse) InitDefault() {
+	*p = TeamEmbeddingJobStatusResponse{}
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetJobId() (v int64) {
+	return p.JobId
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetStatus() (v string) {
+	return p.Status
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetAttempts() (v int32) {
+	return p.Attempts
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetMaxAttempts() (v int32) {
+	return p.MaxAttempts
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetLastError() (v string) {
+	return p.LastError
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetNextRunTime() (v int64) {
+	return p.NextRunTime
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetUpdatedTime() (v int64) {
+	return p.UpdatedTime
+}
+
+func (p *TeamEmbeddingJobStatusResponse) GetEmbeddingUpdatedTime() (v int64) {
+	return p.EmbeddingUpdatedTime
+}
+func (p *TeamEmbeddingJobStatusResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetJobId(val int64) {
+	p.JobId = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetStatus(val string) {
+	p.Status = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetAttempts(val int32) {
+	p.Attempts = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetMaxAttempts(val int32) {
+	p.MaxAttempts = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetLastError(val string) {
+	p.LastError = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetNextRunTime(val int64) {
+	p.NextRunTime = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetUpdatedTime(val int64) {
+	p.UpdatedTime = val
+}
+func (p *TeamEmbeddingJobStatusResponse) SetEmbeddingUpdatedTime(val int64) {
+	p.EmbeddingUpdatedTime = val
+}
+
+var fieldIDToName_TeamEmbeddingJobStatusResponse = map[int16]string{
+	1:  "status_code",
+	2:  "status_msg",
+	3:  "job_id",
+	4:  "status",
+	5:  "attempts",
+	6:  "max_attempts",
+	7:  "last_error",
+	8:  "next_run_time",
+	9:  "updated_time",
+	10: "embedding_updated_time",
+}
+
+func (p *TeamEmbeddingJobStatusResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamEmbeddingJobStatusResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.JobId = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Status = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Attempts = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.MaxAttempts = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.LastError = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.NextRunTime = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.UpdatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) ReadField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.EmbeddingUpdatedTime = v
+	}
+	return nil
+}
+
+func (p *TeamEmbeddingJobStatusResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamEmbeddingJobStatusResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.JobId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attempts", thrift.I32, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Attempts); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("max_attempts", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MaxAttempts); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_error", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.LastError); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_run_time", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.NextRunTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_time", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embedding_updated_time", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EmbeddingUpdatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamEmbeddingJobStatusResponse(%+v)", *p)
+}
+
+func (p *TeamEmbeddingJobStatusResponse) DeepEqual(ano *TeamEmbeddingJobStatusResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.JobId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Attempts) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.MaxAttempts) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.LastError) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.NextRunTime) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.UpdatedTime) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.EmbeddingUpdatedTime) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamEmbeddingJobStatusResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field3DeepEqual(src int64) bool {
+
+	if p.JobId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field5DeepEqual(src int32) bool {
+
+	if p.Attempts != src {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field6DeepEqual(src int32) bool {
+
+	if p.MaxAttempts != src {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field7DeepEqual(src string) bool {
+
+	if strings.Compare(p.LastError, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field8DeepEqual(src int64) bool {
+
+	if p.NextRunTime != src {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field9DeepEqual(src int64) bool {
+
+	if p.UpdatedTime != src {
+		return false
+	}
+	return true
+}
+func (p *TeamEmbeddingJobStatusResponse) Field10DeepEqual(src int64) bool {
+
+	if p.EmbeddingUpdatedTime != src {
+		return false
+	}
+	return true
+}
+
+type TeamCandidate struct {
+	MemberInfo *MemberInfo `thrift:"member_info,1" frugal:"1,default,MemberInfo" json:"member_info"`
+	Score      float64     `thrift:"score,2" frugal:"2,default,double" json:"score"`
+}
+
+func NewTeamCandidate() *TeamCandidate {
+	return &TeamCandidate{}
+}
+
+func (p *TeamCandidate) InitDefault() {
+	*p = TeamCandidate{}
+}
+
+var TeamCandidate_MemberInfo_DEFAULT *MemberInfo
+
+func (p *TeamCandidate) GetMemberInfo() (v *MemberInfo) {
+	if !p.IsSetMemberInfo() {
+		return TeamCandidate_MemberInfo_DEFAULT
+	}
+	return p.MemberInfo
+}
+
+func (p *TeamCandidate) GetScore() (v float64) {
+	return p.Score
+}
+func (p *TeamCandidate) SetMemberInfo(val *MemberInfo) {
+	p.MemberInfo = val
+}
+func (p *TeamCandidate) SetScore(val float64) {
+	p.Score = val
+}
+
+var fieldIDToName_TeamCandidate = map[int16]string{
+	1: "member_info",
+	2: "score",
+}
+
+func (p *TeamCandidate) IsSetMemberInfo() bool {
+	return p.MemberInfo != nil
+}
+
+func (p *TeamCandidate) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCandidate[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCandidate) ReadField1(iprot thrift.TProtocol) error {
+	p.MemberInfo = NewMemberInfo()
+	if err := p.MemberInfo.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamCandidate) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		p.Score = v
+	}
+	return nil
+}
+
+func (p *TeamCandidate) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCandidate"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCandidate) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("member_info", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.MemberInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCandidate) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Score); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCandidate) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCandidate(%+v)", *p)
+}
+
+func (p *TeamCandidate) DeepEqual(ano *TeamCandidate) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.MemberInfo) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Score) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamCandidate) Field1DeepEqual(src *MemberInfo) bool {
+
+	if !p.MemberInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *TeamCandidate) Field2DeepEqual(src float64) bool {
+
+	if p.Score != src {
+		return false
+	}
+	return true
+}
+
+type JobCandidates struct {
+	Job        string           `thrift:"job,1" frugal:"1,default,string" json:"job"`
+	Candidates []*TeamCandidate `thrift:"candidates,2" frugal:"2,default,list<TeamCandidate>" json:"candidates"`
+}
+
+func NewJobCandidates() *JobCandidates {
+	return &JobCandidates{}
+}
+
+func (p *JobCandidates) InitDefault() {
+	*p = JobCandidates{}
+}
+
+func (p *JobCandidates) GetJob() (v string) {
+	return p.Job
+}
+
+func (p *JobCandidates) GetCandidates() (v []*TeamCandidate) {
+	return p.Candidates
+}
+func (p *JobCandidates) SetJob(val string) {
+	p.Job = val
+}
+func (p *JobCandidates) SetCandidates(val []*TeamCandidate) {
+	p.Candidates = val
+}
+
+var fieldIDToName_JobCandidates = map[int16]string{
+	1: "job",
+	2: "candidates",
+}
+
+func (p *JobCandidates) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobCandidates[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobCandidates) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Job = v
+	}
+	return nil
+}
+
+func (p *JobCandidates) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Candidates = make([]*TeamCandidate, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewTeamCandidate()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.Candidates = append(p.Candidates, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *JobCandidates) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JobCandidates"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobCandidates) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Job); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobCandidates) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("candidates", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Candidates)); err != nil {
+		return err
+	}
+	for _, v := range p.Candidates {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *JobCandidates) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobCandidates(%+v)", *p)
+}
+
+func (p *JobCandidates) DeepEqual(ano *JobCandidates) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Job) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Candidates) {
+		return false
+	}
+	return true
+}
+
+func (p *JobCandidates) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Job, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *JobCandidates) Field2DeepEqual(src []*TeamCandidate) bool {
+
+	if len(p.Candidates) != len(src) {
+		return false
+	}
+	for i, v := range p.Candidates {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TeamCandidatesRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	TeamId int32 `thrift:"team_id,2" frugal:"2,default,i32" json:"team_id"`
+	Limit  int32 `thrift:"limit,3" frugal:"3,default,i32" json:"limit"`
+}
+
+func NewTeamCandidatesRequest() *TeamCandidatesRequest {
+	return &TeamCandidatesRequest{}
+}
+
+func (p *TeamCandidatesRequest) InitDefault() {
+	*p = TeamCandidatesRequest{}
+}
+
+func (p *TeamCandidatesRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+
+func (p *TeamCandidatesRequest) GetTeamId() (v int32) {
+	return p.TeamId
+}
+
+func (p *TeamCandidatesRequest) GetLimit() (v int32) {
+	return p.Limit
+}
+func (p *TeamCandidatesRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *TeamCandidatesRequest) SetTeamId(val int32) {
+	p.TeamId = val
+}
+func (p *TeamCandidatesRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+
+var fieldIDToName_TeamCandidatesRequest = map[int16]string{
+	1: "user_id",
+	2: "team_id",
+	3: "limit",
+}
+
+func (p *TeamCandidatesRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCandidatesRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.TeamId = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCandidatesRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("team_id", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.TeamId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Limit); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamCandidatesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCandidatesRequest(%+v)", *p)
+}
+
+func (p *TeamCandidatesRequest) DeepEqual(ano *TeamCandidatesRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TeamId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamCandidatesRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamCandidatesRequest) Field2DeepEqual(src int32) bool {
+
+	if p.TeamId != src {
+		return false
+	}
+	return true
+}
+func (p *TeamCandidatesRequest) Field3DeepEqual(src int32) bool {
+
+	if p.Limit != src {
+		return false
+	}
+	return true
+}
+
+type TeamCandidatesResponse struct {
+	StatusCode    int32            `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg     string           `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	JobCandidates []*JobCandidates `thrift:"job_candidates,3" frugal:"3,default,list<JobCandidates>" json:"job_candidates"`
+}
+
+func NewTeamCandidatesResponse() *TeamCandidatesResponse {
+	return &TeamCandidatesResponse{}
+}
+
+func (p *TeamCandidatesResponse) InitDefault() {
+	*p = TeamCandidatesResponse{}
+}
+
+func (p *TeamCandidatesResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *TeamCandidatesResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *TeamCandidatesResponse) GetJobCandidates() (v []*JobCandidates) {
+	return p.JobCandidates
+}
+func (p *TeamCandidatesResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *TeamCandidatesResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *TeamCandidatesResponse) SetJobCandidates(val []*JobCandidates) {
+	p.JobCandidates = val
+}
+
+var fieldIDToName_TeamCandidatesResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "job_candidates",
+}
+
+func (p *TeamCandidatesResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamCandidatesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *TeamCandidatesResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.JobCandidates = make([]*JobCandidates, 0, size)
+	for i := 0; i < size; i++ {
+		_elem := NewJobCandidates()
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		p.JobCandidates = append(p.JobCandidates, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamCandidatesResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCandidatesResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_candidates", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.JobCandidates)); err != nil {
+		return err
+	}
+	for _, v := range p.JobCandidates {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TeamCandidatesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamCandidatesResponse(%+v)", *p)
+}
+
+func (p *TeamCandidatesResponse) DeepEqual(ano *TeamCandidatesResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.JobCandidates) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamCandidatesResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *TeamCandidatesResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TeamCandidatesResponse) Field3DeepEqual(src []*JobCandidates) bool {
+
+	if len(p.JobCandidates) != len(src) {
+		return false
+	}
+	for i, v := range p.JobCandidates {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TeamService interface {
+	TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error)
+
+	TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error)
+
+	TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error)
+
+	TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error)
+
+	TeamApplicationWithdraw(ctx context.Context, req *TeamApplicationWithdrawRequest) (r *TeamApplicationWithdrawResponse, err error)
+
+	MyApplications(ctx context.Context, req *MyApplicationsRequest) (r *MyApplicationsResponse, err error)
+
+	MyTeamList(ctx context.Context, req *MyTeamListRequest) (r *MyTeamListResponse, err error)
+
+	TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error)
+
+	TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error)
+
+	TeamLeave(ctx context.Context, req *TeamLeaveRequest) (r *TeamLeaveResponse, err error)
+
+	TeamMemberRemove(ctx context.Context, req *TeamMemberRemoveRequest) (r *TeamMemberRemoveResponse, err error)
+
+	TeamLeaderTransfer(ctx context.Context, req *TeamLeaderTransferRequest) (r *TeamLeaderTransferResponse, err error)
+
+	TeamClose(ctx context.Context, req *TeamCloseRequest) (r *TeamCloseResponse, err error)
+
+	TeamDisband(ctx context.Context, req *TeamDisbandRequest) (r *TeamDisbandResponse, err error)
+
+	TeamInvitationSend(ctx context.Context, req *TeamInvitationSendRequest) (r *TeamInvitationSendResponse, err error)
+
+	TeamInvitationRespond(ctx context.Context, req *TeamInvitationRespondRequest) (r *TeamInvitationRespondResponse, err error)
+
+	TeamInvitationList(ctx context.Context, req *TeamInvitationListRequest) (r *TeamInvitationListResponse, err error)
+
+	TeamSentInvitationList(ctx context.Context, req *TeamSentInvitationListRequest) (r *TeamSentInvitationListResponse, err error)
+
+	TeamInvitationCancel(ctx context.Context, req *TeamInvitationCancelRequest) (r *TeamInvitationCancelResponse, err error)
+
+	TeamEmbeddingJobStatus(ctx context.Context, req *TeamEmbeddingJobStatusRequest) (r *TeamEmbeddingJobStatusResponse, err error)
+
+	TeamCandidates(ctx context.Context, req *TeamCandidatesRequest) (r *TeamCandidatesResponse, err error)
+}
+
+type TeamServiceClient struct {
+	c thrift.TClient
+}
+
+func NewTeamServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TeamServiceClient {
+	return &TeamServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewTeamServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TeamServiceClient {
+	return &TeamServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewTeamServiceClient(c thrift.TClient) *TeamServiceClient {
+	return &TeamServiceClient{
+		c: c,
+	}
+}
+
+func (p *TeamServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *TeamServiceClient) TeamCreate(ctx context.Context, req *TeamCreateRequest) (r *TeamCreateResponse, err error) {
+	var _args TeamServiceTeamCreateArgs
+	_args.Req = req
+	var _result TeamServiceTeamCreateResult
+	if err = p.Client_().Call(ctx, "TeamCreate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamList(ctx context.Context, req *TeamListRequest) (r *TeamListResponse, err error) {
+	var _args TeamServiceTeamListArgs
+	_args.Req = req
+	var _result TeamServiceTeamListResult
+	if err = p.Client_().Call(ctx, "TeamList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamInfo(ctx context.Context, req *TeamInfoRequest) (r *TeamInfoResponse, err error) {
+	var _args TeamServiceTeamInfoArgs
+	_args.Req = req
+	var _result TeamServiceTeamInfoResult
+	if err = p.Client_().Call(ctx, "TeamInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamApplicationSubmit(ctx context.Context, req *TeamApplicationSubmitRequest) (r *TeamApplicationSubmitResponse, err error) {
+	var _args TeamServiceTeamApplicationSubmitArgs
+	_args.Req = req
+	var _result TeamServiceTeamApplicationSubmitResult
+	if err = p.Client_().Call(ctx, "TeamApplicationSubmit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamApplicationWithdraw(ctx context.Context, req *TeamApplicationWithdrawRequest) (r *TeamApplicationWithdrawResponse, err error) {
+	var _args TeamServiceTeamApplicationWithdrawArgs
+	_args.Req = req
+	var _result TeamServiceTeamApplicationWithdrawResult
+	if err = p.Client_().Call(ctx, "TeamApplicationWithdraw", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) MyApplications(ctx context.Context, req *MyApplicationsRequest) (r *MyApplicationsResponse, err error) {
+	var _args TeamServiceMyApplicationsArgs
+	_args.Req = req
+	var _result TeamServiceMyApplicationsResult
+	if err = p.Client_().Call(ctx, "MyApplications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) MyTeamList(ctx context.Context, req *MyTeamListRequest) (r *MyTeamListResponse, err error) {
+	var _args TeamServiceMyTeamListArgs
+	_args.Req = req
+	var _result TeamServiceMyTeamListResult
+	if err = p.Client_().Call(ctx, "MyTeamList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamManageList(ctx context.Context, req *TeamManageListRequest) (r *TeamManageListResponse, err error) {
+	var _args TeamServiceTeamManageListArgs
+	_args.Req = req
+	var _result TeamServiceTeamManageListResult
+	if err = p.Client_().Call(ctx, "TeamManageList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamManageAction(ctx context.Context, req *TeamManageActionRequest) (r *TeamManageActionResponse, err error) {
+	var _args TeamServiceTeamManageActionArgs
+	_args.Req = req
+	var _result TeamServiceTeamManageActionResult
+	if err = p.Client_().Call(ctx, "TeamManageAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamLeave(ctx context.Context, req *TeamLeaveRequest) (r *TeamLeaveResponse, err error) {
+	var _args TeamServiceTeamLeaveArgs
+	_args.Req = req
+	var _result TeamServiceTeamLeaveResult
+	if err = p.Client_().Call(ctx, "TeamLeave", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamMemberRemove(ctx context.Context, req *TeamMemberRemoveRequest) (r *TeamMemberRemoveResponse, err error) {
+	var _args TeamServiceTeamMemberRemoveArgs
+	_args.Req = req
+	var _result TeamServiceTeamMemberRemoveResult
+	if err = p.Client_().Call(ctx, "TeamMemberRemove", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamLeaderTransfer(ctx context.Context, req *TeamLeaderTransferRequest) (r *TeamLeaderTransferResponse, err error) {
+	var _args TeamServiceTeamLeaderTransferArgs
+	_args.Req = req
+	var _result TeamServiceTeamLeaderTransferResult
+	if err = p.Client_().Call(ctx, "TeamLeaderTransfer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamClose(ctx context.Context, req *TeamCloseRequest) (r *TeamCloseResponse, err error) {
+	var _args TeamServiceTeamCloseArgs
+	_args.Req = req
+	var _result TeamServiceTeamCloseResult
+	if err = p.Client_().Call(ctx, "TeamClose", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamDisband(ctx context.Context, req *TeamDisbandRequest) (r *TeamDisbandResponse, err error) {
+	var _args TeamServiceTeamDisbandArgs
+	_args.Req = req
+	var _result TeamServiceTeamDisbandResult
+	if err = p.Client_().Call(ctx, "TeamDisband", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamInvitationSend(ctx context.Context, req *TeamInvitationSendRequest) (r *TeamInvitationSendResponse, err error) {
+	var _args TeamServiceTeamInvitationSendArgs
+	_args.Req = req
+	var _result TeamServiceTeamInvitationSendResult
+	if err = p.Client_().Call(ctx, "TeamInvitationSend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamInvitationRespond(ctx context.Context, req *TeamInvitationRespondRequest) (r *TeamInvitationRespondResponse, err error) {
+	var _args TeamServiceTeamInvitationRespondArgs
+	_args.Req = req
+	var _result TeamServiceTeamInvitationRespondResult
+	if err = p.Client_().Call(ctx, "TeamInvitationRespond", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamInvitationList(ctx context.Context, req *TeamInvitationListRequest) (r *TeamInvitationListResponse, err error) {
+	var _args TeamServiceTeamInvitationListArgs
+	_args.Req = req
+	var _result TeamServiceTeamInvitationListResult
+	if err = p.Client_().Call(ctx, "TeamInvitationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamSentInvitationList(ctx context.Context, req *TeamSentInvitationListRequest) (r *TeamSentInvitationListResponse, err error) {
+	var _args TeamServiceTeamSentInvitationListArgs
+	_args.Req = req
+	var _result TeamServiceTeamSentInvitationListResult
+	if err = p.Client_().Call(ctx, "TeamSentInvitationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamInvitationCancel(ctx context.Context, req *TeamInvitationCancelRequest) (r *TeamInvitationCancelResponse, err error) {
+	var _args TeamServiceTeamInvitationCancelArgs
+	_args.Req = req
+	var _result TeamServiceTeamInvitationCancelResult
+	if err = p.Client_().Call(ctx, "TeamInvitationCancel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamEmbeddingJobStatus(ctx context.Context, req *TeamEmbeddingJobStatusRequest) (r *TeamEmbeddingJobStatusResponse, err error) {
+	var _args TeamServiceTeamEmbeddingJobStatusArgs
+	_args.Req = req
+	var _result TeamServiceTeamEmbeddingJobStatusResult
+	if err = p.Client_().Call(ctx, "TeamEmbeddingJobStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TeamServiceClient) TeamCandidates(ctx context.Context, req *TeamCandidatesRequest) (r *TeamCandidatesResponse, err error) {
+	var _args TeamServiceTeamCandidatesArgs
+	_args.Req = req
+	var _result TeamServiceTeamCandidatesResult
+	if err = p.Client_().Call(ctx, "TeamCandidates", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type TeamServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      TeamService
+}
+
+func (p *TeamServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *TeamServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *TeamServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewTeamServiceProcessor(handler TeamService) *TeamServiceProcessor {
+	self := &TeamServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("TeamCreate", &teamServiceProcessorTeamCreate{handler: handler})
+	self.AddToProcessorMap("TeamList", &teamServiceProcessorTeamList{handler: handler})
+	self.AddToProcessorMap("TeamInfo", &teamServiceProcessorTeamInfo{handler: handler})
+	self.AddToProcessorMap("TeamApplicationSubmit", &teamServiceProcessorTeamApplicationSubmit{handler: handler})
+	self.AddToProcessorMap("TeamApplicationWithdraw", &teamServiceProcessorTeamApplicationWithdraw{handler: handler})
+	self.AddToProcessorMap("MyApplications", &teamServiceProcessorMyApplications{handler: handler})
+	self.AddToProcessorMap("MyTeamList", &teamServiceProcessorMyTeamList{handler: handler})
+	self.AddToProcessorMap("TeamManageList", &teamServiceProcessorTeamManageList{handler: handler})
+	self.AddToProcessorMap("TeamManageAction", &teamServiceProcessorTeamManageAction{handler: handler})
+	self.AddToProcessorMap("TeamLeave", &teamServiceProcessorTeamLeave{handler: handler})
+	self.AddToProcessorMap("TeamMemberRemove", &teamServiceProcessorTeamMemberRemove{handler: handler})
+	self.AddToProcessorMap("TeamLeaderTransfer", &teamServiceProcessorTeamLeaderTransfer{handler: handler})
+	self.AddToProcessorMap("TeamClose", &teamServiceProcessorTeamClose{handler: handler})
+	self.AddToProcessorMap("TeamDisband", &teamServiceProcessorTeamDisband{handler: handler})
+	self.AddToProcessorMap("TeamInvitationSend", &teamServiceProcessorTeamInvitationSend{handler: handler})
+	self.AddToProcessorMap("TeamInvitationRespond", &teamServiceProcessorTeamInvitationRespond{handler: handler})
+	self.AddToProcessorMap("TeamInvitationList", &teamServiceProcessorTeamInvitationList{handler: handler})
+	self.AddToProcessorMap("TeamSentInvitationList", &teamServiceProcessorTeamSentInvitationList{handler: handler})
+	self.AddToProcessorMap("TeamInvitationCancel", &teamServiceProcessorTeamInvitationCancel{handler: handler})
+	self.AddToProcessorMap("TeamEmbeddingJobStatus", &teamServiceProcessorTeamEmbeddingJobStatus{handler: handler})
+	self.AddToProcessorMap("TeamCandidates", &teamServiceProcessorTeamCandidates{handler: handler})
+	return self
+}
+func (p *TeamServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type teamServiceProcessorTeamCreate struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamCreate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamCreateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamCreateResult{}
+	var retval *TeamCreateResponse
+	if retval, err2 = p.handler.TeamCreate(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamCreate: "+err2.Error())
+		oprot.WriteMessageBegin("TeamCreate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamCreate", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamList struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamListResult{}
+	var retval *TeamListResponse
+	if retval, err2 = p.handler.TeamList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamInfo struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamInfoResult{}
+	var retval *TeamInfoResponse
+	if retval, err2 = p.handler.TeamInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInfo: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamApplicationSubmit struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamApplicationSubmit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamApplicationSubmitArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamApplicationSubmitResult{}
+	var retval *TeamApplicationSubmitResponse
+	if retval, err2 = p.handler.TeamApplicationSubmit(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamApplicationSubmit: "+err2.Error())
+		oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamApplicationSubmit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamApplicationWithdraw struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamApplicationWithdraw) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamApplicationWithdrawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamApplicationWithdraw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamApplicationWithdrawResult{}
+	var retval *TeamApplicationWithdrawResponse
+	if retval, err2 = p.handler.TeamApplicationWithdraw(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamApplicationWithdraw: "+err2.Error())
+		oprot.WriteMessageBegin("TeamApplicationWithdraw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamApplicationWithdraw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorMyApplications struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorMyApplications) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceMyApplicationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MyApplications", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceMyApplicationsResult{}
+	var retval *MyApplicationsResponse
+	if retval, err2 = p.handler.MyApplications(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MyApplications: "+err2.Error())
+		oprot.WriteMessageBegin("MyApplications", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MyApplications", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorMyTeamList struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorMyTeamList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceMyTeamListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MyTeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceMyTeamListResult{}
+	var retval *MyTeamListResponse
+	if retval, err2 = p.handler.MyTeamList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MyTeamList: "+err2.Error())
+		oprot.WriteMessageBegin("MyTeamList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MyTeamList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamManageList struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamManageList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamManageListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamManageListResult{}
+	var retval *TeamManageListResponse
+	if retval, err2 = p.handler.TeamManageList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamManageList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamManageAction struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamManageAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamManageActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamManageActionResult{}
+	var retval *TeamManageActionResponse
+	if retval, err2 = p.handler.TeamManageAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageAction: "+err2.Error())
+		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamManageAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamLeave struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamLeave) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamLeaveArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamLeave", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamLeaveResult{}
+	var retval *TeamLeaveResponse
+	if retval, err2 = p.handler.TeamLeave(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamLeave: "+err2.Error())
+		oprot.WriteMessageBegin("TeamLeave", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamLeave", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamMemberRemove struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamMemberRemove) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamMemberRemoveArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamMemberRemove", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamMemberRemoveResult{}
+	var retval *TeamMemberRemoveResponse
+	if retval, err2 = p.handler.TeamMemberRemove(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamMemberRemove: "+err2.Error())
+		oprot.WriteMessageBegin("TeamMemberRemove", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamMemberRemove", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamLeaderTransfer struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamLeaderTransfer) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamLeaderTransferArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamLeaderTransfer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamLeaderTransferResult{}
+	var retval *TeamLeaderTransferResponse
+	if retval, err2 = p.handler.TeamLeaderTransfer(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamLeaderTransfer: "+err2.Error())
+		oprot.WriteMessageBegin("TeamLeaderTransfer", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamLeaderTransfer", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamClose struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamClose) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamCloseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamClose", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamCloseResult{}
+	var retval *TeamCloseResponse
+	if retval, err2 = p.handler.TeamClose(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamClose: "+err2.Error())
+		oprot.WriteMessageBegin("TeamClose", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamClose", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamDisband struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamDisband) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamDisbandArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamDisband", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamDisbandResult{}
+	var retval *TeamDisbandResponse
+	if retval, err2 = p.handler.TeamDisband(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamDisband: "+err2.Error())
+		oprot.WriteMessageBegin("TeamDisband", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamDisband", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamInvitationSend struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamInvitationSend) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamInvitationSendArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationSend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamInvitationSendResult{}
+	var retval *TeamInvitationSendResponse
+	if retval, err2 = p.handler.TeamInvitationSend(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationSend: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationSend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationSend", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamInvitationRespond struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamInvitationRespond) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamInvitationRespondArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationRespond", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamInvitationRespondResult{}
+	var retval *TeamInvitationRespondResponse
+	if retval, err2 = p.handler.TeamInvitationRespond(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationRespond: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationRespond", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationRespond", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamInvitationList struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamInvitationList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamInvitationListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamInvitationListResult{}
+	var retval *TeamInvitationListResponse
+	if retval, err2 = p.handler.TeamInvitationList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamSentInvitationList struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamSentInvitationList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamSentInvitationListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamSentInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamSentInvitationListResult{}
+	var retval *TeamSentInvitationListResponse
+	if retval, err2 = p.handler.TeamSentInvitationList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamSentInvitationList: "+err2.Error())
+		oprot.WriteMessageBegin("TeamSentInvitationList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamSentInvitationList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamInvitationCancel struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamInvitationCancel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamInvitationCancelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamInvitationCancel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamInvitationCancelResult{}
+	var retval *TeamInvitationCancelResponse
+	if retval, err2 = p.handler.TeamInvitationCancel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamInvitationCancel: "+err2.Error())
+		oprot.WriteMessageBegin("TeamInvitationCancel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamInvitationCancel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamEmbeddingJobStatus struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamEmbeddingJobStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamEmbeddingJobStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamEmbeddingJobStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamEmbeddingJobStatusResult{}
+	var retval *TeamEmbeddingJobStatusResponse
+	if retval, err2 = p.handler.TeamEmbeddingJobStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamEmbeddingJobStatus: "+err2.Error())
+		oprot.WriteMessageBegin("TeamEmbeddingJobStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamEmbeddingJobStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type teamServiceProcessorTeamCandidates struct {
+	handler TeamService
+}
+
+func (p *teamServiceProcessorTeamCandidates) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TeamServiceTeamCandidatesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TeamCandidates", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TeamServiceTeamCandidatesResult{}
+	var retval *TeamCandidatesResponse
+	if retval, err2 = p.handler.TeamCandidates(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamCandidates: "+err2.Error())
+		oprot.WriteMessageBegin("TeamCandidates", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TeamCandidates", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type TeamServiceTeamCreateArgs struct {
+	Req *TeamCreateRequest `thrift:"req,1" frugal:"1,default,TeamCreateRequest" json:"req"`
+}
+
+func NewTeamServiceTeamCreateArgs() *TeamServiceTeamCreateArgs {
+	return &TeamServiceTeamCreateArgs{}
+}
+
+func (p *TeamServiceTeamCreateArgs) InitDefault() {
+	*p = TeamServiceTeamCreateArgs{}
+}
+
+var TeamServiceTeamCreateArgs_Req_DEFAULT *TeamCreateRequest
+
+func (p *TeamServiceTeamCreateArgs) GetReq() (v *TeamCreateRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamCreateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamCreateArgs) SetReq(val *TeamCreateRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamCreateArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamCreateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCreateArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCreateArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamCreateRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCreate_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCreateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamCreateArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamCreateArgs) DeepEqual(ano *TeamServiceTeamCreateArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamCreateArgs) Field1DeepEqual(src *TeamCreateRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamCreateResult struct {
+	Success *TeamCreateResponse `thrift:"success,0,optional" frugal:"0,optional,TeamCreateResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamCreateResult() *TeamServiceTeamCreateResult {
+	return &TeamServiceTeamCreateResult{}
+}
+
+func (p *TeamServiceTeamCreateResult) InitDefault() {
+	*p = TeamServiceTeamCreateResult{}
+}
+
+var TeamServiceTeamCreateResult_Success_DEFAULT *TeamCreateResponse
+
+func (p *TeamServiceTeamCreateResult) GetSuccess() (v *TeamCreateResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamCreateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamCreateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamCreateResponse)
+}
+
+var fieldIDToName_TeamServiceTeamCreateResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamCreateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamCreateResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCreateResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCreateResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamCreateResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamCreateResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamCreate_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCreateResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamCreateResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamCreateResult) DeepEqual(ano *TeamServiceTeamCreateResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamCreateResult) Field0DeepEqual(src *TeamCreateResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamListArgs struct {
+	Req *TeamListRequest `thrift:"req,1" frugal:"1,default,TeamListRequest" json:"req"`
+}
+
+func NewTeamServiceTeamListArgs() *TeamServiceTeamListArgs {
+	return &TeamServiceTeamListArgs{}
+}
+
+func (p *TeamServiceTeamListArgs) InitDefault() {
+	*p = TeamServiceTeamListArgs{}
+}
+
+var TeamServiceTeamListArgs_Req_DEFAULT *TeamListRequest
+
+func (p *TeamServiceTeamListArgs) GetReq() (v *TeamListRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamListArgs) SetReq(val *TeamListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamListRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamListArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamListArgs) DeepEqual(ano *TeamServiceTeamListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamListArgs) Field1DeepEqual(src *TeamListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamListResult struct {
+	Success *TeamListResponse `thrift:"success,0,optional" frugal:"0,optional,TeamListResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamListResult() *TeamServiceTeamListResult {
+	return &TeamServiceTeamListResult{}
+}
+
+func (p *TeamServiceTeamListResult) InitDefault() {
+	*p = TeamServiceTeamListResult{}
+}
+
+var TeamServiceTeamListResult_Success_DEFAULT *TeamListResponse
+
+func (p *TeamServiceTeamListResult) GetSuccess() (v *TeamListResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamListResponse)
+}
+
+var fieldIDToName_TeamServiceTeamListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamListResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamListResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamListResult) DeepEqual(ano *TeamServiceTeamListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamListResult) Field0DeepEqual(src *TeamListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamInfoArgs struct {
+	Req *TeamInfoRequest `thrift:"req,1" frugal:"1,default,TeamInfoRequest" json:"req"`
+}
+
+func NewTeamServiceTeamInfoArgs() *TeamServiceTeamInfoArgs {
+	return &TeamServiceTeamInfoArgs{}
+}
+
+func (p *TeamServiceTeamInfoArgs) InitDefault() {
+	*p = TeamServiceTeamInfoArgs{}
+}
+
+var TeamServiceTeamInfoArgs_Req_DEFAULT *TeamInfoRequest
+
+func (p *TeamServiceTeamInfoArgs) GetReq() (v *TeamInfoRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamInfoArgs) SetReq(val *TeamInfoRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInfoRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamInfoArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamInfoArgs) DeepEqual(ano *TeamServiceTeamInfoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamInfoArgs) Field1DeepEqual(src *TeamInfoRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamInfoResult struct {
+	Success *TeamInfoResponse `thrift:"success,0,optional" frugal:"0,optional,TeamInfoResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamInfoResult() *TeamServiceTeamInfoResult {
+	return &TeamServiceTeamInfoResult{}
+}
+
+func (p *TeamServiceTeamInfoResult) InitDefault() {
+	*p = TeamServiceTeamInfoResult{}
+}
+
+var TeamServiceTeamInfoResult_Success_DEFAULT *TeamInfoResponse
+
+func (p *TeamServiceTeamInfoResult) GetSuccess() (v *TeamInfoResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamInfoResponse)
+}
+
+var fieldIDToName_TeamServiceTeamInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamInfoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInfoResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamInfoResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamInfoResult) DeepEqual(ano *TeamServiceTeamInfoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamInfoResult) Field0DeepEqual(src *TeamInfoResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamApplicationSubmitArgs struct {
+	Req *TeamApplicationSubmitRequest `thrift:"req,1" frugal:"1,default,TeamApplicationSubmitRequest" json:"req"`
+}
+
+func NewTeamServiceTeamApplicationSubmitArgs() *TeamServiceTeamApplicationSubmitArgs {
+	return &TeamServiceTeamApplicationSubmitArgs{}
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) InitDefault() {
+	*p = TeamServiceTeamApplicationSubmitArgs{}
+}
+
+var TeamServiceTeamApplicationSubmitArgs_Req_DEFAULT *TeamApplicationSubmitRequest
+
+func (p *TeamServiceTeamApplicationSubmitArgs) GetReq() (v *TeamApplicationSubmitRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamApplicationSubmitArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamApplicationSubmitArgs) SetReq(val *TeamApplicationSubmitRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamApplicationSubmitArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamApplicationSubmitArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamApplicationSubmitRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationSubmit_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamApplicationSubmitArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) DeepEqual(ano *TeamServiceTeamApplicationSubmitArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamApplicationSubmitArgs) Field1DeepEqual(src *TeamApplicationSubmitRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamApplicationSubmitResult struct {
+	Success *TeamApplicationSubmitResponse `thrift:"success,0,optional" frugal:"0,optional,TeamApplicationSubmitResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamApplicationSubmitResult() *TeamServiceTeamApplicationSubmitResult {
+	return &TeamServiceTeamApplicationSubmitResult{}
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) InitDefault() {
+	*p = TeamServiceTeamApplicationSubmitResult{}
+}
+
+var TeamServiceTeamApplicationSubmitResult_Success_DEFAULT *TeamApplicationSubmitResponse
+
+func (p *TeamServiceTeamApplicationSubmitResult) GetSuccess() (v *TeamApplicationSubmitResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamApplicationSubmitResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamApplicationSubmitResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamApplicationSubmitResponse)
+}
+
+var fieldIDToName_TeamServiceTeamApplicationSubmitResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamApplicationSubmitResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamApplicationSubmitResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationSubmit_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamApplicationSubmitResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) DeepEqual(ano *TeamServiceTeamApplicationSubmitResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamApplicationSubmitResult) Field0DeepEqual(src *TeamApplicationSubmitResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamApplicationWithdrawArgs struct {
+	Req *TeamApplicationWithdrawRequest `thrift:"req,1" frugal:"1,default,TeamApplicationWithdrawRequest" json:"req"`
+}
+
+func NewTeamServiceTeamApplicationWithdrawArgs() *TeamServiceTeamApplicationWithdrawArgs {
+	return &TeamServiceTeamApplicationWithdrawArgs{}
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) InitDefault() {
+	*p = TeamServiceTeamApplicationWithdrawArgs{}
+}
+
+var TeamServiceTeamApplicationWithdrawArgs_Req_DEFAULT *TeamApplicationWithdrawRequest
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) GetReq() (v *TeamApplicationWithdrawRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamApplicationWithdrawArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamApplicationWithdrawArgs) SetReq(val *TeamApplicationWithdrawRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamApplicationWithdrawArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamApplicationWithdrawArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamApplicationWithdrawRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationWithdraw_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamApplicationWithdrawArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) DeepEqual(ano *TeamServiceTeamApplicationWithdrawArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamApplicationWithdrawArgs) Field1DeepEqual(src *TeamApplicationWithdrawRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamApplicationWithdrawResult struct {
+	Success *TeamApplicationWithdrawResponse `thrift:"success,0,optional" frugal:"0,optional,TeamApplicationWithdrawResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamApplicationWithdrawResult() *TeamServiceTeamApplicationWithdrawResult {
+	return &TeamServiceTeamApplicationWithdrawResult{}
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) InitDefault() {
+	*p = TeamServiceTeamApplicationWithdrawResult{}
+}
+
+var TeamServiceTeamApplicationWithdrawResult_Success_DEFAULT *TeamApplicationWithdrawResponse
+
+func (p *TeamServiceTeamApplicationWithdrawResult) GetSuccess() (v *TeamApplicationWithdrawResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamApplicationWithdrawResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamApplicationWithdrawResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamApplicationWithdrawResponse)
+}
+
+var fieldIDToName_TeamServiceTeamApplicationWithdrawResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamApplicationWithdrawResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamApplicationWithdrawResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamApplicationWithdraw_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamApplicationWithdrawResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) DeepEqual(ano *TeamServiceTeamApplicationWithdrawResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamApplicationWithdrawResult) Field0DeepEqual(src *TeamApplicationWithdrawResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceMyApplicationsArgs struct {
+	Req *MyApplicationsRequest `thrift:"req,1" frugal:"1,default,MyApplicationsRequest" json:"req"`
+}
+
+func NewTeamServiceMyApplicationsArgs() *TeamServiceMyApplicationsArgs {
+	return &TeamServiceMyApplicationsArgs{}
+}
+
+func (p *TeamServiceMyApplicationsArgs) InitDefault() {
+	*p = TeamServiceMyApplicationsArgs{}
+}
+
+var TeamServiceMyApplicationsArgs_Req_DEFAULT *MyApplicationsRequest
+
+func (p *TeamServiceMyApplicationsArgs) GetReq() (v *MyApplicationsRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceMyApplicationsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceMyApplicationsArgs) SetReq(val *MyApplicationsRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceMyApplicationsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceMyApplicationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceMyApplicationsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceMyApplicationsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyApplicationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewMyApplicationsRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceMyApplicationsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyApplications_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyApplicationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceMyApplicationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceMyApplicationsArgs(%+v)", *p)
+}
+
+func (p *TeamServiceMyApplicationsArgs) DeepEqual(ano *TeamServiceMyApplicationsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceMyApplicationsArgs) Field1DeepEqual(src *MyApplicationsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceMyApplicationsResult struct {
+	Success *MyApplicationsResponse `thrift:"success,0,optional" frugal:"0,optional,MyApplicationsResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceMyApplicationsResult() *TeamServiceMyApplicationsResult {
+	return &TeamServiceMyApplicationsResult{}
+}
+
+func (p *TeamServiceMyApplicationsResult) InitDefault() {
+	*p = TeamServiceMyApplicationsResult{}
+}
+
+var TeamServiceMyApplicationsResult_Success_DEFAULT *MyApplicationsResponse
+
+func (p *TeamServiceMyApplicationsResult) GetSuccess() (v *MyApplicationsResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceMyApplicationsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceMyApplicationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MyApplicationsResponse)
+}
+
+var fieldIDToName_TeamServiceMyApplicationsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceMyApplicationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceMyApplicationsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceMyApplicationsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyApplicationsResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewMyApplicationsResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceMyApplicationsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyApplications_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyApplicationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceMyApplicationsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceMyApplicationsResult(%+v)", *p)
+}
+
+func (p *TeamServiceMyApplicationsResult) DeepEqual(ano *TeamServiceMyApplicationsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceMyApplicationsResult) Field0DeepEqual(src *MyApplicationsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceMyTeamListArgs struct {
+	Req *MyTeamListRequest `thrift:"req,1" frugal:"1,default,MyTeamListRequest" json:"req"`
+}
+
+func NewTeamServiceMyTeamListArgs() *TeamServiceMyTeamListArgs {
+	return &TeamServiceMyTeamListArgs{}
+}
+
+func (p *TeamServiceMyTeamListArgs) InitDefault() {
+	*p = TeamServiceMyTeamListArgs{}
+}
+
+var TeamServiceMyTeamListArgs_Req_DEFAULT *MyTeamListRequest
+
+func (p *TeamServiceMyTeamListArgs) GetReq() (v *MyTeamListRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceMyTeamListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceMyTeamListArgs) SetReq(val *MyTeamListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceMyTeamListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceMyTeamListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceMyTeamListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceMyTeamListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyTeamListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewMyTeamListRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceMyTeamListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyTeamList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyTeamListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceMyTeamListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceMyTeamListArgs(%+v)", *p)
+}
+
+func (p *TeamServiceMyTeamListArgs) DeepEqual(ano *TeamServiceMyTeamListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceMyTeamListArgs) Field1DeepEqual(src *MyTeamListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceMyTeamListResult struct {
+	Success *MyTeamListResponse `thrift:"success,0,optional" frugal:"0,optional,MyTeamListResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceMyTeamListResult() *TeamServiceMyTeamListResult {
+	return &TeamServiceMyTeamListResult{}
+}
+
+func (p *TeamServiceMyTeamListResult) InitDefault() {
+	*p = TeamServiceMyTeamListResult{}
+}
+
+var TeamServiceMyTeamListResult_Success_DEFAULT *MyTeamListResponse
+
+func (p *TeamServiceMyTeamListResult) GetSuccess() (v *MyTeamListResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceMyTeamListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceMyTeamListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MyTeamListResponse)
+}
+
+var fieldIDToName_TeamServiceMyTeamListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceMyTeamListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceMyTeamListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceMyTeamListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyTeamListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewMyTeamListResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceMyTeamListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MyTeamList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceMyTeamListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceMyTeamListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceMyTeamListResult(%+v)", *p)
+}
+
+func (p *TeamServiceMyTeamListResult) DeepEqual(ano *TeamServiceMyTeamListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceMyTeamListResult) Field0DeepEqual(src *MyTeamListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamManageListArgs struct {
+	Req *TeamManageListRequest `thrift:"req,1" frugal:"1,default,TeamManageListRequest" json:"req"`
+}
+
+func NewTeamServiceTeamManageListArgs() *TeamServiceTeamManageListArgs {
+	return &TeamServiceTeamManageListArgs{}
+}
+
+func (p *TeamServiceTeamManageListArgs) InitDefault() {
+	*p = TeamServiceTeamManageListArgs{}
+}
+
+var TeamServiceTeamManageListArgs_Req_DEFAULT *TeamManageListRequest
+
+func (p *TeamServiceTeamManageListArgs) GetReq() (v *TeamManageListRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamManageListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamManageListArgs) SetReq(val *TeamManageListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamManageListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamManageListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamManageListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamManageListRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamManageListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamManageListArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamManageListArgs) DeepEqual(ano *TeamServiceTeamManageListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamManageListArgs) Field1DeepEqual(src *TeamManageListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamManageListResult struct {
+	Success *TeamManageListResponse `thrift:"success,0,optional" frugal:"0,optional,TeamManageListResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamManageListResult() *TeamServiceTeamManageListResult {
+	return &TeamServiceTeamManageListResult{}
+}
+
+func (p *TeamServiceTeamManageListResult) InitDefault() {
+	*p = TeamServiceTeamManageListResult{}
+}
+
+var TeamServiceTeamManageListResult_Success_DEFAULT *TeamManageListResponse
+
+func (p *TeamServiceTeamManageListResult) GetSuccess() (v *TeamManageListResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamManageListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamManageListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamManageListResponse)
+}
+
+var fieldIDToName_TeamServiceTeamManageListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamManageListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamManageListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamManageListResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamManageListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamManageListResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamManageListResult) DeepEqual(ano *TeamServiceTeamManageListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamManageListResult) Field0DeepEqual(src *TeamManageListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamManageActionArgs struct {
+	Req *TeamManageActionRequest `thrift:"req,1" frugal:"1,default,TeamManageActionRequest" json:"req"`
+}
+
+func NewTeamServiceTeamManageActionArgs() *TeamServiceTeamManageActionArgs {
+	return &TeamServiceTeamManageActionArgs{}
+}
+
+func (p *TeamServiceTeamManageActionArgs) InitDefault() {
+	*p = TeamServiceTeamManageActionArgs{}
+}
+
+var TeamServiceTeamManageActionArgs_Req_DEFAULT *TeamManageActionRequest
+
+func (p *TeamServiceTeamManageActionArgs) GetReq() (v *TeamManageActionRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamManageActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamManageActionArgs) SetReq(val *TeamManageActionRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamManageActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamManageActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamManageActionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageActionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamManageActionRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamManageActionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageAction_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamManageActionArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamManageActionArgs) DeepEqual(ano *TeamServiceTeamManageActionArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamManageActionArgs) Field1DeepEqual(src *TeamManageActionRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamManageActionResult struct {
+	Success *TeamManageActionResponse `thrift:"success,0,optional" frugal:"0,optional,TeamManageActionResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamManageActionResult() *TeamServiceTeamManageActionResult {
+	return &TeamServiceTeamManageActionResult{}
+}
+
+func (p *TeamServiceTeamManageActionResult) InitDefault() {
+	*p = TeamServiceTeamManageActionResult{}
+}
+
+var TeamServiceTeamManageActionResult_Success_DEFAULT *TeamManageActionResponse
+
+func (p *TeamServiceTeamManageActionResult) GetSuccess() (v *TeamManageActionResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamManageActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamManageActionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamManageActionResponse)
+}
+
+var fieldIDToName_TeamServiceTeamManageActionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamManageActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamManageActionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageActionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageActionResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamManageActionResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamManageActionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamManageAction_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamManageActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamManageActionResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamManageActionResult) DeepEqual(ano *TeamServiceTeamManageActionResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamManageActionResult) Field0DeepEqual(src *TeamManageActionResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamLeaveArgs struct {
+	Req *TeamLeaveRequest `thrift:"req,1" frugal:"1,default,TeamLeaveRequest" json:"req"`
+}
+
+func NewTeamServiceTeamLeaveArgs() *TeamServiceTeamLeaveArgs {
+	return &TeamServiceTeamLeaveArgs{}
+}
+
+func (p *TeamServiceTeamLeaveArgs) InitDefault() {
+	*p = TeamServiceTeamLeaveArgs{}
+}
+
+var TeamServiceTeamLeaveArgs_Req_DEFAULT *TeamLeaveRequest
+
+func (p *TeamServiceTeamLeaveArgs) GetReq() (v *TeamLeaveRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamLeaveArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamLeaveArgs) SetReq(val *TeamLeaveRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamLeaveArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamLeaveArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamLeaveArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamLeaveArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaveArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamLeaveRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamLeaveArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeave_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaveArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaveArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamLeaveArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamLeaveArgs) DeepEqual(ano *TeamServiceTeamLeaveArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamLeaveArgs) Field1DeepEqual(src *TeamLeaveRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamLeaveResult struct {
+	Success *TeamLeaveResponse `thrift:"success,0,optional" frugal:"0,optional,TeamLeaveResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamLeaveResult() *TeamServiceTeamLeaveResult {
+	return &TeamServiceTeamLeaveResult{}
+}
+
+func (p *TeamServiceTeamLeaveResult) InitDefault() {
+	*p = TeamServiceTeamLeaveResult{}
+}
+
+var TeamServiceTeamLeaveResult_Success_DEFAULT *TeamLeaveResponse
+
+func (p *TeamServiceTeamLeaveResult) GetSuccess() (v *TeamLeaveResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamLeaveResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamLeaveResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamLeaveResponse)
+}
+
+var fieldIDToName_TeamServiceTeamLeaveResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamLeaveResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamLeaveResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamLeaveResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaveResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamLeaveResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamLeaveResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeave_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaveResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaveResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamLeaveResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamLeaveResult) DeepEqual(ano *TeamServiceTeamLeaveResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamLeaveResult) Field0DeepEqual(src *TeamLeaveResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamMemberRemoveArgs struct {
+	Req *TeamMemberRemoveRequest `thrift:"req,1" frugal:"1,default,TeamMemberRemoveRequest" json:"req"`
+}
+
+func NewTeamServiceTeamMemberRemoveArgs() *TeamServiceTeamMemberRemoveArgs {
+	return &TeamServiceTeamMemberRemoveArgs{}
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) InitDefault() {
+	*p = TeamServiceTeamMemberRemoveArgs{}
+}
+
+var TeamServiceTeamMemberRemoveArgs_Req_DEFAULT *TeamMemberRemoveRequest
+
+func (p *TeamServiceTeamMemberRemoveArgs) GetReq() (v *TeamMemberRemoveRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamMemberRemoveArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamMemberRemoveArgs) SetReq(val *TeamMemberRemoveRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamMemberRemoveArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamMemberRemoveArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamMemberRemoveRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamMemberRemove_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamMemberRemoveArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) DeepEqual(ano *TeamServiceTeamMemberRemoveArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamMemberRemoveArgs) Field1DeepEqual(src *TeamMemberRemoveRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamMemberRemoveResult struct {
+	Success *TeamMemberRemoveResponse `thrift:"success,0,optional" frugal:"0,optional,TeamMemberRemoveResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamMemberRemoveResult() *TeamServiceTeamMemberRemoveResult {
+	return &TeamServiceTeamMemberRemoveResult{}
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) InitDefault() {
+	*p = TeamServiceTeamMemberRemoveResult{}
+}
+
+var TeamServiceTeamMemberRemoveResult_Success_DEFAULT *TeamMemberRemoveResponse
+
+func (p *TeamServiceTeamMemberRemoveResult) GetSuccess() (v *TeamMemberRemoveResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamMemberRemoveResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamMemberRemoveResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamMemberRemoveResponse)
+}
+
+var fieldIDToName_TeamServiceTeamMemberRemoveResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamMemberRemoveResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamMemberRemoveResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamMemberRemove_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamMemberRemoveResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) DeepEqual(ano *TeamServiceTeamMemberRemoveResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamMemberRemoveResult) Field0DeepEqual(src *TeamMemberRemoveResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamLeaderTransferArgs struct {
+	Req *TeamLeaderTransferRequest `thrift:"req,1" frugal:"1,default,TeamLeaderTransferRequest" json:"req"`
+}
+
+func NewTeamServiceTeamLeaderTransferArgs() *TeamServiceTeamLeaderTransferArgs {
+	return &TeamServiceTeamLeaderTransferArgs{}
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) InitDefault() {
+	*p = TeamServiceTeamLeaderTransferArgs{}
+}
+
+var TeamServiceTeamLeaderTransferArgs_Req_DEFAULT *TeamLeaderTransferRequest
+
+func (p *TeamServiceTeamLeaderTransferArgs) GetReq() (v *TeamLeaderTransferRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamLeaderTransferArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamLeaderTransferArgs) SetReq(val *TeamLeaderTransferRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamLeaderTransferArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamLeaderTransferArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamLeaderTransferRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaderTransfer_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamLeaderTransferArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) DeepEqual(ano *TeamServiceTeamLeaderTransferArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamLeaderTransferArgs) Field1DeepEqual(src *TeamLeaderTransferRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamLeaderTransferResult struct {
+	Success *TeamLeaderTransferResponse `thrift:"success,0,optional" frugal:"0,optional,TeamLeaderTransferResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamLeaderTransferResult() *TeamServiceTeamLeaderTransferResult {
+	return &TeamServiceTeamLeaderTransferResult{}
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) InitDefault() {
+	*p = TeamServiceTeamLeaderTransferResult{}
+}
+
+var TeamServiceTeamLeaderTransferResult_Success_DEFAULT *TeamLeaderTransferResponse
+
+func (p *TeamServiceTeamLeaderTransferResult) GetSuccess() (v *TeamLeaderTransferResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamLeaderTransferResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamLeaderTransferResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamLeaderTransferResponse)
+}
+
+var fieldIDToName_TeamServiceTeamLeaderTransferResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamLeaderTransferResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamLeaderTransferResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamLeaderTransfer_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamLeaderTransferResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) DeepEqual(ano *TeamServiceTeamLeaderTransferResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamLeaderTransferResult) Field0DeepEqual(src *TeamLeaderTransferResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamCloseArgs struct {
+	Req *TeamCloseRequest `thrift:"req,1" frugal:"1,default,TeamCloseRequest" json:"req"`
+}
+
+func NewTeamServiceTeamCloseArgs() *TeamServiceTeamCloseArgs {
+	return &TeamServiceTeamCloseArgs{}
+}
+
+func (p *TeamServiceTeamCloseArgs) InitDefault() {
+	*p = TeamServiceTeamCloseArgs{}
+}
+
+var TeamServiceTeamCloseArgs_Req_DEFAULT *TeamCloseRequest
+
+func (p *TeamServiceTeamCloseArgs) GetReq() (v *TeamCloseRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamCloseArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamCloseArgs) SetReq(val *TeamCloseRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamCloseArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamCloseArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamCloseArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCloseArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCloseArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamCloseRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamCloseArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamClose_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCloseArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCloseArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamCloseArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamCloseArgs) DeepEqual(ano *TeamServiceTeamCloseArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamCloseArgs) Field1DeepEqual(src *TeamCloseRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamCloseResult struct {
+	Success *TeamCloseResponse `thrift:"success,0,optional" frugal:"0,optional,TeamCloseResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamCloseResult() *TeamServiceTeamCloseResult {
+	return &TeamServiceTeamCloseResult{}
+}
+
+func (p *TeamServiceTeamCloseResult) InitDefault() {
+	*p = TeamServiceTeamCloseResult{}
+}
+
+var TeamServiceTeamCloseResult_Success_DEFAULT *TeamCloseResponse
+
+func (p *TeamServiceTeamCloseResult) GetSuccess() (v *TeamCloseResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamCloseResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamCloseResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamCloseResponse)
+}
+
+var fieldIDToName_TeamServiceTeamCloseResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamCloseResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamCloseResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCloseResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCloseResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamCloseResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamCloseResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamClose_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCloseResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamCloseResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamCloseResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamCloseResult) DeepEqual(ano *TeamServiceTeamCloseResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamCloseResult) Field0DeepEqual(src *TeamCloseResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamDisbandArgs struct {
+	Req *TeamDisbandRequest `thrift:"req,1" frugal:"1,default,TeamDisbandRequest" json:"req"`
+}
+
+func NewTeamServiceTeamDisbandArgs() *TeamServiceTeamDisbandArgs {
+	return &TeamServiceTeamDisbandArgs{}
+}
+
+func (p *TeamServiceTeamDisbandArgs) InitDefault() {
+	*p = TeamServiceTeamDisbandArgs{}
+}
+
+var TeamServiceTeamDisbandArgs_Req_DEFAULT *TeamDisbandRequest
+
+func (p *TeamServiceTeamDisbandArgs) GetReq() (v *TeamDisbandRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamDisbandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamDisbandArgs) SetReq(val *TeamDisbandRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamDisbandArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamDisbandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamDisbandArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamDisbandArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamDisbandArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamDisbandRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamDisbandArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamDisband_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamDisbandArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamDisbandArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamDisbandArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamDisbandArgs) DeepEqual(ano *TeamServiceTeamDisbandArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamDisbandArgs) Field1DeepEqual(src *TeamDisbandRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamDisbandResult struct {
+	Success *TeamDisbandResponse `thrift:"success,0,optional" frugal:"0,optional,TeamDisbandResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamDisbandResult() *TeamServiceTeamDisbandResult {
+	return &TeamServiceTeamDisbandResult{}
+}
+
+func (p *TeamServiceTeamDisbandResult) InitDefault() {
+	*p = TeamServiceTeamDisbandResult{}
+}
+
+var TeamServiceTeamDisbandResult_Success_DEFAULT *TeamDisbandResponse
+
+func (p *TeamServiceTeamDisbandResult) GetSuccess() (v *TeamDisbandResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamDisbandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TeamServiceTeamDisbandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamDisbandResponse)
+}
+
+var fieldIDToName_TeamServiceTeamDisbandResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TeamServiceTeamDisbandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamDisbandResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamDisbandResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamDisbandResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamDisbandResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamDisbandResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamDisband_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamDisbandResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamDisbandResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TeamServiceTeamDisbandResult(%+v)", *p)
+}
+
+func (p *TeamServiceTeamDisbandResult) DeepEqual(ano *TeamServiceTeamDisbandResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TeamServiceTeamDisbandResult) Field0DeepEqual(src *TeamDisbandResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TeamServiceTeamInvitationSendArgs struct {
+	Req *TeamInvitationSendRequest `thrift:"req,1" frugal:"1,default,TeamInvitationSendRequest" json:"req"`
+}
+
+func NewTeamServiceTeamInvitationSendArgs() *TeamServiceTeamInvitationSendArgs {
+	return &TeamServiceTeamInvitationSendArgs{}
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) InitDefault() {
+	*p = TeamServiceTeamInvitationSendArgs{}
+}
+
+var TeamServiceTeamInvitationSendArgs_Req_DEFAULT *TeamInvitationSendRequest
+
+func (p *TeamServiceTeamInvitationSendArgs) GetReq() (v *TeamInvitationSendRequest) {
+	if !p.IsSetReq() {
+		return TeamServiceTeamInvitationSendArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TeamServiceTeamInvitationSendArgs) SetReq(val *TeamInvitationSendRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TeamServiceTeamInvitationSendArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationSendArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationSendRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationSend_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("TeamServiceTeamInvitationSendArgs(%+v)", *p)
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) DeepEqual(ano *TeamServiceTeamInvitationSendArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *TeamServiceTeamInvitationSendArgs) Field1DeepEqual(src *TeamInvitationSendRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+type TeamServiceTeamInvitationSendResult struct {
+	Success *TeamInvitationSendResponse `thrift:"success,0,optional" frugal:"0,optional,TeamInvitationSendResponse" json:"success,omitempty"`
+}
+
+func NewTeamServiceTeamInvitationSendResult() *TeamServiceTeamInvitationSendResult {
+	return &TeamServiceTeamInvitationSendResult{}
+}
+
+func (p *TeamServiceTeamInvitationSendResult) InitDefault() {
+	*p = TeamServiceTeamInvitationSendResult{}
+}
+
+var TeamServiceTeamInvitationSendResult_Success_DEFAULT *TeamInvitationSendResponse
+
+func (p *TeamServiceTeamInvitationSendResult) GetSuccess() (v *TeamInvitationSendResponse) {
+	if !p.IsSetSuccess() {
+		return TeamServiceTeamInvitationSendResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+func (p *TeamServiceTeamInvitationSendResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamInvitationSendResponse)
 }
 
-type teamServiceProcessorTeamManageList struct {
-	handler TeamService
+var fieldIDToName_TeamServiceTeamInvitationSendResult = map[int16]string{
+	0: "success",
 }
 
-func (p *teamServiceProcessorTeamManageList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceTeamManageListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamServiceTeamInvitationSendResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TeamServiceTeamInvitationSendResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceTeamManageListResult{}
-	var retval *TeamManageListResponse
-	if retval, err2 = p.handler.TeamManageList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageList: "+err2.Error())
-		oprot.WriteMessageBegin("TeamManageList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("TeamManageList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationSendResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TeamServiceTeamInvitationSendResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationSendResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *TeamServiceTeamInvitationSendResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TeamInvitationSend_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type teamServiceProcessorTeamManageAction struct {
-	handler TeamService
+func (p *TeamServiceTeamInvitationSendResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *teamServiceProcessorTeamManageAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TeamServiceTeamManageActionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TeamServiceTeamInvitationSendResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("TeamServiceTeamInvitationSendResult(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TeamServiceTeamManageActionResult{}
-	var retval *TeamManageActionResponse
-	if retval, err2 = p.handler.TeamManageAction(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TeamManageAction: "+err2.Error())
-		oprot.WriteMessageBegin("TeamManageAction", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("TeamManageAction", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *TeamServiceTeamInvitationSendResult) DeepEqual(ano *TeamServiceTeamInvitationSendResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *TeamServiceTeamInvitationSendResult) Field0DeepEqual(src *TeamInvitationSendResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type TeamServiceTeamCreateArgs struct {
-	Req *TeamCreateRequest `thrift:"req,1" frugal:"1,default,TeamCreateRequest" json:"req"`
+type TeamServiceTeamInvitationRespondArgs struct {
+	Req *TeamInvitationRespondRequest `thrift:"req,1" frugal:"1,default,TeamInvitationRespondRequest" json:"req"`
 }
 
-func NewTeamServiceTeamCreateArgs() *TeamServiceTeamCreateArgs {
-	return &TeamServiceTeamCreateArgs{}
+func NewTeamServiceTeamInvitationRespondArgs() *TeamServiceTeamInvitationRespondArgs {
+	return &TeamServiceTeamInvitationRespondArgs{}
 }
 
-func (p *TeamServiceTeamCreateArgs) InitDefault() {
-	*p = TeamServiceTeamCreateArgs{}
+func (p *TeamServiceTeamInvitationRespondArgs) InitDefault() {
+	*p = TeamServiceTeamInvitationRespondArgs{}
 }
 
-var TeamServiceTeamCreateArgs_Req_DEFAULT *TeamCreateRequest
+var TeamServiceTeamInvitationRespondArgs_Req_DEFAULT *TeamInvitationRespondRequest
 
-func (p *TeamServiceTeamCreateArgs) GetReq() (v *TeamCreateRequest) {
+func (p *TeamServiceTeamInvitationRespondArgs) GetReq() (v *TeamInvitationRespondRequest) {
 	if !p.IsSetReq() {
-		return TeamServiceTeamCreateArgs_Req_DEFAULT
+		return TeamServiceTeamInvitationRespondArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TeamServiceTeamCreateArgs) SetReq(val *TeamCreateRequest) {
+func (p *TeamServiceTeamInvitationRespondArgs) SetReq(val *TeamInvitationRespondRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TeamServiceTeamCreateArgs = map[int16]string{
+var fieldIDToName_TeamServiceTeamInvitationRespondArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceTeamCreateArgs) IsSetReq() bool {
+func (p *TeamServiceTeamInvitationRespondArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceTeamCreateArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationRespondArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6685,7 +24562,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCreateArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationRespondArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6695,17 +24572,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamCreateArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamCreateRequest()
+func (p *TeamServiceTeamInvitationRespondArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationRespondRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamCreateArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationRespondArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreate_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationRespond_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6732,7 +24609,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamCreateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationRespondArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6749,14 +24626,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamCreateArgs) String() string {
+func (p *TeamServiceTeamInvitationRespondArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamCreateArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamInvitationRespondArgs(%+v)", *p)
 }
 
-func (p *TeamServiceTeamCreateArgs) DeepEqual(ano *TeamServiceTeamCreateArgs) bool {
+func (p *TeamServiceTeamInvitationRespondArgs) DeepEqual(ano *TeamServiceTeamInvitationRespondArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6768,7 +24645,7 @@ func (p *TeamServiceTeamCreateArgs) DeepEqual(ano *TeamServiceTeamCreateArgs) bo
 	return true
 }
 
-func (p *TeamServiceTeamCreateArgs) Field1DeepEqual(src *TeamCreateRequest) bool {
+func (p *TeamServiceTeamInvitationRespondArgs) Field1DeepEqual(src *TeamInvitationRespondRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -6776,39 +24653,39 @@ func (p *TeamServiceTeamCreateArgs) Field1DeepEqual(src *TeamCreateRequest) bool
 	return true
 }
 
-type TeamServiceTeamCreateResult struct {
-	Success *TeamCreateResponse `thrift:"success,0,optional" frugal:"0,optional,TeamCreateResponse" json:"success,omitempty"`
+type TeamServiceTeamInvitationRespondResult struct {
+	Success *TeamInvitationRespondResponse `thrift:"success,0,optional" frugal:"0,optional,TeamInvitationRespondResponse" json:"success,omitempty"`
 }
-
-func NewTeamServiceTeamCreateResult() *TeamServiceTeamCreateResult {
-	return &TeamServiceTeamCreateResult{}
+
+func NewTeamServiceTeamInvitationRespondResult() *TeamServiceTeamInvitationRespondResult {
+	return &TeamServiceTeamInvitationRespondResult{}
 }
 
-func (p *TeamServiceTeamCreateResult) InitDefault() {
-	*p = TeamServiceTeamCreateResult{}
+func (p *TeamServiceTeamInvitationRespondResult) InitDefault() {
+	*p = TeamServiceTeamInvitationRespondResult{}
 }
 
-var TeamServiceTeamCreateResult_Success_DEFAULT *TeamCreateResponse
+var TeamServiceTeamInvitationRespondResult_Success_DEFAULT *TeamInvitationRespondResponse
 
-func (p *TeamServiceTeamCreateResult) GetSuccess() (v *TeamCreateResponse) {
+func (p *TeamServiceTeamInvitationRespondResult) GetSuccess() (v *TeamInvitationRespondResponse) {
 	if !p.IsSetSuccess() {
-		return TeamServiceTeamCreateResult_Success_DEFAULT
+		return TeamServiceTeamInvitationRespondResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TeamServiceTeamCreateResult) SetSuccess(x interface{}) {
-	p.Success = x.(*TeamCreateResponse)
+func (p *TeamServiceTeamInvitationRespondResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamInvitationRespondResponse)
 }
 
-var fieldIDToName_TeamServiceTeamCreateResult = map[int16]string{
+var fieldIDToName_TeamServiceTeamInvitationRespondResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceTeamCreateResult) IsSetSuccess() bool {
+func (p *TeamServiceTeamInvitationRespondResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceTeamCreateResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationRespondResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6857,7 +24734,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCreateResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationRespondResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6867,17 +24744,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamCreateResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamCreateResponse()
+func (p *TeamServiceTeamInvitationRespondResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationRespondResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamCreateResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationRespondResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamCreate_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationRespond_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6904,7 +24781,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamCreateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationRespondResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6923,14 +24800,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamCreateResult) String() string {
+func (p *TeamServiceTeamInvitationRespondResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamCreateResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamInvitationRespondResult(%+v)", *p)
 }
 
-func (p *TeamServiceTeamCreateResult) DeepEqual(ano *TeamServiceTeamCreateResult) bool {
+func (p *TeamServiceTeamInvitationRespondResult) DeepEqual(ano *TeamServiceTeamInvitationRespondResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6942,7 +24819,7 @@ func (p *TeamServiceTeamCreateResult) DeepEqual(ano *TeamServiceTeamCreateResult
 	return true
 }
 
-func (p *TeamServiceTeamCreateResult) Field0DeepEqual(src *TeamCreateResponse) bool {
+func (p *TeamServiceTeamInvitationRespondResult) Field0DeepEqual(src *TeamInvitationRespondResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -6950,39 +24827,39 @@ func (p *TeamServiceTeamCreateResult) Field0DeepEqual(src *TeamCreateResponse) b
 	return true
 }
 
-type TeamServiceTeamListArgs struct {
-	Req *TeamListRequest `thrift:"req,1" frugal:"1,default,TeamListRequest" json:"req"`
+type TeamServiceTeamInvitationListArgs struct {
+	Req *TeamInvitationListRequest `thrift:"req,1" frugal:"1,default,TeamInvitationListRequest" json:"req"`
 }
 
-func NewTeamServiceTeamListArgs() *TeamServiceTeamListArgs {
-	return &TeamServiceTeamListArgs{}
+func NewTeamServiceTeamInvitationListArgs() *TeamServiceTeamInvitationListArgs {
+	return &TeamServiceTeamInvitationListArgs{}
 }
 
-func (p *TeamServiceTeamListArgs) InitDefault() {
-	*p = TeamServiceTeamListArgs{}
+func (p *TeamServiceTeamInvitationListArgs) InitDefault() {
+	*p = TeamServiceTeamInvitationListArgs{}
 }
 
-var TeamServiceTeamListArgs_Req_DEFAULT *TeamListRequest
+var TeamServiceTeamInvitationListArgs_Req_DEFAULT *TeamInvitationListRequest
 
-func (p *TeamServiceTeamListArgs) GetReq() (v *TeamListRequest) {
+func (p *TeamServiceTeamInvitationListArgs) GetReq() (v *TeamInvitationListRequest) {
 	if !p.IsSetReq() {
-		return TeamServiceTeamListArgs_Req_DEFAULT
+		return TeamServiceTeamInvitationListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TeamServiceTeamListArgs) SetReq(val *TeamListRequest) {
+func (p *TeamServiceTeamInvitationListArgs) SetReq(val *TeamInvitationListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TeamServiceTeamListArgs = map[int16]string{
+var fieldIDToName_TeamServiceTeamInvitationListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceTeamListArgs) IsSetReq() bool {
+func (p *TeamServiceTeamInvitationListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceTeamListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7031,7 +24908,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7041,17 +24918,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamListRequest()
+func (p *TeamServiceTeamInvitationListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamList_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7078,7 +24955,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7095,14 +24972,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamListArgs) String() string {
+func (p *TeamServiceTeamInvitationListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamListArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamInvitationListArgs(%+v)", *p)
 }
 
-func (p *TeamServiceTeamListArgs) DeepEqual(ano *TeamServiceTeamListArgs) bool {
+func (p *TeamServiceTeamInvitationListArgs) DeepEqual(ano *TeamServiceTeamInvitationListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7114,7 +24991,7 @@ func (p *TeamServiceTeamListArgs) DeepEqual(ano *TeamServiceTeamListArgs) bool {
 	return true
 }
 
-func (p *TeamServiceTeamListArgs) Field1DeepEqual(src *TeamListRequest) bool {
+func (p *TeamServiceTeamInvitationListArgs) Field1DeepEqual(src *TeamInvitationListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -7122,39 +24999,39 @@ func (p *TeamServiceTeamListArgs) Field1DeepEqual(src *TeamListRequest) bool {
 	return true
 }
 
-type TeamServiceTeamListResult struct {
-	Success *TeamListResponse `thrift:"success,0,optional" frugal:"0,optional,TeamListResponse" json:"success,omitempty"`
+type TeamServiceTeamInvitationListResult struct {
+	Success *TeamInvitationListResponse `thrift:"success,0,optional" frugal:"0,optional,TeamInvitationListResponse" json:"success,omitempty"`
 }
 
-func NewTeamServiceTeamListResult() *TeamServiceTeamListResult {
-	return &TeamServiceTeamListResult{}
+func NewTeamServiceTeamInvitationListResult() *TeamServiceTeamInvitationListResult {
+	return &TeamServiceTeamInvitationListResult{}
 }
 
-func (p *TeamServiceTeamListResult) InitDefault() {
-	*p = TeamServiceTeamListResult{}
+func (p *TeamServiceTeamInvitationListResult) InitDefault() {
+	*p = TeamServiceTeamInvitationListResult{}
 }
 
-var TeamServiceTeamListResult_Success_DEFAULT *TeamListResponse
+var TeamServiceTeamInvitationListResult_Success_DEFAULT *TeamInvitationListResponse
 
-func (p *TeamServiceTeamListResult) GetSuccess() (v *TeamListResponse) {
+func (p *TeamServiceTeamInvitationListResult) GetSuccess() (v *TeamInvitationListResponse) {
 	if !p.IsSetSuccess() {
-		return TeamServiceTeamListResult_Success_DEFAULT
+		return TeamServiceTeamInvitationListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TeamServiceTeamListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*TeamListResponse)
+func (p *TeamServiceTeamInvitationListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamInvitationListResponse)
 }
 
-var fieldIDToName_TeamServiceTeamListResult = map[int16]string{
+var fieldIDToName_TeamServiceTeamInvitationListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceTeamListResult) IsSetSuccess() bool {
+func (p *TeamServiceTeamInvitationListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceTeamListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7203,7 +25080,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7213,17 +25090,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamListResponse()
+func (p *TeamServiceTeamInvitationListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamList_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7250,7 +25127,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7269,14 +25146,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamListResult) String() string {
+func (p *TeamServiceTeamInvitationListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamListResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamInvitationListResult(%+v)", *p)
 }
 
-func (p *TeamServiceTeamListResult) DeepEqual(ano *TeamServiceTeamListResult) bool {
+func (p *TeamServiceTeamInvitationListResult) DeepEqual(ano *TeamServiceTeamInvitationListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7288,7 +25165,7 @@ func (p *TeamServiceTeamListResult) DeepEqual(ano *TeamServiceTeamListResult) bo
 	return true
 }
 
-func (p *TeamServiceTeamListResult) Field0DeepEqual(src *TeamListResponse) bool {
+func (p *TeamServiceTeamInvitationListResult) Field0DeepEqual(src *TeamInvitationListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -7296,39 +25173,39 @@ func (p *TeamServiceTeamListResult) Field0DeepEqual(src *TeamListResponse) bool
 	return true
 }
 
-type TeamServiceTeamInfoArgs struct {
-	Req *TeamInfoRequest `thrift:"req,1" frugal:"1,default,TeamInfoRequest" json:"req"`
+type TeamServiceTeamSentInvitationListArgs struct {
+	Req *TeamSentInvitationListRequest `thrift:"req,1" frugal:"1,default,TeamSentInvitationListRequest" json:"req"`
 }
 
-func NewTeamServiceTeamInfoArgs() *TeamServiceTeamInfoArgs {
-	return &TeamServiceTeamInfoArgs{}
+func NewTeamServiceTeamSentInvitationListArgs() *TeamServiceTeamSentInvitationListArgs {
+	return &TeamServiceTeamSentInvitationListArgs{}
 }
 
-func (p *TeamServiceTeamInfoArgs) InitDefault() {
-	*p = TeamServiceTeamInfoArgs{}
+func (p *TeamServiceTeamSentInvitationListArgs) InitDefault() {
+	*p = TeamServiceTeamSentInvitationListArgs{}
 }
 
-var TeamServiceTeamInfoArgs_Req_DEFAULT *TeamInfoRequest
+var TeamServiceTeamSentInvitationListArgs_Req_DEFAULT *TeamSentInvitationListRequest
 
-func (p *TeamServiceTeamInfoArgs) GetReq() (v *TeamInfoRequest) {
+func (p *TeamServiceTeamSentInvitationListArgs) GetReq() (v *TeamSentInvitationListRequest) {
 	if !p.IsSetReq() {
-		return TeamServiceTeamInfoArgs_Req_DEFAULT
+		return TeamServiceTeamSentInvitationListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TeamServiceTeamInfoArgs) SetReq(val *TeamInfoRequest) {
+func (p *TeamServiceTeamSentInvitationListArgs) SetReq(val *TeamSentInvitationListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TeamServiceTeamInfoArgs = map[int16]string{
+var fieldIDToName_TeamServiceTeamSentInvitationListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceTeamInfoArgs) IsSetReq() bool {
+func (p *TeamServiceTeamSentInvitationListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceTeamInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamSentInvitationListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7377,7 +25254,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamSentInvitationListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7387,17 +25264,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamInfoRequest()
+func (p *TeamServiceTeamSentInvitationListArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamSentInvitationListRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamSentInvitationListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamSentInvitationList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7424,7 +25301,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamSentInvitationListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7441,14 +25318,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamInfoArgs) String() string {
+func (p *TeamServiceTeamSentInvitationListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamInfoArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamSentInvitationListArgs(%+v)", *p)
 }
 
-func (p *TeamServiceTeamInfoArgs) DeepEqual(ano *TeamServiceTeamInfoArgs) bool {
+func (p *TeamServiceTeamSentInvitationListArgs) DeepEqual(ano *TeamServiceTeamSentInvitationListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7460,7 +25337,7 @@ func (p *TeamServiceTeamInfoArgs) DeepEqual(ano *TeamServiceTeamInfoArgs) bool {
 	return true
 }
 
-func (p *TeamServiceTeamInfoArgs) Field1DeepEqual(src *TeamInfoRequest) bool {
+func (p *TeamServiceTeamSentInvitationListArgs) Field1DeepEqual(src *TeamSentInvitationListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -7468,39 +25345,39 @@ func (p *TeamServiceTeamInfoArgs) Field1DeepEqual(src *TeamInfoRequest) bool {
 	return true
 }
 
-type TeamServiceTeamInfoResult struct {
-	Success *TeamInfoResponse `thrift:"success,0,optional" frugal:"0,optional,TeamInfoResponse" json:"success,omitempty"`
+type TeamServiceTeamSentInvitationListResult struct {
+	Success *TeamSentInvitationListResponse `thrift:"success,0,optional" frugal:"0,optional,TeamSentInvitationListResponse" json:"success,omitempty"`
 }
 
-func NewTeamServiceTeamInfoResult() *TeamServiceTeamInfoResult {
-	return &TeamServiceTeamInfoResult{}
+func NewTeamServiceTeamSentInvitationListResult() *TeamServiceTeamSentInvitationListResult {
+	return &TeamServiceTeamSentInvitationListResult{}
 }
 
-func (p *TeamServiceTeamInfoResult) InitDefault() {
-	*p = TeamServiceTeamInfoResult{}
+func (p *TeamServiceTeamSentInvitationListResult) InitDefault() {
+	*p = TeamServiceTeamSentInvitationListResult{}
 }
 
-var TeamServiceTeamInfoResult_Success_DEFAULT *TeamInfoResponse
+var TeamServiceTeamSentInvitationListResult_Success_DEFAULT *TeamSentInvitationListResponse
 
-func (p *TeamServiceTeamInfoResult) GetSuccess() (v *TeamInfoResponse) {
+func (p *TeamServiceTeamSentInvitationListResult) GetSuccess() (v *TeamSentInvitationListResponse) {
 	if !p.IsSetSuccess() {
-		return TeamServiceTeamInfoResult_Success_DEFAULT
+		return TeamServiceTeamSentInvitationListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TeamServiceTeamInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*TeamInfoResponse)
+func (p *TeamServiceTeamSentInvitationListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamSentInvitationListResponse)
 }
 
-var fieldIDToName_TeamServiceTeamInfoResult = map[int16]string{
+var fieldIDToName_TeamServiceTeamSentInvitationListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceTeamInfoResult) IsSetSuccess() bool {
+func (p *TeamServiceTeamSentInvitationListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceTeamInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamSentInvitationListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7549,7 +25426,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamSentInvitationListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7559,17 +25436,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamInfoResponse()
+func (p *TeamServiceTeamSentInvitationListResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamSentInvitationListResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamSentInvitationListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamSentInvitationList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7596,7 +25473,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamSentInvitationListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7615,14 +25492,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamInfoResult) String() string {
+func (p *TeamServiceTeamSentInvitationListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamInfoResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamSentInvitationListResult(%+v)", *p)
 }
 
-func (p *TeamServiceTeamInfoResult) DeepEqual(ano *TeamServiceTeamInfoResult) bool {
+func (p *TeamServiceTeamSentInvitationListResult) DeepEqual(ano *TeamServiceTeamSentInvitationListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7634,7 +25511,7 @@ func (p *TeamServiceTeamInfoResult) DeepEqual(ano *TeamServiceTeamInfoResult) bo
 	return true
 }
 
-func (p *TeamServiceTeamInfoResult) Field0DeepEqual(src *TeamInfoResponse) bool {
+func (p *TeamServiceTeamSentInvitationListResult) Field0DeepEqual(src *TeamSentInvitationListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -7642,39 +25519,39 @@ func (p *TeamServiceTeamInfoResult) Field0DeepEqual(src *TeamInfoResponse) bool
 	return true
 }
 
-type TeamServiceTeamApplicationSubmitArgs struct {
-	Req *TeamApplicationSubmitRequest `thrift:"req,1" frugal:"1,default,TeamApplicationSubmitRequest" json:"req"`
+type TeamServiceTeamInvitationCancelArgs struct {
+	Req *TeamInvitationCancelRequest `thrift:"req,1" frugal:"1,default,TeamInvitationCancelRequest" json:"req"`
 }
 
-func NewTeamServiceTeamApplicationSubmitArgs() *TeamServiceTeamApplicationSubmitArgs {
-	return &TeamServiceTeamApplicationSubmitArgs{}
+func NewTeamServiceTeamInvitationCancelArgs() *TeamServiceTeamInvitationCancelArgs {
+	return &TeamServiceTeamInvitationCancelArgs{}
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) InitDefault() {
-	*p = TeamServiceTeamApplicationSubmitArgs{}
+func (p *TeamServiceTeamInvitationCancelArgs) InitDefault() {
+	*p = TeamServiceTeamInvitationCancelArgs{}
 }
 
-var TeamServiceTeamApplicationSubmitArgs_Req_DEFAULT *TeamApplicationSubmitRequest
+var TeamServiceTeamInvitationCancelArgs_Req_DEFAULT *TeamInvitationCancelRequest
 
-func (p *TeamServiceTeamApplicationSubmitArgs) GetReq() (v *TeamApplicationSubmitRequest) {
+func (p *TeamServiceTeamInvitationCancelArgs) GetReq() (v *TeamInvitationCancelRequest) {
 	if !p.IsSetReq() {
-		return TeamServiceTeamApplicationSubmitArgs_Req_DEFAULT
+		return TeamServiceTeamInvitationCancelArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TeamServiceTeamApplicationSubmitArgs) SetReq(val *TeamApplicationSubmitRequest) {
+func (p *TeamServiceTeamInvitationCancelArgs) SetReq(val *TeamInvitationCancelRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TeamServiceTeamApplicationSubmitArgs = map[int16]string{
+var fieldIDToName_TeamServiceTeamInvitationCancelArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) IsSetReq() bool {
+func (p *TeamServiceTeamInvitationCancelArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationCancelArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7723,7 +25600,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamApplicationSubmitArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationCancelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7733,17 +25610,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamApplicationSubmitRequest()
+func (p *TeamServiceTeamInvitationCancelArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamInvitationCancelRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationCancelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmit_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationCancel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7770,7 +25647,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationCancelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7787,14 +25664,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) String() string {
+func (p *TeamServiceTeamInvitationCancelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamApplicationSubmitArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamInvitationCancelArgs(%+v)", *p)
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) DeepEqual(ano *TeamServiceTeamApplicationSubmitArgs) bool {
+func (p *TeamServiceTeamInvitationCancelArgs) DeepEqual(ano *TeamServiceTeamInvitationCancelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7806,7 +25683,7 @@ func (p *TeamServiceTeamApplicationSubmitArgs) DeepEqual(ano *TeamServiceTeamApp
 	return true
 }
 
-func (p *TeamServiceTeamApplicationSubmitArgs) Field1DeepEqual(src *TeamApplicationSubmitRequest) bool {
+func (p *TeamServiceTeamInvitationCancelArgs) Field1DeepEqual(src *TeamInvitationCancelRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -7814,39 +25691,39 @@ func (p *TeamServiceTeamApplicationSubmitArgs) Field1DeepEqual(src *TeamApplicat
 	return true
 }
 
-type TeamServiceTeamApplicationSubmitResult struct {
-	Success *TeamApplicationSubmitResponse `thrift:"success,0,optional" frugal:"0,optional,TeamApplicationSubmitResponse" json:"success,omitempty"`
+type TeamServiceTeamInvitationCancelResult struct {
+	Success *TeamInvitationCancelResponse `thrift:"success,0,optional" frugal:"0,optional,TeamInvitationCancelResponse" json:"success,omitempty"`
 }
 
-func NewTeamServiceTeamApplicationSubmitResult() *TeamServiceTeamApplicationSubmitResult {
-	return &TeamServiceTeamApplicationSubmitResult{}
+func NewTeamServiceTeamInvitationCancelResult() *TeamServiceTeamInvitationCancelResult {
+	return &TeamServiceTeamInvitationCancelResult{}
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) InitDefault() {
-	*p = TeamServiceTeamApplicationSubmitResult{}
+func (p *TeamServiceTeamInvitationCancelResult) InitDefault() {
+	*p = TeamServiceTeamInvitationCancelResult{}
 }
 
-var TeamServiceTeamApplicationSubmitResult_Success_DEFAULT *TeamApplicationSubmitResponse
+var TeamServiceTeamInvitationCancelResult_Success_DEFAULT *TeamInvitationCancelResponse
 
-func (p *TeamServiceTeamApplicationSubmitResult) GetSuccess() (v *TeamApplicationSubmitResponse) {
+func (p *TeamServiceTeamInvitationCancelResult) GetSuccess() (v *TeamInvitationCancelResponse) {
 	if !p.IsSetSuccess() {
-		return TeamServiceTeamApplicationSubmitResult_Success_DEFAULT
+		return TeamServiceTeamInvitationCancelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TeamServiceTeamApplicationSubmitResult) SetSuccess(x interface{}) {
-	p.Success = x.(*TeamApplicationSubmitResponse)
+func (p *TeamServiceTeamInvitationCancelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamInvitationCancelResponse)
 }
 
-var fieldIDToName_TeamServiceTeamApplicationSubmitResult = map[int16]string{
+var fieldIDToName_TeamServiceTeamInvitationCancelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) IsSetSuccess() bool {
+func (p *TeamServiceTeamInvitationCancelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationCancelResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7895,7 +25772,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamApplicationSubmitResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamInvitationCancelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7905,17 +25782,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamApplicationSubmitResponse()
+func (p *TeamServiceTeamInvitationCancelResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamInvitationCancelResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationCancelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamApplicationSubmit_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamInvitationCancel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7942,7 +25819,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamInvitationCancelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7961,14 +25838,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) String() string {
+func (p *TeamServiceTeamInvitationCancelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamApplicationSubmitResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamInvitationCancelResult(%+v)", *p)
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) DeepEqual(ano *TeamServiceTeamApplicationSubmitResult) bool {
+func (p *TeamServiceTeamInvitationCancelResult) DeepEqual(ano *TeamServiceTeamInvitationCancelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7980,7 +25857,7 @@ func (p *TeamServiceTeamApplicationSubmitResult) DeepEqual(ano *TeamServiceTeamA
 	return true
 }
 
-func (p *TeamServiceTeamApplicationSubmitResult) Field0DeepEqual(src *TeamApplicationSubmitResponse) bool {
+func (p *TeamServiceTeamInvitationCancelResult) Field0DeepEqual(src *TeamInvitationCancelResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -7988,39 +25865,39 @@ func (p *TeamServiceTeamApplicationSubmitResult) Field0DeepEqual(src *TeamApplic
 	return true
 }
 
-type TeamServiceTeamManageListArgs struct {
-	Req *TeamManageListRequest `thrift:"req,1" frugal:"1,default,TeamManageListRequest" json:"req"`
+type TeamServiceTeamEmbeddingJobStatusArgs struct {
+	Req *TeamEmbeddingJobStatusRequest `thrift:"req,1" frugal:"1,default,TeamEmbeddingJobStatusRequest" json:"req"`
 }
 
-func NewTeamServiceTeamManageListArgs() *TeamServiceTeamManageListArgs {
-	return &TeamServiceTeamManageListArgs{}
+func NewTeamServiceTeamEmbeddingJobStatusArgs() *TeamServiceTeamEmbeddingJobStatusArgs {
+	return &TeamServiceTeamEmbeddingJobStatusArgs{}
 }
 
-func (p *TeamServiceTeamManageListArgs) InitDefault() {
-	*p = TeamServiceTeamManageListArgs{}
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) InitDefault() {
+	*p = TeamServiceTeamEmbeddingJobStatusArgs{}
 }
 
-var TeamServiceTeamManageListArgs_Req_DEFAULT *TeamManageListRequest
+var TeamServiceTeamEmbeddingJobStatusArgs_Req_DEFAULT *TeamEmbeddingJobStatusRequest
 
-func (p *TeamServiceTeamManageListArgs) GetReq() (v *TeamManageListRequest) {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) GetReq() (v *TeamEmbeddingJobStatusRequest) {
 	if !p.IsSetReq() {
-		return TeamServiceTeamManageListArgs_Req_DEFAULT
+		return TeamServiceTeamEmbeddingJobStatusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TeamServiceTeamManageListArgs) SetReq(val *TeamManageListRequest) {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) SetReq(val *TeamEmbeddingJobStatusRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TeamServiceTeamManageListArgs = map[int16]string{
+var fieldIDToName_TeamServiceTeamEmbeddingJobStatusArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceTeamManageListArgs) IsSetReq() bool {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceTeamManageListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8069,7 +25946,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamEmbeddingJobStatusArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8079,17 +25956,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageListArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamManageListRequest()
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamEmbeddingJobStatusRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamManageListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageList_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamEmbeddingJobStatus_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8116,7 +25993,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8133,14 +26010,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageListArgs) String() string {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamManageListArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamEmbeddingJobStatusArgs(%+v)", *p)
 }
 
-func (p *TeamServiceTeamManageListArgs) DeepEqual(ano *TeamServiceTeamManageListArgs) bool {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) DeepEqual(ano *TeamServiceTeamEmbeddingJobStatusArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8152,7 +26029,7 @@ func (p *TeamServiceTeamManageListArgs) DeepEqual(ano *TeamServiceTeamManageList
 	return true
 }
 
-func (p *TeamServiceTeamManageListArgs) Field1DeepEqual(src *TeamManageListRequest) bool {
+func (p *TeamServiceTeamEmbeddingJobStatusArgs) Field1DeepEqual(src *TeamEmbeddingJobStatusRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -8160,39 +26037,39 @@ func (p *TeamServiceTeamManageListArgs) Field1DeepEqual(src *TeamManageListReque
 	return true
 }
 
-type TeamServiceTeamManageListResult struct {
-	Success *TeamManageListResponse `thrift:"success,0,optional" frugal:"0,optional,TeamManageListResponse" json:"success,omitempty"`
+type TeamServiceTeamEmbeddingJobStatusResult struct {
+	Success *TeamEmbeddingJobStatusResponse `thrift:"success,0,optional" frugal:"0,optional,TeamEmbeddingJobStatusResponse" json:"success,omitempty"`
 }
 
-func NewTeamServiceTeamManageListResult() *TeamServiceTeamManageListResult {
-	return &TeamServiceTeamManageListResult{}
+func NewTeamServiceTeamEmbeddingJobStatusResult() *TeamServiceTeamEmbeddingJobStatusResult {
+	return &TeamServiceTeamEmbeddingJobStatusResult{}
 }
 
-func (p *TeamServiceTeamManageListResult) InitDefault() {
-	*p = TeamServiceTeamManageListResult{}
+func (p *TeamServiceTeamEmbeddingJobStatusResult) InitDefault() {
+	*p = TeamServiceTeamEmbeddingJobStatusResult{}
 }
 
-var TeamServiceTeamManageListResult_Success_DEFAULT *TeamManageListResponse
+var TeamServiceTeamEmbeddingJobStatusResult_Success_DEFAULT *TeamEmbeddingJobStatusResponse
 
-func (p *TeamServiceTeamManageListResult) GetSuccess() (v *TeamManageListResponse) {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) GetSuccess() (v *TeamEmbeddingJobStatusResponse) {
 	if !p.IsSetSuccess() {
-		return TeamServiceTeamManageListResult_Success_DEFAULT
+		return TeamServiceTeamEmbeddingJobStatusResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TeamServiceTeamManageListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*TeamManageListResponse)
+func (p *TeamServiceTeamEmbeddingJobStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamEmbeddingJobStatusResponse)
 }
 
-var fieldIDToName_TeamServiceTeamManageListResult = map[int16]string{
+var fieldIDToName_TeamServiceTeamEmbeddingJobStatusResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceTeamManageListResult) IsSetSuccess() bool {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceTeamManageListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8241,7 +26118,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamEmbeddingJobStatusResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8251,17 +26128,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageListResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamManageListResponse()
+func (p *TeamServiceTeamEmbeddingJobStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamEmbeddingJobStatusResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamManageListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageList_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamEmbeddingJobStatus_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8288,7 +26165,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8307,14 +26184,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageListResult) String() string {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamManageListResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamEmbeddingJobStatusResult(%+v)", *p)
 }
 
-func (p *TeamServiceTeamManageListResult) DeepEqual(ano *TeamServiceTeamManageListResult) bool {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) DeepEqual(ano *TeamServiceTeamEmbeddingJobStatusResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8326,7 +26203,7 @@ func (p *TeamServiceTeamManageListResult) DeepEqual(ano *TeamServiceTeamManageLi
 	return true
 }
 
-func (p *TeamServiceTeamManageListResult) Field0DeepEqual(src *TeamManageListResponse) bool {
+func (p *TeamServiceTeamEmbeddingJobStatusResult) Field0DeepEqual(src *TeamEmbeddingJobStatusResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -8334,39 +26211,39 @@ func (p *TeamServiceTeamManageListResult) Field0DeepEqual(src *TeamManageListRes
 	return true
 }
 
-type TeamServiceTeamManageActionArgs struct {
-	Req *TeamManageActionRequest `thrift:"req,1" frugal:"1,default,TeamManageActionRequest" json:"req"`
+type TeamServiceTeamCandidatesArgs struct {
+	Req *TeamCandidatesRequest `thrift:"req,1" frugal:"1,default,TeamCandidatesRequest" json:"req"`
 }
 
-func NewTeamServiceTeamManageActionArgs() *TeamServiceTeamManageActionArgs {
-	return &TeamServiceTeamManageActionArgs{}
+func NewTeamServiceTeamCandidatesArgs() *TeamServiceTeamCandidatesArgs {
+	return &TeamServiceTeamCandidatesArgs{}
 }
 
-func (p *TeamServiceTeamManageActionArgs) InitDefault() {
-	*p = TeamServiceTeamManageActionArgs{}
+func (p *TeamServiceTeamCandidatesArgs) InitDefault() {
+	*p = TeamServiceTeamCandidatesArgs{}
 }
 
-var TeamServiceTeamManageActionArgs_Req_DEFAULT *TeamManageActionRequest
+var TeamServiceTeamCandidatesArgs_Req_DEFAULT *TeamCandidatesRequest
 
-func (p *TeamServiceTeamManageActionArgs) GetReq() (v *TeamManageActionRequest) {
+func (p *TeamServiceTeamCandidatesArgs) GetReq() (v *TeamCandidatesRequest) {
 	if !p.IsSetReq() {
-		return TeamServiceTeamManageActionArgs_Req_DEFAULT
+		return TeamServiceTeamCandidatesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TeamServiceTeamManageActionArgs) SetReq(val *TeamManageActionRequest) {
+func (p *TeamServiceTeamCandidatesArgs) SetReq(val *TeamCandidatesRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TeamServiceTeamManageActionArgs = map[int16]string{
+var fieldIDToName_TeamServiceTeamCandidatesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TeamServiceTeamManageActionArgs) IsSetReq() bool {
+func (p *TeamServiceTeamCandidatesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TeamServiceTeamManageActionArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamCandidatesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8415,7 +26292,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageActionArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCandidatesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8425,17 +26302,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageActionArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewTeamManageActionRequest()
+func (p *TeamServiceTeamCandidatesArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewTeamCandidatesRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamManageActionArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamCandidatesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageAction_args"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCandidates_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8462,7 +26339,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamCandidatesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8479,14 +26356,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageActionArgs) String() string {
+func (p *TeamServiceTeamCandidatesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamManageActionArgs(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamCandidatesArgs(%+v)", *p)
 }
 
-func (p *TeamServiceTeamManageActionArgs) DeepEqual(ano *TeamServiceTeamManageActionArgs) bool {
+func (p *TeamServiceTeamCandidatesArgs) DeepEqual(ano *TeamServiceTeamCandidatesArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8498,7 +26375,7 @@ func (p *TeamServiceTeamManageActionArgs) DeepEqual(ano *TeamServiceTeamManageAc
 	return true
 }
 
-func (p *TeamServiceTeamManageActionArgs) Field1DeepEqual(src *TeamManageActionRequest) bool {
+func (p *TeamServiceTeamCandidatesArgs) Field1DeepEqual(src *TeamCandidatesRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -8506,39 +26383,39 @@ func (p *TeamServiceTeamManageActionArgs) Field1DeepEqual(src *TeamManageActionR
 	return true
 }
 
-type TeamServiceTeamManageActionResult struct {
-	Success *TeamManageActionResponse `thrift:"success,0,optional" frugal:"0,optional,TeamManageActionResponse" json:"success,omitempty"`
+type TeamServiceTeamCandidatesResult struct {
+	Success *TeamCandidatesResponse `thrift:"success,0,optional" frugal:"0,optional,TeamCandidatesResponse" json:"success,omitempty"`
 }
 
-func NewTeamServiceTeamManageActionResult() *TeamServiceTeamManageActionResult {
-	return &TeamServiceTeamManageActionResult{}
+func NewTeamServiceTeamCandidatesResult() *TeamServiceTeamCandidatesResult {
+	return &TeamServiceTeamCandidatesResult{}
 }
 
-func (p *TeamServiceTeamManageActionResult) InitDefault() {
-	*p = TeamServiceTeamManageActionResult{}
+func (p *TeamServiceTeamCandidatesResult) InitDefault() {
+	*p = TeamServiceTeamCandidatesResult{}
 }
 
-var TeamServiceTeamManageActionResult_Success_DEFAULT *TeamManageActionResponse
+var TeamServiceTeamCandidatesResult_Success_DEFAULT *TeamCandidatesResponse
 
-func (p *TeamServiceTeamManageActionResult) GetSuccess() (v *TeamManageActionResponse) {
+func (p *TeamServiceTeamCandidatesResult) GetSuccess() (v *TeamCandidatesResponse) {
 	if !p.IsSetSuccess() {
-		return TeamServiceTeamManageActionResult_Success_DEFAULT
+		return TeamServiceTeamCandidatesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TeamServiceTeamManageActionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*TeamManageActionResponse)
+func (p *TeamServiceTeamCandidatesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TeamCandidatesResponse)
 }
 
-var fieldIDToName_TeamServiceTeamManageActionResult = map[int16]string{
+var fieldIDToName_TeamServiceTeamCandidatesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TeamServiceTeamManageActionResult) IsSetSuccess() bool {
+func (p *TeamServiceTeamCandidatesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TeamServiceTeamManageActionResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamCandidatesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8587,7 +26464,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamManageActionResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TeamServiceTeamCandidatesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8597,17 +26474,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageActionResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewTeamManageActionResponse()
+func (p *TeamServiceTeamCandidatesResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewTeamCandidatesResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *TeamServiceTeamManageActionResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamCandidatesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TeamManageAction_result"); err != nil {
+	if err = oprot.WriteStructBegin("TeamCandidates_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8634,7 +26511,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TeamServiceTeamCandidatesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8653,14 +26530,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TeamServiceTeamManageActionResult) String() string {
+func (p *TeamServiceTeamCandidatesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TeamServiceTeamManageActionResult(%+v)", *p)
+	return fmt.Sprintf("TeamServiceTeamCandidatesResult(%+v)", *p)
 }
 
-func (p *TeamServiceTeamManageActionResult) DeepEqual(ano *TeamServiceTeamManageActionResult) bool {
+func (p *TeamServiceTeamCandidatesResult) DeepEqual(ano *TeamServiceTeamCandidatesResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8672,7 +26549,7 @@ func (p *TeamServiceTeamManageActionResult) DeepEqual(ano *TeamServiceTeamManage
 	return true
 }
 
-func (p *TeamServiceTeamManageActionResult) Field0DeepEqual(src *TeamManageActionResponse) bool {
+func (p *TeamServiceTeamCandidatesResult) Field0DeepEqual(src *TeamCandidatesResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
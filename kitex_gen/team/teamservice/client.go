@@ -15,8 +15,23 @@ type Client interface {
 	TeamList(ctx context.Context, req *team.TeamListRequest, callOptions ...callopt.Option) (r *team.TeamListResponse, err error)
 	TeamInfo(ctx context.Context, req *team.TeamInfoRequest, callOptions ...callopt.Option) (r *team.TeamInfoResponse, err error)
 	TeamApplicationSubmit(ctx context.Context, req *team.TeamApplicationSubmitRequest, callOptions ...callopt.Option) (r *team.TeamApplicationSubmitResponse, err error)
+	TeamApplicationWithdraw(ctx context.Context, req *team.TeamApplicationWithdrawRequest, callOptions ...callopt.Option) (r *team.TeamApplicationWithdrawResponse, err error)
+	MyApplications(ctx context.Context, req *team.MyApplicationsRequest, callOptions ...callopt.Option) (r *team.MyApplicationsResponse, err error)
+	MyTeamList(ctx context.Context, req *team.MyTeamListRequest, callOptions ...callopt.Option) (r *team.MyTeamListResponse, err error)
 	TeamManageList(ctx context.Context, req *team.TeamManageListRequest, callOptions ...callopt.Option) (r *team.TeamManageListResponse, err error)
 	TeamManageAction(ctx context.Context, req *team.TeamManageActionRequest, callOptions ...callopt.Option) (r *team.TeamManageActionResponse, err error)
+	TeamLeave(ctx context.Context, req *team.TeamLeaveRequest, callOptions ...callopt.Option) (r *team.TeamLeaveResponse, err error)
+	TeamMemberRemove(ctx context.Context, req *team.TeamMemberRemoveRequest, callOptions ...callopt.Option) (r *team.TeamMemberRemoveResponse, err error)
+	TeamLeaderTransfer(ctx context.Context, req *team.TeamLeaderTransferRequest, callOptions ...callopt.Option) (r *team.TeamLeaderTransferResponse, err error)
+	TeamClose(ctx context.Context, req *team.TeamCloseRequest, callOptions ...callopt.Option) (r *team.TeamCloseResponse, err error)
+	TeamDisband(ctx context.Context, req *team.TeamDisbandRequest, callOptions ...callopt.Option) (r *team.TeamDisbandResponse, err error)
+	TeamInvitationSend(ctx context.Context, req *team.TeamInvitationSendRequest, callOptions ...callopt.Option) (r *team.TeamInvitationSendResponse, err error)
+	TeamInvitationRespond(ctx context.Context, req *team.TeamInvitationRespondRequest, callOptions ...callopt.Option) (r *team.TeamInvitationRespondResponse, err error)
+	TeamInvitationList(ctx context.Context, req *team.TeamInvitationListRequest, callOptions ...callopt.Option) (r *team.TeamInvitationListResponse, err error)
+	TeamSentInvitationList(ctx context.Context, req *team.TeamSentInvitationListRequest, callOptions ...callopt.Option) (r *team.TeamSentInvitationListResponse, err error)
+	TeamInvitationCancel(ctx context.Context, req *team.TeamInvitationCancelRequest, callOptions ...callopt.Option) (r *team.TeamInvitationCancelResponse, err error)
+	TeamEmbeddingJobStatus(ctx context.Context, req *team.TeamEmbeddingJobStatusRequest, callOptions ...callopt.Option) (r *team.TeamEmbeddingJobStatusResponse, err error)
+	TeamCandidates(ctx context.Context, req *team.TeamCandidatesRequest, callOptions ...callopt.Option) (r *team.TeamCandidatesResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -68,6 +83,21 @@ func (p *kTeamServiceClient) TeamApplicationSubmit(ctx context.Context, req *tea
 	return p.kClient.TeamApplicationSubmit(ctx, req)
 }
 
+func (p *kTeamServiceClient) TeamApplicationWithdraw(ctx context.Context, req *team.TeamApplicationWithdrawRequest, callOptions ...callopt.Option) (r *team.TeamApplicationWithdrawResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamApplicationWithdraw(ctx, req)
+}
+
+func (p *kTeamServiceClient) MyApplications(ctx context.Context, req *team.MyApplicationsRequest, callOptions ...callopt.Option) (r *team.MyApplicationsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MyApplications(ctx, req)
+}
+
+func (p *kTeamServiceClient) MyTeamList(ctx context.Context, req *team.MyTeamListRequest, callOptions ...callopt.Option) (r *team.MyTeamListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MyTeamList(ctx, req)
+}
+
 func (p *kTeamServiceClient) TeamManageList(ctx context.Context, req *team.TeamManageListRequest, callOptions ...callopt.Option) (r *team.TeamManageListResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.TeamManageList(ctx, req)
@@ -77,3 +107,63 @@ func (p *kTeamServiceClient) TeamManageAction(ctx context.Context, req *team.Tea
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.TeamManageAction(ctx, req)
 }
+
+func (p *kTeamServiceClient) TeamLeave(ctx context.Context, req *team.TeamLeaveRequest, callOptions ...callopt.Option) (r *team.TeamLeaveResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamLeave(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamMemberRemove(ctx context.Context, req *team.TeamMemberRemoveRequest, callOptions ...callopt.Option) (r *team.TeamMemberRemoveResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamMemberRemove(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamLeaderTransfer(ctx context.Context, req *team.TeamLeaderTransferRequest, callOptions ...callopt.Option) (r *team.TeamLeaderTransferResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamLeaderTransfer(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamClose(ctx context.Context, req *team.TeamCloseRequest, callOptions ...callopt.Option) (r *team.TeamCloseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamClose(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamDisband(ctx context.Context, req *team.TeamDisbandRequest, callOptions ...callopt.Option) (r *team.TeamDisbandResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamDisband(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamInvitationSend(ctx context.Context, req *team.TeamInvitationSendRequest, callOptions ...callopt.Option) (r *team.TeamInvitationSendResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamInvitationSend(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamInvitationRespond(ctx context.Context, req *team.TeamInvitationRespondRequest, callOptions ...callopt.Option) (r *team.TeamInvitationRespondResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamInvitationRespond(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamInvitationList(ctx context.Context, req *team.TeamInvitationListRequest, callOptions ...callopt.Option) (r *team.TeamInvitationListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamInvitationList(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamSentInvitationList(ctx context.Context, req *team.TeamSentInvitationListRequest, callOptions ...callopt.Option) (r *team.TeamSentInvitationListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamSentInvitationList(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamInvitationCancel(ctx context.Context, req *team.TeamInvitationCancelRequest, callOptions ...callopt.Option) (r *team.TeamInvitationCancelResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamInvitationCancel(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamEmbeddingJobStatus(ctx context.Context, req *team.TeamEmbeddingJobStatusRequest, callOptions ...callopt.Option) (r *team.TeamEmbeddingJobStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamEmbeddingJobStatus(ctx, req)
+}
+
+func (p *kTeamServiceClient) TeamCandidates(ctx context.Context, req *team.TeamCandidatesRequest, callOptions ...callopt.Option) (r *team.TeamCandidatesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TeamCandidates(ctx, req)
+}
@@ -19,12 +19,27 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "TeamService"
 	handlerType := (*team.TeamService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"TeamCreate":            kitex.NewMethodInfo(teamCreateHandler, newTeamServiceTeamCreateArgs, newTeamServiceTeamCreateResult, false),
-		"TeamList":              kitex.NewMethodInfo(teamListHandler, newTeamServiceTeamListArgs, newTeamServiceTeamListResult, false),
-		"TeamInfo":              kitex.NewMethodInfo(teamInfoHandler, newTeamServiceTeamInfoArgs, newTeamServiceTeamInfoResult, false),
-		"TeamApplicationSubmit": kitex.NewMethodInfo(teamApplicationSubmitHandler, newTeamServiceTeamApplicationSubmitArgs, newTeamServiceTeamApplicationSubmitResult, false),
-		"TeamManageList":        kitex.NewMethodInfo(teamManageListHandler, newTeamServiceTeamManageListArgs, newTeamServiceTeamManageListResult, false),
-		"TeamManageAction":      kitex.NewMethodInfo(teamManageActionHandler, newTeamServiceTeamManageActionArgs, newTeamServiceTeamManageActionResult, false),
+		"TeamCreate":              kitex.NewMethodInfo(teamCreateHandler, newTeamServiceTeamCreateArgs, newTeamServiceTeamCreateResult, false),
+		"TeamList":                kitex.NewMethodInfo(teamListHandler, newTeamServiceTeamListArgs, newTeamServiceTeamListResult, false),
+		"TeamInfo":                kitex.NewMethodInfo(teamInfoHandler, newTeamServiceTeamInfoArgs, newTeamServiceTeamInfoResult, false),
+		"TeamApplicationSubmit":   kitex.NewMethodInfo(teamApplicationSubmitHandler, newTeamServiceTeamApplicationSubmitArgs, newTeamServiceTeamApplicationSubmitResult, false),
+		"TeamApplicationWithdraw": kitex.NewMethodInfo(teamApplicationWithdrawHandler, newTeamServiceTeamApplicationWithdrawArgs, newTeamServiceTeamApplicationWithdrawResult, false),
+		"MyApplications":          kitex.NewMethodInfo(myApplicationsHandler, newTeamServiceMyApplicationsArgs, newTeamServiceMyApplicationsResult, false),
+		"MyTeamList":              kitex.NewMethodInfo(myTeamListHandler, newTeamServiceMyTeamListArgs, newTeamServiceMyTeamListResult, false),
+		"TeamManageList":          kitex.NewMethodInfo(teamManageListHandler, newTeamServiceTeamManageListArgs, newTeamServiceTeamManageListResult, false),
+		"TeamManageAction":        kitex.NewMethodInfo(teamManageActionHandler, newTeamServiceTeamManageActionArgs, newTeamServiceTeamManageActionResult, false),
+		"TeamLeave":               kitex.NewMethodInfo(teamLeaveHandler, newTeamServiceTeamLeaveArgs, newTeamServiceTeamLeaveResult, false),
+		"TeamMemberRemove":        kitex.NewMethodInfo(teamMemberRemoveHandler, newTeamServiceTeamMemberRemoveArgs, newTeamServiceTeamMemberRemoveResult, false),
+		"TeamLeaderTransfer":      kitex.NewMethodInfo(teamLeaderTransferHandler, newTeamServiceTeamLeaderTransferArgs, newTeamServiceTeamLeaderTransferResult, false),
+		"TeamClose":               kitex.NewMethodInfo(teamCloseHandler, newTeamServiceTeamCloseArgs, newTeamServiceTeamCloseResult, false),
+		"TeamDisband":             kitex.NewMethodInfo(teamDisbandHandler, newTeamServiceTeamDisbandArgs, newTeamServiceTeamDisbandResult, false),
+		"TeamInvitationSend":      kitex.NewMethodInfo(teamInvitationSendHandler, newTeamServiceTeamInvitationSendArgs, newTeamServiceTeamInvitationSendResult, false),
+		"TeamInvitationRespond":   kitex.NewMethodInfo(teamInvitationRespondHandler, newTeamServiceTeamInvitationRespondArgs, newTeamServiceTeamInvitationRespondResult, false),
+		"TeamInvitationList":      kitex.NewMethodInfo(teamInvitationListHandler, newTeamServiceTeamInvitationListArgs, newTeamServiceTeamInvitationListResult, false),
+		"TeamSentInvitationList":  kitex.NewMethodInfo(teamSentInvitationListHandler, newTeamServiceTeamSentInvitationListArgs, newTeamServiceTeamSentInvitationListResult, false),
+		"TeamInvitationCancel":    kitex.NewMethodInfo(teamInvitationCancelHandler, newTeamServiceTeamInvitationCancelArgs, newTeamServiceTeamInvitationCancelResult, false),
+		"TeamEmbeddingJobStatus":  kitex.NewMethodInfo(teamEmbeddingJobStatusHandler, newTeamServiceTeamEmbeddingJobStatusArgs, newTeamServiceTeamEmbeddingJobStatusResult, false),
+		"TeamCandidates":          kitex.NewMethodInfo(teamCandidatesHandler, newTeamServiceTeamCandidatesArgs, newTeamServiceTeamCandidatesResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "team",
@@ -113,6 +128,60 @@ func newTeamServiceTeamApplicationSubmitResult() interface{} {
 	return team.NewTeamServiceTeamApplicationSubmitResult()
 }
 
+func teamApplicationWithdrawHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamApplicationWithdrawArgs)
+	realResult := result.(*team.TeamServiceTeamApplicationWithdrawResult)
+	success, err := handler.(team.TeamService).TeamApplicationWithdraw(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamApplicationWithdrawArgs() interface{} {
+	return team.NewTeamServiceTeamApplicationWithdrawArgs()
+}
+
+func newTeamServiceTeamApplicationWithdrawResult() interface{} {
+	return team.NewTeamServiceTeamApplicationWithdrawResult()
+}
+
+func myApplicationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceMyApplicationsArgs)
+	realResult := result.(*team.TeamServiceMyApplicationsResult)
+	success, err := handler.(team.TeamService).MyApplications(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceMyApplicationsArgs() interface{} {
+	return team.NewTeamServiceMyApplicationsArgs()
+}
+
+func newTeamServiceMyApplicationsResult() interface{} {
+	return team.NewTeamServiceMyApplicationsResult()
+}
+
+func myTeamListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceMyTeamListArgs)
+	realResult := result.(*team.TeamServiceMyTeamListResult)
+	success, err := handler.(team.TeamService).MyTeamList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceMyTeamListArgs() interface{} {
+	return team.NewTeamServiceMyTeamListArgs()
+}
+
+func newTeamServiceMyTeamListResult() interface{} {
+	return team.NewTeamServiceMyTeamListResult()
+}
+
 func teamManageListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*team.TeamServiceTeamManageListArgs)
 	realResult := result.(*team.TeamServiceTeamManageListResult)
@@ -149,6 +218,222 @@ func newTeamServiceTeamManageActionResult() interface{} {
 	return team.NewTeamServiceTeamManageActionResult()
 }
 
+func teamLeaveHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamLeaveArgs)
+	realResult := result.(*team.TeamServiceTeamLeaveResult)
+	success, err := handler.(team.TeamService).TeamLeave(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamLeaveArgs() interface{} {
+	return team.NewTeamServiceTeamLeaveArgs()
+}
+
+func newTeamServiceTeamLeaveResult() interface{} {
+	return team.NewTeamServiceTeamLeaveResult()
+}
+
+func teamMemberRemoveHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamMemberRemoveArgs)
+	realResult := result.(*team.TeamServiceTeamMemberRemoveResult)
+	success, err := handler.(team.TeamService).TeamMemberRemove(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamMemberRemoveArgs() interface{} {
+	return team.NewTeamServiceTeamMemberRemoveArgs()
+}
+
+func newTeamServiceTeamMemberRemoveResult() interface{} {
+	return team.NewTeamServiceTeamMemberRemoveResult()
+}
+
+func teamLeaderTransferHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamLeaderTransferArgs)
+	realResult := result.(*team.TeamServiceTeamLeaderTransferResult)
+	success, err := handler.(team.TeamService).TeamLeaderTransfer(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamLeaderTransferArgs() interface{} {
+	return team.NewTeamServiceTeamLeaderTransferArgs()
+}
+
+func newTeamServiceTeamLeaderTransferResult() interface{} {
+	return team.NewTeamServiceTeamLeaderTransferResult()
+}
+
+func teamCloseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamCloseArgs)
+	realResult := result.(*team.TeamServiceTeamCloseResult)
+	success, err := handler.(team.TeamService).TeamClose(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamCloseArgs() interface{} {
+	return team.NewTeamServiceTeamCloseArgs()
+}
+
+func newTeamServiceTeamCloseResult() interface{} {
+	return team.NewTeamServiceTeamCloseResult()
+}
+
+func teamDisbandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamDisbandArgs)
+	realResult := result.(*team.TeamServiceTeamDisbandResult)
+	success, err := handler.(team.TeamService).TeamDisband(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamDisbandArgs() interface{} {
+	return team.NewTeamServiceTeamDisbandArgs()
+}
+
+func newTeamServiceTeamDisbandResult() interface{} {
+	return team.NewTeamServiceTeamDisbandResult()
+}
+
+func teamInvitationSendHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamInvitationSendArgs)
+	realResult := result.(*team.TeamServiceTeamInvitationSendResult)
+	success, err := handler.(team.TeamService).TeamInvitationSend(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamInvitationSendArgs() interface{} {
+	return team.NewTeamServiceTeamInvitationSendArgs()
+}
+
+func newTeamServiceTeamInvitationSendResult() interface{} {
+	return team.NewTeamServiceTeamInvitationSendResult()
+}
+
+func teamInvitationRespondHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamInvitationRespondArgs)
+	realResult := result.(*team.TeamServiceTeamInvitationRespondResult)
+	success, err := handler.(team.TeamService).TeamInvitationRespond(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamInvitationRespondArgs() interface{} {
+	return team.NewTeamServiceTeamInvitationRespondArgs()
+}
+
+func newTeamServiceTeamInvitationRespondResult() interface{} {
+	return team.NewTeamServiceTeamInvitationRespondResult()
+}
+
+func teamInvitationListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamInvitationListArgs)
+	realResult := result.(*team.TeamServiceTeamInvitationListResult)
+	success, err := handler.(team.TeamService).TeamInvitationList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamInvitationListArgs() interface{} {
+	return team.NewTeamServiceTeamInvitationListArgs()
+}
+
+func newTeamServiceTeamInvitationListResult() interface{} {
+	return team.NewTeamServiceTeamInvitationListResult()
+}
+
+func teamSentInvitationListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamSentInvitationListArgs)
+	realResult := result.(*team.TeamServiceTeamSentInvitationListResult)
+	success, err := handler.(team.TeamService).TeamSentInvitationList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamSentInvitationListArgs() interface{} {
+	return team.NewTeamServiceTeamSentInvitationListArgs()
+}
+
+func newTeamServiceTeamSentInvitationListResult() interface{} {
+	return team.NewTeamServiceTeamSentInvitationListResult()
+}
+
+func teamInvitationCancelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamInvitationCancelArgs)
+	realResult := result.(*team.TeamServiceTeamInvitationCancelResult)
+	success, err := handler.(team.TeamService).TeamInvitationCancel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamInvitationCancelArgs() interface{} {
+	return team.NewTeamServiceTeamInvitationCancelArgs()
+}
+
+func newTeamServiceTeamInvitationCancelResult() interface{} {
+	return team.NewTeamServiceTeamInvitationCancelResult()
+}
+
+func teamEmbeddingJobStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamEmbeddingJobStatusArgs)
+	realResult := result.(*team.TeamServiceTeamEmbeddingJobStatusResult)
+	success, err := handler.(team.TeamService).TeamEmbeddingJobStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamEmbeddingJobStatusArgs() interface{} {
+	return team.NewTeamServiceTeamEmbeddingJobStatusArgs()
+}
+
+func newTeamServiceTeamEmbeddingJobStatusResult() interface{} {
+	return team.NewTeamServiceTeamEmbeddingJobStatusResult()
+}
+
+func teamCandidatesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*team.TeamServiceTeamCandidatesArgs)
+	realResult := result.(*team.TeamServiceTeamCandidatesResult)
+	success, err := handler.(team.TeamService).TeamCandidates(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newTeamServiceTeamCandidatesArgs() interface{} {
+	return team.NewTeamServiceTeamCandidatesArgs()
+}
+
+func newTeamServiceTeamCandidatesResult() interface{} {
+	return team.NewTeamServiceTeamCandidatesResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -199,6 +484,36 @@ func (p *kClient) TeamApplicationSubmit(ctx context.Context, req *team.TeamAppli
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) TeamApplicationWithdraw(ctx context.Context, req *team.TeamApplicationWithdrawRequest) (r *team.TeamApplicationWithdrawResponse, err error) {
+	var _args team.TeamServiceTeamApplicationWithdrawArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamApplicationWithdrawResult
+	if err = p.c.Call(ctx, "TeamApplicationWithdraw", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MyApplications(ctx context.Context, req *team.MyApplicationsRequest) (r *team.MyApplicationsResponse, err error) {
+	var _args team.TeamServiceMyApplicationsArgs
+	_args.Req = req
+	var _result team.TeamServiceMyApplicationsResult
+	if err = p.c.Call(ctx, "MyApplications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MyTeamList(ctx context.Context, req *team.MyTeamListRequest) (r *team.MyTeamListResponse, err error) {
+	var _args team.TeamServiceMyTeamListArgs
+	_args.Req = req
+	var _result team.TeamServiceMyTeamListResult
+	if err = p.c.Call(ctx, "MyTeamList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) TeamManageList(ctx context.Context, req *team.TeamManageListRequest) (r *team.TeamManageListResponse, err error) {
 	var _args team.TeamServiceTeamManageListArgs
 	_args.Req = req
@@ -218,3 +533,123 @@ func (p *kClient) TeamManageAction(ctx context.Context, req *team.TeamManageActi
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) TeamLeave(ctx context.Context, req *team.TeamLeaveRequest) (r *team.TeamLeaveResponse, err error) {
+	var _args team.TeamServiceTeamLeaveArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamLeaveResult
+	if err = p.c.Call(ctx, "TeamLeave", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamMemberRemove(ctx context.Context, req *team.TeamMemberRemoveRequest) (r *team.TeamMemberRemoveResponse, err error) {
+	var _args team.TeamServiceTeamMemberRemoveArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamMemberRemoveResult
+	if err = p.c.Call(ctx, "TeamMemberRemove", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamLeaderTransfer(ctx context.Context, req *team.TeamLeaderTransferRequest) (r *team.TeamLeaderTransferResponse, err error) {
+	var _args team.TeamServiceTeamLeaderTransferArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamLeaderTransferResult
+	if err = p.c.Call(ctx, "TeamLeaderTransfer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamClose(ctx context.Context, req *team.TeamCloseRequest) (r *team.TeamCloseResponse, err error) {
+	var _args team.TeamServiceTeamCloseArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamCloseResult
+	if err = p.c.Call(ctx, "TeamClose", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamDisband(ctx context.Context, req *team.TeamDisbandRequest) (r *team.TeamDisbandResponse, err error) {
+	var _args team.TeamServiceTeamDisbandArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamDisbandResult
+	if err = p.c.Call(ctx, "TeamDisband", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamInvitationSend(ctx context.Context, req *team.TeamInvitationSendRequest) (r *team.TeamInvitationSendResponse, err error) {
+	var _args team.TeamServiceTeamInvitationSendArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamInvitationSendResult
+	if err = p.c.Call(ctx, "TeamInvitationSend", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamInvitationRespond(ctx context.Context, req *team.TeamInvitationRespondRequest) (r *team.TeamInvitationRespondResponse, err error) {
+	var _args team.TeamServiceTeamInvitationRespondArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamInvitationRespondResult
+	if err = p.c.Call(ctx, "TeamInvitationRespond", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamInvitationList(ctx context.Context, req *team.TeamInvitationListRequest) (r *team.TeamInvitationListResponse, err error) {
+	var _args team.TeamServiceTeamInvitationListArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamInvitationListResult
+	if err = p.c.Call(ctx, "TeamInvitationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamSentInvitationList(ctx context.Context, req *team.TeamSentInvitationListRequest) (r *team.TeamSentInvitationListResponse, err error) {
+	var _args team.TeamServiceTeamSentInvitationListArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamSentInvitationListResult
+	if err = p.c.Call(ctx, "TeamSentInvitationList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamInvitationCancel(ctx context.Context, req *team.TeamInvitationCancelRequest) (r *team.TeamInvitationCancelResponse, err error) {
+	var _args team.TeamServiceTeamInvitationCancelArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamInvitationCancelResult
+	if err = p.c.Call(ctx, "TeamInvitationCancel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamEmbeddingJobStatus(ctx context.Context, req *team.TeamEmbeddingJobStatusRequest) (r *team.TeamEmbeddingJobStatusResponse, err error) {
+	var _args team.TeamServiceTeamEmbeddingJobStatusArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamEmbeddingJobStatusResult
+	if err = p.c.Call(ctx, "TeamEmbeddingJobStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TeamCandidates(ctx context.Context, req *team.TeamCandidatesRequest) (r *team.TeamCandidatesResponse, err error) {
+	var _args team.TeamServiceTeamCandidatesArgs
+	_args.Req = req
+	var _result team.TeamServiceTeamCandidatesResult
+	if err = p.c.Call(ctx, "TeamCandidates", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
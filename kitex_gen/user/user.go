@@ -3818,48 +3818,30 @@ func (p *UserProfileInfoResponse) Field3DeepEqual(src *UserProfileInfo) bool {
 	return true
 }
 
-type UserProfileUploadRequest struct {
-	UserId          int32            `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
-	UserProfileInfo *UserProfileInfo `thrift:"user_profile_info,2" frugal:"2,default,UserProfileInfo" json:"user_profile_info"`
-}
-
-func NewUserProfileUploadRequest() *UserProfileUploadRequest {
-	return &UserProfileUploadRequest{}
+type UserProfileInfoBatchRequest struct {
+	UserIds []int32 `thrift:"user_ids,1" frugal:"1,default,list<i32>" json:"user_ids"`
 }
 
-func (p *UserProfileUploadRequest) InitDefault() {
-	*p = UserProfileUploadRequest{}
+func NewUserProfileInfoBatchRequest() *UserProfileInfoBatchRequest {
+	return &UserProfileInfoBatchRequest{}
 }
 
-func (p *UserProfileUploadRequest) GetUserId() (v int32) {
-	return p.UserId
+func (p *UserProfileInfoBatchRequest) InitDefault() {
+	*p = UserProfileInfoBatchRequest{}
 }
 
-var UserProfileUploadRequest_UserProfileInfo_DEFAULT *UserProfileInfo
-
-func (p *UserProfileUploadRequest) GetUserProfileInfo() (v *UserProfileInfo) {
-	if !p.IsSetUserProfileInfo() {
-		return UserProfileUploadRequest_UserProfileInfo_DEFAULT
-	}
-	return p.UserProfileInfo
-}
-func (p *UserProfileUploadRequest) SetUserId(val int32) {
-	p.UserId = val
-}
-func (p *UserProfileUploadRequest) SetUserProfileInfo(val *UserProfileInfo) {
-	p.UserProfileInfo = val
+func (p *UserProfileInfoBatchRequest) GetUserIds() (v []int32) {
+	return p.UserIds
 }
-
-var fieldIDToName_UserProfileUploadRequest = map[int16]string{
-	1: "user_id",
-	2: "user_profile_info",
+func (p *UserProfileInfoBatchRequest) SetUserIds(val []int32) {
+	p.UserIds = val
 }
 
-func (p *UserProfileUploadRequest) IsSetUserProfileInfo() bool {
-	return p.UserProfileInfo != nil
+var fieldIDToName_UserProfileInfoBatchRequest = map[int16]string{
+	1: "user_ids",
 }
 
-func (p *UserProfileUploadRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserProfileInfoBatchRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3879,7 +3861,7 @@ func (p *UserProfileUploadRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3888,16 +3870,6 @@ func (p *UserProfileUploadRequest) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				if err = iprot.Skip(fieldTypeId); err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3918,7 +3890,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserProfileUploadRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserProfileInfoBatchRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3928,26 +3900,31 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserProfileUploadRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *UserProfileInfoBatchRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		p.UserId = v
 	}
-	return nil
-}
+	p.UserIds = make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
 
-func (p *UserProfileUploadRequest) ReadField2(iprot thrift.TProtocol) error {
-	p.UserProfileInfo = NewUserProfileInfo()
-	if err := p.UserProfileInfo.Read(iprot); err != nil {
+		p.UserIds = append(p.UserIds, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserProfileUploadRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserProfileInfoBatchRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileUploadRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileInfoBatchRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3955,10 +3932,6 @@ func (p *UserProfileUploadRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -3978,28 +3951,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserProfileUploadRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+func (p *UserProfileInfoBatchRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.UserId); err != nil {
+	if err := oprot.WriteListBegin(thrift.I32, len(p.UserIds)); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *UserProfileUploadRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_profile_info", thrift.STRUCT, 2); err != nil {
-		goto WriteFieldBeginError
+	for _, v := range p.UserIds {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
 	}
-	if err := p.UserProfileInfo.Write(oprot); err != nil {
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4007,81 +3971,86 @@ func (p *UserProfileUploadRequest) writeField2(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserProfileUploadRequest) String() string {
+func (p *UserProfileInfoBatchRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserProfileUploadRequest(%+v)", *p)
+	return fmt.Sprintf("UserProfileInfoBatchRequest(%+v)", *p)
 }
 
-func (p *UserProfileUploadRequest) DeepEqual(ano *UserProfileUploadRequest) bool {
+func (p *UserProfileInfoBatchRequest) DeepEqual(ano *UserProfileInfoBatchRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.UserId) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.UserProfileInfo) {
+	if !p.Field1DeepEqual(ano.UserIds) {
 		return false
 	}
 	return true
 }
 
-func (p *UserProfileUploadRequest) Field1DeepEqual(src int32) bool {
+func (p *UserProfileInfoBatchRequest) Field1DeepEqual(src []int32) bool {
 
-	if p.UserId != src {
+	if len(p.UserIds) != len(src) {
 		return false
 	}
-	return true
-}
-func (p *UserProfileUploadRequest) Field2DeepEqual(src *UserProfileInfo) bool {
-
-	if !p.UserProfileInfo.DeepEqual(src) {
-		return false
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
 
-type UserProfileUploadResponse struct {
-	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
-	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+type UserProfileInfoBatchResponse struct {
+	StatusCode       int32                      `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg        string                     `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	UserProfileInfos map[int32]*UserProfileInfo `thrift:"user_profile_infos,3" frugal:"3,default,map<i32:UserProfileInfo>" json:"user_profile_infos"`
 }
 
-func NewUserProfileUploadResponse() *UserProfileUploadResponse {
-	return &UserProfileUploadResponse{}
+func NewUserProfileInfoBatchResponse() *UserProfileInfoBatchResponse {
+	return &UserProfileInfoBatchResponse{}
 }
 
-func (p *UserProfileUploadResponse) InitDefault() {
-	*p = UserProfileUploadResponse{}
+func (p *UserProfileInfoBatchResponse) InitDefault() {
+	*p = UserProfileInfoBatchResponse{}
 }
 
-func (p *UserProfileUploadResponse) GetStatusCode() (v int32) {
+func (p *UserProfileInfoBatchResponse) GetStatusCode() (v int32) {
 	return p.StatusCode
 }
 
-func (p *UserProfileUploadResponse) GetStatusMsg() (v string) {
+func (p *UserProfileInfoBatchResponse) GetStatusMsg() (v string) {
 	return p.StatusMsg
 }
-func (p *UserProfileUploadResponse) SetStatusCode(val int32) {
+
+func (p *UserProfileInfoBatchResponse) GetUserProfileInfos() (v map[int32]*UserProfileInfo) {
+	return p.UserProfileInfos
+}
+func (p *UserProfileInfoBatchResponse) SetStatusCode(val int32) {
 	p.StatusCode = val
 }
-func (p *UserProfileUploadResponse) SetStatusMsg(val string) {
+func (p *UserProfileInfoBatchResponse) SetStatusMsg(val string) {
 	p.StatusMsg = val
 }
+func (p *UserProfileInfoBatchResponse) SetUserProfileInfos(val map[int32]*UserProfileInfo) {
+	p.UserProfileInfos = val
+}
 
-var fieldIDToName_UserProfileUploadResponse = map[int16]string{
+var fieldIDToName_UserProfileInfoBatchResponse = map[int16]string{
 	1: "status_code",
 	2: "status_msg",
+	3: "user_profile_infos",
 }
 
-func (p *UserProfileUploadResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserProfileInfoBatchResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4120,6 +4089,16 @@ func (p *UserProfileUploadResponse) Read(iprot thrift.TProtocol) (err error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4140,7 +4119,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserProfileUploadResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserProfileInfoBatchResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4150,7 +4129,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserProfileUploadResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *UserProfileInfoBatchResponse) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
@@ -4159,7 +4138,7 @@ func (p *UserProfileUploadResponse) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserProfileUploadResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *UserProfileInfoBatchResponse) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
@@ -4168,9 +4147,35 @@ func (p *UserProfileUploadResponse) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserProfileUploadResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserProfileInfoBatchResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	p.UserProfileInfos = make(map[int32]*UserProfileInfo, size)
+	for i := 0; i < size; i++ {
+		var _key int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+		_val := NewUserProfileInfo()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		p.UserProfileInfos[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *UserProfileInfoBatchResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileUploadResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileInfoBatchResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4182,6 +4187,10 @@ func (p *UserProfileUploadResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
@@ -4201,7 +4210,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserProfileUploadResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserProfileInfoBatchResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4218,7 +4227,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserProfileUploadResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *UserProfileInfoBatchResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4235,14 +4244,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UserProfileUploadResponse) String() string {
+func (p *UserProfileInfoBatchResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_profile_infos", thrift.MAP, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.I32, thrift.STRUCT, len(p.UserProfileInfos)); err != nil {
+		return err
+	}
+	for k, v := range p.UserProfileInfos {
+
+		if err := oprot.WriteI32(k); err != nil {
+			return err
+		}
+
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UserProfileInfoBatchResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserProfileUploadResponse(%+v)", *p)
+	return fmt.Sprintf("UserProfileInfoBatchResponse(%+v)", *p)
 }
 
-func (p *UserProfileUploadResponse) DeepEqual(ano *UserProfileUploadResponse) bool {
+func (p *UserProfileInfoBatchResponse) DeepEqual(ano *UserProfileInfoBatchResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4254,486 +4293,2359 @@ func (p *UserProfileUploadResponse) DeepEqual(ano *UserProfileUploadResponse) bo
 	if !p.Field2DeepEqual(ano.StatusMsg) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.UserProfileInfos) {
+		return false
+	}
 	return true
 }
 
-func (p *UserProfileUploadResponse) Field1DeepEqual(src int32) bool {
+func (p *UserProfileInfoBatchResponse) Field1DeepEqual(src int32) bool {
 
 	if p.StatusCode != src {
 		return false
 	}
 	return true
 }
-func (p *UserProfileUploadResponse) Field2DeepEqual(src string) bool {
+func (p *UserProfileInfoBatchResponse) Field2DeepEqual(src string) bool {
 
 	if strings.Compare(p.StatusMsg, src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *UserProfileInfoBatchResponse) Field3DeepEqual(src map[int32]*UserProfileInfo) bool {
 
-type UserService interface {
-	UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error)
-
-	UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error)
-
-	UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error)
-
-	UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error)
+	if len(p.UserProfileInfos) != len(src) {
+		return false
+	}
+	for k, v := range p.UserProfileInfos {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
 
-	UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error)
+type UserProfileUploadRequest struct {
+	UserId          int32            `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+	UserProfileInfo *UserProfileInfo `thrift:"user_profile_info,2" frugal:"2,default,UserProfileInfo" json:"user_profile_info"`
+}
 
-	UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error)
+func NewUserProfileUploadRequest() *UserProfileUploadRequest {
+	return &UserProfileUploadRequest{}
 }
 
-type UserServiceClient struct {
-	c thrift.TClient
+func (p *UserProfileUploadRequest) InitDefault() {
+	*p = UserProfileUploadRequest{}
 }
 
-func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *UserProfileUploadRequest) GetUserId() (v int32) {
+	return p.UserId
 }
 
-func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+var UserProfileUploadRequest_UserProfileInfo_DEFAULT *UserProfileInfo
+
+func (p *UserProfileUploadRequest) GetUserProfileInfo() (v *UserProfileInfo) {
+	if !p.IsSetUserProfileInfo() {
+		return UserProfileUploadRequest_UserProfileInfo_DEFAULT
 	}
+	return p.UserProfileInfo
+}
+func (p *UserProfileUploadRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+func (p *UserProfileUploadRequest) SetUserProfileInfo(val *UserProfileInfo) {
+	p.UserProfileInfo = val
 }
 
-func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
-	return &UserServiceClient{
-		c: c,
-	}
+var fieldIDToName_UserProfileUploadRequest = map[int16]string{
+	1: "user_id",
+	2: "user_profile_info",
 }
 
-func (p *UserServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *UserProfileUploadRequest) IsSetUserProfileInfo() bool {
+	return p.UserProfileInfo != nil
 }
 
-func (p *UserServiceClient) UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error) {
-	var _args UserServiceUserRegisterArgs
-	_args.Req = req
-	var _result UserServiceUserRegisterResult
-	if err = p.Client_().Call(ctx, "UserRegister", &_args, &_result); err != nil {
-		return
+func (p *UserProfileUploadRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error) {
-	var _args UserServiceUserLoginArgs
-	_args.Req = req
-	var _result UserServiceUserLoginResult
-	if err = p.Client_().Call(ctx, "UserLogin", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error) {
-	var _args UserServiceUserInfoArgs
-	_args.Req = req
-	var _result UserServiceUserInfoResult
-	if err = p.Client_().Call(ctx, "UserInfo", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserProfileUploadRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *UserServiceClient) UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error) {
-	var _args UserServiceUserInfoUploadArgs
-	_args.Req = req
-	var _result UserServiceUserInfoUploadResult
-	if err = p.Client_().Call(ctx, "UserInfoUpload", &_args, &_result); err != nil {
-		return
+
+func (p *UserProfileUploadRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
 	}
-	return _result.GetSuccess(), nil
+	return nil
 }
-func (p *UserServiceClient) UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error) {
-	var _args UserServiceUserProfileInfoArgs
-	_args.Req = req
-	var _result UserServiceUserProfileInfoResult
-	if err = p.Client_().Call(ctx, "UserProfileInfo", &_args, &_result); err != nil {
-		return
+
+func (p *UserProfileUploadRequest) ReadField2(iprot thrift.TProtocol) error {
+	p.UserProfileInfo = NewUserProfileInfo()
+	if err := p.UserProfileInfo.Read(iprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	return nil
 }
-func (p *UserServiceClient) UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error) {
-	var _args UserServiceUserProfileUploadArgs
-	_args.Req = req
-	var _result UserServiceUserProfileUploadResult
-	if err = p.Client_().Call(ctx, "UserProfileUpload", &_args, &_result); err != nil {
-		return
+
+func (p *UserProfileUploadRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserProfileUploadRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 
-type UserServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      UserService
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *UserProfileUploadRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *UserProfileUploadRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_profile_info", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.UserProfileInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *UserProfileUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserProfileUploadRequest(%+v)", *p)
 }
 
-func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
-	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("UserRegister", &userServiceProcessorUserRegister{handler: handler})
-	self.AddToProcessorMap("UserLogin", &userServiceProcessorUserLogin{handler: handler})
-	self.AddToProcessorMap("UserInfo", &userServiceProcessorUserInfo{handler: handler})
-	self.AddToProcessorMap("UserInfoUpload", &userServiceProcessorUserInfoUpload{handler: handler})
-	self.AddToProcessorMap("UserProfileInfo", &userServiceProcessorUserProfileInfo{handler: handler})
-	self.AddToProcessorMap("UserProfileUpload", &userServiceProcessorUserProfileUpload{handler: handler})
-	return self
-}
-func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *UserProfileUploadRequest) DeepEqual(ano *UserProfileUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if !p.Field2DeepEqual(ano.UserProfileInfo) {
+		return false
+	}
+	return true
 }
 
-type userServiceProcessorUserRegister struct {
-	handler UserService
+func (p *UserProfileUploadRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
 }
+func (p *UserProfileUploadRequest) Field2DeepEqual(src *UserProfileInfo) bool {
 
-func (p *userServiceProcessorUserRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUserRegisterArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if !p.UserProfileInfo.DeepEqual(src) {
+		return false
 	}
+	return true
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUserRegisterResult{}
-	var retval *UserRegisterResponse
-	if retval, err2 = p.handler.UserRegister(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserRegister: "+err2.Error())
-		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+type UserProfileUploadResponse struct {
+	StatusCode int32  `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg  string `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+}
+
+func NewUserProfileUploadResponse() *UserProfileUploadResponse {
+	return &UserProfileUploadResponse{}
+}
+
+func (p *UserProfileUploadResponse) InitDefault() {
+	*p = UserProfileUploadResponse{}
+}
+
+func (p *UserProfileUploadResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *UserProfileUploadResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+func (p *UserProfileUploadResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *UserProfileUploadResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+
+var fieldIDToName_UserProfileUploadResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+}
+
+func (p *UserProfileUploadResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserProfileUploadResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserProfileUploadResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		p.StatusCode = v
 	}
-	if err2 = oprot.WriteMessageBegin("UserRegister", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *UserProfileUploadResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *UserProfileUploadResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserProfileUploadResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserProfileUploadResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserProfileUploadResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UserProfileUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserProfileUploadResponse(%+v)", *p)
+}
+
+func (p *UserProfileUploadResponse) DeepEqual(ano *UserProfileUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *UserProfileUploadResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *UserProfileUploadResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type UserEmbeddingRequest struct {
+	UserId int32 `thrift:"user_id,1" frugal:"1,default,i32" json:"user_id"`
+}
+
+func NewUserEmbeddingRequest() *UserEmbeddingRequest {
+	return &UserEmbeddingRequest{}
+}
+
+func (p *UserEmbeddingRequest) InitDefault() {
+	*p = UserEmbeddingRequest{}
+}
+
+func (p *UserEmbeddingRequest) GetUserId() (v int32) {
+	return p.UserId
+}
+func (p *UserEmbeddingRequest) SetUserId(val int32) {
+	p.UserId = val
+}
+
+var fieldIDToName_UserEmbeddingRequest = map[int16]string{
+	1: "user_id",
+}
+
+func (p *UserEmbeddingRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserEmbeddingRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserEmbeddingRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.UserId = v
+	}
+	return nil
+}
+
+func (p *UserEmbeddingRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserEmbeddingRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserEmbeddingRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserEmbeddingRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserEmbeddingRequest(%+v)", *p)
+}
+
+func (p *UserEmbeddingRequest) DeepEqual(ano *UserEmbeddingRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *UserEmbeddingRequest) Field1DeepEqual(src int32) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+type UserEmbeddingResponse struct {
+	StatusCode           int32     `thrift:"status_code,1" frugal:"1,default,i32" json:"status_code"`
+	StatusMsg            string    `thrift:"status_msg,2" frugal:"2,default,string" json:"status_msg"`
+	Embedding            []float64 `thrift:"embedding,3" frugal:"3,default,list<double>" json:"embedding"`
+	Model                string    `thrift:"model,4" frugal:"4,default,string" json:"model"`
+	EmbeddingUpdatedTime int64     `thrift:"embedding_updated_time,5" frugal:"5,default,i64" json:"embedding_updated_time"`
+}
+
+func NewUserEmbeddingResponse() *UserEmbeddingResponse {
+	return &UserEmbeddingResponse{}
+}
+
+func (p *UserEmbeddingResponse) InitDefault() {
+	*p = UserEmbeddingResponse{}
+}
+
+func (p *UserEmbeddingResponse) GetStatusCode() (v int32) {
+	return p.StatusCode
+}
+
+func (p *UserEmbeddingResponse) GetStatusMsg() (v string) {
+	return p.StatusMsg
+}
+
+func (p *UserEmbeddingResponse) GetEmbedding() (v []float64) {
+	return p.Embedding
+}
+
+func (p *UserEmbeddingResponse) GetModel() (v string) {
+	return p.Model
+}
+
+func (p *UserEmbeddingResponse) GetEmbeddingUpdatedTime() (v int64) {
+	return p.EmbeddingUpdatedTime
+}
+func (p *UserEmbeddingResponse) SetStatusCode(val int32) {
+	p.StatusCode = val
+}
+func (p *UserEmbeddingResponse) SetStatusMsg(val string) {
+	p.StatusMsg = val
+}
+func (p *UserEmbeddingResponse) SetEmbedding(val []float64) {
+	p.Embedding = val
+}
+func (p *UserEmbeddingResponse) SetModel(val string) {
+	p.Model = val
+}
+func (p *UserEmbeddingResponse) SetEmbeddingUpdatedTime(val int64) {
+	p.EmbeddingUpdatedTime = val
+}
+
+var fieldIDToName_UserEmbeddingResponse = map[int16]string{
+	1: "status_code",
+	2: "status_msg",
+	3: "embedding",
+	4: "model",
+	5: "embedding_updated_time",
+}
+
+func (p *UserEmbeddingResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserEmbeddingResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		p.StatusCode = v
+	}
+	return nil
+}
+
+func (p *UserEmbeddingResponse) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.StatusMsg = v
+	}
+	return nil
+}
+
+func (p *UserEmbeddingResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	p.Embedding = make([]float64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem float64
+		if v, err := iprot.ReadDouble(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		p.Embedding = append(p.Embedding, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *UserEmbeddingResponse) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		p.Model = v
+	}
+	return nil
+}
+
+func (p *UserEmbeddingResponse) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		p.EmbeddingUpdatedTime = v
+	}
+	return nil
+}
+
+func (p *UserEmbeddingResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserEmbeddingResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.StatusCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status_msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StatusMsg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embedding", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.DOUBLE, len(p.Embedding)); err != nil {
+		return err
+	}
+	for _, v := range p.Embedding {
+		if err := oprot.WriteDouble(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("model", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Model); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("embedding_updated_time", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EmbeddingUpdatedTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UserEmbeddingResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserEmbeddingResponse(%+v)", *p)
+}
+
+func (p *UserEmbeddingResponse) DeepEqual(ano *UserEmbeddingResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StatusCode) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StatusMsg) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Embedding) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Model) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.EmbeddingUpdatedTime) {
+		return false
+	}
+	return true
+}
+
+func (p *UserEmbeddingResponse) Field1DeepEqual(src int32) bool {
+
+	if p.StatusCode != src {
+		return false
+	}
+	return true
+}
+func (p *UserEmbeddingResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.StatusMsg, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UserEmbeddingResponse) Field3DeepEqual(src []float64) bool {
+
+	if len(p.Embedding) != len(src) {
+		return false
+	}
+	for i, v := range p.Embedding {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *UserEmbeddingResponse) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Model, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UserEmbeddingResponse) Field5DeepEqual(src int64) bool {
+
+	if p.EmbeddingUpdatedTime != src {
+		return false
+	}
+	return true
+}
+
+type UserService interface {
+	UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error)
+
+	UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error)
+
+	UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error)
+
+	UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error)
+
+	UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error)
+
+	UserProfileInfoBatch(ctx context.Context, req *UserProfileInfoBatchRequest) (r *UserProfileInfoBatchResponse, err error)
+
+	UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error)
+
+	UserEmbedding(ctx context.Context, req *UserEmbeddingRequest) (r *UserEmbeddingResponse, err error)
+}
+
+type UserServiceClient struct {
+	c thrift.TClient
+}
+
+func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
+	return &UserServiceClient{
+		c: c,
+	}
+}
+
+func (p *UserServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *UserServiceClient) UserRegister(ctx context.Context, req *UserRegisterRequest) (r *UserRegisterResponse, err error) {
+	var _args UserServiceUserRegisterArgs
+	_args.Req = req
+	var _result UserServiceUserRegisterResult
+	if err = p.Client_().Call(ctx, "UserRegister", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserLogin(ctx context.Context, req *UserLoginRequest) (r *UserLoginResponse, err error) {
+	var _args UserServiceUserLoginArgs
+	_args.Req = req
+	var _result UserServiceUserLoginResult
+	if err = p.Client_().Call(ctx, "UserLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error) {
+	var _args UserServiceUserInfoArgs
+	_args.Req = req
+	var _result UserServiceUserInfoResult
+	if err = p.Client_().Call(ctx, "UserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserInfoUpload(ctx context.Context, req *UserInfoUploadRequest) (r *UserInfoUploadResponse, err error) {
+	var _args UserServiceUserInfoUploadArgs
+	_args.Req = req
+	var _result UserServiceUserInfoUploadResult
+	if err = p.Client_().Call(ctx, "UserInfoUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserProfileInfo(ctx context.Context, req *UserProfileInfoRequest) (r *UserProfileInfoResponse, err error) {
+	var _args UserServiceUserProfileInfoArgs
+	_args.Req = req
+	var _result UserServiceUserProfileInfoResult
+	if err = p.Client_().Call(ctx, "UserProfileInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserProfileInfoBatch(ctx context.Context, req *UserProfileInfoBatchRequest) (r *UserProfileInfoBatchResponse, err error) {
+	var _args UserServiceUserProfileInfoBatchArgs
+	_args.Req = req
+	var _result UserServiceUserProfileInfoBatchResult
+	if err = p.Client_().Call(ctx, "UserProfileInfoBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserProfileUpload(ctx context.Context, req *UserProfileUploadRequest) (r *UserProfileUploadResponse, err error) {
+	var _args UserServiceUserProfileUploadArgs
+	_args.Req = req
+	var _result UserServiceUserProfileUploadResult
+	if err = p.Client_().Call(ctx, "UserProfileUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UserEmbedding(ctx context.Context, req *UserEmbeddingRequest) (r *UserEmbeddingResponse, err error) {
+	var _args UserServiceUserEmbeddingArgs
+	_args.Req = req
+	var _result UserServiceUserEmbeddingResult
+	if err = p.Client_().Call(ctx, "UserEmbedding", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type UserServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      UserService
+}
+
+func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
+	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("UserRegister", &userServiceProcessorUserRegister{handler: handler})
+	self.AddToProcessorMap("UserLogin", &userServiceProcessorUserLogin{handler: handler})
+	self.AddToProcessorMap("UserInfo", &userServiceProcessorUserInfo{handler: handler})
+	self.AddToProcessorMap("UserInfoUpload", &userServiceProcessorUserInfoUpload{handler: handler})
+	self.AddToProcessorMap("UserProfileInfo", &userServiceProcessorUserProfileInfo{handler: handler})
+	self.AddToProcessorMap("UserProfileInfoBatch", &userServiceProcessorUserProfileInfoBatch{handler: handler})
+	self.AddToProcessorMap("UserProfileUpload", &userServiceProcessorUserProfileUpload{handler: handler})
+	self.AddToProcessorMap("UserEmbedding", &userServiceProcessorUserEmbedding{handler: handler})
+	return self
+}
+func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type userServiceProcessorUserRegister struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserRegisterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserRegisterResult{}
+	var retval *UserRegisterResponse
+	if retval, err2 = p.handler.UserRegister(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserRegister: "+err2.Error())
+		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserRegister", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserLogin struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserLoginArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserLoginResult{}
+	var retval *UserLoginResponse
+	if retval, err2 = p.handler.UserLogin(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserLogin: "+err2.Error())
+		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserLogin", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserInfo struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserInfoResult{}
+	var retval *UserInfoResponse
+	if retval, err2 = p.handler.UserInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfo: "+err2.Error())
+		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserInfoUpload struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserInfoUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserInfoUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserInfoUploadResult{}
+	var retval *UserInfoUploadResponse
+	if retval, err2 = p.handler.UserInfoUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfoUpload: "+err2.Error())
+		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserInfoUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserProfileInfo struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserProfileInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserProfileInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserProfileInfoResult{}
+	var retval *UserProfileInfoResponse
+	if retval, err2 = p.handler.UserProfileInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileInfo: "+err2.Error())
+		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserProfileInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserProfileInfoBatch struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserProfileInfoBatch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserProfileInfoBatchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserProfileInfoBatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserProfileInfoBatchResult{}
+	var retval *UserProfileInfoBatchResponse
+	if retval, err2 = p.handler.UserProfileInfoBatch(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileInfoBatch: "+err2.Error())
+		oprot.WriteMessageBegin("UserProfileInfoBatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserProfileInfoBatch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserProfileUpload struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserProfileUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserProfileUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserProfileUploadResult{}
+	var retval *UserProfileUploadResponse
+	if retval, err2 = p.handler.UserProfileUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileUpload: "+err2.Error())
+		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserProfileUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUserEmbedding struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUserEmbedding) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUserEmbeddingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserEmbedding", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUserEmbeddingResult{}
+	var retval *UserEmbeddingResponse
+	if retval, err2 = p.handler.UserEmbedding(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserEmbedding: "+err2.Error())
+		oprot.WriteMessageBegin("UserEmbedding", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserEmbedding", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type UserServiceUserRegisterArgs struct {
+	Req *UserRegisterRequest `thrift:"req,1" frugal:"1,default,UserRegisterRequest" json:"req"`
+}
+
+func NewUserServiceUserRegisterArgs() *UserServiceUserRegisterArgs {
+	return &UserServiceUserRegisterArgs{}
+}
+
+func (p *UserServiceUserRegisterArgs) InitDefault() {
+	*p = UserServiceUserRegisterArgs{}
+}
+
+var UserServiceUserRegisterArgs_Req_DEFAULT *UserRegisterRequest
+
+func (p *UserServiceUserRegisterArgs) GetReq() (v *UserRegisterRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUserRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUserRegisterArgs) SetReq(val *UserRegisterRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_UserServiceUserRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUserRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUserRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserRegisterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserRegisterRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *UserServiceUserRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRegister_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUserRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUserRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUserRegisterArgs(%+v)", *p)
+}
+
+func (p *UserServiceUserRegisterArgs) DeepEqual(ano *UserServiceUserRegisterArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUserRegisterArgs) Field1DeepEqual(src *UserRegisterRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UserServiceUserRegisterResult struct {
+	Success *UserRegisterResponse `thrift:"success,0,optional" frugal:"0,optional,UserRegisterResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUserRegisterResult() *UserServiceUserRegisterResult {
+	return &UserServiceUserRegisterResult{}
+}
+
+func (p *UserServiceUserRegisterResult) InitDefault() {
+	*p = UserServiceUserRegisterResult{}
+}
+
+var UserServiceUserRegisterResult_Success_DEFAULT *UserRegisterResponse
+
+func (p *UserServiceUserRegisterResult) GetSuccess() (v *UserRegisterResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUserRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUserRegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserRegisterResponse)
+}
+
+var fieldIDToName_UserServiceUserRegisterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUserRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUserRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserRegisterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUserRegisterResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserRegisterResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *UserServiceUserRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRegister_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type userServiceProcessorUserLogin struct {
-	handler UserService
+func (p *UserServiceUserRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *userServiceProcessorUserLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUserLoginArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceUserRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("UserServiceUserRegisterResult(%+v)", *p)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUserLoginResult{}
-	var retval *UserLoginResponse
-	if retval, err2 = p.handler.UserLogin(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserLogin: "+err2.Error())
-		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UserLogin", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UserServiceUserRegisterResult) DeepEqual(ano *UserServiceUserRegisterResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *UserServiceUserRegisterResult) Field0DeepEqual(src *UserRegisterResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+type UserServiceUserLoginArgs struct {
+	Req *UserLoginRequest `thrift:"req,1" frugal:"1,default,UserLoginRequest" json:"req"`
+}
+
+func NewUserServiceUserLoginArgs() *UserServiceUserLoginArgs {
+	return &UserServiceUserLoginArgs{}
+}
+
+func (p *UserServiceUserLoginArgs) InitDefault() {
+	*p = UserServiceUserLoginArgs{}
+}
+
+var UserServiceUserLoginArgs_Req_DEFAULT *UserLoginRequest
+
+func (p *UserServiceUserLoginArgs) GetReq() (v *UserLoginRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUserLoginArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
+}
+func (p *UserServiceUserLoginArgs) SetReq(val *UserLoginRequest) {
+	p.Req = val
 }
 
-type userServiceProcessorUserInfo struct {
-	handler UserService
+var fieldIDToName_UserServiceUserLoginArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *userServiceProcessorUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUserInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceUserLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUserLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUserInfoResult{}
-	var retval *UserInfoResponse
-	if retval, err2 = p.handler.UserInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfo: "+err2.Error())
-		oprot.WriteMessageBegin("UserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("UserInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserLoginArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserLoginRequest()
+	if err := p.Req.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *UserServiceUserLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserLogin_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return true, err
-}
-
-type userServiceProcessorUserInfoUpload struct {
-	handler UserService
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *userServiceProcessorUserInfoUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUserInfoUploadArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceUserLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUserInfoUploadResult{}
-	var retval *UserInfoUploadResponse
-	if retval, err2 = p.handler.UserInfoUpload(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserInfoUpload: "+err2.Error())
-		oprot.WriteMessageBegin("UserInfoUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("UserInfoUpload", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUserLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("UserServiceUserLoginArgs(%+v)", *p)
+}
+
+func (p *UserServiceUserLoginArgs) DeepEqual(ano *UserServiceUserLoginArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *UserServiceUserLoginArgs) Field1DeepEqual(src *UserLoginRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type userServiceProcessorUserProfileInfo struct {
-	handler UserService
+type UserServiceUserLoginResult struct {
+	Success *UserLoginResponse `thrift:"success,0,optional" frugal:"0,optional,UserLoginResponse" json:"success,omitempty"`
 }
 
-func (p *userServiceProcessorUserProfileInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUserProfileInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewUserServiceUserLoginResult() *UserServiceUserLoginResult {
+	return &UserServiceUserLoginResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUserProfileInfoResult{}
-	var retval *UserProfileInfoResponse
-	if retval, err2 = p.handler.UserProfileInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileInfo: "+err2.Error())
-		oprot.WriteMessageBegin("UserProfileInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UserProfileInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UserServiceUserLoginResult) InitDefault() {
+	*p = UserServiceUserLoginResult{}
+}
+
+var UserServiceUserLoginResult_Success_DEFAULT *UserLoginResponse
+
+func (p *UserServiceUserLoginResult) GetSuccess() (v *UserLoginResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUserLoginResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+func (p *UserServiceUserLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserLoginResponse)
+}
+
+var fieldIDToName_UserServiceUserLoginResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUserLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUserLoginResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				if err = iprot.Skip(fieldTypeId); err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserLoginResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type userServiceProcessorUserProfileUpload struct {
-	handler UserService
+func (p *UserServiceUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserLoginResponse()
+	if err := p.Success.Read(iprot); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (p *userServiceProcessorUserProfileUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUserProfileUploadArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServiceUserLoginResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserLogin_result"); err != nil {
+		goto WriteStructBeginError
 	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUserProfileUploadResult{}
-	var retval *UserProfileUploadResponse
-	if retval, err2 = p.handler.UserProfileUpload(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserProfileUpload: "+err2.Error())
-		oprot.WriteMessageBegin("UserProfileUpload", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("UserProfileUpload", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUserLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUserLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("UserServiceUserLoginResult(%+v)", *p)
+}
+
+func (p *UserServiceUserLoginResult) DeepEqual(ano *UserServiceUserLoginResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *UserServiceUserLoginResult) Field0DeepEqual(src *UserLoginResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type UserServiceUserRegisterArgs struct {
-	Req *UserRegisterRequest `thrift:"req,1" frugal:"1,default,UserRegisterRequest" json:"req"`
+type UserServiceUserInfoArgs struct {
+	Req *UserInfoRequest `thrift:"req,1" frugal:"1,default,UserInfoRequest" json:"req"`
 }
 
-func NewUserServiceUserRegisterArgs() *UserServiceUserRegisterArgs {
-	return &UserServiceUserRegisterArgs{}
+func NewUserServiceUserInfoArgs() *UserServiceUserInfoArgs {
+	return &UserServiceUserInfoArgs{}
 }
 
-func (p *UserServiceUserRegisterArgs) InitDefault() {
-	*p = UserServiceUserRegisterArgs{}
+func (p *UserServiceUserInfoArgs) InitDefault() {
+	*p = UserServiceUserInfoArgs{}
 }
 
-var UserServiceUserRegisterArgs_Req_DEFAULT *UserRegisterRequest
+var UserServiceUserInfoArgs_Req_DEFAULT *UserInfoRequest
 
-func (p *UserServiceUserRegisterArgs) GetReq() (v *UserRegisterRequest) {
+func (p *UserServiceUserInfoArgs) GetReq() (v *UserInfoRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUserRegisterArgs_Req_DEFAULT
+		return UserServiceUserInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUserRegisterArgs) SetReq(val *UserRegisterRequest) {
+func (p *UserServiceUserInfoArgs) SetReq(val *UserInfoRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_UserServiceUserRegisterArgs = map[int16]string{
+var fieldIDToName_UserServiceUserInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUserRegisterArgs) IsSetReq() bool {
+func (p *UserServiceUserInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUserRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4782,7 +6694,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserRegisterArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4792,17 +6704,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserRegisterRequest()
+func (p *UserServiceUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserInfoRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRegister_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4829,7 +6741,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4846,14 +6758,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUserRegisterArgs) String() string {
+func (p *UserServiceUserInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserInfoArgs(%+v)", *p)
 }
 
-func (p *UserServiceUserRegisterArgs) DeepEqual(ano *UserServiceUserRegisterArgs) bool {
+func (p *UserServiceUserInfoArgs) DeepEqual(ano *UserServiceUserInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -4865,7 +6777,7 @@ func (p *UserServiceUserRegisterArgs) DeepEqual(ano *UserServiceUserRegisterArgs
 	return true
 }
 
-func (p *UserServiceUserRegisterArgs) Field1DeepEqual(src *UserRegisterRequest) bool {
+func (p *UserServiceUserInfoArgs) Field1DeepEqual(src *UserInfoRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -4873,39 +6785,39 @@ func (p *UserServiceUserRegisterArgs) Field1DeepEqual(src *UserRegisterRequest)
 	return true
 }
 
-type UserServiceUserRegisterResult struct {
-	Success *UserRegisterResponse `thrift:"success,0,optional" frugal:"0,optional,UserRegisterResponse" json:"success,omitempty"`
+type UserServiceUserInfoResult struct {
+	Success *UserInfoResponse `thrift:"success,0,optional" frugal:"0,optional,UserInfoResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUserRegisterResult() *UserServiceUserRegisterResult {
-	return &UserServiceUserRegisterResult{}
+func NewUserServiceUserInfoResult() *UserServiceUserInfoResult {
+	return &UserServiceUserInfoResult{}
 }
 
-func (p *UserServiceUserRegisterResult) InitDefault() {
-	*p = UserServiceUserRegisterResult{}
+func (p *UserServiceUserInfoResult) InitDefault() {
+	*p = UserServiceUserInfoResult{}
 }
 
-var UserServiceUserRegisterResult_Success_DEFAULT *UserRegisterResponse
+var UserServiceUserInfoResult_Success_DEFAULT *UserInfoResponse
 
-func (p *UserServiceUserRegisterResult) GetSuccess() (v *UserRegisterResponse) {
+func (p *UserServiceUserInfoResult) GetSuccess() (v *UserInfoResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUserRegisterResult_Success_DEFAULT
+		return UserServiceUserInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUserRegisterResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserRegisterResponse)
+func (p *UserServiceUserInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserInfoResponse)
 }
 
-var fieldIDToName_UserServiceUserRegisterResult = map[int16]string{
+var fieldIDToName_UserServiceUserInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUserRegisterResult) IsSetSuccess() bool {
+func (p *UserServiceUserInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUserRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4954,7 +6866,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserRegisterResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4964,17 +6876,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserRegisterResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserRegisterResponse()
+func (p *UserServiceUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserInfoResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRegister_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5001,7 +6913,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5020,14 +6932,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUserRegisterResult) String() string {
+func (p *UserServiceUserInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserRegisterResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserInfoResult(%+v)", *p)
 }
 
-func (p *UserServiceUserRegisterResult) DeepEqual(ano *UserServiceUserRegisterResult) bool {
+func (p *UserServiceUserInfoResult) DeepEqual(ano *UserServiceUserInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5039,7 +6951,7 @@ func (p *UserServiceUserRegisterResult) DeepEqual(ano *UserServiceUserRegisterRe
 	return true
 }
 
-func (p *UserServiceUserRegisterResult) Field0DeepEqual(src *UserRegisterResponse) bool {
+func (p *UserServiceUserInfoResult) Field0DeepEqual(src *UserInfoResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -5047,39 +6959,39 @@ func (p *UserServiceUserRegisterResult) Field0DeepEqual(src *UserRegisterRespons
 	return true
 }
 
-type UserServiceUserLoginArgs struct {
-	Req *UserLoginRequest `thrift:"req,1" frugal:"1,default,UserLoginRequest" json:"req"`
+type UserServiceUserInfoUploadArgs struct {
+	Req *UserInfoUploadRequest `thrift:"req,1" frugal:"1,default,UserInfoUploadRequest" json:"req"`
 }
 
-func NewUserServiceUserLoginArgs() *UserServiceUserLoginArgs {
-	return &UserServiceUserLoginArgs{}
+func NewUserServiceUserInfoUploadArgs() *UserServiceUserInfoUploadArgs {
+	return &UserServiceUserInfoUploadArgs{}
 }
 
-func (p *UserServiceUserLoginArgs) InitDefault() {
-	*p = UserServiceUserLoginArgs{}
+func (p *UserServiceUserInfoUploadArgs) InitDefault() {
+	*p = UserServiceUserInfoUploadArgs{}
 }
 
-var UserServiceUserLoginArgs_Req_DEFAULT *UserLoginRequest
+var UserServiceUserInfoUploadArgs_Req_DEFAULT *UserInfoUploadRequest
 
-func (p *UserServiceUserLoginArgs) GetReq() (v *UserLoginRequest) {
+func (p *UserServiceUserInfoUploadArgs) GetReq() (v *UserInfoUploadRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUserLoginArgs_Req_DEFAULT
+		return UserServiceUserInfoUploadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUserLoginArgs) SetReq(val *UserLoginRequest) {
+func (p *UserServiceUserInfoUploadArgs) SetReq(val *UserInfoUploadRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_UserServiceUserLoginArgs = map[int16]string{
+var fieldIDToName_UserServiceUserInfoUploadArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUserLoginArgs) IsSetReq() bool {
+func (p *UserServiceUserInfoUploadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUserLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoUploadArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5128,7 +7040,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserLoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoUploadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5138,17 +7050,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserLoginRequest()
+func (p *UserServiceUserInfoUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserInfoUploadRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoUploadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserLogin_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserInfoUpload_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5175,7 +7087,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5192,14 +7104,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUserLoginArgs) String() string {
+func (p *UserServiceUserInfoUploadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserLoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserInfoUploadArgs(%+v)", *p)
 }
 
-func (p *UserServiceUserLoginArgs) DeepEqual(ano *UserServiceUserLoginArgs) bool {
+func (p *UserServiceUserInfoUploadArgs) DeepEqual(ano *UserServiceUserInfoUploadArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5211,7 +7123,7 @@ func (p *UserServiceUserLoginArgs) DeepEqual(ano *UserServiceUserLoginArgs) bool
 	return true
 }
 
-func (p *UserServiceUserLoginArgs) Field1DeepEqual(src *UserLoginRequest) bool {
+func (p *UserServiceUserInfoUploadArgs) Field1DeepEqual(src *UserInfoUploadRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5219,39 +7131,39 @@ func (p *UserServiceUserLoginArgs) Field1DeepEqual(src *UserLoginRequest) bool {
 	return true
 }
 
-type UserServiceUserLoginResult struct {
-	Success *UserLoginResponse `thrift:"success,0,optional" frugal:"0,optional,UserLoginResponse" json:"success,omitempty"`
+type UserServiceUserInfoUploadResult struct {
+	Success *UserInfoUploadResponse `thrift:"success,0,optional" frugal:"0,optional,UserInfoUploadResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUserLoginResult() *UserServiceUserLoginResult {
-	return &UserServiceUserLoginResult{}
+func NewUserServiceUserInfoUploadResult() *UserServiceUserInfoUploadResult {
+	return &UserServiceUserInfoUploadResult{}
 }
 
-func (p *UserServiceUserLoginResult) InitDefault() {
-	*p = UserServiceUserLoginResult{}
+func (p *UserServiceUserInfoUploadResult) InitDefault() {
+	*p = UserServiceUserInfoUploadResult{}
 }
 
-var UserServiceUserLoginResult_Success_DEFAULT *UserLoginResponse
+var UserServiceUserInfoUploadResult_Success_DEFAULT *UserInfoUploadResponse
 
-func (p *UserServiceUserLoginResult) GetSuccess() (v *UserLoginResponse) {
+func (p *UserServiceUserInfoUploadResult) GetSuccess() (v *UserInfoUploadResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUserLoginResult_Success_DEFAULT
+		return UserServiceUserInfoUploadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUserLoginResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserLoginResponse)
+func (p *UserServiceUserInfoUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserInfoUploadResponse)
 }
 
-var fieldIDToName_UserServiceUserLoginResult = map[int16]string{
+var fieldIDToName_UserServiceUserInfoUploadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUserLoginResult) IsSetSuccess() bool {
+func (p *UserServiceUserInfoUploadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUserLoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoUploadResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5300,7 +7212,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserLoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoUploadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5310,17 +7222,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserLoginResponse()
+func (p *UserServiceUserInfoUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserInfoUploadResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserLoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoUploadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserLogin_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserInfoUpload_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5347,7 +7259,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserInfoUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5366,14 +7278,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUserLoginResult) String() string {
+func (p *UserServiceUserInfoUploadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserLoginResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserInfoUploadResult(%+v)", *p)
 }
 
-func (p *UserServiceUserLoginResult) DeepEqual(ano *UserServiceUserLoginResult) bool {
+func (p *UserServiceUserInfoUploadResult) DeepEqual(ano *UserServiceUserInfoUploadResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5385,7 +7297,7 @@ func (p *UserServiceUserLoginResult) DeepEqual(ano *UserServiceUserLoginResult)
 	return true
 }
 
-func (p *UserServiceUserLoginResult) Field0DeepEqual(src *UserLoginResponse) bool {
+func (p *UserServiceUserInfoUploadResult) Field0DeepEqual(src *UserInfoUploadResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -5393,39 +7305,39 @@ func (p *UserServiceUserLoginResult) Field0DeepEqual(src *UserLoginResponse) boo
 	return true
 }
 
-type UserServiceUserInfoArgs struct {
-	Req *UserInfoRequest `thrift:"req,1" frugal:"1,default,UserInfoRequest" json:"req"`
+type UserServiceUserProfileInfoArgs struct {
+	Req *UserProfileInfoRequest `thrift:"req,1" frugal:"1,default,UserProfileInfoRequest" json:"req"`
 }
 
-func NewUserServiceUserInfoArgs() *UserServiceUserInfoArgs {
-	return &UserServiceUserInfoArgs{}
+func NewUserServiceUserProfileInfoArgs() *UserServiceUserProfileInfoArgs {
+	return &UserServiceUserProfileInfoArgs{}
 }
 
-func (p *UserServiceUserInfoArgs) InitDefault() {
-	*p = UserServiceUserInfoArgs{}
+func (p *UserServiceUserProfileInfoArgs) InitDefault() {
+	*p = UserServiceUserProfileInfoArgs{}
 }
 
-var UserServiceUserInfoArgs_Req_DEFAULT *UserInfoRequest
+var UserServiceUserProfileInfoArgs_Req_DEFAULT *UserProfileInfoRequest
 
-func (p *UserServiceUserInfoArgs) GetReq() (v *UserInfoRequest) {
+func (p *UserServiceUserProfileInfoArgs) GetReq() (v *UserProfileInfoRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUserInfoArgs_Req_DEFAULT
+		return UserServiceUserProfileInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUserInfoArgs) SetReq(val *UserInfoRequest) {
+func (p *UserServiceUserProfileInfoArgs) SetReq(val *UserProfileInfoRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_UserServiceUserInfoArgs = map[int16]string{
+var fieldIDToName_UserServiceUserProfileInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUserInfoArgs) IsSetReq() bool {
+func (p *UserServiceUserProfileInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5474,7 +7386,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5484,17 +7396,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserInfoRequest()
+func (p *UserServiceUserProfileInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserProfileInfoRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5521,7 +7433,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5538,14 +7450,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoArgs) String() string {
+func (p *UserServiceUserProfileInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserProfileInfoArgs(%+v)", *p)
 }
 
-func (p *UserServiceUserInfoArgs) DeepEqual(ano *UserServiceUserInfoArgs) bool {
+func (p *UserServiceUserProfileInfoArgs) DeepEqual(ano *UserServiceUserProfileInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5557,7 +7469,7 @@ func (p *UserServiceUserInfoArgs) DeepEqual(ano *UserServiceUserInfoArgs) bool {
 	return true
 }
 
-func (p *UserServiceUserInfoArgs) Field1DeepEqual(src *UserInfoRequest) bool {
+func (p *UserServiceUserProfileInfoArgs) Field1DeepEqual(src *UserProfileInfoRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5565,39 +7477,39 @@ func (p *UserServiceUserInfoArgs) Field1DeepEqual(src *UserInfoRequest) bool {
 	return true
 }
 
-type UserServiceUserInfoResult struct {
-	Success *UserInfoResponse `thrift:"success,0,optional" frugal:"0,optional,UserInfoResponse" json:"success,omitempty"`
+type UserServiceUserProfileInfoResult struct {
+	Success *UserProfileInfoResponse `thrift:"success,0,optional" frugal:"0,optional,UserProfileInfoResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUserInfoResult() *UserServiceUserInfoResult {
-	return &UserServiceUserInfoResult{}
+func NewUserServiceUserProfileInfoResult() *UserServiceUserProfileInfoResult {
+	return &UserServiceUserProfileInfoResult{}
 }
 
-func (p *UserServiceUserInfoResult) InitDefault() {
-	*p = UserServiceUserInfoResult{}
+func (p *UserServiceUserProfileInfoResult) InitDefault() {
+	*p = UserServiceUserProfileInfoResult{}
 }
 
-var UserServiceUserInfoResult_Success_DEFAULT *UserInfoResponse
+var UserServiceUserProfileInfoResult_Success_DEFAULT *UserProfileInfoResponse
 
-func (p *UserServiceUserInfoResult) GetSuccess() (v *UserInfoResponse) {
+func (p *UserServiceUserProfileInfoResult) GetSuccess() (v *UserProfileInfoResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUserInfoResult_Success_DEFAULT
+		return UserServiceUserProfileInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUserInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserInfoResponse)
+func (p *UserServiceUserProfileInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserProfileInfoResponse)
 }
 
-var fieldIDToName_UserServiceUserInfoResult = map[int16]string{
+var fieldIDToName_UserServiceUserProfileInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUserInfoResult) IsSetSuccess() bool {
+func (p *UserServiceUserProfileInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5646,7 +7558,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5656,17 +7568,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserInfoResponse()
+func (p *UserServiceUserProfileInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserProfileInfoResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5693,7 +7605,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5712,14 +7624,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoResult) String() string {
+func (p *UserServiceUserProfileInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserProfileInfoResult(%+v)", *p)
 }
 
-func (p *UserServiceUserInfoResult) DeepEqual(ano *UserServiceUserInfoResult) bool {
+func (p *UserServiceUserProfileInfoResult) DeepEqual(ano *UserServiceUserProfileInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5731,7 +7643,7 @@ func (p *UserServiceUserInfoResult) DeepEqual(ano *UserServiceUserInfoResult) bo
 	return true
 }
 
-func (p *UserServiceUserInfoResult) Field0DeepEqual(src *UserInfoResponse) bool {
+func (p *UserServiceUserProfileInfoResult) Field0DeepEqual(src *UserProfileInfoResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -5739,39 +7651,39 @@ func (p *UserServiceUserInfoResult) Field0DeepEqual(src *UserInfoResponse) bool
 	return true
 }
 
-type UserServiceUserInfoUploadArgs struct {
-	Req *UserInfoUploadRequest `thrift:"req,1" frugal:"1,default,UserInfoUploadRequest" json:"req"`
+type UserServiceUserProfileInfoBatchArgs struct {
+	Req *UserProfileInfoBatchRequest `thrift:"req,1" frugal:"1,default,UserProfileInfoBatchRequest" json:"req"`
 }
 
-func NewUserServiceUserInfoUploadArgs() *UserServiceUserInfoUploadArgs {
-	return &UserServiceUserInfoUploadArgs{}
+func NewUserServiceUserProfileInfoBatchArgs() *UserServiceUserProfileInfoBatchArgs {
+	return &UserServiceUserProfileInfoBatchArgs{}
 }
 
-func (p *UserServiceUserInfoUploadArgs) InitDefault() {
-	*p = UserServiceUserInfoUploadArgs{}
+func (p *UserServiceUserProfileInfoBatchArgs) InitDefault() {
+	*p = UserServiceUserProfileInfoBatchArgs{}
 }
 
-var UserServiceUserInfoUploadArgs_Req_DEFAULT *UserInfoUploadRequest
+var UserServiceUserProfileInfoBatchArgs_Req_DEFAULT *UserProfileInfoBatchRequest
 
-func (p *UserServiceUserInfoUploadArgs) GetReq() (v *UserInfoUploadRequest) {
+func (p *UserServiceUserProfileInfoBatchArgs) GetReq() (v *UserProfileInfoBatchRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUserInfoUploadArgs_Req_DEFAULT
+		return UserServiceUserProfileInfoBatchArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUserInfoUploadArgs) SetReq(val *UserInfoUploadRequest) {
+func (p *UserServiceUserProfileInfoBatchArgs) SetReq(val *UserProfileInfoBatchRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_UserServiceUserInfoUploadArgs = map[int16]string{
+var fieldIDToName_UserServiceUserProfileInfoBatchArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUserInfoUploadArgs) IsSetReq() bool {
+func (p *UserServiceUserProfileInfoBatchArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUserInfoUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoBatchArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5820,7 +7732,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoUploadArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileInfoBatchArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5830,17 +7742,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoUploadArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserInfoUploadRequest()
+func (p *UserServiceUserProfileInfoBatchArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserProfileInfoBatchRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserInfoUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoBatchArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfoUpload_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileInfoBatch_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5867,7 +7779,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoBatchArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5884,14 +7796,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoUploadArgs) String() string {
+func (p *UserServiceUserProfileInfoBatchArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserInfoUploadArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserProfileInfoBatchArgs(%+v)", *p)
 }
 
-func (p *UserServiceUserInfoUploadArgs) DeepEqual(ano *UserServiceUserInfoUploadArgs) bool {
+func (p *UserServiceUserProfileInfoBatchArgs) DeepEqual(ano *UserServiceUserProfileInfoBatchArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5903,7 +7815,7 @@ func (p *UserServiceUserInfoUploadArgs) DeepEqual(ano *UserServiceUserInfoUpload
 	return true
 }
 
-func (p *UserServiceUserInfoUploadArgs) Field1DeepEqual(src *UserInfoUploadRequest) bool {
+func (p *UserServiceUserProfileInfoBatchArgs) Field1DeepEqual(src *UserProfileInfoBatchRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5911,39 +7823,39 @@ func (p *UserServiceUserInfoUploadArgs) Field1DeepEqual(src *UserInfoUploadReque
 	return true
 }
 
-type UserServiceUserInfoUploadResult struct {
-	Success *UserInfoUploadResponse `thrift:"success,0,optional" frugal:"0,optional,UserInfoUploadResponse" json:"success,omitempty"`
+type UserServiceUserProfileInfoBatchResult struct {
+	Success *UserProfileInfoBatchResponse `thrift:"success,0,optional" frugal:"0,optional,UserProfileInfoBatchResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUserInfoUploadResult() *UserServiceUserInfoUploadResult {
-	return &UserServiceUserInfoUploadResult{}
+func NewUserServiceUserProfileInfoBatchResult() *UserServiceUserProfileInfoBatchResult {
+	return &UserServiceUserProfileInfoBatchResult{}
 }
 
-func (p *UserServiceUserInfoUploadResult) InitDefault() {
-	*p = UserServiceUserInfoUploadResult{}
+func (p *UserServiceUserProfileInfoBatchResult) InitDefault() {
+	*p = UserServiceUserProfileInfoBatchResult{}
 }
 
-var UserServiceUserInfoUploadResult_Success_DEFAULT *UserInfoUploadResponse
+var UserServiceUserProfileInfoBatchResult_Success_DEFAULT *UserProfileInfoBatchResponse
 
-func (p *UserServiceUserInfoUploadResult) GetSuccess() (v *UserInfoUploadResponse) {
+func (p *UserServiceUserProfileInfoBatchResult) GetSuccess() (v *UserProfileInfoBatchResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUserInfoUploadResult_Success_DEFAULT
+		return UserServiceUserProfileInfoBatchResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUserInfoUploadResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserInfoUploadResponse)
+func (p *UserServiceUserProfileInfoBatchResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserProfileInfoBatchResponse)
 }
 
-var fieldIDToName_UserServiceUserInfoUploadResult = map[int16]string{
+var fieldIDToName_UserServiceUserProfileInfoBatchResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUserInfoUploadResult) IsSetSuccess() bool {
+func (p *UserServiceUserProfileInfoBatchResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUserInfoUploadResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoBatchResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5992,7 +7904,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserInfoUploadResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileInfoBatchResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6002,17 +7914,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoUploadResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserInfoUploadResponse()
+func (p *UserServiceUserProfileInfoBatchResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserProfileInfoBatchResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserInfoUploadResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoBatchResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserInfoUpload_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileInfoBatch_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6039,7 +7951,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileInfoBatchResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6058,14 +7970,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUserInfoUploadResult) String() string {
+func (p *UserServiceUserProfileInfoBatchResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserInfoUploadResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserProfileInfoBatchResult(%+v)", *p)
 }
 
-func (p *UserServiceUserInfoUploadResult) DeepEqual(ano *UserServiceUserInfoUploadResult) bool {
+func (p *UserServiceUserProfileInfoBatchResult) DeepEqual(ano *UserServiceUserProfileInfoBatchResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6077,7 +7989,7 @@ func (p *UserServiceUserInfoUploadResult) DeepEqual(ano *UserServiceUserInfoUplo
 	return true
 }
 
-func (p *UserServiceUserInfoUploadResult) Field0DeepEqual(src *UserInfoUploadResponse) bool {
+func (p *UserServiceUserProfileInfoBatchResult) Field0DeepEqual(src *UserProfileInfoBatchResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -6085,39 +7997,39 @@ func (p *UserServiceUserInfoUploadResult) Field0DeepEqual(src *UserInfoUploadRes
 	return true
 }
 
-type UserServiceUserProfileInfoArgs struct {
-	Req *UserProfileInfoRequest `thrift:"req,1" frugal:"1,default,UserProfileInfoRequest" json:"req"`
+type UserServiceUserProfileUploadArgs struct {
+	Req *UserProfileUploadRequest `thrift:"req,1" frugal:"1,default,UserProfileUploadRequest" json:"req"`
 }
 
-func NewUserServiceUserProfileInfoArgs() *UserServiceUserProfileInfoArgs {
-	return &UserServiceUserProfileInfoArgs{}
+func NewUserServiceUserProfileUploadArgs() *UserServiceUserProfileUploadArgs {
+	return &UserServiceUserProfileUploadArgs{}
 }
 
-func (p *UserServiceUserProfileInfoArgs) InitDefault() {
-	*p = UserServiceUserProfileInfoArgs{}
+func (p *UserServiceUserProfileUploadArgs) InitDefault() {
+	*p = UserServiceUserProfileUploadArgs{}
 }
 
-var UserServiceUserProfileInfoArgs_Req_DEFAULT *UserProfileInfoRequest
+var UserServiceUserProfileUploadArgs_Req_DEFAULT *UserProfileUploadRequest
 
-func (p *UserServiceUserProfileInfoArgs) GetReq() (v *UserProfileInfoRequest) {
+func (p *UserServiceUserProfileUploadArgs) GetReq() (v *UserProfileUploadRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUserProfileInfoArgs_Req_DEFAULT
+		return UserServiceUserProfileUploadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUserProfileInfoArgs) SetReq(val *UserProfileInfoRequest) {
+func (p *UserServiceUserProfileUploadArgs) SetReq(val *UserProfileUploadRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_UserServiceUserProfileInfoArgs = map[int16]string{
+var fieldIDToName_UserServiceUserProfileUploadArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUserProfileInfoArgs) IsSetReq() bool {
+func (p *UserServiceUserProfileUploadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUserProfileInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileUploadArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6166,7 +8078,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileUploadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6176,17 +8088,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserProfileInfoRequest()
+func (p *UserServiceUserProfileUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserProfileUploadRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserProfileInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileUploadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileUpload_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6213,7 +8125,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6230,14 +8142,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileInfoArgs) String() string {
+func (p *UserServiceUserProfileUploadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserProfileInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserProfileUploadArgs(%+v)", *p)
 }
 
-func (p *UserServiceUserProfileInfoArgs) DeepEqual(ano *UserServiceUserProfileInfoArgs) bool {
+func (p *UserServiceUserProfileUploadArgs) DeepEqual(ano *UserServiceUserProfileUploadArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6249,7 +8161,7 @@ func (p *UserServiceUserProfileInfoArgs) DeepEqual(ano *UserServiceUserProfileIn
 	return true
 }
 
-func (p *UserServiceUserProfileInfoArgs) Field1DeepEqual(src *UserProfileInfoRequest) bool {
+func (p *UserServiceUserProfileUploadArgs) Field1DeepEqual(src *UserProfileUploadRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -6257,39 +8169,39 @@ func (p *UserServiceUserProfileInfoArgs) Field1DeepEqual(src *UserProfileInfoReq
 	return true
 }
 
-type UserServiceUserProfileInfoResult struct {
-	Success *UserProfileInfoResponse `thrift:"success,0,optional" frugal:"0,optional,UserProfileInfoResponse" json:"success,omitempty"`
+type UserServiceUserProfileUploadResult struct {
+	Success *UserProfileUploadResponse `thrift:"success,0,optional" frugal:"0,optional,UserProfileUploadResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUserProfileInfoResult() *UserServiceUserProfileInfoResult {
-	return &UserServiceUserProfileInfoResult{}
+func NewUserServiceUserProfileUploadResult() *UserServiceUserProfileUploadResult {
+	return &UserServiceUserProfileUploadResult{}
 }
 
-func (p *UserServiceUserProfileInfoResult) InitDefault() {
-	*p = UserServiceUserProfileInfoResult{}
+func (p *UserServiceUserProfileUploadResult) InitDefault() {
+	*p = UserServiceUserProfileUploadResult{}
 }
 
-var UserServiceUserProfileInfoResult_Success_DEFAULT *UserProfileInfoResponse
+var UserServiceUserProfileUploadResult_Success_DEFAULT *UserProfileUploadResponse
 
-func (p *UserServiceUserProfileInfoResult) GetSuccess() (v *UserProfileInfoResponse) {
+func (p *UserServiceUserProfileUploadResult) GetSuccess() (v *UserProfileUploadResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUserProfileInfoResult_Success_DEFAULT
+		return UserServiceUserProfileUploadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUserProfileInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserProfileInfoResponse)
+func (p *UserServiceUserProfileUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserProfileUploadResponse)
 }
 
-var fieldIDToName_UserServiceUserProfileInfoResult = map[int16]string{
+var fieldIDToName_UserServiceUserProfileUploadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUserProfileInfoResult) IsSetSuccess() bool {
+func (p *UserServiceUserProfileUploadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUserProfileInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileUploadResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6338,7 +8250,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileUploadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6348,17 +8260,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserProfileInfoResponse()
+func (p *UserServiceUserProfileUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserProfileUploadResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserProfileInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileUploadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserProfileUpload_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6385,7 +8297,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserProfileUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6404,14 +8316,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileInfoResult) String() string {
+func (p *UserServiceUserProfileUploadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserProfileInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserProfileUploadResult(%+v)", *p)
 }
 
-func (p *UserServiceUserProfileInfoResult) DeepEqual(ano *UserServiceUserProfileInfoResult) bool {
+func (p *UserServiceUserProfileUploadResult) DeepEqual(ano *UserServiceUserProfileUploadResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6423,7 +8335,7 @@ func (p *UserServiceUserProfileInfoResult) DeepEqual(ano *UserServiceUserProfile
 	return true
 }
 
-func (p *UserServiceUserProfileInfoResult) Field0DeepEqual(src *UserProfileInfoResponse) bool {
+func (p *UserServiceUserProfileUploadResult) Field0DeepEqual(src *UserProfileUploadResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -6431,39 +8343,39 @@ func (p *UserServiceUserProfileInfoResult) Field0DeepEqual(src *UserProfileInfoR
 	return true
 }
 
-type UserServiceUserProfileUploadArgs struct {
-	Req *UserProfileUploadRequest `thrift:"req,1" frugal:"1,default,UserProfileUploadRequest" json:"req"`
+type UserServiceUserEmbeddingArgs struct {
+	Req *UserEmbeddingRequest `thrift:"req,1" frugal:"1,default,UserEmbeddingRequest" json:"req"`
 }
 
-func NewUserServiceUserProfileUploadArgs() *UserServiceUserProfileUploadArgs {
-	return &UserServiceUserProfileUploadArgs{}
+func NewUserServiceUserEmbeddingArgs() *UserServiceUserEmbeddingArgs {
+	return &UserServiceUserEmbeddingArgs{}
 }
 
-func (p *UserServiceUserProfileUploadArgs) InitDefault() {
-	*p = UserServiceUserProfileUploadArgs{}
+func (p *UserServiceUserEmbeddingArgs) InitDefault() {
+	*p = UserServiceUserEmbeddingArgs{}
 }
 
-var UserServiceUserProfileUploadArgs_Req_DEFAULT *UserProfileUploadRequest
+var UserServiceUserEmbeddingArgs_Req_DEFAULT *UserEmbeddingRequest
 
-func (p *UserServiceUserProfileUploadArgs) GetReq() (v *UserProfileUploadRequest) {
+func (p *UserServiceUserEmbeddingArgs) GetReq() (v *UserEmbeddingRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUserProfileUploadArgs_Req_DEFAULT
+		return UserServiceUserEmbeddingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUserProfileUploadArgs) SetReq(val *UserProfileUploadRequest) {
+func (p *UserServiceUserEmbeddingArgs) SetReq(val *UserEmbeddingRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_UserServiceUserProfileUploadArgs = map[int16]string{
+var fieldIDToName_UserServiceUserEmbeddingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUserProfileUploadArgs) IsSetReq() bool {
+func (p *UserServiceUserEmbeddingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUserProfileUploadArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserEmbeddingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6512,7 +8424,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileUploadArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserEmbeddingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6522,17 +8434,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileUploadArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = NewUserProfileUploadRequest()
+func (p *UserServiceUserEmbeddingArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = NewUserEmbeddingRequest()
 	if err := p.Req.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserProfileUploadArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserEmbeddingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileUpload_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserEmbedding_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6559,7 +8471,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserEmbeddingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6576,14 +8488,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileUploadArgs) String() string {
+func (p *UserServiceUserEmbeddingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserProfileUploadArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserEmbeddingArgs(%+v)", *p)
 }
 
-func (p *UserServiceUserProfileUploadArgs) DeepEqual(ano *UserServiceUserProfileUploadArgs) bool {
+func (p *UserServiceUserEmbeddingArgs) DeepEqual(ano *UserServiceUserEmbeddingArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6595,7 +8507,7 @@ func (p *UserServiceUserProfileUploadArgs) DeepEqual(ano *UserServiceUserProfile
 	return true
 }
 
-func (p *UserServiceUserProfileUploadArgs) Field1DeepEqual(src *UserProfileUploadRequest) bool {
+func (p *UserServiceUserEmbeddingArgs) Field1DeepEqual(src *UserEmbeddingRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -6603,39 +8515,39 @@ func (p *UserServiceUserProfileUploadArgs) Field1DeepEqual(src *UserProfileUploa
 	return true
 }
 
-type UserServiceUserProfileUploadResult struct {
-	Success *UserProfileUploadResponse `thrift:"success,0,optional" frugal:"0,optional,UserProfileUploadResponse" json:"success,omitempty"`
+type UserServiceUserEmbeddingResult struct {
+	Success *UserEmbeddingResponse `thrift:"success,0,optional" frugal:"0,optional,UserEmbeddingResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUserProfileUploadResult() *UserServiceUserProfileUploadResult {
-	return &UserServiceUserProfileUploadResult{}
+func NewUserServiceUserEmbeddingResult() *UserServiceUserEmbeddingResult {
+	return &UserServiceUserEmbeddingResult{}
 }
 
-func (p *UserServiceUserProfileUploadResult) InitDefault() {
-	*p = UserServiceUserProfileUploadResult{}
+func (p *UserServiceUserEmbeddingResult) InitDefault() {
+	*p = UserServiceUserEmbeddingResult{}
 }
 
-var UserServiceUserProfileUploadResult_Success_DEFAULT *UserProfileUploadResponse
+var UserServiceUserEmbeddingResult_Success_DEFAULT *UserEmbeddingResponse
 
-func (p *UserServiceUserProfileUploadResult) GetSuccess() (v *UserProfileUploadResponse) {
+func (p *UserServiceUserEmbeddingResult) GetSuccess() (v *UserEmbeddingResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUserProfileUploadResult_Success_DEFAULT
+		return UserServiceUserEmbeddingResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUserProfileUploadResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserProfileUploadResponse)
+func (p *UserServiceUserEmbeddingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserEmbeddingResponse)
 }
 
-var fieldIDToName_UserServiceUserProfileUploadResult = map[int16]string{
+var fieldIDToName_UserServiceUserEmbeddingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUserProfileUploadResult) IsSetSuccess() bool {
+func (p *UserServiceUserEmbeddingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUserProfileUploadResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserEmbeddingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6684,7 +8596,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserProfileUploadResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUserEmbeddingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6694,17 +8606,17 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileUploadResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = NewUserProfileUploadResponse()
+func (p *UserServiceUserEmbeddingResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = NewUserEmbeddingResponse()
 	if err := p.Success.Read(iprot); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (p *UserServiceUserProfileUploadResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserEmbeddingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserProfileUpload_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserEmbedding_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6731,7 +8643,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUserEmbeddingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6750,14 +8662,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUserProfileUploadResult) String() string {
+func (p *UserServiceUserEmbeddingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUserProfileUploadResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUserEmbeddingResult(%+v)", *p)
 }
 
-func (p *UserServiceUserProfileUploadResult) DeepEqual(ano *UserServiceUserProfileUploadResult) bool {
+func (p *UserServiceUserEmbeddingResult) DeepEqual(ano *UserServiceUserEmbeddingResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6769,7 +8681,7 @@ func (p *UserServiceUserProfileUploadResult) DeepEqual(ano *UserServiceUserProfi
 	return true
 }
 
-func (p *UserServiceUserProfileUploadResult) Field0DeepEqual(src *UserProfileUploadResponse) bool {
+func (p *UserServiceUserEmbeddingResult) Field0DeepEqual(src *UserEmbeddingResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -16,7 +16,9 @@ type Client interface {
 	UserInfo(ctx context.Context, req *user.UserInfoRequest, callOptions ...callopt.Option) (r *user.UserInfoResponse, err error)
 	UserInfoUpload(ctx context.Context, req *user.UserInfoUploadRequest, callOptions ...callopt.Option) (r *user.UserInfoUploadResponse, err error)
 	UserProfileInfo(ctx context.Context, req *user.UserProfileInfoRequest, callOptions ...callopt.Option) (r *user.UserProfileInfoResponse, err error)
+	UserProfileInfoBatch(ctx context.Context, req *user.UserProfileInfoBatchRequest, callOptions ...callopt.Option) (r *user.UserProfileInfoBatchResponse, err error)
 	UserProfileUpload(ctx context.Context, req *user.UserProfileUploadRequest, callOptions ...callopt.Option) (r *user.UserProfileUploadResponse, err error)
+	UserEmbedding(ctx context.Context, req *user.UserEmbeddingRequest, callOptions ...callopt.Option) (r *user.UserEmbeddingResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -73,7 +75,17 @@ func (p *kUserServiceClient) UserProfileInfo(ctx context.Context, req *user.User
 	return p.kClient.UserProfileInfo(ctx, req)
 }
 
+func (p *kUserServiceClient) UserProfileInfoBatch(ctx context.Context, req *user.UserProfileInfoBatchRequest, callOptions ...callopt.Option) (r *user.UserProfileInfoBatchResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UserProfileInfoBatch(ctx, req)
+}
+
 func (p *kUserServiceClient) UserProfileUpload(ctx context.Context, req *user.UserProfileUploadRequest, callOptions ...callopt.Option) (r *user.UserProfileUploadResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UserProfileUpload(ctx, req)
 }
+
+func (p *kUserServiceClient) UserEmbedding(ctx context.Context, req *user.UserEmbeddingRequest, callOptions ...callopt.Option) (r *user.UserEmbeddingResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UserEmbedding(ctx, req)
+}
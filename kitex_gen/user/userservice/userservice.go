@@ -19,12 +19,14 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "UserService"
 	handlerType := (*user.UserService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"UserRegister":      kitex.NewMethodInfo(userRegisterHandler, newUserServiceUserRegisterArgs, newUserServiceUserRegisterResult, false),
-		"UserLogin":         kitex.NewMethodInfo(userLoginHandler, newUserServiceUserLoginArgs, newUserServiceUserLoginResult, false),
-		"UserInfo":          kitex.NewMethodInfo(userInfoHandler, newUserServiceUserInfoArgs, newUserServiceUserInfoResult, false),
-		"UserInfoUpload":    kitex.NewMethodInfo(userInfoUploadHandler, newUserServiceUserInfoUploadArgs, newUserServiceUserInfoUploadResult, false),
-		"UserProfileInfo":   kitex.NewMethodInfo(userProfileInfoHandler, newUserServiceUserProfileInfoArgs, newUserServiceUserProfileInfoResult, false),
-		"UserProfileUpload": kitex.NewMethodInfo(userProfileUploadHandler, newUserServiceUserProfileUploadArgs, newUserServiceUserProfileUploadResult, false),
+		"UserRegister":         kitex.NewMethodInfo(userRegisterHandler, newUserServiceUserRegisterArgs, newUserServiceUserRegisterResult, false),
+		"UserLogin":            kitex.NewMethodInfo(userLoginHandler, newUserServiceUserLoginArgs, newUserServiceUserLoginResult, false),
+		"UserInfo":             kitex.NewMethodInfo(userInfoHandler, newUserServiceUserInfoArgs, newUserServiceUserInfoResult, false),
+		"UserInfoUpload":       kitex.NewMethodInfo(userInfoUploadHandler, newUserServiceUserInfoUploadArgs, newUserServiceUserInfoUploadResult, false),
+		"UserProfileInfo":      kitex.NewMethodInfo(userProfileInfoHandler, newUserServiceUserProfileInfoArgs, newUserServiceUserProfileInfoResult, false),
+		"UserProfileInfoBatch": kitex.NewMethodInfo(userProfileInfoBatchHandler, newUserServiceUserProfileInfoBatchArgs, newUserServiceUserProfileInfoBatchResult, false),
+		"UserProfileUpload":    kitex.NewMethodInfo(userProfileUploadHandler, newUserServiceUserProfileUploadArgs, newUserServiceUserProfileUploadResult, false),
+		"UserEmbedding":        kitex.NewMethodInfo(userEmbeddingHandler, newUserServiceUserEmbeddingArgs, newUserServiceUserEmbeddingResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "user",
@@ -131,6 +133,24 @@ func newUserServiceUserProfileInfoResult() interface{} {
 	return user.NewUserServiceUserProfileInfoResult()
 }
 
+func userProfileInfoBatchHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUserProfileInfoBatchArgs)
+	realResult := result.(*user.UserServiceUserProfileInfoBatchResult)
+	success, err := handler.(user.UserService).UserProfileInfoBatch(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUserProfileInfoBatchArgs() interface{} {
+	return user.NewUserServiceUserProfileInfoBatchArgs()
+}
+
+func newUserServiceUserProfileInfoBatchResult() interface{} {
+	return user.NewUserServiceUserProfileInfoBatchResult()
+}
+
 func userProfileUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*user.UserServiceUserProfileUploadArgs)
 	realResult := result.(*user.UserServiceUserProfileUploadResult)
@@ -149,6 +169,24 @@ func newUserServiceUserProfileUploadResult() interface{} {
 	return user.NewUserServiceUserProfileUploadResult()
 }
 
+func userEmbeddingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUserEmbeddingArgs)
+	realResult := result.(*user.UserServiceUserEmbeddingResult)
+	success, err := handler.(user.UserService).UserEmbedding(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUserEmbeddingArgs() interface{} {
+	return user.NewUserServiceUserEmbeddingArgs()
+}
+
+func newUserServiceUserEmbeddingResult() interface{} {
+	return user.NewUserServiceUserEmbeddingResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -209,6 +247,16 @@ func (p *kClient) UserProfileInfo(ctx context.Context, req *user.UserProfileInfo
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) UserProfileInfoBatch(ctx context.Context, req *user.UserProfileInfoBatchRequest) (r *user.UserProfileInfoBatchResponse, err error) {
+	var _args user.UserServiceUserProfileInfoBatchArgs
+	_args.Req = req
+	var _result user.UserServiceUserProfileInfoBatchResult
+	if err = p.c.Call(ctx, "UserProfileInfoBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) UserProfileUpload(ctx context.Context, req *user.UserProfileUploadRequest) (r *user.UserProfileUploadResponse, err error) {
 	var _args user.UserServiceUserProfileUploadArgs
 	_args.Req = req
@@ -218,3 +266,13 @@ func (p *kClient) UserProfileUpload(ctx context.Context, req *user.UserProfileUp
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) UserEmbedding(ctx context.Context, req *user.UserEmbeddingRequest) (r *user.UserEmbeddingResponse, err error) {
+	var _args user.UserServiceUserEmbeddingArgs
+	_args.Req = req
+	var _result user.UserServiceUserEmbeddingResult
+	if err = p.c.Call(ctx, "UserEmbedding", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,24 @@
+package application
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// DefaultExpireDays 入队申请提交后未被处理的默认过期天数
+const DefaultExpireDays = 7
+
+// ExpireDays 返回入队申请的过期天数，可通过 TEAM_APPLICATION_EXPIRE_DAYS 配置，设为 0 时申请不会过期
+func ExpireDays() int {
+	n, err := strconv.Atoi(os.Getenv("TEAM_APPLICATION_EXPIRE_DAYS"))
+	if err != nil || n < 0 {
+		return DefaultExpireDays
+	}
+	return n
+}
+
+// ExpireAfter 返回入队申请的有效期，申请不会过期时返回 0
+func ExpireAfter() time.Duration {
+	return time.Duration(ExpireDays()) * 24 * time.Hour
+}
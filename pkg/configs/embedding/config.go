@@ -0,0 +1,27 @@
+package embedding
+
+import "os"
+
+const (
+	// ProviderOpenAI 使用兼容 OpenAI 协议的远程 embedding 接口
+	ProviderOpenAI = "openai"
+	// ProviderLocal 使用本地哈希 embedding，无需网络
+	ProviderLocal = "local"
+	// ProviderFake 使用确定性的假 embedding，仅用于测试
+	ProviderFake = "fake"
+
+	// LocalDimension 是本地 embedding 的向量维度
+	LocalDimension = 512
+)
+
+// Provider 返回当前使用的 embedding 后端
+// 优先读取 EMBEDDING_PROVIDER，未设置时若存在 DASHSCOPE_API_KEY 则使用远程接口，否则使用本地后端
+func Provider() string {
+	if p := os.Getenv("EMBEDDING_PROVIDER"); p != "" {
+		return p
+	}
+	if os.Getenv("DASHSCOPE_API_KEY") != "" {
+		return ProviderOpenAI
+	}
+	return ProviderLocal
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// SinkFile 事件以 JSON Lines 格式追加写入本地文件
+	SinkFile = "file"
+	// SinkTable 事件追加写入数据库中的 feed_event 表
+	SinkTable = "table"
+
+	// DefaultDir 文件 sink 的默认目录
+	DefaultDir = "./log/events"
+	// DefaultBatchSize 每批写入的最大事件数
+	DefaultBatchSize = 200
+	// DefaultFlushInterval 未攒满一批时的最长写入间隔
+	DefaultFlushInterval = 5 * time.Second
+	// DefaultBufferSize 等待写入的事件缓冲区大小，缓冲区满时丢弃新事件
+	DefaultBufferSize = 10000
+)
+
+// Sink 返回事件的写入方式，可通过 EVENT_SINK 配置为 file 或 table，默认为 file
+func Sink() string {
+	if s := os.Getenv("EVENT_SINK"); s != "" {
+		return s
+	}
+	return SinkFile
+}
+
+// Dir 返回文件 sink 的目录，可通过 EVENT_LOG_DIR 配置
+func Dir() string {
+	if d := os.Getenv("EVENT_LOG_DIR"); d != "" {
+		return d
+	}
+	return DefaultDir
+}
+
+// BatchSize 返回每批写入的最大事件数，可通过 EVENT_BATCH_SIZE 配置
+func BatchSize() int {
+	n, err := strconv.Atoi(os.Getenv("EVENT_BATCH_SIZE"))
+	if err != nil || n <= 0 {
+		return DefaultBatchSize
+	}
+	return n
+}
+
+// FlushInterval 返回最长写入间隔，可通过 EVENT_FLUSH_INTERVAL 配置，如 10s
+func FlushInterval() time.Duration {
+	d, err := time.ParseDuration(os.Getenv("EVENT_FLUSH_INTERVAL"))
+	if err != nil || d <= 0 {
+		return DefaultFlushInterval
+	}
+	return d
+}
@@ -0,0 +1,199 @@
+package recommend
+
+import (
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultSemanticWeight 语义相似度在推荐分数中的默认权重
+	DefaultSemanticWeight = 0.6
+	// DefaultSkillWeight 技能匹配度在推荐分数中的默认权重
+	DefaultSkillWeight = 0.4
+
+	// DefaultContestSemanticWeight 用户档案与赛事的语义相似度在赛事推荐分数中的默认权重
+	DefaultContestSemanticWeight = 0.6
+	// DefaultContestFieldWeight 用户对赛事领域的偏好在赛事推荐分数中的默认权重
+	DefaultContestFieldWeight = 0.25
+	// DefaultContestFormatWeight 用户对赛事形式的偏好在赛事推荐分数中的默认权重
+	DefaultContestFormatWeight = 0.15
+)
+
+// ProficiencyWeights 用户技能熟练度对应的权重，未知的熟练度按 DefaultProficiencyWeight 计算
+var ProficiencyWeights = map[string]float64{
+	"一般": 0.4,
+	"良好": 0.6,
+	"熟练": 0.8,
+	"精通": 1.0,
+}
+
+// DefaultProficiencyWeight 未填写或无法识别的熟练度的权重
+const DefaultProficiencyWeight = 0.5
+
+// weight 读取非负的权重配置，未设置或格式错误时返回 def
+func weight(key string, def float64) float64 {
+	v, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || v < 0 {
+		return def
+	}
+	return v
+}
+
+// SemanticWeight 返回语义相似度的权重，可通过 RECOMMEND_SEMANTIC_WEIGHT 配置
+func SemanticWeight() float64 {
+	return weight("RECOMMEND_SEMANTIC_WEIGHT", DefaultSemanticWeight)
+}
+
+// SkillWeight 返回技能匹配度的权重，可通过 RECOMMEND_SKILL_WEIGHT 配置
+func SkillWeight() float64 {
+	return weight("RECOMMEND_SKILL_WEIGHT", DefaultSkillWeight)
+}
+
+// ContestSemanticWeight 返回赛事推荐中语义相似度的权重，可通过 RECOMMEND_CONTEST_SEMANTIC_WEIGHT 配置
+func ContestSemanticWeight() float64 {
+	return weight("RECOMMEND_CONTEST_SEMANTIC_WEIGHT", DefaultContestSemanticWeight)
+}
+
+// ContestFieldWeight 返回赛事推荐中领域偏好的权重，可通过 RECOMMEND_CONTEST_FIELD_WEIGHT 配置
+func ContestFieldWeight() float64 {
+	return weight("RECOMMEND_CONTEST_FIELD_WEIGHT", DefaultContestFieldWeight)
+}
+
+// ContestFormatWeight 返回赛事推荐中形式偏好的权重，可通过 RECOMMEND_CONTEST_FORMAT_WEIGHT 配置
+func ContestFormatWeight() float64 {
+	return weight("RECOMMEND_CONTEST_FORMAT_WEIGHT", DefaultContestFormatWeight)
+}
+
+// DefaultRerankDepth 使用重排模型重新打分的候选队伍数
+const DefaultRerankDepth = 50
+
+// RerankModelPath 返回重排模型文件的路径，可通过 RECOMMEND_RERANK_MODEL 配置，为空时不使用重排模型
+func RerankModelPath() string {
+	return os.Getenv("RECOMMEND_RERANK_MODEL")
+}
+
+// RerankDepth 返回使用重排模型重新打分的候选队伍数，可通过 RECOMMEND_RERANK_DEPTH 配置
+func RerankDepth() int {
+	n, err := strconv.Atoi(os.Getenv("RECOMMEND_RERANK_DEPTH"))
+	if err != nil || n <= 0 {
+		return DefaultRerankDepth
+	}
+	return n
+}
+
+const (
+	// DefaultDiversityLambda 多样性重排中相关性所占的比重，为 1 时不做多样性重排
+	DefaultDiversityLambda = 0.7
+	// DefaultDiversityDepth 参与多样性重排的候选队伍数
+	DefaultDiversityDepth = 50
+	// DefaultSeenLimit 用户看过但没有申请的队伍在该次数后被降到列表末尾
+	DefaultSeenLimit = 5
+	// DefaultSeenWindow 统计队伍曝光次数的时间范围
+	DefaultSeenWindow = 14 * 24 * time.Hour
+)
+
+// DiversityLambda 返回多样性重排中相关性所占的比重，取值范围 [0, 1]，可通过 RECOMMEND_DIVERSITY_LAMBDA 配置
+func DiversityLambda() float64 {
+	return math.Min(weight("RECOMMEND_DIVERSITY_LAMBDA", DefaultDiversityLambda), 1)
+}
+
+// DiversityDepth 返回参与多样性重排的候选队伍数，可通过 RECOMMEND_DIVERSITY_DEPTH 配置
+func DiversityDepth() int {
+	n, err := strconv.Atoi(os.Getenv("RECOMMEND_DIVERSITY_DEPTH"))
+	if err != nil || n <= 0 {
+		return DefaultDiversityDepth
+	}
+	return n
+}
+
+// SeenLimit 返回看过但未申请的队伍被降级所需的曝光次数，可通过 RECOMMEND_SEEN_LIMIT 配置，为 0 时不降级
+func SeenLimit() int {
+	n, err := strconv.Atoi(os.Getenv("RECOMMEND_SEEN_LIMIT"))
+	if err != nil || n < 0 {
+		return DefaultSeenLimit
+	}
+	return n
+}
+
+// SeenWindow 返回统计队伍曝光次数的时间范围，可通过 RECOMMEND_SEEN_WINDOW 配置，如 336h
+func SeenWindow() time.Duration {
+	d, err := time.ParseDuration(os.Getenv("RECOMMEND_SEEN_WINDOW"))
+	if err != nil || d <= 0 {
+		return DefaultSeenWindow
+	}
+	return d
+}
+
+const (
+	// DefaultPopularityApplicationWeight 近期申请数在热度分数中的默认权重
+	DefaultPopularityApplicationWeight = 0.5
+	// DefaultPopularityFillWeight 队伍人数占比在热度分数中的默认权重
+	DefaultPopularityFillWeight = 0.2
+	// DefaultPopularityRecencyWeight 队伍创建时间在热度分数中的默认权重
+	DefaultPopularityRecencyWeight = 0.3
+	// DefaultPopularityWindow 统计近期申请数的时间范围
+	DefaultPopularityWindow = 30 * 24 * time.Hour
+)
+
+// PopularityApplicationWeight 返回近期申请数在热度分数中的权重，可通过 RECOMMEND_POPULARITY_APPLICATION_WEIGHT 配置
+func PopularityApplicationWeight() float64 {
+	return weight("RECOMMEND_POPULARITY_APPLICATION_WEIGHT", DefaultPopularityApplicationWeight)
+}
+
+// PopularityFillWeight 返回队伍人数占比在热度分数中的权重，可通过 RECOMMEND_POPULARITY_FILL_WEIGHT 配置
+func PopularityFillWeight() float64 {
+	return weight("RECOMMEND_POPULARITY_FILL_WEIGHT", DefaultPopularityFillWeight)
+}
+
+// PopularityRecencyWeight 返回队伍创建时间在热度分数中的权重，可通过 RECOMMEND_POPULARITY_RECENCY_WEIGHT 配置
+func PopularityRecencyWeight() float64 {
+	return weight("RECOMMEND_POPULARITY_RECENCY_WEIGHT", DefaultPopularityRecencyWeight)
+}
+
+// PopularityWindow 返回统计近期申请数的时间范围，可通过 RECOMMEND_POPULARITY_WINDOW 配置，如 720h
+func PopularityWindow() time.Duration {
+	d, err := time.ParseDuration(os.Getenv("RECOMMEND_POPULARITY_WINDOW"))
+	if err != nil || d <= 0 {
+		return DefaultPopularityWindow
+	}
+	return d
+}
+
+// DefaultSnapshotTTL 个性化队伍列表排序快照的有效期，翻页期间排序保持不变
+const DefaultSnapshotTTL = 10 * time.Minute
+
+// SnapshotTTL 返回队伍列表排序快照的有效期，可通过 RECOMMEND_SNAPSHOT_TTL 配置，如 15m
+func SnapshotTTL() time.Duration {
+	d, err := time.ParseDuration(os.Getenv("RECOMMEND_SNAPSHOT_TTL"))
+	if err != nil || d <= 0 {
+		return DefaultSnapshotTTL
+	}
+	return d
+}
+
+// DefaultCandidatePoolSize 为队伍推荐候选队员时最多参与打分的用户数
+const DefaultCandidatePoolSize = 200
+
+// CandidatePoolSize 返回为队伍推荐候选队员时最多参与打分的用户数，可通过 RECOMMEND_CANDIDATE_POOL_SIZE 配置
+func CandidatePoolSize() int {
+	n, err := strconv.Atoi(os.Getenv("RECOMMEND_CANDIDATE_POOL_SIZE"))
+	if err != nil || n <= 0 {
+		return DefaultCandidatePoolSize
+	}
+	return n
+}
+
+// DefaultSearchTopK 个性化推荐时从向量索引中取出参与打分的队伍数
+const DefaultSearchTopK = 200
+
+// SearchTopK 返回个性化推荐时从向量索引中取出参与打分的队伍数，可通过 RECOMMEND_SEARCH_TOP_K 配置
+// 未进入前 K 个的队伍不参与个性化排序，在列表中排在推荐队伍之后
+func SearchTopK() int {
+	n, err := strconv.Atoi(os.Getenv("RECOMMEND_SEARCH_TOP_K"))
+	if err != nil || n <= 0 {
+		return DefaultSearchTopK
+	}
+	return n
+}
@@ -65,7 +65,9 @@ CREATE TABLE `team_info` (
   `leader_id` INT,
   `description` LONGTEXT,
   `embedding` JSON COMMENT '队伍信息的向量表示',
-  `embedding_updated_time` DATETIME COMMENT 'embedding更新时间'
+  `embedding_updated_time` DATETIME COMMENT 'embedding更新时间',
+  `embedding_version` VARCHAR(255) COMMENT '生成embedding时的模型与模板版本',
+  `closed` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '队伍已停止招募'
 );
 
 CREATE TABLE `team_application` (
@@ -74,7 +76,25 @@ CREATE TABLE `team_application` (
   `team_id` INT,
   `reason` TEXT,
   `created_time` DATETIME,
-  `application_type` INT COMMENT '申请类型，如退出申请，加入申请'
+  `application_type` INT COMMENT '申请类型，如退出申请，加入申请',
+  `status` VARCHAR(16) COMMENT '申请状态：pending、accepted、rejected、withdrawn、expired',
+  `decided_by` INT COMMENT '处理申请的用户：接受或拒绝时为队长，撤回时为申请人，过期时为 0',
+  `decided_time` DATETIME COMMENT '申请被处理、撤回或过期的时间',
+  INDEX `idx_team_application_status` (`status`)
+);
+
+CREATE TABLE `team_invitation` (
+  `invitation_id` INT PRIMARY KEY AUTO_INCREMENT,
+  `team_id` INT,
+  `inviter_id` INT COMMENT '发出邀请的队长',
+  `invitee_id` INT COMMENT '被邀请的用户',
+  `message` TEXT,
+  `created_time` DATETIME,
+  `status` VARCHAR(16) COMMENT '邀请状态：pending、accepted、declined、cancelled、expired',
+  `decided_time` DATETIME COMMENT '邀请被处理、取消或过期的时间',
+  INDEX `idx_team_invitation_team_id` (`team_id`),
+  INDEX `idx_team_invitation_invitee_id` (`invitee_id`),
+  INDEX `idx_team_invitation_status` (`status`)
 );
 
 CREATE TABLE `article` (
@@ -90,7 +110,10 @@ CREATE TABLE `article` (
 CREATE TABLE `team_user_relationship` (
   `team_user_id` INT PRIMARY KEY AUTO_INCREMENT,
   `user_id` INT,
-  `team_id` INT
+  `team_id` INT,
+  `contest_id` INT NOT NULL DEFAULT 0 COMMENT '队伍所属赛事',
+  UNIQUE INDEX `idx_user_contest` (`user_id`, `contest_id`),
+  INDEX `idx_team_user_relationship_team_id` (`team_id`)
 );
 
 CREATE TABLE `user_favorites` (
@@ -119,6 +142,51 @@ CREATE TABLE `team_skills` (
 
 );
 
+-- 队伍 embedding 异步任务表
+CREATE TABLE `team_embedding_job` (
+    `job_id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `team_id` INT,
+    `status` VARCHAR(20) NOT NULL COMMENT 'pending / running / succeeded / failed',
+    `attempts` INT NOT NULL DEFAULT 0,
+    `max_attempts` INT NOT NULL,
+    `next_run_time` DATETIME,
+    `last_error` TEXT COMMENT '最近一次失败的原因',
+    `created_time` DATETIME,
+    `updated_time` DATETIME,
+    INDEX `idx_team_id` (`team_id`),
+    INDEX `idx_status_next_run_time` (`status`, `next_run_time`)
+);
+
+-- 用户档案 embedding 表
+CREATE TABLE `user_embedding` (
+    `user_id` INT PRIMARY KEY,
+    `embedding` JSON COMMENT '用户档案文本的 embedding 向量',
+    `model` VARCHAR(255) COMMENT '生成 embedding 所用的模型',
+    `embedding_updated_time` DATETIME
+);
+
+-- 队伍推荐曝光记录，用于统计各实验分组的申请转化
+CREATE TABLE `feed_event` (
+    `event_id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `schema_version` INT NOT NULL COMMENT '事件格式的版本',
+    `type` VARCHAR(32) NOT NULL COMMENT 'impression, detail_view, application, accept 或 reject',
+    `source` VARCHAR(32) NOT NULL COMMENT '产生事件的服务',
+    `request_id` VARCHAR(32) COMMENT '同一次列表请求产生的曝光共享同一个 request_id',
+    `user_id` INT COMMENT '发起行为的用户',
+    `contest_id` INT,
+    `team_id` INT,
+    `rank` INT COMMENT '曝光在列表中的位置，从 1 开始',
+    `experiment` VARCHAR(64) COMMENT '实验标签',
+    `score` DOUBLE COMMENT '曝光时的推荐分数',
+    `application_id` INT,
+    `applicant_id` INT COMMENT '接受与拒绝事件中的申请人',
+    `created_time` DATETIME(3),
+    INDEX `idx_feed_event_type` (`type`),
+    INDEX `idx_feed_event_user_id` (`user_id`),
+    INDEX `idx_feed_event_created_time` (`created_time`),
+    INDEX `idx_experiment_created_time` (`experiment`, `created_time`)
+);
+
 ALTER TABLE `contest` COMMENT = '存储赛事板块';
 
 ALTER TABLE `contact` COMMENT = '存储竞赛负责人, contest的子表';
@@ -4,12 +4,15 @@ const (
 	SecretKey   = "secret key"
 	IdentityKey = "user_id"
 
+	ApiServiceName      = "api"
 	UserServiceName     = "user"
 	ContestServiceName  = "contest"
 	TeamServiceName     = "team"
 	FavoriteServiceName = "favorite"
 	ArticleServiceName  = "article"
 
+	EmbeddingJobMaxAttempts = 5 // 队伍 embedding 任务的最大尝试次数
+
 	//CPURateLimit float64 = 80.0
 	//
 	//MaxVideoSize int64 = 128 * 1024 * 1024 // 可上传的单个视频大小最大为 128 MB
@@ -0,0 +1,50 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+
+	conf "github.com/Yra-A/Fusion_Go/pkg/configs/embedding"
+	"github.com/Yra-A/Fusion_Go/pkg/configs/openai"
+)
+
+// Embedder 将文本转换为向量
+type Embedder interface {
+	// Embed 生成单条文本的 embedding
+	Embed(ctx context.Context, text string) ([]float64, error)
+	// Model 返回生成 embedding 所使用的模型名称
+	Model() string
+}
+
+var defaultEmbedder Embedder
+
+// Init 按配置初始化默认的 embedding 后端
+func Init() {
+	e, err := New(conf.Provider())
+	if err != nil {
+		panic(err)
+	}
+	defaultEmbedder = e
+}
+
+// Default 返回 Init 初始化的 embedding 后端
+func Default() Embedder {
+	return defaultEmbedder
+}
+
+// New 根据 provider 创建对应的 embedding 后端
+func New(provider string) (Embedder, error) {
+	switch provider {
+	case conf.ProviderOpenAI:
+		client, err := openai.NewClient()
+		if err != nil {
+			return nil, err
+		}
+		return NewOpenAI(client, openai.EmbeddingModel), nil
+	case conf.ProviderLocal:
+		return NewLocal(conf.LocalDimension), nil
+	case conf.ProviderFake:
+		return NewFake(conf.LocalDimension), nil
+	}
+	return nil, fmt.Errorf("unknown embedding provider: %s", provider)
+}
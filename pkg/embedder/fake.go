@@ -0,0 +1,62 @@
+package embedder
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+)
+
+// FakeEmbedder 用于测试的 embedding 后端
+// 未在 Vectors 中预设的文本会根据文本哈希生成确定的随机向量
+type FakeEmbedder struct {
+	Dim     int
+	Vectors map[string][]float64
+	// Err 不为空时 Embed 直接返回该错误
+	Err error
+
+	mu    sync.Mutex
+	calls int
+}
+
+// NewFake 创建一个新的 FakeEmbedder 实例
+func NewFake(dim int) *FakeEmbedder {
+	return &FakeEmbedder{
+		Dim:     dim,
+		Vectors: make(map[string][]float64),
+	}
+}
+
+// Embed 返回预设向量或确定的随机向量
+func (e *FakeEmbedder) Embed(_ context.Context, text string) ([]float64, error) {
+	e.mu.Lock()
+	e.calls++
+	e.mu.Unlock()
+	if e.Err != nil {
+		return nil, e.Err
+	}
+	if vec, ok := e.Vectors[text]; ok {
+		return vec, nil
+	}
+	h := fnv.New64a()
+	h.Write([]byte(text))
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+	vec := make([]float64, e.Dim)
+	for i := range vec {
+		vec[i] = r.NormFloat64()
+	}
+	normalize(vec)
+	return vec, nil
+}
+
+// Model 返回假模型名称
+func (e *FakeEmbedder) Model() string {
+	return "fake"
+}
+
+// Calls 返回 Embed 被调用的次数
+func (e *FakeEmbedder) Calls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
@@ -0,0 +1,102 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// LocalEmbedder 基于特征哈希的本地 embedding，无需网络且结果确定
+// 英文按单词切分，中文按单字和相邻双字切分，词频取对数后哈希到固定维度并做 L2 归一化
+type LocalEmbedder struct {
+	dim int
+}
+
+// NewLocal 创建一个新的 LocalEmbedder 实例
+func NewLocal(dim int) *LocalEmbedder {
+	return &LocalEmbedder{dim: dim}
+}
+
+// Embed 在本地生成 embedding
+func (e *LocalEmbedder) Embed(_ context.Context, text string) ([]float64, error) {
+	tf := make(map[string]int)
+	for _, token := range tokenize(text) {
+		tf[token]++
+	}
+	// 排序保证浮点累加顺序一致，结果完全确定
+	tokens := make([]string, 0, len(tf))
+	for token := range tf {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	vec := make([]float64, e.dim)
+	for _, token := range tokens {
+		h := fnv.New64a()
+		h.Write([]byte(token))
+		sum := h.Sum64()
+		weight := 1 + math.Log(float64(tf[token]))
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vec[sum%uint64(e.dim)] += weight
+	}
+	normalize(vec)
+	return vec, nil
+}
+
+// Model 返回本地模型名称
+func (e *LocalEmbedder) Model() string {
+	return fmt.Sprintf("local-hash-%d", e.dim)
+}
+
+// tokenize 将文本切分为英文单词、中文单字和中文双字
+func tokenize(text string) []string {
+	var tokens []string
+	var word strings.Builder
+	var prevHan rune
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+			if prevHan != 0 {
+				tokens = append(tokens, string([]rune{prevHan, r}))
+			}
+			prevHan = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#':
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+		prevHan = 0
+	}
+	flush()
+	return tokens
+}
+
+// normalize 对向量做 L2 归一化，零向量保持不变
+func normalize(vec []float64) {
+	var norm float64
+	for _, v := range vec {
+		norm += v * v
+	}
+	if norm == 0 {
+		return
+	}
+	norm = math.Sqrt(norm)
+	for i := range vec {
+		vec[i] /= norm
+	}
+}
@@ -0,0 +1,69 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestLocalEmbedder 测试本地 embedding 的确定性、归一化以及语义相近文本的相似度
+func TestLocalEmbedder(t *testing.T) {
+	ctx := context.Background()
+	e := NewLocal(256)
+
+	a1, err := e.Embed(ctx, "岗位名称：Go 后端\n技能要求：Go, MySQL, Redis")
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	a2, _ := e.Embed(ctx, "岗位名称：Go 后端\n技能要求：Go, MySQL, Redis")
+	if len(a1) != 256 {
+		t.Fatalf("Embed() dimension = %d, want 256", len(a1))
+	}
+	for i := range a1 {
+		if a1[i] != a2[i] {
+			t.Fatalf("Embed() should be deterministic")
+		}
+	}
+	if n := Cosine(a1, a1); math.Abs(n-1) > 1e-9 {
+		t.Errorf("Embed() should be L2 normalized, self cosine = %v", n)
+	}
+
+	// 语义相近的文本应比无关文本更相似
+	near, _ := e.Embed(ctx, "熟悉 Go 后端开发，了解 MySQL 和 Redis")
+	far, _ := e.Embed(ctx, "擅长平面设计和海报制作，熟练使用 Photoshop")
+	if Cosine(a1, near) <= Cosine(a1, far) {
+		t.Errorf("related text similarity %v should be greater than unrelated %v", Cosine(a1, near), Cosine(a1, far))
+	}
+
+	// 空文本返回零向量而不是报错
+	empty, err := e.Embed(ctx, "")
+	if err != nil || len(empty) != 256 {
+		t.Errorf("Embed(\"\") = %v, %v", len(empty), err)
+	}
+}
+
+// TestFakeEmbedder 测试假 embedding 的预设向量与错误注入
+func TestFakeEmbedder(t *testing.T) {
+	ctx := context.Background()
+	e := NewFake(8)
+	e.Vectors["preset"] = []float64{1, 0, 0, 0, 0, 0, 0, 0}
+
+	v, _ := e.Embed(ctx, "preset")
+	if v[0] != 1 {
+		t.Errorf("Embed() should return preset vector")
+	}
+	x, _ := e.Embed(ctx, "other")
+	y, _ := e.Embed(ctx, "other")
+	if Cosine(x, y) < 1-1e-9 {
+		t.Errorf("Embed() should be deterministic for the same text")
+	}
+
+	e.Err = errors.New("boom")
+	if _, err := e.Embed(ctx, "other"); err == nil {
+		t.Errorf("Embed() should return injected error")
+	}
+	if e.Calls() != 4 {
+		t.Errorf("Calls() = %d, want 4", e.Calls())
+	}
+}
@@ -0,0 +1,48 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// OpenAIEmbedder 调用兼容 OpenAI 协议的接口生成 embedding
+type OpenAIEmbedder struct {
+	client *openai.Client
+	model  string
+}
+
+// NewOpenAI 创建一个新的 OpenAIEmbedder 实例
+func NewOpenAI(client *openai.Client, model string) *OpenAIEmbedder {
+	return &OpenAIEmbedder{
+		client: client,
+		model:  model,
+	}
+}
+
+// Embed 调用远程接口生成 embedding
+func (e *OpenAIEmbedder) Embed(ctx context.Context, text string) ([]float64, error) {
+	resp, err := e.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
+		Input: text,
+		Model: openai.EmbeddingModel(e.model),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedding: %v", err)
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("no embedding data returned")
+	}
+
+	// 将 float32 转换为 float64
+	embedding := make([]float64, len(resp.Data[0].Embedding))
+	for i, v := range resp.Data[0].Embedding {
+		embedding[i] = float64(v)
+	}
+	return embedding, nil
+}
+
+// Model 返回远程模型名称
+func (e *OpenAIEmbedder) Model() string {
+	return e.model
+}
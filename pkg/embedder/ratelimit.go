@@ -0,0 +1,29 @@
+package embedder
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitedEmbedder 限制调用频率的 embedding 后端，用于批量生成时避免触发上游限流
+type RateLimitedEmbedder struct {
+	Embedder
+	limiter *rate.Limiter
+}
+
+// NewRateLimited 包装 e，使 Embed 每秒最多调用 qps 次
+func NewRateLimited(e Embedder, qps float64) *RateLimitedEmbedder {
+	return &RateLimitedEmbedder{
+		Embedder: e,
+		limiter:  rate.NewLimiter(rate.Limit(qps), 1),
+	}
+}
+
+// Embed 等待令牌后调用被包装的后端
+func (e *RateLimitedEmbedder) Embed(ctx context.Context, text string) ([]float64, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return e.Embedder.Embed(ctx, text)
+}
@@ -0,0 +1,48 @@
+package embedder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Yra-A/Fusion_Go/kitex_gen/user"
+)
+
+// genderText 将性别数字转换为文字描述
+func genderText(gender int32) string {
+	switch gender {
+	case 1:
+		return "男"
+	case 2:
+		return "女"
+	default:
+		return "未知"
+	}
+}
+
+// UserProfileText 构建用于生成用户 embedding 的文本描述
+func UserProfileText(p *user.UserProfileInfo) string {
+	info := p.UserInfo
+	if info == nil {
+		info = &user.UserInfo{}
+	}
+	skills := make([]string, 0, len(p.UserSkills))
+	for _, s := range p.UserSkills {
+		if s == nil {
+			continue
+		}
+		skills = append(skills, fmt.Sprintf("%s（%s，%s）", s.Skill, s.Category, s.Proficiency))
+	}
+	return fmt.Sprintf("性别: %s, 入学年份: %d, 学院: %s, 昵称: %s, 自我介绍: %s, 荣誉: %s, 技能: %s",
+		genderText(info.Gender),
+		info.EnrollmentYear,
+		info.College,
+		info.Nickname,
+		p.Introduction,
+		strings.Join(p.Honors, "、"),
+		strings.Join(skills, "、"))
+}
+
+// ContestText 构建用于生成赛事 embedding 的文本描述
+func ContestText(title string, description string, requirements string) string {
+	return fmt.Sprintf("赛事名称: %s\n赛事简介: %s\n参赛要求: %s", title, description, requirements)
+}
@@ -18,6 +18,17 @@ const (
 	EmptyUsernameOrPasswordErrCode = 10008
 	ContestNotExistErrCode         = 10009
 	ArticleNotExistErrCode         = 10010
+	ApplicationNotPendingErrCode   = 10011
+	ApplicationDuplicateErrCode    = 10012
+	TeamClosedErrCode              = 10013
+	NotTeamMemberErrCode           = 10014
+	LeaderCannotLeaveErrCode       = 10015
+	TeamFullErrCode                = 10016
+	AlreadyInContestTeamErrCode    = 10017
+	InvitationNotPendingErrCode    = 10018
+	InvitationDuplicateErrCode     = 10019
+	ListCursorExpiredErrCode       = 10020
+	TeamEmbeddingNotReadyErrCode   = 10021
 )
 
 type ErrNo struct {
@@ -51,6 +62,17 @@ var (
 	EmptyUsernameOrPasswordErr = NewErrNo(EmptyUsernameOrPasswordErrCode, "用户名或密码为空")
 	ContestNotExistErr         = NewErrNo(ContestNotExistErrCode, "赛事不存在")
 	ArticleNotExistErr         = NewErrNo(ArticleNotExistErrCode, "文章不存在")
+	ApplicationNotPendingErr   = NewErrNo(ApplicationNotPendingErrCode, "申请已被处理、撤回或已过期")
+	ApplicationDuplicateErr    = NewErrNo(ApplicationDuplicateErrCode, "已有待处理的入队申请")
+	TeamClosedErr              = NewErrNo(TeamClosedErrCode, "队伍已停止招募")
+	NotTeamMemberErr           = NewErrNo(NotTeamMemberErrCode, "用户不是队伍成员")
+	LeaderCannotLeaveErr       = NewErrNo(LeaderCannotLeaveErrCode, "队长需要先转让队长身份或解散队伍")
+	TeamFullErr                = NewErrNo(TeamFullErrCode, "队伍人数已达到赛事上限")
+	AlreadyInContestTeamErr    = NewErrNo(AlreadyInContestTeamErrCode, "用户已加入该赛事的队伍")
+	InvitationNotPendingErr    = NewErrNo(InvitationNotPendingErrCode, "邀请已被处理、取消或已过期")
+	InvitationDuplicateErr     = NewErrNo(InvitationDuplicateErrCode, "已向该用户发出待处理的邀请")
+	ListCursorExpiredErr       = NewErrNo(ListCursorExpiredErrCode, "列表游标已过期，请从第一页重新获取")
+	TeamEmbeddingNotReadyErr   = NewErrNo(TeamEmbeddingNotReadyErrCode, "队伍的推荐信息正在生成，请稍后再试")
 )
 
 // ConvertErr convert error to Errno
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// SchemaVersion 事件格式的版本，字段含义变化时递增，新增字段不改变版本
+const SchemaVersion = 1
+
+// 事件类型
+const (
+	// TypeImpression 队伍出现在用户看到的队伍列表中
+	TypeImpression = "impression"
+	// TypeDetailView 用户查看队伍详情
+	TypeDetailView = "detail_view"
+	// TypeApplication 用户提交入队申请
+	TypeApplication = "application"
+	// TypeAccept 队长接受入队申请
+	TypeAccept = "accept"
+	// TypeReject 队长拒绝入队申请
+	TypeReject = "reject"
+	// TypeWithdraw 申请人撤回入队申请
+	TypeWithdraw = "withdraw"
+)
+
+// Event 队伍信息流中的一次曝光或交互，文件 sink 与 table sink 使用相同的字段
+type Event struct {
+	EventID       int64     `gorm:"primary_key;column:event_id;autoIncrement" json:"-"`
+	SchemaVersion int       `gorm:"column:schema_version" json:"schema_version"`
+	Type          string    `gorm:"column:type;size:32;index" json:"type"`
+	Source        string    `gorm:"column:source;size:32" json:"source"`         // 产生事件的服务
+	RequestID     string    `gorm:"column:request_id;size:32" json:"request_id"` // 同一次列表请求产生的曝光共享同一个 request_id
+	UserID        int32     `gorm:"column:user_id;index" json:"user_id"`         // 发起行为的用户，曝光与查看时为浏览者，接受与拒绝时为队长
+	ContestID     int32     `gorm:"column:contest_id" json:"contest_id"`         // 未知时为 0
+	TeamID        int32     `gorm:"column:team_id" json:"team_id"`
+	Rank          int32     `gorm:"column:rank" json:"rank"`                                                       // 曝光在列表中的位置，从 1 开始，其余事件为 0
+	Experiment    string    `gorm:"column:experiment;size:64;index:idx_experiment_created_time" json:"experiment"` // 曝光所在的实验标签，格式为 实验名/分组名
+	Score         float64   `gorm:"column:score" json:"score"`                                                     // 曝光时的推荐分数，未使用推荐排序时为 0
+	ApplicationID int32     `gorm:"column:application_id" json:"application_id"`                                   // 申请、接受与拒绝事件对应的申请
+	ApplicantID   int32     `gorm:"column:applicant_id" json:"applicant_id"`                                       // 接受与拒绝事件中的申请人
+	CreatedTime   time.Time `gorm:"column:created_time;index;index:idx_experiment_created_time" json:"created_time"`
+}
+
+func (Event) TableName() string {
+	return "feed_event"
+}
+
+// NewRequestID 生成用于关联同一次请求中多个事件的随机 ID
+func NewRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,152 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	conf "github.com/Yra-A/Fusion_Go/pkg/configs/events"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+// Recorder 在后台攒批写入事件，记录事件不会阻塞调用方
+type Recorder struct {
+	source    string
+	sink      Sink
+	batchSize int
+	interval  time.Duration
+
+	mu      sync.RWMutex
+	closed  bool
+	events  chan *Event
+	done    chan struct{}
+	dropped int64
+}
+
+// NewRecorder 创建 Recorder 并启动后台写入，攒满 batchSize 个事件或距上次写入超过 interval 时写入一批
+func NewRecorder(source string, sink Sink, batchSize int, interval time.Duration) *Recorder {
+	r := &Recorder{
+		source:    source,
+		sink:      sink,
+		batchSize: batchSize,
+		interval:  interval,
+		events:    make(chan *Event, conf.DefaultBufferSize),
+		done:      make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Record 记录一个事件，补全 schema 版本、来源与时间；缓冲区已满或已关闭时丢弃该事件
+func (r *Recorder) Record(e *Event) {
+	e.SchemaVersion = SchemaVersion
+	e.Source = r.source
+	if e.CreatedTime.IsZero() {
+		e.CreatedTime = time.Now()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		atomic.AddInt64(&r.dropped, 1)
+		return
+	}
+	select {
+	case r.events <- e:
+	default:
+		atomic.AddInt64(&r.dropped, 1)
+	}
+}
+
+// Dropped 返回因缓冲区已满或已关闭而丢弃的事件数
+func (r *Recorder) Dropped() int64 {
+	return atomic.LoadInt64(&r.dropped)
+}
+
+// Close 停止接收事件，并等待缓冲区中的事件写入完成
+func (r *Recorder) Close() {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.events)
+	}
+	r.mu.Unlock()
+	<-r.done
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	batch := make([]*Event, 0, r.batchSize)
+	for {
+		select {
+		case e, ok := <-r.events:
+			if !ok {
+				r.flush(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) >= r.batchSize {
+				r.flush(batch)
+				batch = make([]*Event, 0, r.batchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				r.flush(batch)
+				batch = make([]*Event, 0, r.batchSize)
+			}
+		}
+	}
+}
+
+// flush 写入一批事件，写入失败时丢弃这批事件，避免阻塞后续事件
+func (r *Recorder) flush(batch []*Event) {
+	if len(batch) == 0 {
+		return
+	}
+	if err := r.sink.Write(batch); err != nil {
+		atomic.AddInt64(&r.dropped, int64(len(batch)))
+		klog.Errorf("写入 %d 个 %s 事件失败: %v", len(batch), r.source, err)
+	}
+}
+
+var defaultRecorder *Recorder
+
+// Init 按配置初始化服务的默认 Recorder，db 为 nil 时只能使用文件 sink
+func Init(source string, db *gorm.DB) {
+	sink, err := NewSink(conf.Sink(), source, db)
+	if err != nil {
+		panic(err)
+	}
+	defaultRecorder = NewRecorder(source, sink, conf.BatchSize(), conf.FlushInterval())
+}
+
+// InitTable 初始化服务的默认 Recorder，不论 EVENT_SINK 的配置都写入 feed_event 表
+// 用于在线读取曝光事件的服务，如 team 服务的看过降级与实验转化统计
+func InitTable(source string, db *gorm.DB) {
+	sink, err := NewTableSink(db)
+	if err != nil {
+		panic(err)
+	}
+	defaultRecorder = NewRecorder(source, sink, conf.BatchSize(), conf.FlushInterval())
+}
+
+// Record 通过默认 Recorder 记录事件，未初始化时忽略
+func Record(e *Event) {
+	if defaultRecorder == nil {
+		return
+	}
+	defaultRecorder.Record(e)
+}
+
+// Close 写入默认 Recorder 中剩余的事件
+func Close() {
+	if defaultRecorder == nil {
+		return
+	}
+	defaultRecorder.Close()
+	if n := defaultRecorder.Dropped(); n > 0 {
+		klog.Warnf("共丢弃 %d 个 %s 事件", n, defaultRecorder.source)
+	}
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memSink struct {
+	mu      sync.Mutex
+	batches [][]*Event
+}
+
+func (s *memSink) Write(events []*Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+// TestRecorder 测试按批大小写入，并在关闭时写入剩余事件
+func TestRecorder(t *testing.T) {
+	sink := &memSink{}
+	r := NewRecorder("team", sink, 2, time.Hour)
+	for i := int32(1); i <= 5; i++ {
+		r.Record(&Event{Type: TypeImpression, TeamID: i, Rank: i})
+	}
+	r.Close()
+	r.Record(&Event{Type: TypeImpression})
+
+	if len(sink.batches) != 3 || len(sink.batches[0]) != 2 || len(sink.batches[2]) != 1 {
+		t.Fatalf("Recorder batches = %v, want sizes 2, 2, 1", sink.batches)
+	}
+	e := sink.batches[0][0]
+	if e.SchemaVersion != SchemaVersion || e.Source != "team" || e.CreatedTime.IsZero() {
+		t.Errorf("Record() did not fill defaults: %+v", e)
+	}
+	if r.Dropped() != 1 {
+		t.Errorf("Dropped() = %d, want 1", r.Dropped())
+	}
+}
+
+// TestFileSink 测试事件按日期追加写入 JSON Lines 文件
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFileSink(dir, "api")
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	day := time.Date(2024, 3, 1, 12, 0, 0, 0, time.Local)
+	for i := 0; i < 2; i++ {
+		if err := s.Write([]*Event{{Type: TypeDetailView, UserID: 1, TeamID: int32(i + 10), CreatedTime: day}}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	f, err := os.Open(s.path(day))
+	if err != nil {
+		t.Fatalf("open event file: %v", err)
+	}
+	defer f.Close()
+	var teams []int32
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var e Event
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			t.Fatalf("decode event: %v", err)
+		}
+		teams = append(teams, e.TeamID)
+	}
+	if len(teams) != 2 || teams[0] != 10 || teams[1] != 11 {
+		t.Errorf("event file teams = %v, want [10 11]", teams)
+	}
+}
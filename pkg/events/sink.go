@@ -0,0 +1,107 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	conf "github.com/Yra-A/Fusion_Go/pkg/configs/events"
+	"gorm.io/gorm"
+)
+
+// Sink 批量追加写入事件
+type Sink interface {
+	Write(events []*Event) error
+}
+
+// NewSink 根据 kind 创建 sink，table sink 需要传入数据库连接
+func NewSink(kind string, source string, db *gorm.DB) (Sink, error) {
+	switch kind {
+	case conf.SinkFile:
+		return NewFileSink(conf.Dir(), source)
+	case conf.SinkTable:
+		if db == nil {
+			return nil, fmt.Errorf("event sink %q requires a database", kind)
+		}
+		return NewTableSink(db)
+	default:
+		return nil, fmt.Errorf("unknown event sink: %s", kind)
+	}
+}
+
+// FileSink 以 JSON Lines 格式将事件追加写入本地文件，每个服务每天一个文件
+type FileSink struct {
+	dir    string
+	prefix string
+}
+
+// NewFileSink 创建写入 dir 目录的文件 sink，文件名为 <prefix>-<日期>.jsonl
+func NewFileSink(dir string, prefix string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileSink{dir: dir, prefix: prefix}, nil
+}
+
+func (s *FileSink) path(t time.Time) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%s-%s.jsonl", s.prefix, t.Format("20060102")))
+}
+
+// Write 按事件发生的日期追加写入对应的文件
+func (s *FileSink) Write(events []*Event) error {
+	byPath := make(map[string][]*Event)
+	var paths []string
+	for _, e := range events {
+		p := s.path(e.CreatedTime)
+		if _, ok := byPath[p]; !ok {
+			paths = append(paths, p)
+		}
+		byPath[p] = append(byPath[p], e)
+	}
+	for _, p := range paths {
+		if err := appendLines(p, byPath[p]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendLines(path string, events []*Event) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// TableSink 将事件追加写入 feed_event 表
+type TableSink struct {
+	db *gorm.DB
+}
+
+// NewTableSink 创建 table sink，并在表不存在时建表
+func NewTableSink(db *gorm.DB) (*TableSink, error) {
+	if err := db.AutoMigrate(&Event{}); err != nil {
+		return nil, err
+	}
+	return &TableSink{db: db}, nil
+}
+
+func (s *TableSink) Write(events []*Event) error {
+	return s.db.CreateInBatches(events, len(events)).Error
+}
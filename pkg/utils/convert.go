@@ -197,13 +197,31 @@ func ConvertTeamBriefInfoToAPI(src *team.TeamBriefInfo) *api.TeamBriefInfo {
 		return nil
 	}
 	return &api.TeamBriefInfo{
-		ContestID:    src.ContestId,
-		TeamID:       src.TeamId,
-		Title:        src.Title,
-		Goal:         src.Goal,
-		CurPeopleNum: src.CurPeopleNum,
-		CreatedTime:  src.CreatedTime,
-		LeaderInfo:   ConvertMemberInfoToAPI(src.LeaderInfo),
+		ContestID:      src.ContestId,
+		TeamID:         src.TeamId,
+		Title:          src.Title,
+		Goal:           src.Goal,
+		CurPeopleNum:   src.CurPeopleNum,
+		CreatedTime:    src.CreatedTime,
+		LeaderInfo:     ConvertMemberInfoToAPI(src.LeaderInfo),
+		Recommendation: ConvertTeamRecommendationToAPI(src.Recommendation),
+		Closed:         src.Closed,
+	}
+}
+
+func ConvertTeamRecommendationToAPI(src *team.TeamRecommendation) *api.TeamRecommendation {
+	if src == nil {
+		return nil
+	}
+	return &api.TeamRecommendation{
+		Score:           src.Score,
+		SemanticScore:   src.SemanticScore,
+		SkillScore:      src.SkillScore,
+		Job:             src.Job,
+		MatchedSkills:   src.MatchedSkills,
+		TimeBoost:       src.TimeBoost,
+		PopularityScore: src.PopularityScore,
+		Reason:          src.Reason,
 	}
 }
 
@@ -230,6 +248,9 @@ func ConvertApplicationToAPI(src *team.TeamApplication) *api.TeamApplication {
 		CreatedTime:     src.CreatedTime,
 		ApplicationType: src.ApplicationType,
 		MemberInfo:      ConvertMemberInfoToAPI(src.MemberInfo),
+		Status:          src.Status,
+		DecidedTime:     src.DecidedTime,
+		DecidedBy:       src.DecidedBy,
 	}
 }
 
@@ -240,6 +261,88 @@ func ConvertApplicationListToAPI(applicationList []*team.TeamApplication) (apiAp
 	return
 }
 
+func ConvertInvitationToAPI(src *team.TeamInvitation) *api.TeamInvitation {
+	if src == nil {
+		return nil
+	}
+	return &api.TeamInvitation{
+		InvitationID: src.InvitationId,
+		TeamID:       src.TeamId,
+		TeamTitle:    src.TeamTitle,
+		ContestID:    src.ContestId,
+		InviterInfo:  ConvertMemberInfoToAPI(src.InviterInfo),
+		InviteeID:    src.InviteeId,
+		Message:      src.Message,
+		CreatedTime:  src.CreatedTime,
+		Status:       src.Status,
+		DecidedTime:  src.DecidedTime,
+	}
+}
+
+func ConvertInvitationListToAPI(invitationList []*team.TeamInvitation) (apiInvitationList []*api.TeamInvitation) {
+	for _, invitation := range invitationList {
+		apiInvitationList = append(apiInvitationList, ConvertInvitationToAPI(invitation))
+	}
+	return
+}
+
+func ConvertMyApplicationToAPI(src *team.MyApplication) *api.MyApplication {
+	if src == nil {
+		return nil
+	}
+	return &api.MyApplication{
+		Application:  ConvertApplicationToAPI(src.Application),
+		TeamTitle:    src.TeamTitle,
+		ContestID:    src.ContestId,
+		ContestTitle: src.ContestTitle,
+	}
+}
+
+func ConvertMyApplicationListToAPI(applicationList []*team.MyApplication) (apiApplicationList []*api.MyApplication) {
+	for _, application := range applicationList {
+		apiApplicationList = append(apiApplicationList, ConvertMyApplicationToAPI(application))
+	}
+	return
+}
+
+func ConvertMyTeamToAPI(src *team.MyTeam) *api.MyTeam {
+	if src == nil {
+		return nil
+	}
+	return &api.MyTeam{
+		TeamBriefInfo: ConvertTeamBriefInfoToAPI(src.TeamBriefInfo),
+		ContestTitle:  src.ContestTitle,
+		Role:          src.Role,
+	}
+}
+
+func ConvertMyTeamListToAPI(teamList []*team.MyTeam) (apiTeamList []*api.MyTeam) {
+	for _, t := range teamList {
+		apiTeamList = append(apiTeamList, ConvertMyTeamToAPI(t))
+	}
+	return
+}
+
+func ConvertJobCandidatesListToAPI(jobCandidatesList []*team.JobCandidates) (apiJobCandidatesList []*api.JobCandidates) {
+	for _, jobCandidates := range jobCandidatesList {
+		if jobCandidates == nil {
+			continue
+		}
+		candidates := make([]*api.TeamCandidate, 0, len(jobCandidates.Candidates))
+		for _, c := range jobCandidates.Candidates {
+			candidates = append(candidates, &api.TeamCandidate{
+				MemberInfo: ConvertMemberInfoToAPI(c.MemberInfo),
+				Score:      c.Score,
+			})
+		}
+		apiJobCandidatesList = append(apiJobCandidatesList, &api.JobCandidates{
+			Job:        jobCandidates.Job,
+			Candidates: candidates,
+		})
+	}
+	return
+}
+
 func ConvertContestBriefToFavoriteBrief(src *contest.ContestBrief) *favorite.ContestBrief {
 	if src == nil {
 		return nil